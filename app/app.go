@@ -221,15 +221,15 @@ func New(
 	)
 	transferModule := transfer.NewAppModule(app.TransferKeeper)
 
-	// Create static IBC router, add transfer route, then set and seal it
-	ibcRouter := porttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferModule)
-	app.IBCKeeper.SetRouter(ibcRouter)
-
 	app.thorchainKeeper = thorchainkeeper.NewKeeper(
 		appCodec, app.BankKeeper, app.AccountKeeper, app.TransferKeeper, keys[thorchaintypes.StoreKey],
 	)
 
+	// Create static IBC router, add transfer route, then set and seal it
+	ibcRouter := porttypes.NewRouter()
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, mayachain.NewIBCMiddleware(transferModule, app.thorchainKeeper))
+	app.IBCKeeper.SetRouter(ibcRouter)
+
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
 
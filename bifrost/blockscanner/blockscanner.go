@@ -30,6 +30,14 @@ type BlockScannerFetcher interface {
 	GetHeight() (int64, error)
 }
 
+// BlockNotifier is implemented by chain scanners that are notified of new
+// blocks, the block scanner waits on the notifications instead of polling the
+// chain height
+type BlockNotifier interface {
+	// BlockNotifications return the channel the new block heights are sent to
+	BlockNotifications() <-chan int64
+}
+
 type Block struct {
 	Height int64
 	Txs    []string
@@ -218,7 +226,7 @@ func (b *BlockScanner) scanBlocks() {
 				continue
 			}
 			if chainHeight < currentBlock {
-				time.Sleep(b.cfg.BlockHeightDiscoverBackoff)
+				b.waitForBlock()
 				continue
 			}
 			txIn, err := b.chainScanner.FetchTxs(currentBlock)
@@ -263,6 +271,21 @@ func (b *BlockScanner) scanBlocks() {
 	}
 }
 
+// waitForBlock backs off until the next block is expected, a chain scanner that
+// is notified of new blocks wakes the scanner up as soon as one is committed
+func (b *BlockScanner) waitForBlock() {
+	notifier, ok := b.chainScanner.(BlockNotifier)
+	if !ok {
+		time.Sleep(b.cfg.BlockHeightDiscoverBackoff)
+		return
+	}
+	select {
+	case <-b.stopChan:
+	case <-notifier.BlockNotifications():
+	case <-time.After(b.cfg.BlockHeightDiscoverBackoff):
+	}
+}
+
 // FetchLastHeight retrieves the last height to start scanning blocks from on startup
 //  1. Check if we have a height specified in config AND
 //     its higher than the block scanner storage one, use that
@@ -321,3 +321,33 @@ func (s *BlockScannerTestSuite) TestIsChainPaused(c *C) {
 	isHalted = cbs.isChainPaused()
 	c.Assert(isHalted, Equals, true)
 }
+
+type notifyingFetcher struct {
+	DummyFetcher
+	blocks chan int64
+}
+
+func (n notifyingFetcher) BlockNotifications() <-chan int64 {
+	return n.blocks
+}
+
+func (s *BlockScannerTestSuite) TestWaitForBlock(c *C) {
+	fetcher := notifyingFetcher{blocks: make(chan int64, 1)}
+	cbs := &BlockScanner{
+		cfg:          config.BifrostBlockScannerConfiguration{BlockHeightDiscoverBackoff: time.Minute},
+		stopChan:     make(chan struct{}),
+		chainScanner: fetcher,
+	}
+
+	// a new block wakes the scanner up before the back off
+	fetcher.blocks <- 2
+	start := time.Now()
+	cbs.waitForBlock()
+	c.Assert(time.Since(start) < time.Second, Equals, true)
+
+	// without notification it backs off
+	cbs.cfg.BlockHeightDiscoverBackoff = 100 * time.Millisecond
+	start = time.Now()
+	cbs.waitForBlock()
+	c.Assert(time.Since(start) >= 100*time.Millisecond, Equals, true)
+}
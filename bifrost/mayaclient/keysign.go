@@ -9,6 +9,7 @@ import (
 
 	btypes "gitlab.com/mayachain/mayanode/bifrost/blockscanner/types"
 	"gitlab.com/mayachain/mayanode/bifrost/mayaclient/types"
+	stypes "gitlab.com/mayachain/mayanode/x/mayachain/types"
 )
 
 var ErrNotFound = fmt.Errorf("not found")
@@ -60,3 +61,19 @@ func (b *mayachainBridge) GetKeysign(blockHeight int64, pk string) (types.TxOut,
 
 	return query.Keysign, nil
 }
+
+// GetScheduledOutbounds retrieves the outbounds scheduled for the coming blocks
+func (b *mayachainBridge) GetScheduledOutbounds() ([]stypes.QueryTxOutItem, error) {
+	buf, s, err := b.getWithPath(ScheduledOutboundEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get scheduled outbounds: %w", err)
+	}
+	if s != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", s)
+	}
+	var items []stypes.QueryTxOutItem
+	if err := json.Unmarshal(buf, &items); err != nil {
+		return nil, fmt.Errorf("fail to unmarshal scheduled outbounds from json: %w", err)
+	}
+	return items, nil
+}
@@ -37,23 +37,25 @@ import (
 
 // Endpoint urls
 const (
-	AuthAccountEndpoint      = "/auth/accounts"
-	BroadcastTxsEndpoint     = "/"
-	KeygenEndpoint           = "/mayachain/keygen"
-	KeysignEndpoint          = "/mayachain/keysign"
-	LastBlockEndpoint        = "/mayachain/lastblock"
-	NodeAccountEndpoint      = "/mayachain/node"
-	SignerMembershipEndpoint = "/mayachain/vaults/%s/signers"
-	StatusEndpoint           = "/status"
-	AsgardVault              = "/mayachain/vaults/asgard"
-	PubKeysEndpoint          = "/mayachain/vaults/pubkeys"
-	MayachainConstants       = "/mayachain/constants"
-	RagnarokEndpoint         = "/mayachain/ragnarok"
-	MimirEndpoint            = "/mayachain/mimir"
-	ChainVersionEndpoint     = "/mayachain/version"
-	InboundAddressesEndpoint = "/mayachain/inbound_addresses"
-	PoolsEndpoint            = "/mayachain/pools"
-	MAYANameEndpoint         = "/mayachain/mayaname/%s"
+	AuthAccountEndpoint       = "/auth/accounts"
+	BroadcastTxsEndpoint      = "/"
+	KeygenEndpoint            = "/mayachain/keygen"
+	KeysignEndpoint           = "/mayachain/keysign"
+	ScheduledOutboundEndpoint = "/mayachain/queue/scheduled"
+	LastBlockEndpoint         = "/mayachain/lastblock"
+	NodeAccountEndpoint       = "/mayachain/node"
+	SignerMembershipEndpoint  = "/mayachain/vaults/%s/signers"
+	StatusEndpoint            = "/status"
+	AsgardVault               = "/mayachain/vaults/asgard"
+	PubKeysEndpoint           = "/mayachain/vaults/pubkeys"
+	MayachainConstants        = "/mayachain/constants"
+	RagnarokEndpoint          = "/mayachain/ragnarok"
+	MimirEndpoint             = "/mayachain/mimir"
+	ChainVersionEndpoint      = "/mayachain/version"
+	InboundAddressesEndpoint  = "/mayachain/inbound_addresses"
+	PoolsEndpoint             = "/mayachain/pools"
+	MAYANameEndpoint          = "/mayachain/mayaname/%s"
+	EVMTokensEndpoint         = "/mayachain/evm_tokens/%s"
 )
 
 // mayachainBridge will be used to send tx to MAYAChain
@@ -91,6 +93,7 @@ type MayachainBridge interface {
 	GetPubKeys() ([]PubKeyContractAddressPair, error)
 	GetSolvencyMsg(height int64, chain common.Chain, pubKey common.PubKey, coins common.Coins) sdk.Msg
 	GetMAYAName(name string) (stypes.MAYAName, error)
+	GetEVMTokens(chain common.Chain) (stypes.EVMTokens, error)
 	GetMayachainVersion() (semver.Version, error)
 	IsCatchingUp() (bool, error)
 	PostKeysignFailure(blame stypes.Blame, height int64, memo string, coins common.Coins, pubkey common.PubKey) (common.TxID, error)
@@ -102,6 +105,7 @@ type MayachainBridge interface {
 	GetLastSignedOutHeight(chain common.Chain) (int64, error)
 	Broadcast(msgs ...sdk.Msg) (common.TxID, error)
 	GetKeysign(blockHeight int64, pk string) (types.TxOut, error)
+	GetScheduledOutbounds() ([]stypes.QueryTxOutItem, error)
 	GetNodeAccount(string) (*stypes.NodeAccount, error)
 	GetKeygenBlock(int64, string) (stypes.KeygenBlock, error)
 }
@@ -633,3 +637,19 @@ func (b *mayachainBridge) GetMAYAName(name string) (stypes.MAYAName, error) {
 	}
 	return tn, nil
 }
+
+// GetEVMTokens retrieve the tokens whitelisted on the given EVM chain
+func (b *mayachainBridge) GetEVMTokens(chain common.Chain) (stypes.EVMTokens, error) {
+	buf, s, err := b.getWithPath(fmt.Sprintf(EVMTokensEndpoint, chain))
+	if err != nil {
+		return nil, fmt.Errorf("fail to get evm tokens: %w", err)
+	}
+	if s != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", s)
+	}
+	var tokens stypes.EVMTokens
+	if err := json.Unmarshal(buf, &tokens); err != nil {
+		return nil, fmt.Errorf("fail to unmarshal evm tokens from json: %w", err)
+	}
+	return tokens, nil
+}
@@ -69,6 +69,8 @@ func (s *MayachainSuite) SetUpTest(c *C) {
 			httpTestHandler(c, rw, "../../test/fixtures/endpoints/inbound_addresses/inbound_addresses.json")
 		case strings.HasPrefix(req.RequestURI, "/mayachain/mayaname/"):
 			httpTestHandler(c, rw, "../../test/fixtures/endpoints/mayaname/mayaname.json")
+		case strings.HasPrefix(req.RequestURI, "/mayachain/evm_tokens/"):
+			httpTestHandler(c, rw, "../../test/fixtures/endpoints/evm_tokens/eth.json")
 		}
 	}))
 	s.cfg.ChainHost = s.server.Listener.Addr().String()
@@ -317,3 +319,13 @@ func (s *MayachainSuite) TestMAYAName(c *C) {
 	c.Assert(result.Aliases[0].Chain, Equals, common.BASEChain)
 	c.Assert(result.Aliases[0].Address, Equals, common.Address("tmaya1tdfqy34uptx207scymqsy4k5uzfmry5sffuam7"))
 }
+
+func (s *MayachainSuite) TestGetEVMTokens(c *C) {
+	result, err := s.bridge.GetEVMTokens(common.ETHChain)
+	c.Assert(err, IsNil)
+	c.Assert(result, HasLen, 2)
+	c.Assert(result[0].Chain, Equals, common.ETHChain)
+	c.Assert(result[0].Address, Equals, common.Address("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"))
+	c.Assert(result[0].Symbol, Equals, "USDC")
+	c.Assert(result[0].Decimals, Equals, int64(6))
+}
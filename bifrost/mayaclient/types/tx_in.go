@@ -8,6 +8,7 @@ import (
 	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
 	mem "gitlab.com/mayachain/mayanode/x/mayachain/memo"
+	stypes "gitlab.com/mayachain/mayanode/x/mayachain/types"
 )
 
 type TxIn struct {
@@ -33,6 +34,9 @@ type TxInItem struct {
 	Aggregator            string        `json:"aggregator"`
 	AggregatorTarget      string        `json:"aggregator_target"`
 	AggregatorTargetLimit *cosmos.Uint  `json:"aggregator_target_limit"`
+	// BlockHash and Proof tie the tx to the block it was observed in
+	BlockHash string                 `json:"block_hash,omitempty"`
+	Proof     *stypes.InclusionProof `json:"proof,omitempty"`
 }
 type TxInStatus byte
 
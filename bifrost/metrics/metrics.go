@@ -37,6 +37,11 @@ const (
 	SignerError   MetricName = `signer_error`
 
 	PubKeyManagerError MetricName = `pubkey_manager_error`
+
+	SignerLanePending      MetricName = `signer_lane_pending`
+	SignerLaneActive       MetricName = `signer_lane_active`
+	SignerLaneOldestHeight MetricName = `signer_lane_oldest_height`
+	SignerLanesWaiting     MetricName = `signer_lanes_waiting`
 )
 
 // Metrics used to provide promethus metrics
@@ -155,6 +160,41 @@ var (
 	}
 
 	gauges = map[MetricName]prometheus.Gauge{}
+
+	gaugeVecs = map[MetricName]*prometheus.GaugeVec{
+		SignerLanePending: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "signer",
+			Subsystem: "lanes",
+			Name:      "pending",
+			Help:      "number of outbound items waiting in a signer lane",
+		}, []string{
+			"chain", "vault",
+		}),
+		SignerLaneActive: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "signer",
+			Subsystem: "lanes",
+			Name:      "active",
+			Help:      "whether a signer lane is currently being worked on",
+		}, []string{
+			"chain", "vault",
+		}),
+		SignerLaneOldestHeight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "signer",
+			Subsystem: "lanes",
+			Name:      "oldest_height",
+			Help:      "mayachain height of the oldest outbound waiting in a signer lane",
+		}, []string{
+			"chain", "vault",
+		}),
+		SignerLanesWaiting: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "signer",
+			Subsystem: "lanes",
+			Name:      "waiting",
+			Help:      "number of signer lanes with pending items waiting for a free worker",
+		}, []string{
+			"chain",
+		}),
+	}
 )
 
 // NewMetrics create a new instance of Metrics
@@ -173,6 +213,9 @@ func NewMetrics(cfg config.BifrostMetricsConfiguration) (*Metrics, error) {
 	for _, item := range histograms {
 		prometheus.MustRegister(item)
 	}
+	for _, item := range gaugeVecs {
+		prometheus.MustRegister(item)
+	}
 	// create a new mux server
 	server := http.NewServeMux()
 	// register a new handler for the /metrics endpoint
@@ -238,6 +281,14 @@ func (m *Metrics) GetCounterVec(name MetricName) *prometheus.CounterVec {
 	return nil
 }
 
+// GetGaugeVec return a gauge vector by name
+func (m *Metrics) GetGaugeVec(name MetricName) *prometheus.GaugeVec {
+	if g, ok := gaugeVecs[name]; ok {
+		return g
+	}
+	return nil
+}
+
 // Start
 func (m *Metrics) Start() error {
 	if !m.cfg.Enabled {
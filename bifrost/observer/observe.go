@@ -538,7 +538,7 @@ func (o *Observer) getThorchainTxIns(txIn types.TxIn) (stypes.ObservedTxs, error
 		}
 		o.logger.Debug().Str("tx-hash", item.Tx).Msg("txInItem")
 		blockHeight := strconv.FormatInt(item.BlockHeight, 10)
-		txID, err := common.NewTxID(item.Tx)
+		txID, err := common.NewTxIDV106(item.Tx)
 		if err != nil {
 			o.errCounter.WithLabelValues("fail_to_parse_tx_hash", blockHeight).Inc()
 			return nil, fmt.Errorf("fail to parse tx hash, %s is invalid: %w", item.Tx, err)
@@ -576,6 +576,8 @@ func (o *Observer) getThorchainTxIns(txIn types.TxIn) (stypes.ObservedTxs, error
 		tx.Aggregator = item.Aggregator
 		tx.AggregatorTarget = item.AggregatorTarget
 		tx.AggregatorTargetLimit = item.AggregatorTargetLimit
+		tx.BlockHash = item.BlockHash
+		tx.Proof = item.Proof
 		txs = append(txs, tx)
 	}
 	return txs, nil
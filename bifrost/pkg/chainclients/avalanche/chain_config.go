@@ -0,0 +1,73 @@
+package avalanche
+
+import (
+	"encoding/json"
+	"fmt"
+
+	tssp "gitlab.com/thorchain/tss/go-tss/tss"
+
+	"gitlab.com/mayachain/mayanode/bifrost/mayaclient"
+	"gitlab.com/mayachain/mayanode/bifrost/metrics"
+	"gitlab.com/mayachain/mayanode/bifrost/pkg/chainclients/shared/evm"
+	"gitlab.com/mayachain/mayanode/bifrost/pubkeymanager"
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/config"
+)
+
+const (
+	// prefixTokenMeta declares prefix to use in leveldb to avoid conflicts
+	// #nosec G101 this is just a prefix
+	prefixTokenMeta  = `avax-tokenmeta-`
+	prefixBlockMeta  = `avax-blockmeta-`
+	prefixSignedMeta = `avax-signedtx-`
+
+	GasPriceResolution int64 = 250000000000 // wei per gas unit (250 gwei)
+
+	maxGasLimit     = 200000
+	defaultDecimals = 18 // on AVAX, consolidate all decimals to 18, in Wei
+)
+
+// NewAvalancheClient creates new instance of an AVAX C-Chain client
+func NewAvalancheClient(thorKeys *mayaclient.Keys,
+	cfg config.BifrostChainConfiguration,
+	server *tssp.TssServer,
+	bridge mayaclient.MayachainBridge,
+	m *metrics.Metrics,
+	pubkeyMgr pubkeymanager.PubKeyValidator,
+	poolMgr mayaclient.PoolManager,
+) (*evm.Client, error) {
+	chainCfg, err := newChainConfig(cfg.BlockScanner)
+	if err != nil {
+		return nil, err
+	}
+	return evm.NewClient(chainCfg, thorKeys, cfg, server, bridge, m, pubkeyMgr, poolMgr)
+}
+
+// newChainConfig returns the AVAX C-chain configuration of the EVM chain client
+func newChainConfig(cfg config.BifrostBlockScannerConfiguration) (evm.ChainConfig, error) {
+	// load token list, it is the default until the whitelist is read from mayachain
+	var whitelistTokens evm.TokenList
+	if err := json.Unmarshal(tokenList, &whitelistTokens); err != nil {
+		return evm.ChainConfig{}, fmt.Errorf("fail to load token list,err: %w", err)
+	}
+	return evm.ChainConfig{
+		Chain:              common.AVAXChain,
+		NativeAsset:        common.AVAXAsset,
+		DefaultDecimals:    defaultDecimals,
+		GasStrategy:        evm.GasStrategyBlockFees,
+		GasCacheBlocks:     uint64(cfg.GasCacheSize),
+		GasPriceResolution: GasPriceResolution,
+		GasFee:             common.GetAVAXGasFee,
+		MaxGasLimit:        maxGasLimit,
+		// AVAX C-Chain has instant finality, no block reward is needed to
+		// derive confirmations from
+		BlockReward: nil,
+		// AVAX C-Chain blocktime is around 2 seconds
+		SolvencyBlocks:     100,
+		Tokens:             whitelistTokens.Tokens,
+		Aggregators:        evm.LatestAggregatorContracts(common.AVAXChain),
+		PrefixTokenMeta:    prefixTokenMeta,
+		PrefixBlockMeta:    prefixBlockMeta,
+		PrefixSignedTxItem: prefixSignedMeta,
+	}, nil
+}
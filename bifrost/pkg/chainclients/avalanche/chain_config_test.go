@@ -0,0 +1,31 @@
+package avalanche
+
+import (
+	"testing"
+
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/mayachain/mayanode/bifrost/pkg/chainclients/shared/evm"
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/config"
+)
+
+func TestPackage(t *testing.T) { TestingT(t) }
+
+type ChainConfigTestSuite struct{}
+
+var _ = Suite(&ChainConfigTestSuite{})
+
+func (s *ChainConfigTestSuite) TestNewChainConfig(c *C) {
+	chainCfg, err := newChainConfig(config.BifrostBlockScannerConfiguration{GasCacheSize: 100})
+	c.Assert(err, IsNil)
+	c.Check(chainCfg.Chain, Equals, common.AVAXChain)
+	c.Check(chainCfg.NativeAsset.Equals(common.AVAXAsset), Equals, true)
+	c.Check(chainCfg.GasStrategy, Equals, evm.GasStrategyBlockFees)
+	c.Check(chainCfg.GasCacheBlocks, Equals, uint64(100))
+	c.Check(chainCfg.GasPriceResolution, Equals, GasPriceResolution)
+	// instant finality, no block reward
+	c.Check(chainCfg.BlockReward, IsNil)
+	c.Check(len(chainCfg.Tokens) > 0, Equals, true)
+	c.Check(chainCfg.Aggregators, DeepEquals, evm.LatestAggregatorContracts(common.AVAXChain))
+}
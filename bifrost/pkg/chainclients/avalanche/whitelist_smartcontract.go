@@ -1,3 +0,0 @@
-package avalanche
-
-var whitelistSmartContractAddress = LatestAggregatorContracts()
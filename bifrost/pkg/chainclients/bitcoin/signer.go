@@ -211,19 +211,47 @@ func (c *Client) getSourceScript(tx stypes.TxOutItem) ([]byte, error) {
 	return txscript.PayToAddrScript(addr)
 }
 
+// getTaprootWitnessProgram returns the witness program of the given address when it is a
+// witness v1 (P2TR) address on the current network, nil otherwise
+func (c *Client) getTaprootWitnessProgram(addr common.Address) []byte {
+	hrp, program, err := common.DecodeTaprootAddress(addr.String())
+	if err != nil || hrp != c.getChainCfg().Bech32HRPSegwit {
+		return nil
+	}
+	return program
+}
+
+// getPayToAddrScript build the output script paying to the given address
+// btcutil doesn't support witness v1 , so P2TR script (OP_1 <32 bytes program>) is built here
+func (c *Client) getPayToAddrScript(addr common.Address) ([]byte, error) {
+	if program := c.getTaprootWitnessProgram(addr); program != nil {
+		return txscript.NewScriptBuilder().AddOp(txscript.OP_1).AddData(program).Script()
+	}
+	outputAddr, err := btcutil.DecodeAddress(addr.String(), c.getChainCfg())
+	if err != nil {
+		return nil, fmt.Errorf("fail to decode next address: %w", err)
+	}
+	return txscript.PayToAddrScript(outputAddr)
+}
+
 // estimateTxSize will create a temporary MsgTx, and use it to estimate the final tx size
 // the value in the temporary MsgTx is not real
 // https://bitcoinops.org/en/tools/calc-size/
-func (c *Client) estimateTxSize(memo string, txes []btcjson.ListUnspentResult) int64 {
+func (c *Client) estimateTxSize(memo string, toAddress common.Address, txes []btcjson.ListUnspentResult) int64 {
 	// overhead - 10.75
 	// Per Input - 67.75
 	// Per output - 31 , we sometimes have 2 output , and sometimes only have 1 , it depends ,here we only count 1
+	// P2TR output - 43 , as the witness program is 32 bytes instead of 20 bytes
 	// it is better to underestimate rather than over estimate
 	// 10.5 overhead for null data
 	// len(memo) is the size of memo put in null data
 	// these get us very close to the final vbytes.
 	//  multiple by 100 , so don't need to deal with float
-	return int64((1075+6775*len(txes)+1050)/100) + int64(31+len([]byte(memo)))
+	outputSize := 31
+	if c.getTaprootWitnessProgram(toAddress) != nil {
+		outputSize = 43
+	}
+	return int64((1075+6775*len(txes)+1050)/100) + int64(outputSize+len([]byte(memo)))
 }
 
 func (c *Client) buildTx(tx stypes.TxOutItem, sourceScript []byte) (*wire.MsgTx, map[string]int64, error) {
@@ -251,11 +279,7 @@ func (c *Client) buildTx(tx stypes.TxOutItem, sourceScript []byte) (*wire.MsgTx,
 		individualAmounts[fmt.Sprintf("%s-%d", txID, item.Vout)] = int64(amt)
 	}
 
-	outputAddr, err := btcutil.DecodeAddress(tx.ToAddress.String(), c.getChainCfg())
-	if err != nil {
-		return nil, nil, fmt.Errorf("fail to decode next address: %w", err)
-	}
-	buf, err := txscript.PayToAddrScript(outputAddr)
+	buf, err := c.getPayToAddrScript(tx.ToAddress)
 	if err != nil {
 		return nil, nil, fmt.Errorf("fail to get pay to address script: %w", err)
 	}
@@ -265,7 +289,7 @@ func (c *Client) buildTx(tx stypes.TxOutItem, sourceScript []byte) (*wire.MsgTx,
 		return nil, nil, fmt.Errorf("fail to parse total amount(%f),err: %w", totalAmt, err)
 	}
 	coinToCustomer := tx.Coins.GetCoin(common.BTCAsset)
-	totalSize := c.estimateTxSize(tx.Memo, txes)
+	totalSize := c.estimateTxSize(tx.Memo, tx.ToAddress, txes)
 
 	// bitcoind has a default rule max fee rate should less than 0.1 BTC / kb
 	// the MaxGas coming from BASEChain doesn't follow this rule , thus the MaxGas might be over the limit
@@ -376,20 +400,25 @@ func (c *Client) SignTx(tx stypes.TxOutItem, mayachainHeight int64) ([]byte, []b
 		return nil, nil, fmt.Errorf("fail to get source pay to address script: %w", err)
 	}
 
-	// verify output address
-	outputAddr, err := btcutil.DecodeAddress(tx.ToAddress.String(), c.getChainCfg())
-	if err != nil {
-		return nil, nil, fmt.Errorf("fail to decode next address: %w", err)
-	}
-	if outputAddr.String() != tx.ToAddress.String() {
-		c.logger.Info().Msgf("output address: %s, to address: %s can't roundtrip", outputAddr.String(), tx.ToAddress.String())
-		return nil, nil, nil
-	}
-	switch outputAddr.(type) {
-	case *btcutil.AddressPubKey:
-		c.logger.Info().Msgf("address: %s is address pubkey type, should not be used", outputAddr)
+	// verify output address, taproot addresses are decoded with bech32m separately
+	if c.getTaprootWitnessProgram(tx.ToAddress) == nil {
+		outputAddr, err := btcutil.DecodeAddress(tx.ToAddress.String(), c.getChainCfg())
+		if err != nil {
+			return nil, nil, fmt.Errorf("fail to decode next address: %w", err)
+		}
+		if outputAddr.String() != tx.ToAddress.String() {
+			c.logger.Info().Msgf("output address: %s, to address: %s can't roundtrip", outputAddr.String(), tx.ToAddress.String())
+			return nil, nil, nil
+		}
+		switch outputAddr.(type) {
+		case *btcutil.AddressPubKey:
+			c.logger.Info().Msgf("address: %s is address pubkey type, should not be used", outputAddr)
+			return nil, nil, nil
+		default: // keep lint happy
+		}
+	} else if strings.ToLower(tx.ToAddress.String()) != tx.ToAddress.String() {
+		c.logger.Info().Msgf("to address: %s can't roundtrip", tx.ToAddress.String())
 		return nil, nil, nil
-	default: // keep lint happy
 	}
 
 	// load from checkpoint if it exists
@@ -22,6 +22,7 @@ import (
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/storage"
 	ctypes "gitlab.com/mayachain/binance-sdk/common/types"
+	"gitlab.com/thorchain/bifrost/txscript"
 	. "gopkg.in/check.v1"
 
 	"gitlab.com/mayachain/mayanode/bifrost/mayaclient"
@@ -48,6 +49,8 @@ type BitcoinSignerSuite struct {
 
 var _ = Suite(&BitcoinSignerSuite{})
 
+const taprootTestnetAddress = common.Address("tb1pqqqqp399et2xygdj5xreqhjjvcmzhxw4aywxecjdzew6hylgvsesf3hn0c")
+
 func (s *BitcoinSignerSuite) SetUpSuite(c *C) {
 	types2.SetupConfigForTest()
 	kb := cKeys.NewInMemory()
@@ -363,7 +366,7 @@ func (s *BitcoinSignerSuite) TestIsSelfTransaction(c *C) {
 }
 
 func (s *BitcoinSignerSuite) TestEstimateTxSize(c *C) {
-	size := s.client.estimateTxSize("OUT:2180B871F2DEA2546E1403DBFE9C26B062ABAFFD979CF3A65F2B4D2230105CF1", []btcjson.ListUnspentResult{
+	txes := []btcjson.ListUnspentResult{
 		{
 			TxID:      "66d2d6b5eb564972c59e4797683a1225a02515a41119f0a8919381236b63e948",
 			Vout:      0,
@@ -374,8 +377,34 @@ func (s *BitcoinSignerSuite) TestEstimateTxSize(c *C) {
 			Vout:      0,
 			Spendable: true,
 		},
-	})
+	}
+	addr, err := types2.GetRandomPubKey().GetAddress(common.BTCChain)
+	c.Assert(err, IsNil)
+	size := s.client.estimateTxSize("OUT:2180B871F2DEA2546E1403DBFE9C26B062ABAFFD979CF3A65F2B4D2230105CF1", addr, txes)
 	c.Assert(size, Equals, int64(255))
+
+	// P2TR output is 12 vbytes larger than P2WPKH
+	size = s.client.estimateTxSize("OUT:2180B871F2DEA2546E1403DBFE9C26B062ABAFFD979CF3A65F2B4D2230105CF1", taprootTestnetAddress, txes)
+	c.Assert(size, Equals, int64(267))
+}
+
+func (s *BitcoinSignerSuite) TestGetPayToAddrScript(c *C) {
+	script, err := s.client.getPayToAddrScript(taprootTestnetAddress)
+	c.Assert(err, IsNil)
+	c.Assert(script, HasLen, 34)
+	c.Assert(script[0], Equals, byte(txscript.OP_1))
+	c.Assert(script[1], Equals, byte(txscript.OP_DATA_32))
+
+	// taproot address from a different network
+	_, err = s.client.getPayToAddrScript("bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0")
+	c.Assert(err, NotNil)
+
+	addr, err := types2.GetRandomPubKey().GetAddress(common.BTCChain)
+	c.Assert(err, IsNil)
+	script, err = s.client.getPayToAddrScript(addr)
+	c.Assert(err, IsNil)
+	c.Assert(script, HasLen, 22)
+	c.Assert(script[0], Equals, byte(txscript.OP_0))
 }
 
 func (s *BitcoinSignerSuite) TestSignTxWithAddressPubkey(c *C) {
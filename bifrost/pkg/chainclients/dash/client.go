@@ -27,6 +27,7 @@ import (
 	"gitlab.com/mayachain/mayanode/bifrost/mayaclient/types"
 	"gitlab.com/mayachain/mayanode/bifrost/metrics"
 	"gitlab.com/mayachain/mayanode/bifrost/pkg/chainclients/runners"
+	"gitlab.com/mayachain/mayanode/bifrost/pkg/chainclients/shared/inclusion"
 	"gitlab.com/mayachain/mayanode/bifrost/pkg/chainclients/shared/utxo"
 	"gitlab.com/mayachain/mayanode/bifrost/pkg/chainclients/signercache"
 	"gitlab.com/mayachain/mayanode/bifrost/tss"
@@ -632,6 +633,15 @@ func (c *Client) extractTxs(block *btcjson.GetBlockVerboseTxResult) (types.TxIn,
 		}
 		txItems = append(txItems, txInItem)
 	}
+	if len(txItems) > 0 {
+		txIDs := make([]string, len(block.Tx))
+		for i := range block.Tx {
+			txIDs[i] = block.Tx[i].Txid
+		}
+		if err := inclusion.AttachUTXOProofs(txItems, block.Hash, block.MerkleRoot, txIDs); err != nil {
+			c.logger.Err(err).Int64("height", block.Height).Msg("fail to attach inclusion proofs")
+		}
+	}
 	txIn.TxArray = txItems
 	txIn.Count = strconv.Itoa(len(txItems))
 	return txIn, nil
@@ -32,6 +32,7 @@ import (
 	"gitlab.com/mayachain/mayanode/bifrost/mayaclient/types"
 	"gitlab.com/mayachain/mayanode/bifrost/metrics"
 	"gitlab.com/mayachain/mayanode/bifrost/pkg/chainclients/runners"
+	"gitlab.com/mayachain/mayanode/bifrost/pkg/chainclients/shared/inclusion"
 	"gitlab.com/mayachain/mayanode/bifrost/pkg/chainclients/shared/utxo"
 	"gitlab.com/mayachain/mayanode/bifrost/pkg/chainclients/signercache"
 	"gitlab.com/mayachain/mayanode/bifrost/tss"
@@ -783,8 +784,11 @@ func (c *Client) getBlock(height int64) (*btcjson.GetBlockVerboseTxResult, error
 	}
 	sem := semaphore.NewWeighted(int64(maxWorker))
 	g, _ := errgroup.WithContext(context.Background())
-	lock := &sync.Mutex{}
-	for _, txID := range block.Tx {
+	// txs are kept in block order, the merkle branches of the observed txs
+	// are built from it
+	blockResult.Tx = make([]btcjson.TxRawResult, len(block.Tx))
+	for idx, txID := range block.Tx {
+		idx := idx
 		txHash, err := chainhash.NewHashFromStr(txID)
 		if err != nil {
 			return &btcjson.GetBlockVerboseTxResult{}, err
@@ -799,9 +803,7 @@ func (c *Client) getBlock(height int64) (*btcjson.GetBlockVerboseTxResult, error
 			if err != nil {
 				return err
 			}
-			lock.Lock()
-			defer lock.Unlock()
-			blockResult.Tx = append(blockResult.Tx, *tx)
+			blockResult.Tx[idx] = *tx
 			return nil
 		})
 	}
@@ -940,6 +942,15 @@ func (c *Client) extractTxs(block *btcjson.GetBlockVerboseTxResult) (types.TxIn,
 		}
 		txItems = append(txItems, txInItem)
 	}
+	if len(txItems) > 0 {
+		txIDs := make([]string, len(block.Tx))
+		for i := range block.Tx {
+			txIDs[i] = block.Tx[i].Txid
+		}
+		if err := inclusion.AttachUTXOProofs(txItems, block.Hash, block.MerkleRoot, txIDs); err != nil {
+			c.logger.Err(err).Int64("height", block.Height).Msg("fail to attach inclusion proofs")
+		}
+	}
 	txIn.TxArray = txItems
 	txIn.Count = strconv.Itoa(len(txItems))
 	return txIn, nil
@@ -0,0 +1,97 @@
+package ethereum
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/blang/semver"
+	"github.com/rs/zerolog/log"
+	tssp "gitlab.com/thorchain/tss/go-tss/tss"
+
+	"gitlab.com/mayachain/mayanode/bifrost/mayaclient"
+	"gitlab.com/mayachain/mayanode/bifrost/metrics"
+	"gitlab.com/mayachain/mayanode/bifrost/pkg/chainclients/shared/evm"
+	"gitlab.com/mayachain/mayanode/bifrost/pubkeymanager"
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/tokenlist"
+	"gitlab.com/mayachain/mayanode/config"
+)
+
+const (
+	// prefixTokenMeta declares prefix to use in leveldb to avoid conflicts
+	// #nosec G101 this is just a prefix
+	prefixTokenMeta    = `eth-tokenmeta-`
+	prefixBlockMeta    = `eth-blockmeta-`
+	prefixSignedTxItem = `signed-txitem-`
+
+	maxGasLimit          = 400000
+	ethBlockRewardAndFee = 3 * 1e18
+	defaultDecimals      = 18 // on ETH , consolidate all decimals to 18, in Wei
+)
+
+var attackAddresses = []string{
+	"0x3a196410a0f5facd08fd7880a4b8551cd085c031",
+	"0x4b713980d60b4994e0aa298a66805ec0d35ebc5a",
+	"0x08416a6823e5090e5605300fb8b48ee2053555b0",
+}
+
+// NewClient create new instance of Ethereum client
+func NewClient(thorKeys *mayaclient.Keys,
+	cfg config.BifrostChainConfiguration,
+	server *tssp.TssServer,
+	bridge mayaclient.MayachainBridge,
+	m *metrics.Metrics,
+	pubkeyMgr pubkeymanager.PubKeyValidator,
+	poolMgr mayaclient.PoolManager,
+) (*evm.Client, error) {
+	chainCfg, err := newChainConfig(cfg.BlockScanner)
+	if err != nil {
+		return nil, err
+	}
+	return evm.NewClient(chainCfg, thorKeys, cfg, server, bridge, m, pubkeyMgr, poolMgr)
+}
+
+// newChainConfig returns the Ethereum configuration of the EVM chain client,
+// the gas strategy follows the suggested fee version of the scanner
+func newChainConfig(cfg config.BifrostBlockScannerConfiguration) (evm.ChainConfig, error) {
+	chainCfg := evm.ChainConfig{
+		Chain:              common.ETHChain,
+		NativeAsset:        common.ETHAsset,
+		DefaultDecimals:    defaultDecimals,
+		InitialGasPrice:    initialGasPrice,
+		GasFee:             common.GetETHGasFee,
+		MaxGasLimit:        maxGasLimit,
+		BlockReward:        big.NewInt(ethBlockRewardAndFee),
+		SolvencyBlocks:     20,
+		BlockedAddresses:   attackAddresses,
+		PrefixTokenMeta:    prefixTokenMeta,
+		PrefixBlockMeta:    prefixBlockMeta,
+		PrefixSignedTxItem: prefixSignedTxItem,
+	}
+	// suggested gas fee configuration
+	switch cfg.SuggestedFeeVersion {
+	case 1:
+		chainCfg.GasStrategy = evm.GasStrategySuggested
+		chainCfg.GasCacheBlocks = 20
+		chainCfg.BlockLag = 0
+	case 2:
+		chainCfg.GasStrategy = evm.GasStrategyBlockFees
+		chainCfg.GasCacheBlocks = 40
+		chainCfg.BlockLag = 1
+	default:
+		return evm.ChainConfig{}, fmt.Errorf("unsupported suggested fee version: %d", cfg.SuggestedFeeVersion)
+	}
+	log.Info().Msgf("suggested fee version: %d", cfg.SuggestedFeeVersion)
+
+	// the token list compiled into the binary is the default until the whitelist is read from mayachain
+	compiled := tokenlist.GetETHTokenList(semver.MustParse("9999.0.0")).Tokens
+	for _, token := range compiled {
+		chainCfg.Tokens = append(chainCfg.Tokens, evm.ERC20Token{
+			Address:  token.Address,
+			Symbol:   token.Symbol,
+			Name:     token.Name,
+			Decimals: token.Decimals,
+		})
+	}
+	return chainCfg, nil
+}
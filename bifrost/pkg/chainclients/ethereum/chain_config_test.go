@@ -0,0 +1,40 @@
+package ethereum
+
+import (
+	"testing"
+
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/mayachain/mayanode/bifrost/pkg/chainclients/shared/evm"
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/config"
+)
+
+func TestPackage(t *testing.T) { TestingT(t) }
+
+type ChainConfigTestSuite struct{}
+
+var _ = Suite(&ChainConfigTestSuite{})
+
+func (s *ChainConfigTestSuite) TestNewChainConfig(c *C) {
+	chainCfg, err := newChainConfig(config.BifrostBlockScannerConfiguration{SuggestedFeeVersion: 1})
+	c.Assert(err, IsNil)
+	c.Check(chainCfg.Chain, Equals, common.ETHChain)
+	c.Check(chainCfg.NativeAsset.Equals(common.ETHAsset), Equals, true)
+	c.Check(chainCfg.GasStrategy, Equals, evm.GasStrategySuggested)
+	c.Check(chainCfg.GasCacheBlocks, Equals, uint64(20))
+	c.Check(chainCfg.BlockLag, Equals, uint64(0))
+	c.Check(chainCfg.BlockReward, NotNil)
+	c.Check(chainCfg.BlockedAddresses, DeepEquals, attackAddresses)
+	c.Check(len(chainCfg.Tokens) > 0, Equals, true)
+
+	chainCfg, err = newChainConfig(config.BifrostBlockScannerConfiguration{SuggestedFeeVersion: 2})
+	c.Assert(err, IsNil)
+	c.Check(chainCfg.GasStrategy, Equals, evm.GasStrategyBlockFees)
+	c.Check(chainCfg.GasCacheBlocks, Equals, uint64(40))
+	c.Check(chainCfg.BlockLag, Equals, uint64(1))
+
+	// unsupported suggested fee version
+	_, err = newChainConfig(config.BifrostBlockScannerConfiguration{SuggestedFeeVersion: 3})
+	c.Assert(err, NotNil)
+}
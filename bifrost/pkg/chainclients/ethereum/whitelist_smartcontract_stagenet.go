@@ -1,10 +0,0 @@
-//go:build stagenet
-// +build stagenet
-
-package ethereum
-
-import (
-	"gitlab.com/mayachain/mayanode/common"
-)
-
-var whitelistSmartContractAddress = []common.Address{}
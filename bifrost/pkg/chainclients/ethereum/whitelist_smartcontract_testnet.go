@@ -1,10 +0,0 @@
-//go:build testnet || mocknet
-// +build testnet mocknet
-
-package ethereum
-
-import (
-	"gitlab.com/mayachain/mayanode/common"
-)
-
-var whitelistSmartContractAddress = []common.Address{}
@@ -1,17 +1,21 @@
 package gaia
 
 import (
+	"encoding/hex"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	cKeys "github.com/cosmos/cosmos-sdk/crypto/keyring"
 	ctypes "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	btypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	rpcclient "github.com/tendermint/tendermint/rpc/client/http"
 
 	"github.com/rs/zerolog/log"
@@ -229,3 +233,96 @@ func (s *BlockScannerTestSuite) TestProcessTxs(c *C) {
 	// proccessTxs should filter out everything besides the valid MsgSend
 	c.Assert(len(txInItems), Equals, 1)
 }
+
+func (s *BlockScannerTestSuite) TestProcessTxsMultiMsg(c *C) {
+	cfg := config.BifrostBlockScannerConfiguration{ChainID: common.GAIAChain}
+
+	registry := s.bridge.GetContext().InterfaceRegistry
+	btypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	version := "1.105.0"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.RequestURI, mayaclient.ChainVersionEndpoint) {
+			if _, err := w.Write([]byte(fmt.Sprintf(`{"current":"%s"}`, version))); err != nil {
+				c.Fatal("unable to write version", err)
+			}
+			return
+		}
+		res, err := os.ReadFile("./test-data/tx_results_by_height.json")
+		if err != nil {
+			c.Fatal("unable to load tx_results_by_height.json")
+		}
+		if _, err := w.Write(res); err != nil {
+			c.Fatal("unable to write /block_result", err)
+		}
+	}))
+	defer server.Close()
+
+	rpcClient, err := rpcclient.NewWithClient(server.URL, "/websocket", server.Client())
+	c.Assert(err, IsNil)
+	bridge, err := mayaclient.NewMayachainBridge(config.BifrostClientConfiguration{
+		ChainID:   "thorchain",
+		ChainHost: server.Listener.Addr().String(),
+	}, s.m, s.keys)
+	c.Assert(err, IsNil)
+
+	// a batched withdrawal, the second message carries a coin that isn't whitelisted
+	txConfig := authtx.NewTxConfig(cdc, authtx.DefaultSignModes)
+	txBuilder := txConfig.NewTxBuilder()
+	c.Assert(txBuilder.SetMsgs(
+		&btypes.MsgSend{
+			FromAddress: "cosmos1exchange",
+			ToAddress:   "cosmos1vault",
+			Amount:      ctypes.NewCoins(ctypes.NewCoin("uatom", ctypes.NewInt(1000000))),
+		},
+		&btypes.MsgSend{
+			FromAddress: "cosmos1exchange",
+			ToAddress:   "cosmos1user",
+			Amount:      ctypes.NewCoins(ctypes.NewCoin("uatom", ctypes.NewInt(2000000)), ctypes.NewCoin("uusd", ctypes.NewInt(5))),
+		},
+	), IsNil)
+	txBuilder.SetMemo("memo")
+	txBuilder.SetFeeAmount(ctypes.NewCoins(ctypes.NewCoin("uatom", ctypes.NewInt(5000))))
+	txBuilder.SetGasLimit(200000)
+	rawTx, err := txConfig.TxEncoder()(txBuilder.GetTx())
+	c.Assert(err, IsNil)
+	hash := hex.EncodeToString(tmhash.Sum(rawTx))
+
+	blockScanner := CosmosBlockScanner{
+		cfg:       cfg,
+		txService: rpcClient,
+		cdc:       cdc,
+		bridge:    bridge,
+		logger:    log.Logger.With().Str("module", "blockscanner").Str("chain", common.GAIAChain.String()).Logger(),
+	}
+
+	// before 1.106.0 only the first MsgSend is observed
+	txInItems, err := blockScanner.processTxs(1, [][]byte{rawTx})
+	c.Assert(err, IsNil)
+	c.Assert(txInItems, HasLen, 1)
+	c.Check(txInItems[0].Tx, Equals, hash)
+	c.Check(txInItems[0].To, Equals, "cosmos1vault")
+
+	// the fixture answers with request id 0, so use a fresh client
+	version = "1.106.0"
+	blockScanner.txService, err = rpcclient.NewWithClient(server.URL, "/websocket", server.Client())
+	c.Assert(err, IsNil)
+	txInItems, err = blockScanner.processTxs(1, [][]byte{rawTx})
+	c.Assert(err, IsNil)
+	c.Assert(txInItems, HasLen, 2)
+	c.Check(txInItems[0].Tx, Equals, hash)
+	c.Check(txInItems[0].To, Equals, "cosmos1vault")
+	c.Check(txInItems[0].Coins, HasLen, 1)
+	c.Check(txInItems[0].Coins[0].Amount.Uint64(), Equals, uint64(100000000))
+	c.Check(txInItems[0].Gas[0].Amount.Uint64(), Equals, uint64(500000))
+	c.Check(txInItems[1].Tx, Equals, hash+"-1")
+	c.Check(txInItems[1].To, Equals, "cosmos1user")
+	c.Check(txInItems[1].Coins, HasLen, 1)
+	c.Check(txInItems[1].Coins[0].Amount.Uint64(), Equals, uint64(200000000))
+	c.Check(txInItems[1].Gas[0].Amount.Uint64(), Equals, uint64(1))
+
+	txID, err := common.NewTxIDV106(txInItems[1].Tx)
+	c.Assert(err, IsNil)
+	c.Check(txID.SubIndex(), Equals, 1)
+}
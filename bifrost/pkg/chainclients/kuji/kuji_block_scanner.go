@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
 	rpcclient "github.com/tendermint/tendermint/rpc/client/http"
 
+	"github.com/blang/semver"
 	ctypes "github.com/cosmos/cosmos-sdk/types"
 	btypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/rs/zerolog"
@@ -29,6 +30,7 @@ import (
 	"gitlab.com/mayachain/mayanode/bifrost/mayaclient/types"
 	"gitlab.com/mayachain/mayanode/bifrost/metrics"
 	"gitlab.com/mayachain/mayanode/bifrost/pkg/chainclients/kuji/wasm"
+	"gitlab.com/mayachain/mayanode/bifrost/pkg/chainclients/shared/inclusion"
 	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
 	"gitlab.com/mayachain/mayanode/config"
@@ -304,6 +306,8 @@ func (c *KujiBlockScanner) processTxs(height int64, rawTxs [][]byte) ([]types.Tx
 		return []types.TxInItem{}, fmt.Errorf("unable to get BlockResults: %w", err)
 	}
 
+	observeAllMsgs := c.observeAllMsgs()
+	fakeGas := common.NewCoin(c.cfg.ChainID.GetGasAsset(), cosmos.NewUint(1))
 	var txIn []types.TxInItem
 	for i, rawTx := range rawTxs {
 		hash := hex.EncodeToString(tmhash.Sum(rawTx))
@@ -327,6 +331,7 @@ func (c *KujiBlockScanner) processTxs(height int64, rawTxs [][]byte) ([]types.Tx
 		memo := mem.GetMemo()
 		c.updateGasCache(feeTx)
 
+		subIndex := 0
 		for _, msg := range tx.GetMsgs() {
 			if msg, isMsgSend := msg.(*btypes.MsgSend); isMsgSend {
 				// Transaction contains a relevant MsgSend, check if the transaction was successful...
@@ -364,22 +369,48 @@ func (c *KujiBlockScanner) processTxs(height int64, rawTxs [][]byte) ([]types.Tx
 				// BASEChain only supports gas paid in KUJI, if gas is paid in another asset
 				// then fake gas as `0.000001 KUJI`, the fee is not used but cannot be empty
 				if gasFees.IsEmpty() {
-					gasFees = append(gasFees, common.NewCoin(c.cfg.ChainID.GetGasAsset(), cosmos.NewUint(1)))
+					gasFees = append(gasFees, fakeGas)
+				}
+				if !observeAllMsgs {
+					txIn = append(txIn, types.TxInItem{
+						Tx:          hash,
+						BlockHeight: height,
+						Memo:        memo,
+						Sender:      msg.FromAddress,
+						To:          msg.ToAddress,
+						Coins:       coins,
+						Gas:         gasFees,
+					})
+
+					// If there are more than one TxIn item per transaction hash,
+					// thornode will fail to process any after the first.
+					// Therefore, limit to 1 MsgSend per transaction.
+					break
+				}
+
+				// Every coin of every MsgSend is observed on its own, the fee is
+				// paid once so only the first observation of the tx carries it
+				for _, coin := range coins {
+					txID, err := common.NewSubTxID(hash, subIndex)
+					if err != nil {
+						c.logger.Error().Err(err).Str("txhash", hash).Msg("fail to build sub indexed tx id")
+						break
+					}
+					gas := gasFees
+					if subIndex > 0 {
+						gas = common.Gas{fakeGas}
+					}
+					subIndex++
+					txIn = append(txIn, types.TxInItem{
+						Tx:          strings.ToLower(txID.String()),
+						BlockHeight: height,
+						Memo:        memo,
+						Sender:      msg.FromAddress,
+						To:          msg.ToAddress,
+						Coins:       common.Coins{coin},
+						Gas:         gas,
+					})
 				}
-				txIn = append(txIn, types.TxInItem{
-					Tx:          hash,
-					BlockHeight: height,
-					Memo:        memo,
-					Sender:      msg.FromAddress,
-					To:          msg.ToAddress,
-					Coins:       coins,
-					Gas:         gasFees,
-				})
-
-				// If there are more than one TxIn item per transaction hash,
-				// thornode will fail to process any after the first.
-				// Therefore, limit to 1 MsgSend per transaction.
-				break
 			}
 		}
 
@@ -388,6 +419,20 @@ func (c *KujiBlockScanner) processTxs(height int64, rawTxs [][]byte) ([]types.Tx
 	return txIn, nil
 }
 
+// observeAllMsgs returns true once BASEChain accepts sub indexed tx ids, before
+// that only the first MsgSend of a tx is observed
+func (c *KujiBlockScanner) observeAllMsgs() bool {
+	if c.bridge == nil {
+		return false
+	}
+	version, err := c.bridge.GetMayachainVersion()
+	if err != nil {
+		c.logger.Err(err).Msg("fail to get BASEChain version")
+		return false
+	}
+	return version.GTE(semver.MustParse("1.106.0"))
+}
+
 func (c *KujiBlockScanner) FetchTxs(height int64) (types.TxIn, error) {
 	block, err := c.GetBlock(height)
 	if err != nil {
@@ -398,6 +443,11 @@ func (c *KujiBlockScanner) FetchTxs(height int64) (types.TxIn, error) {
 	if err != nil {
 		return types.TxIn{}, err
 	}
+	if len(txs) > 0 {
+		if err := inclusion.AttachCosmosTxProofs(txs, block); err != nil {
+			c.logger.Err(err).Int64("height", height).Msg("fail to attach inclusion proofs")
+		}
+	}
 
 	txIn := types.TxIn{
 		Count:    strconv.Itoa(len(txs)),
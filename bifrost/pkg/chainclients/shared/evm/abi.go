@@ -0,0 +1,15 @@
+package evm
+
+import (
+	_ "embed"
+)
+
+// smart contract ABI, it doesn't change often after deploy , and also if it changed , likely bifrost will need to be upgraded to support
+// given that , there is no point to put these into config file
+// all EVM chains run the same router, so there is a single copy of it
+
+//go:embed abi/router.json
+var routerContractABI string
+
+//go:embed abi/erc20.json
+var erc20ContractABI string
@@ -0,0 +1,124 @@
+package evm
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	ecommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/syndtr/goleveldb/leveldb"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/x/mayachain/aggregators"
+)
+
+// GasStrategy is how the gas price reported to mayachain is derived from the chain
+type GasStrategy int
+
+const (
+	// GasStrategySuggested follows the gas price suggested by the node, the
+	// highest suggestion of the cached blocks is used
+	GasStrategySuggested GasStrategy = iota + 1
+	// GasStrategyBlockFees follows the fees paid on chain, the mean plus three
+	// standard deviations of the 25th percentile fee of the cached blocks is used
+	GasStrategyBlockFees
+)
+
+// ChainConfig is what sets one EVM chain apart from another, a chain client is
+// the EVM client and scanner parameterised by the ChainConfig of its chain
+type ChainConfig struct {
+	Chain       common.Chain
+	NativeAsset common.Asset
+	// DefaultDecimals all amounts are consolidated to, in wei
+	DefaultDecimals uint64
+	GasStrategy     GasStrategy
+	// GasCacheBlocks is the number of blocks the gas price is derived from
+	GasCacheBlocks uint64
+	// GasPriceResolution the gas price is rounded up to, in wei, zero to not round
+	GasPriceResolution int64
+	// InitialGasPrice is the gas price until one is derived from the chain, in wei
+	InitialGasPrice int64
+	// GasFee returns the fee of a transfer at the given gas price
+	GasFee func(gasPrice *big.Int, msgLen uint64) common.Gas
+	// MaxGasLimit is the most gas units an outbound is allowed to use
+	MaxGasLimit uint64
+	// BlockLag is the number of blocks the scanner stays behind the tip
+	BlockLag uint64
+	// BlockReward is the reward and fees of a block, in wei, the confirmations
+	// an inbound needs are derived from it. Nil when the chain has instant finality.
+	BlockReward *big.Int
+	// SolvencyBlocks is how often the solvency is reported, in blocks of the chain
+	SolvencyBlocks int64
+	// BlockedAddresses are the addresses outbounds are never sent to
+	BlockedAddresses []string
+	// Tokens is the token whitelist until it is read from mayachain
+	Tokens []ERC20Token
+	// Aggregators are the contracts besides the router inbounds are observed through
+	Aggregators []common.Address
+
+	// leveldb prefixes, they must not change once a chain is live
+	PrefixTokenMeta    string
+	PrefixBlockMeta    string
+	PrefixSignedTxItem string
+}
+
+// NewBlockMetaAccessor create a new instance of the block meta accessor of the chain
+func (c ChainConfig) NewBlockMetaAccessor(db *leveldb.DB) (*LevelDBBlockMetaAccessor, error) {
+	return NewLevelDBBlockMetaAccessor(c.PrefixBlockMeta, c.PrefixSignedTxItem, db)
+}
+
+// NewTokenManager create a new instance of the token manager of the chain, the
+// native token is saved to it
+func (c ChainConfig) NewTokenManager(db *leveldb.DB, requestTimeout time.Duration, bridge TokenWhitelistBridge, ethClient *ethclient.Client) (*TokenManager, error) {
+	tokenWhitelist := NewTokenWhitelist(c.Chain, bridge, c.Tokens)
+	tokenManager, err := NewTokenManager(db, c.PrefixTokenMeta, c.NativeAsset, c.DefaultDecimals, requestTimeout, tokenWhitelist, ethClient, routerContractABI, erc20ContractABI)
+	if err != nil {
+		return nil, fmt.Errorf("fail to create token manager: %w", err)
+	}
+	if err := tokenManager.SaveTokenMeta(c.NativeAsset.Symbol.String(), nativeTokenAddr, c.DefaultDecimals); err != nil {
+		return nil, fmt.Errorf("fail to save native token meta: %w", err)
+	}
+	return tokenManager, nil
+}
+
+// IsValidContract returns true when the given address is one of the routers,
+// or one of the whitelisted aggregators when includeAggregators is set
+func (c ChainConfig) IsValidContract(addr *ecommon.Address, routers []common.Address, includeAggregators bool) bool {
+	for _, item := range routers {
+		if strings.EqualFold(item.String(), addr.String()) {
+			return true
+		}
+	}
+	if !includeAggregators {
+		return false
+	}
+	for _, item := range c.Aggregators {
+		if strings.EqualFold(item.String(), addr.String()) {
+			return true
+		}
+	}
+	return false
+}
+
+// makeGas returns the gas paid by a tx of the chain at the given gas price
+func (c ChainConfig) makeGas(gasPrice *big.Int, gas uint64) common.Gas {
+	gasAmt := cosmos.NewUint(gas).Mul(cosmos.NewUintFromBigInt(gasPrice)).QuoUint64(common.One * 100)
+	return common.Gas{
+		{Asset: c.NativeAsset, Amount: gasAmt},
+	}
+}
+
+// LatestAggregatorContracts returns the addresses of the dex aggregators of the
+// given chain
+func LatestAggregatorContracts(chain common.Chain) []common.Address {
+	addrs := []common.Address{}
+	for _, agg := range aggregators.DexAggregators(common.LatestVersion) {
+		if agg.Chain.Equals(chain) {
+			addrs = append(addrs, common.Address(agg.Address))
+		}
+	}
+	return addrs
+}
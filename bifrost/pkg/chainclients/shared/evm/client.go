@@ -1,8 +1,7 @@
-package ethereum
+package evm
 
 import (
 	"context"
-	_ "embed"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -12,8 +11,6 @@ import (
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/codec"
-	"github.com/ethereum/go-ethereum"
-	"github.com/ethereum/go-ethereum/accounts/abi"
 	ecommon "github.com/ethereum/go-ethereum/common"
 	ecore "github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/txpool"
@@ -22,16 +19,14 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
-
-	"gitlab.com/mayachain/mayanode/bifrost/pkg/chainclients/runners"
-	"gitlab.com/mayachain/mayanode/bifrost/pkg/chainclients/signercache"
-
 	tssp "gitlab.com/thorchain/tss/go-tss/tss"
 
 	"gitlab.com/mayachain/mayanode/bifrost/blockscanner"
 	"gitlab.com/mayachain/mayanode/bifrost/mayaclient"
 	stypes "gitlab.com/mayachain/mayanode/bifrost/mayaclient/types"
 	"gitlab.com/mayachain/mayanode/bifrost/metrics"
+	"gitlab.com/mayachain/mayanode/bifrost/pkg/chainclients/runners"
+	"gitlab.com/mayachain/mayanode/bifrost/pkg/chainclients/signercache"
 	"gitlab.com/mayachain/mayanode/bifrost/pubkeymanager"
 	"gitlab.com/mayachain/mayanode/bifrost/tss"
 	"gitlab.com/mayachain/mayanode/common"
@@ -42,22 +37,21 @@ import (
 )
 
 const (
-	maxAsgardAddresses   = 100
-	maxGasLimit          = 400000
-	ethBlockRewardAndFee = 3 * 1e18
+	maxAsgardAddresses = 100
 )
 
-// Client is a structure to sign and broadcast tx to Ethereum chain used by signer mostly
+// Client is a structure to sign and broadcast tx to an EVM chain used by signer mostly
 type Client struct {
 	logger                  zerolog.Logger
 	cfg                     config.BifrostChainConfiguration
+	chainCfg                ChainConfig
 	localPubKey             common.PubKey
 	client                  *ethclient.Client
-	kw                      *keySignWrapper
-	ethScanner              *ETHScanner
+	kw                      *KeySignWrapper
+	evmScanner              *Scanner
 	bridge                  mayaclient.MayachainBridge
 	blockScanner            *blockscanner.BlockScanner
-	vaultABI                *abi.ABI
+	routerCalls             *RouterCallBuilder
 	pubkeyMgr               pubkeymanager.PubKeyValidator
 	poolMgr                 mayaclient.PoolManager
 	asgardAddresses         []common.Address
@@ -70,8 +64,9 @@ type Client struct {
 	lastSolvencyCheckHeight int64
 }
 
-// NewClient create new instance of Ethereum client
-func NewClient(thorKeys *mayaclient.Keys,
+// NewClient create new instance of the client of the chain of the given chain config
+func NewClient(chainCfg ChainConfig,
+	thorKeys *mayaclient.Keys,
 	cfg config.BifrostChainConfiguration,
 	server *tssp.TssServer,
 	bridge mayaclient.MayachainBridge,
@@ -80,7 +75,7 @@ func NewClient(thorKeys *mayaclient.Keys,
 	poolMgr mayaclient.PoolManager,
 ) (*Client, error) {
 	if thorKeys == nil {
-		return nil, fmt.Errorf("fail to create ETH client,thor keys is empty")
+		return nil, fmt.Errorf("fail to create %s client,thor keys is empty", chainCfg.Chain)
 	}
 	tssKm, err := tss.NewKeySign(server, bridge)
 	if err != nil {
@@ -110,48 +105,44 @@ func NewClient(thorKeys *mayaclient.Keys,
 	if poolMgr == nil {
 		return nil, errors.New("pool manager is nil")
 	}
-	ethPrivateKey, err := getETHPrivateKey(priv)
+	evmPrivateKey, err := GetPrivateKey(priv)
 	if err != nil {
 		return nil, err
 	}
 
 	ethClient, err := ethclient.Dial(cfg.RPCHost)
 	if err != nil {
-		return nil, fmt.Errorf("fail to dial ETH rpc host(%s): %w", cfg.RPCHost, err)
+		return nil, fmt.Errorf("fail to dial %s rpc host(%s): %w", chainCfg.Chain, cfg.RPCHost, err)
 	}
 	chainID, err := getChainID(ethClient, cfg.BlockScanner.HTTPRequestTimeout)
 	if err != nil {
 		return nil, err
 	}
-
-	keysignWrapper, err := newKeySignWrapper(ethPrivateKey, pk, tssKm, chainID)
-	if err != nil {
-		return nil, fmt.Errorf("fail to create ETH key sign wrapper: %w", err)
+	if chainID.Uint64() == 0 {
+		return nil, fmt.Errorf("chain id is: %d , invalid", chainID.Uint64())
 	}
-	vaultABI, _, err := getContractABI()
+
+	keysignWrapper, err := NewKeySignWrapper(evmPrivateKey, pk, tssKm, chainID, chainCfg.Chain.String())
 	if err != nil {
-		return nil, fmt.Errorf("fail to get contract abi: %w", err)
+		return nil, fmt.Errorf("fail to create %s key sign wrapper: %w", chainCfg.Chain, err)
 	}
 	pubkeyMgr.GetPubKeys()
 	c := &Client{
-		logger:       log.With().Str("module", "ethereum").Logger(),
+		logger:       log.With().Str("module", "evm").Str("chain", chainCfg.Chain.String()).Logger(),
 		cfg:          cfg,
+		chainCfg:     chainCfg,
 		client:       ethClient,
 		localPubKey:  pk,
 		kw:           keysignWrapper,
 		bridge:       bridge,
-		vaultABI:     vaultABI,
 		pubkeyMgr:    pubkeyMgr,
 		poolMgr:      poolMgr,
 		tssKeySigner: tssKm,
 		wg:           &sync.WaitGroup{},
 		stopchan:     make(chan struct{}),
 	}
-
 	c.logger.Info().Msgf("current chain id: %d", chainID.Uint64())
-	if chainID.Uint64() == 0 {
-		return nil, fmt.Errorf("chain id is: %d , invalid", chainID.Uint64())
-	}
+
 	var path string // if not set later, will in memory storage
 	if len(c.cfg.BlockScanner.DBPath) > 0 {
 		path = fmt.Sprintf("%s/%s", c.cfg.BlockScanner.DBPath, c.cfg.BlockScanner.ChainID)
@@ -166,32 +157,28 @@ func NewClient(thorKeys *mayaclient.Keys,
 	}
 
 	c.signerCacheManager = signerCacheManager
-	c.ethScanner, err = NewETHScanner(c.cfg.BlockScanner, storage, chainID, c.client, c.bridge, m, pubkeyMgr, c.ReportSolvency, signerCacheManager)
+	c.evmScanner, err = NewScanner(chainCfg, c.cfg.BlockScanner, storage, chainID, c.client, c.bridge, m, pubkeyMgr, c.ReportSolvency, signerCacheManager)
 	if err != nil {
-		return c, fmt.Errorf("fail to create eth block scanner: %w", err)
+		return c, fmt.Errorf("fail to create %s block scanner: %w", chainCfg.Chain, err)
 	}
+	c.routerCalls = NewRouterCallBuilder(chainCfg.NativeAsset, c.evmScanner.vaultABI, c.evmScanner.tokenManager)
 
-	c.blockScanner, err = blockscanner.NewBlockScanner(c.cfg.BlockScanner, storage, m, c.bridge, c.ethScanner)
+	c.blockScanner, err = blockscanner.NewBlockScanner(c.cfg.BlockScanner, storage, m, c.bridge, c.evmScanner)
 	if err != nil {
 		return c, fmt.Errorf("fail to create block scanner: %w", err)
 	}
-	localNodeETHAddress, err := c.localPubKey.GetAddress(common.ETHChain)
+	localNodeAddress, err := c.localPubKey.GetAddress(chainCfg.Chain)
 	if err != nil {
-		c.logger.Err(err).Msg("fail to get local node's ETH address")
+		c.logger.Err(err).Msg("fail to get local node's address")
 	}
-	c.logger.Info().Msgf("local node ETH address %s", localNodeETHAddress)
+	c.logger.Info().Msgf("local node %s address %s", chainCfg.Chain, localNodeAddress)
 
 	return c, nil
 }
 
-// IsETH return true if the token address equals to ethToken address
-func IsETH(token string) bool {
-	return strings.EqualFold(token, ethToken)
-}
-
-// Start to monitor Ethereum block chain
+// Start to monitor the chain
 func (c *Client) Start(globalTxsQueue chan stypes.TxIn, globalErrataQueue chan stypes.ErrataBlock, globalSolvencyQueue chan stypes.Solvency) {
-	c.ethScanner.globalErrataQueue = globalErrataQueue
+	c.evmScanner.globalErrataQueue = globalErrataQueue
 	c.globalSolvencyQueue = globalSolvencyQueue
 	c.tssKeySigner.Start()
 	c.blockScanner.Start(globalTxsQueue)
@@ -201,7 +188,7 @@ func (c *Client) Start(globalTxsQueue chan stypes.TxIn, globalErrataQueue chan s
 	go runners.SolvencyCheckRunner(c.GetChain(), c, c.bridge, c.stopchan, c.wg, constants.MayachainBlockTime)
 }
 
-// Stop ETH client
+// Stop the client
 func (c *Client) Stop() {
 	c.tssKeySigner.Stop()
 	c.blockScanner.Stop()
@@ -210,11 +197,12 @@ func (c *Client) Stop() {
 	c.wg.Wait()
 }
 
+// IsBlockScannerHealthy returns if the block scanner is healthy or not
 func (c *Client) IsBlockScannerHealthy() bool {
 	return c.blockScanner.IsHealthy()
 }
 
-// GetConfig return the configurations used by ETH chain
+// GetConfig return the configurations used by the chain client
 func (c *Client) GetConfig() config.BifrostChainConfiguration {
 	return c.cfg
 }
@@ -223,8 +211,7 @@ func (c *Client) getContext() (context.Context, context.CancelFunc) {
 	return context.WithTimeout(context.Background(), c.cfg.BlockScanner.HTTPRequestTimeout)
 }
 
-// getChainID retrieve the chain id from ETH node, and determinate whether we are running on test net by checking the status
-// when it failed to get chain id , it will assume LocalNet
+// getChainID retrieve the chain id from the node
 func getChainID(client *ethclient.Client, timeout time.Duration) (*big.Int, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
@@ -237,17 +224,17 @@ func getChainID(client *ethclient.Client, timeout time.Duration) (*big.Int, erro
 
 // GetChain get chain
 func (c *Client) GetChain() common.Chain {
-	return common.ETHChain
+	return c.chainCfg.Chain
 }
 
-// GetHeight gets height from eth scanner
+// GetHeight gets height from the scanner
 func (c *Client) GetHeight() (int64, error) {
-	return c.ethScanner.GetHeight()
+	return c.evmScanner.GetHeight()
 }
 
 // GetAddress return current signer address, it will be bech32 encoded address
 func (c *Client) GetAddress(poolPubKey common.PubKey) string {
-	addr, err := poolPubKey.GetAddress(common.ETHChain)
+	addr, err := poolPubKey.GetAddress(c.chainCfg.Chain)
 	if err != nil {
 		c.logger.Error().Err(err).Str("pool_pub_key", poolPubKey.String()).Msg("fail to get pool address")
 		return ""
@@ -257,105 +244,51 @@ func (c *Client) GetAddress(poolPubKey common.PubKey) string {
 
 // GetGasFee gets gas fee
 func (c *Client) GetGasFee(gas uint64) common.Gas {
-	return common.GetETHGasFee(c.GetGasPrice(), gas)
+	return c.chainCfg.GasFee(c.GetGasPrice(), gas)
 }
 
-// GetGasPrice gets gas price from eth scanner
+// GetGasPrice gets gas price from the scanner
 func (c *Client) GetGasPrice() *big.Int {
-	gasPrice := c.ethScanner.GetGasPrice()
-	return gasPrice
-}
-
-// estimateGas estimates gas for tx
-func (c *Client) estimateGas(from string, tx *etypes.Transaction) (uint64, error) {
-	ctx, cancel := c.getContext()
-	defer cancel()
-	return c.client.EstimateGas(ctx, ethereum.CallMsg{
-		From:     ecommon.HexToAddress(from),
-		To:       tx.To(),
-		GasPrice: tx.GasPrice(),
-		// Gas:      tx.Gas(),
-		Value: tx.Value(),
-		Data:  tx.Data(),
-	})
+	return c.evmScanner.GetGasPrice()
 }
 
 // GetNonce gets nonce
 func (c *Client) GetNonce(addr string) (uint64, error) {
-	ctx, cancel := c.getContext()
-	defer cancel()
-	nonce, err := c.client.PendingNonceAt(ctx, ecommon.HexToAddress(addr))
-	if err != nil {
-		return 0, fmt.Errorf("fail to get account nonce: %w", err)
-	}
-	return nonce, nil
-}
-
-func getTokenAddressFromAsset(asset common.Asset) string {
-	if asset.Equals(common.ETHAsset) {
-		return ethToken
-	}
-	allParts := strings.Split(asset.Symbol.String(), "-")
-	return allParts[len(allParts)-1]
+	return c.evmScanner.GetNonce(addr)
 }
 
 func (c *Client) getSmartContractAddr(pubkey common.PubKey) common.Address {
-	return c.pubkeyMgr.GetContract(common.ETHChain, pubkey)
+	return c.pubkeyMgr.GetContract(c.chainCfg.Chain, pubkey)
 }
 
 func (c *Client) getSmartContractByAddress(addr common.Address) common.Address {
 	for _, pk := range c.pubkeyMgr.GetPubKeys() {
-		ethAddr, err := pk.GetAddress(common.ETHChain)
+		evmAddr, err := pk.GetAddress(c.chainCfg.Chain)
 		if err != nil {
 			return common.NoAddress
 		}
-		if ethAddr.Equals(addr) {
-			return c.pubkeyMgr.GetContract(common.ETHChain, pk)
+		if evmAddr.Equals(addr) {
+			return c.pubkeyMgr.GetContract(c.chainCfg.Chain, pk)
 		}
 	}
 	return common.NoAddress
 }
 
 func (c *Client) convertSigningAmount(amt *big.Int, token string) *big.Int {
-	// convert 1e8 to 1e18
-	amt = c.convertThorchainAmountToWei(amt)
-	if IsETH(token) {
-		return amt
-	}
-	tm, err := c.ethScanner.getTokenMeta(token)
-	if err != nil {
-		c.logger.Err(err).Msgf("fail to get token meta for token: %s", token)
-		return amt
-	}
-
-	if tm.Decimal == defaultDecimals {
-		// when the smart contract is using 1e18 as decimals , that means is based on WEI
-		// thus the input amt is correct amount to send out
-		return amt
-	}
-	var value big.Int
-	amt = amt.Mul(amt, value.Exp(big.NewInt(10), big.NewInt(int64(tm.Decimal)), nil))
-	amt = amt.Div(amt, value.Exp(big.NewInt(10), big.NewInt(defaultDecimals), nil))
-	return amt
+	return c.evmScanner.tokenManager.ConvertSigningAmount(amt, token)
 }
 
 func (c *Client) convertThorchainAmountToWei(amt *big.Int) *big.Int {
 	return big.NewInt(0).Mul(amt, big.NewInt(common.One*100))
 }
 
-var attackAddresses = []string{
-	"0x3a196410a0f5facd08fd7880a4b8551cd085c031",
-	"0x4b713980d60b4994e0aa298a66805ec0d35ebc5a",
-	"0x08416a6823e5090e5605300fb8b48ee2053555b0",
-}
-
 // SignTx sign the the given TxArrayItem
 func (c *Client) SignTx(tx stypes.TxOutItem, height int64) ([]byte, []byte, error) {
-	if !tx.Chain.Equals(common.ETHChain) {
-		return nil, nil, fmt.Errorf("chain %s is not support by ETH chain client", tx.Chain)
+	if !tx.Chain.Equals(c.chainCfg.Chain) {
+		return nil, nil, fmt.Errorf("chain %s is not support by %s chain client", tx.Chain, c.chainCfg.Chain)
 	}
 
-	for _, item := range attackAddresses {
+	for _, item := range c.chainCfg.BlockedAddresses {
 		if strings.EqualFold(tx.ToAddress.String(), item) {
 			c.logger.Info().Msgf("attacker address: %s, ignore", item)
 			return nil, nil, fmt.Errorf("attacker address: %s, ignore", item)
@@ -387,115 +320,26 @@ func (c *Client) SignTx(tx stypes.TxOutItem, height int64) ([]byte, []byte, erro
 	}
 
 	contractAddr := c.getSmartContractAddr(tx.VaultPubKey)
+	if contractAddr.IsEmpty() && memo.GetType() == mem.TxMigrate {
+		// we may be churning from a vault that does not have a contract
+		// try getting the toAddress (new vault) contract instead
+		contractAddr = c.getSmartContractByAddress(tx.ToAddress)
+	}
 	if contractAddr.IsEmpty() {
 		return nil, nil, fmt.Errorf("can't sign tx , fail to get smart contract address")
 	}
 
-	value := big.NewInt(0)
-	ethValue := big.NewInt(0)
-	var tokenAddr string
-	if len(tx.Coins) == 1 {
-		coin := tx.Coins[0]
-		tokenAddr = getTokenAddressFromAsset(coin.Asset)
-		value = value.Add(value, coin.Amount.BigInt())
-		value = c.convertSigningAmount(value, tokenAddr)
-		if IsETH(tokenAddr) {
-			ethValue = value
-		}
+	fromAddr, err := tx.VaultPubKey.GetAddress(c.chainCfg.Chain)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to get %s address for pub key(%s): %w", c.chainCfg.Chain, tx.VaultPubKey, err)
 	}
 
-	fromAddr, err := tx.VaultPubKey.GetAddress(common.ETHChain)
+	data, hasRouterUpdated, nativeValue, err := c.routerCalls.Build(tx, memo, contractAddr, c.getSmartContractByAddress)
 	if err != nil {
-		return nil, nil, fmt.Errorf("fail to get ETH address for pub key(%s): %w", tx.VaultPubKey, err)
+		return nil, nil, fmt.Errorf("fail to get outbound tx data: %w", err)
 	}
-
-	dest := ecommon.HexToAddress(tx.ToAddress.String())
-	var data []byte
-
-	hasRouterUpdated := false
-	switch memo.GetType() {
-	case mem.TxOutbound, mem.TxRefund, mem.TxRagnarok:
-		if tx.Aggregator == "" {
-			data, err = c.vaultABI.Pack("transferOut", dest, ecommon.HexToAddress(tokenAddr), value, tx.Memo)
-			if err != nil {
-				return nil, nil, fmt.Errorf("fail to create data to call smart contract(transferOut): %w", err)
-			}
-		} else {
-			memoType := memo.GetType()
-			if memoType == mem.TxRefund || memoType == mem.TxRagnarok {
-				return nil, nil, fmt.Errorf("%s can't use transferOutAndCall", memoType)
-			}
-			c.logger.Info().Msgf("aggregator target address: %s", tx.AggregatorTargetAsset)
-			if ethValue.Uint64() == 0 {
-				return nil, nil, fmt.Errorf("transferOutAndCall can only be used when outbound asset is ETH")
-			}
-			targetLimit := tx.AggregatorTargetLimit
-			if targetLimit == nil {
-				zeroLimit := cosmos.ZeroUint()
-				targetLimit = &zeroLimit
-			}
-			aggAddr := ecommon.HexToAddress(tx.Aggregator)
-			targetAddr := ecommon.HexToAddress(tx.AggregatorTargetAsset)
-			// when address can't be round trip , the tx out item will be dropped
-			if !strings.EqualFold(aggAddr.String(), tx.Aggregator) {
-				c.logger.Error().Msgf("aggregator address can't roundtrip , ignore tx (%s != %s)", tx.Aggregator, aggAddr.String())
-				return nil, nil, nil
-			}
-			if !strings.EqualFold(targetAddr.String(), tx.AggregatorTargetAsset) {
-				c.logger.Error().Msgf("aggregator target asset address can't roundtrip , ignore tx (%s != %s)", tx.AggregatorTargetAsset, targetAddr.String())
-				return nil, nil, nil
-			}
-			data, err = c.vaultABI.Pack("transferOutAndCall", aggAddr, targetAddr, dest, targetLimit.BigInt(), tx.Memo)
-			if err != nil {
-				return nil, nil, fmt.Errorf("fail to create data to call smart contract(transferOutAndCall): %w", err)
-			}
-		}
-	case mem.TxMigrate, mem.TxYggdrasilFund:
-		if tx.Aggregator != "" || tx.AggregatorTargetAsset != "" {
-			return nil, nil, fmt.Errorf("migration / yggdrasil+ can't use aggregator")
-		}
-		if IsETH(tokenAddr) {
-			data, err = c.vaultABI.Pack("transferOut", dest, ecommon.HexToAddress(tokenAddr), value, tx.Memo)
-			if err != nil {
-				return nil, nil, fmt.Errorf("fail to create data to call smart contract(transferOut): %w", err)
-			}
-		} else {
-			newSmartContractAddr := c.getSmartContractByAddress(tx.ToAddress)
-			if newSmartContractAddr.IsEmpty() {
-				return nil, nil, fmt.Errorf("fail to get new smart contract address")
-			}
-			data, err = c.vaultABI.Pack("transferAllowance", ecommon.HexToAddress(newSmartContractAddr.String()), dest, ecommon.HexToAddress(tokenAddr), value, tx.Memo)
-			if err != nil {
-				return nil, nil, fmt.Errorf("fail to create data to call smart contract(transferAllowance): %w", err)
-			}
-		}
-	case mem.TxYggdrasilReturn:
-		if tx.Aggregator != "" || tx.AggregatorTargetAsset != "" {
-			return nil, nil, fmt.Errorf("yggdrasil- can't use aggregator")
-		}
-		newSmartContractAddr := c.getSmartContractByAddress(tx.ToAddress)
-		if newSmartContractAddr.IsEmpty() {
-			return nil, nil, fmt.Errorf("fail to get new smart contract address")
-		}
-		hasRouterUpdated = !newSmartContractAddr.Equals(contractAddr)
-
-		var coins []RouterCoin
-		for _, item := range tx.Coins {
-			assetAddr := getTokenAddressFromAsset(item.Asset)
-			assetAmt := c.convertSigningAmount(item.Amount.BigInt(), assetAddr)
-			if IsETH(assetAddr) {
-				ethValue = assetAmt
-				continue
-			}
-			coins = append(coins, RouterCoin{
-				Asset:  ecommon.HexToAddress(assetAddr),
-				Amount: assetAmt,
-			})
-		}
-		data, err = c.vaultABI.Pack("returnVaultAssets", ecommon.HexToAddress(newSmartContractAddr.String()), dest, coins, tx.Memo)
-		if err != nil {
-			return nil, nil, fmt.Errorf("fail to create data to call smart contract(transferVaultAssets): %w", err)
-		}
+	if len(data) == 0 {
+		return nil, nil, nil
 	}
 
 	// the nonce is stored as the transaction checkpoint, if it is set deserialize it
@@ -519,6 +363,26 @@ func (c *Client) SignTx(tx stypes.TxOutItem, height int64) ([]byte, []byte, erro
 		return nil, nil, fmt.Errorf("fail to marshal nonce: %w", err)
 	}
 
+	createdTx, err := c.buildOutboundTx(tx, memo, fromAddr, contractAddr, nonce, data, nativeValue, hasRouterUpdated)
+	if err != nil {
+		return nil, nil, err
+	}
+	if createdTx == nil {
+		return nil, nil, nil
+	}
+
+	rawTx, err := c.sign(createdTx, tx.VaultPubKey, height, tx)
+	if err != nil || len(rawTx) == 0 {
+		return nil, nonceBytes, fmt.Errorf("fail to sign message: %w", err)
+	}
+
+	return rawTx, nil, nil
+}
+
+// buildOutboundTx prices the router call of the given outbound. No tx is
+// returned when the gas can't be estimated, the outbound is skipped then.
+func (c *Client) buildOutboundTx(tx stypes.TxOutItem, memo mem.Memo, fromAddr, contractAddr common.Address, nonce uint64, data []byte, nativeValue *big.Int, hasRouterUpdated bool) (*etypes.Transaction, error) {
+	maxGasLimit := c.chainCfg.MaxGasLimit
 	// compare the gas rate prescribed by THORChain against the price it can get from the chain
 	// ensure signer always pay enough higher gas price
 	// GasRate from thorchain is in 1e8, need to convert to Wei
@@ -528,24 +392,24 @@ func (c *Client) SignTx(tx stypes.TxOutItem, height int64) ([]byte, []byte, erro
 	}
 	c.logger.Info().Msgf("gas rate: %s", gasRate)
 	// outbound tx always send to smart contract address
-	estimatedETHValue := big.NewInt(0)
-	if ethValue.Uint64() > 0 {
-		// when the ETH value is none zero , here override it with a fix value for estimate gas purpose
-		// when ETH value is none zero , if we send the real value for estimate gas , some times it will fail , for many reasons, a few I saw during test
+	estimatedNativeValue := big.NewInt(0)
+	if nativeValue.Uint64() > 0 {
+		// when the native value is none zero , here override it with a fix value for estimate gas purpose
+		// when native value is none zero , if we send the real value for estimate gas , some times it will fail , for many reasons, a few I saw during test
 		// 1. insufficient fund
 		// 2. gas required exceeds allowance
-		// as long as we pass in an ETH value , which we almost guarantee it will not exceed the ETH balance , so we can avoid the above two errors
-		estimatedETHValue = estimatedETHValue.SetInt64(21000)
+		// as long as we pass in a native value , which we almost guarantee it will not exceed the balance , so we can avoid the above two errors
+		estimatedNativeValue = estimatedNativeValue.SetInt64(21000)
 	}
-	createdTx := etypes.NewTransaction(nonce, ecommon.HexToAddress(contractAddr.String()), estimatedETHValue, MaxContractGas, gasRate, data)
-	estimatedGas, err := c.estimateGas(fromAddr.String(), createdTx)
+	createdTx := etypes.NewTransaction(nonce, ecommon.HexToAddress(contractAddr.String()), estimatedNativeValue, MaxContractGas, gasRate, data)
+	estimatedGas, err := c.evmScanner.rpc.EstimateGas(fromAddr.String(), createdTx)
 	if err != nil {
 		// in an edge case that vault doesn't have enough fund to fulfill an outbound transaction , it will fail to estimate gas
 		// the returned error is `execution reverted`
 		// when this fail , chain client should skip the outbound and move on to the next. The network will reschedule the outbound
 		// after 300 blocks
 		c.logger.Err(err).Msgf("fail to estimate gas")
-		return nil, nil, nil
+		return nil, nil
 	}
 	c.logger.Info().Msgf("memo:%s estimated gas unit: %d", tx.Memo, estimatedGas)
 
@@ -554,12 +418,12 @@ func (c *Client) SignTx(tx stypes.TxOutItem, height int64) ([]byte, []byte, erro
 		gasOut.Add(gasOut, c.convertThorchainAmountToWei(coin.Amount.BigInt()))
 	}
 	totalGas := big.NewInt(int64(estimatedGas) * gasRate.Int64())
-	if ethValue.Uint64() > 0 {
+	if nativeValue.Uint64() > 0 {
 		if tx.Aggregator != "" {
 			// At this point, if this is is to an aggregator (which should be white-listed), allow the maximum gas.
 			if estimatedGas > maxGasLimit {
 				// the estimated gas unit is more than the maximum , so bring down the gas rate
-				maxGasWei := big.NewInt(1).Mul(big.NewInt(maxGasLimit), gasRate)
+				maxGasWei := big.NewInt(1).Mul(big.NewInt(int64(maxGasLimit)), gasRate)
 				gasRate = big.NewInt(1).Div(maxGasWei, big.NewInt(int64(estimatedGas)))
 			} else {
 				estimatedGas = maxGasLimit // pay the maximum
@@ -579,7 +443,7 @@ func (c *Client) SignTx(tx stypes.TxOutItem, height int64) ([]byte, []byte, erro
 					// yggdrasil return fund
 					gap := totalGas.Sub(totalGas, gasOut)
 					c.logger.Info().Msgf("yggdrasil return fund , gas need: %s", gap.String())
-					ethValue = ethValue.Sub(ethValue, gap)
+					nativeValue = nativeValue.Sub(nativeValue, gap)
 				} else {
 					gasRate = gasOut.Div(gasOut, big.NewInt(int64(estimatedGas)))
 					c.logger.Info().Msgf("based on estimated gas unit (%d) , total gas will be %s, which is more than %s, so adjust gas rate to %s", estimatedGas, totalGas.String(), gasOut.String(), gasRate.String())
@@ -590,22 +454,12 @@ func (c *Client) SignTx(tx stypes.TxOutItem, height int64) ([]byte, []byte, erro
 				c.logger.Info().Msgf("transaction with memo %s can spend up to %d gas unit, gasRate:%s", tx.Memo, estimatedGas, gasRate)
 			}
 		}
-		createdTx = etypes.NewTransaction(nonce, ecommon.HexToAddress(contractAddr.String()), ethValue, estimatedGas, gasRate, data)
-	} else {
-		if estimatedGas > maxGasLimit {
-			// the estimated gas unit is more than the maximum , so bring down the gas rate
-			maxGasWei := big.NewInt(1).Mul(big.NewInt(maxGasLimit), gasRate)
-			gasRate = big.NewInt(1).Div(maxGasWei, big.NewInt(int64(estimatedGas)))
-		}
-		createdTx = etypes.NewTransaction(nonce, ecommon.HexToAddress(contractAddr.String()), ethValue, estimatedGas, gasRate, data)
+	} else if estimatedGas > maxGasLimit {
+		// the estimated gas unit is more than the maximum , so bring down the gas rate
+		maxGasWei := big.NewInt(1).Mul(big.NewInt(int64(maxGasLimit)), gasRate)
+		gasRate = big.NewInt(1).Div(maxGasWei, big.NewInt(int64(estimatedGas)))
 	}
-
-	rawTx, err := c.sign(createdTx, tx.VaultPubKey, height, tx)
-	if err != nil || len(rawTx) == 0 {
-		return nil, nonceBytes, fmt.Errorf("fail to sign message: %w", err)
-	}
-
-	return rawTx, nil, nil
+	return etypes.NewTransaction(nonce, ecommon.HexToAddress(contractAddr.String()), nativeValue, estimatedGas, gasRate, data), nil
 }
 
 // sign is design to sign a given message with keysign party and keysign wrapper
@@ -632,44 +486,21 @@ func (c *Client) sign(tx *etypes.Transaction, poolPubKey common.PubKey, height i
 
 // GetBalance call smart contract to find out the balance of the given address and token
 func (c *Client) GetBalance(addr, token string, height *big.Int) (*big.Int, error) {
-	ctx, cancel := c.getContext()
-	defer cancel()
-	if IsETH(token) {
-		return c.client.BalanceAt(ctx, ecommon.HexToAddress(addr), height)
-	}
-	contractAddresses := c.pubkeyMgr.GetContracts(common.ETHChain)
-	if len(contractAddresses) == 0 {
+	contractAddresses := c.pubkeyMgr.GetContracts(c.chainCfg.Chain)
+	if !IsNative(token) && len(contractAddresses) == 0 {
 		return nil, fmt.Errorf("fail to get contract address")
 	}
-	input, err := c.vaultABI.Pack("vaultAllowance", ecommon.HexToAddress(addr), ecommon.HexToAddress(token))
-	if err != nil {
-		return nil, fmt.Errorf("fail to create vaultAllowance data to call smart contract")
-	}
-	c.logger.Debug().Msgf("query contract:%s for balance", contractAddresses[0].String())
-	toAddr := ecommon.HexToAddress(contractAddresses[0].String())
-	res, err := c.client.CallContract(ctx, ethereum.CallMsg{
-		From: ecommon.HexToAddress(addr),
-		To:   &toAddr,
-		Data: input,
-	}, height)
-	if err != nil {
-		return nil, err
+	vaultAddr := ""
+	if len(contractAddresses) > 0 {
+		vaultAddr = contractAddresses[0].String()
 	}
-	output, err := c.vaultABI.Unpack("vaultAllowance", res)
-	if err != nil {
-		return nil, err
-	}
-	value, ok := abi.ConvertType(output[0], new(*big.Int)).(**big.Int)
-	if !ok {
-		return *value, fmt.Errorf("dev error: unable to get big.Int")
-	}
-	return *value, nil
+	return c.evmScanner.tokenManager.GetBalance(addr, token, height, vaultAddr)
 }
 
 // GetBalances gets all the balances of the given address
 func (c *Client) GetBalances(addr string, height *big.Int) (common.Coins, error) {
 	// for all the tokens , this chain client have deal with before
-	tokens, err := c.ethScanner.GetTokens()
+	tokens, err := c.evmScanner.GetTokens()
 	if err != nil {
 		return nil, fmt.Errorf("fail to get all the tokens: %w", err)
 	}
@@ -680,33 +511,23 @@ func (c *Client) GetBalances(addr string, height *big.Int) (common.Coins, error)
 			c.logger.Err(err).Msgf("fail to get balance for token:%s", token.Address)
 			continue
 		}
-		asset := common.ETHAsset
-		if !IsETH(token.Address) {
-			asset, err = common.NewAsset(fmt.Sprintf("ETH.%s-%s", token.Symbol, token.Address))
+		asset := c.chainCfg.NativeAsset
+		if !IsNative(token.Address) {
+			asset, err = common.NewAsset(fmt.Sprintf("%s.%s-%s", c.chainCfg.Chain, token.Symbol, token.Address))
 			if err != nil {
 				return nil, err
 			}
 		}
-		bal := c.ethScanner.convertAmount(token.Address, balance)
+		bal := c.evmScanner.convertAmount(token.Address, balance)
 		coins = append(coins, common.NewCoin(asset, bal))
 	}
 
 	return coins.Distinct(), nil
 }
 
-// GetAccount gets account by address in eth client
+// GetAccount gets account by address in the client
 func (c *Client) GetAccount(pk common.PubKey, height *big.Int) (common.Account, error) {
-	addr := c.GetAddress(pk)
-	nonce, err := c.GetNonce(addr)
-	if err != nil {
-		return common.Account{}, err
-	}
-	coins, err := c.GetBalances(addr, height)
-	if err != nil {
-		return common.Account{}, err
-	}
-	account := common.NewAccount(int64(nonce), 0, coins, false)
-	return account, nil
+	return c.GetAccountByAddress(c.GetAddress(pk), height)
 }
 
 // GetAccountByAddress return account information
@@ -723,7 +544,7 @@ func (c *Client) GetAccountByAddress(address string, height *big.Int) (common.Ac
 	return account, nil
 }
 
-// BroadcastTx decodes tx using rlp and broadcasts too Ethereum chain
+// BroadcastTx decodes tx using rlp and broadcasts too the chain
 func (c *Client) BroadcastTx(txOutItem stypes.TxOutItem, hexTx []byte) (string, error) {
 	tx := &etypes.Transaction{}
 	if err := tx.UnmarshalJSON(hexTx); err != nil {
@@ -735,7 +556,7 @@ func (c *Client) BroadcastTx(txOutItem stypes.TxOutItem, hexTx []byte) (string,
 		return "", err
 	}
 	txID := tx.Hash().String()
-	c.logger.Info().Msgf("broadcast tx with memo: %s to ETH chain , hash: %s", txOutItem.Memo, txID)
+	c.logger.Info().Msgf("broadcast tx with memo: %s to %s chain , hash: %s", txOutItem.Memo, c.chainCfg.Chain, txID)
 
 	if err := c.signerCacheManager.SetSigned(txOutItem.CacheHash(), txID); err != nil {
 		c.logger.Err(err).Msgf("fail to mark tx out item (%+v) as signed", txOutItem)
@@ -761,15 +582,15 @@ func (c *Client) ConfirmationCountReady(txIn stypes.TxIn) bool {
 	if txIn.MemPool {
 		return true
 	}
+	// chains with instant finality don't need confirmation
+	if c.chainCfg.BlockReward == nil {
+		return true
+	}
 	blockHeight := txIn.TxArray[0].BlockHeight
 	confirm := txIn.ConfirmationRequired
 	c.logger.Info().Msgf("confirmation required: %d", confirm)
 	// every tx in txIn already have at least 1 confirmation
-	return (c.ethScanner.currentBlockHeight - blockHeight) >= confirm
-}
-
-func (c *Client) getBlockReward(height int64) (*big.Int, error) {
-	return big.NewInt(ethBlockRewardAndFee), nil
+	return (c.evmScanner.currentBlockHeight - blockHeight) >= confirm
 }
 
 func (c *Client) getTotalTransactionValue(txIn stypes.TxIn, excludeFrom []common.Address) cosmos.Uint {
@@ -777,6 +598,7 @@ func (c *Client) getTotalTransactionValue(txIn stypes.TxIn, excludeFrom []common
 	if len(txIn.TxArray) == 0 {
 		return total
 	}
+	nativeAsset := c.chainCfg.NativeAsset
 	for _, item := range txIn.TxArray {
 		fromAsgard := false
 		for _, fromAddress := range excludeFrom {
@@ -789,7 +611,7 @@ func (c *Client) getTotalTransactionValue(txIn stypes.TxIn, excludeFrom []common
 			continue
 		}
 		// if from address is yggdrasil , exclude the value from confirmation counting
-		ok, _ := c.pubkeyMgr.IsValidPoolAddress(item.Sender, common.ETHChain)
+		ok, _ := c.pubkeyMgr.IsValidPoolAddress(item.Sender, c.chainCfg.Chain)
 		if ok {
 			continue
 		}
@@ -798,9 +620,9 @@ func (c *Client) getTotalTransactionValue(txIn stypes.TxIn, excludeFrom []common
 				continue
 			}
 			amount := coin.Amount
-			if !coin.Asset.Equals(common.ETHAsset) {
+			if !coin.Asset.Equals(nativeAsset) {
 				var err error
-				amount, err = c.poolMgr.GetValue(coin.Asset, common.ETHAsset, coin.Amount)
+				amount, err = c.poolMgr.GetValue(coin.Asset, nativeAsset, coin.Amount)
 				if err != nil {
 					c.logger.Err(err).Msgf("fail to get value for %s", coin.Asset)
 					continue
@@ -814,7 +636,7 @@ func (c *Client) getTotalTransactionValue(txIn stypes.TxIn, excludeFrom []common
 }
 
 // getBlockRequiredConfirmation find out how many confirmation the given txIn need to have before it can be send to BASEChain
-func (c *Client) getBlockRequiredConfirmation(txIn stypes.TxIn, height int64) (int64, error) {
+func (c *Client) getBlockRequiredConfirmation(txIn stypes.TxIn) int64 {
 	asgards, err := c.getAsgardAddress()
 	if err != nil {
 		c.logger.Err(err).Msg("fail to get asgard addresses")
@@ -823,10 +645,7 @@ func (c *Client) getBlockRequiredConfirmation(txIn stypes.TxIn, height int64) (i
 	c.logger.Debug().Msgf("asgards: %+v", asgards)
 	totalTxValue := c.getTotalTransactionValue(txIn, asgards)
 	totalTxValueInWei := c.convertThorchainAmountToWei(totalTxValue.BigInt())
-	totalFeeAndSubsidy, err := c.getBlockReward(height)
-	if err != nil {
-		return 0, fmt.Errorf("fail to get coinbase value: %w", err)
-	}
+	totalFeeAndSubsidy := c.chainCfg.BlockReward
 	confirm := cosmos.NewUintFromBigInt(totalTxValueInWei).MulUint64(2).Quo(cosmos.NewUintFromBigInt(totalFeeAndSubsidy)).Uint64()
 	c.logger.Info().Msgf("totalTxValue:%s,total fee and Subsidy:%d,confirmation:%d", totalTxValueInWei, totalFeeAndSubsidy, confirm)
 	if confirm < 2 {
@@ -837,9 +656,9 @@ func (c *Client) getBlockRequiredConfirmation(txIn stypes.TxIn, height int64) (i
 		// trades), the additional delay to swappers is also small (12 secs or
 		// so). Thus, the determination by thorsec, 9R and devs were to set the
 		// new min conf is 2.
-		return 2, nil
+		return 2
 	}
-	return int64(confirm), nil
+	return int64(confirm)
 }
 
 // GetConfirmationCount decide the given txIn how many confirmation it requires
@@ -851,13 +670,12 @@ func (c *Client) GetConfirmationCount(txIn stypes.TxIn) int64 {
 	if txIn.MemPool {
 		return 0
 	}
-	blockHeight := txIn.TxArray[0].BlockHeight
-	confirm, err := c.getBlockRequiredConfirmation(txIn, blockHeight)
-	c.logger.Debug().Msgf("confirmation required: %d", confirm)
-	if err != nil {
-		c.logger.Err(err).Msg("fail to get block confirmation ")
+	// chains with instant finality don't need confirmation
+	if c.chainCfg.BlockReward == nil {
 		return 0
 	}
+	confirm := c.getBlockRequiredConfirmation(txIn)
+	c.logger.Debug().Msgf("confirmation required: %d", confirm)
 	return confirm
 }
 
@@ -871,7 +689,7 @@ func (c *Client) getAsgardAddress() ([]common.Address, error) {
 	}
 
 	for _, v := range vaults {
-		addr, err := v.PubKey.GetAddress(common.ETHChain)
+		addr, err := v.PubKey.GetAddress(c.chainCfg.Chain)
 		if err != nil {
 			c.logger.Err(err).Msg("fail to get address")
 			continue
@@ -898,7 +716,7 @@ func (c *Client) getAsgardAddress() ([]common.Address, error) {
 
 // OnObservedTxIn gets called from observer when we have a valid observation
 func (c *Client) OnObservedTxIn(txIn stypes.TxInItem, blockHeight int64) {
-	c.ethScanner.onObservedTxIn(txIn, blockHeight)
+	c.evmScanner.onObservedTxIn(txIn, blockHeight)
 	m, err := mem.ParseMemo(common.LatestVersion, txIn.Memo)
 	if err != nil {
 		c.logger.Err(err).Msgf("fail to parse memo: %s", txIn.Memo)
@@ -915,13 +733,15 @@ func (c *Client) OnObservedTxIn(txIn stypes.TxInItem, blockHeight int64) {
 	}
 }
 
-func (c *Client) ReportSolvency(ethBlockHeight int64) error {
-	if !c.ShouldReportSolvency(ethBlockHeight) {
+// ReportSolvency reports the balances of the asgard vaults to BASEChain when
+// they can't pay for the outbounds, or when the chain is halted
+func (c *Client) ReportSolvency(blockHeight int64) error {
+	if !c.ShouldReportSolvency(blockHeight) {
 		return nil
 	}
 	// when block scanner is not healthy , falling behind , we don't report solvency , unless the request is coming from
 	// auto-unhalt solvency runner
-	if !c.IsBlockScannerHealthy() && ethBlockHeight == c.ethScanner.currentBlockHeight {
+	if !c.IsBlockScannerHealthy() && blockHeight == c.evmScanner.currentBlockHeight {
 		return nil
 	}
 	asgardVaults, err := c.bridge.GetAsgards()
@@ -929,20 +749,20 @@ func (c *Client) ReportSolvency(ethBlockHeight int64) error {
 		return fmt.Errorf("fail to get asgards,err: %w", err)
 	}
 	for _, asgard := range asgardVaults {
-		acct, err := c.GetAccount(asgard.PubKey, new(big.Int).SetInt64(ethBlockHeight))
+		acct, err := c.GetAccount(asgard.PubKey, new(big.Int).SetInt64(blockHeight))
 		if err != nil {
 			c.logger.Err(err).Msgf("fail to get account balance")
 			continue
 		}
-		if runners.IsVaultSolvent(acct, asgard, cosmos.NewUint(3*MaxContractGas*c.ethScanner.lastReportedGasPrice)) && c.IsBlockScannerHealthy() {
+		if runners.IsVaultSolvent(acct, asgard, cosmos.NewUint(3*MaxContractGas*c.evmScanner.lastReportedGasPrice)) && c.IsBlockScannerHealthy() {
 			// when vault is solvent , don't need to report solvency
 			// when block scanner is not healthy , usually that means the chain is halted , in that scenario , we continue to report solvency
 			continue
 		}
 		select {
 		case c.globalSolvencyQueue <- stypes.Solvency{
-			Height: ethBlockHeight,
-			Chain:  common.ETHChain,
+			Height: blockHeight,
+			Chain:  c.chainCfg.Chain,
 			PubKey: asgard.PubKey,
 			Coins:  acct.Coins,
 		}:
@@ -950,11 +770,11 @@ func (c *Client) ReportSolvency(ethBlockHeight int64) error {
 			c.logger.Info().Msgf("fail to send solvency info to BASEChain, timeout")
 		}
 	}
-	c.lastSolvencyCheckHeight = ethBlockHeight
+	c.lastSolvencyCheckHeight = blockHeight
 	return nil
 }
 
 // ShouldReportSolvency with given block height , should chain client report Solvency to THORNode?
 func (c *Client) ShouldReportSolvency(height int64) bool {
-	return height%20 == 0
+	return height%c.chainCfg.SolvencyBlocks == 0
 }
@@ -1,4 +1,4 @@
-package avalanche
+package evm
 
 import (
 	"encoding/json"
@@ -10,25 +10,29 @@ import (
 	"testing"
 	"time"
 
+	"github.com/blang/semver"
 	"github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	cKeys "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/magiconair/properties/assert"
+	tssp "gitlab.com/thorchain/tss/go-tss/tss"
 	. "gopkg.in/check.v1"
 
+	"gitlab.com/mayachain/mayanode/bifrost/blockscanner"
 	"gitlab.com/mayachain/mayanode/bifrost/mayaclient"
 	stypes "gitlab.com/mayachain/mayanode/bifrost/mayaclient/types"
 	"gitlab.com/mayachain/mayanode/bifrost/metrics"
+	"gitlab.com/mayachain/mayanode/bifrost/pkg/chainclients/signercache"
 	"gitlab.com/mayachain/mayanode/bifrost/pubkeymanager"
 	"gitlab.com/mayachain/mayanode/cmd"
 	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/common/tokenlist"
 	"gitlab.com/mayachain/mayanode/config"
 	types2 "gitlab.com/mayachain/mayanode/x/mayachain/types"
 )
 
-func TestAVAXPackage(t *testing.T) { TestingT(t) }
-
 type AvalancheSuite struct {
 	thordir  string
 	thorKeys *mayaclient.Keys
@@ -39,22 +43,60 @@ type AvalancheSuite struct {
 
 var _ = Suite(&AvalancheSuite{})
 
-var m *metrics.Metrics
-
-func GetMetricForTest(c *C) *metrics.Metrics {
-	if m == nil {
-		var err error
-		m, err = metrics.NewMetrics(config.BifrostMetricsConfiguration{
-			Enabled:      false,
-			ListenPort:   9000,
-			ReadTimeout:  time.Second,
-			WriteTimeout: time.Second,
-			Chains:       common.Chains{common.AVAXChain},
+// avaxGasPriceResolution mirrors the AVAX gas price resolution, 250 gwei
+const avaxGasPriceResolution int64 = 250000000000
+
+// newAVAXChainConfigForTest mirrors the avalanche chain configuration, which
+// can't be imported here without an import cycle
+func newAVAXChainConfigForTest(cfg config.BifrostBlockScannerConfiguration) ChainConfig {
+	chainCfg := ChainConfig{
+		Chain:              common.AVAXChain,
+		NativeAsset:        common.AVAXAsset,
+		DefaultDecimals:    18,
+		GasStrategy:        GasStrategyBlockFees,
+		GasCacheBlocks:     uint64(cfg.GasCacheSize),
+		GasPriceResolution: avaxGasPriceResolution,
+		GasFee:             common.GetAVAXGasFee,
+		MaxGasLimit:        200000,
+		SolvencyBlocks:     100,
+		Aggregators:        LatestAggregatorContracts(common.AVAXChain),
+		PrefixTokenMeta:    "avax-tokenmeta-",
+		PrefixBlockMeta:    "avax-blockmeta-",
+		PrefixSignedTxItem: "avax-signedtx-",
+	}
+	for _, token := range tokenlist.GetAVAXTokenList(semver.MustParse("9999.0.0")).Tokens {
+		chainCfg.Tokens = append(chainCfg.Tokens, ERC20Token{
+			Address:  token.Address,
+			Symbol:   token.Symbol,
+			Name:     token.Name,
+			Decimals: token.Decimals,
 		})
-		c.Assert(m, NotNil)
-		c.Assert(err, IsNil)
 	}
-	return m
+	return chainCfg
+}
+
+func newAVAXClientForTest(thorKeys *mayaclient.Keys,
+	cfg config.BifrostChainConfiguration,
+	server *tssp.TssServer,
+	bridge mayaclient.MayachainBridge,
+	m *metrics.Metrics,
+	pubkeyMgr pubkeymanager.PubKeyValidator,
+	poolMgr mayaclient.PoolManager,
+) (*Client, error) {
+	return NewClient(newAVAXChainConfigForTest(cfg.BlockScanner), thorKeys, cfg, server, bridge, m, pubkeyMgr, poolMgr)
+}
+
+func newAVAXScannerForTest(cfg config.BifrostBlockScannerConfiguration,
+	storage blockscanner.ScannerStorage,
+	chainID *big.Int,
+	client *ethclient.Client,
+	bridge mayaclient.MayachainBridge,
+	m *metrics.Metrics,
+	pubkeyMgr pubkeymanager.PubKeyValidator,
+	solvencyReporter SolvencyReporter,
+	signerCacheManager *signercache.CacheManager,
+) (*Scanner, error) {
+	return NewScanner(newAVAXChainConfigForTest(cfg), cfg, storage, chainID, client, bridge, m, pubkeyMgr, solvencyReporter, signerCacheManager)
 }
 
 func (s *AvalancheSuite) SetUpTest(c *C) {
@@ -70,7 +112,7 @@ func (s *AvalancheSuite) SetUpTest(c *C) {
 			tm, _ := codec.ToTmPubKeyInterface(priKey.PubKey())
 			pk, err := common.NewPubKeyFromCrypto(tm)
 			c.Assert(err, IsNil)
-			content, err := ioutil.ReadFile("../../../../test/fixtures/endpoints/vaults/pubKeys.json")
+			content, err := ioutil.ReadFile("../../../../../test/fixtures/endpoints/vaults/pubKeys.json")
 			c.Assert(err, IsNil)
 			var pubKeysVault types2.QueryVaultsPubKeys
 			c.Assert(json.Unmarshal(content, &pubKeysVault), IsNil)
@@ -88,13 +130,13 @@ func (s *AvalancheSuite) SetUpTest(c *C) {
 			_, err = rw.Write(buf)
 			c.Assert(err, IsNil)
 		case mayaclient.InboundAddressesEndpoint:
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/inbound_addresses/inbound_addresses.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/inbound_addresses/inbound_addresses.json")
 		case mayaclient.AsgardVault:
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/vaults/asgard.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/vaults/asgard.json")
 		case mayaclient.LastBlockEndpoint:
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/lastblock/root.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/lastblock/root.json")
 		case mayaclient.NodeAccountEndpoint:
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/nodeaccount/template.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/nodeaccount/template.json")
 		case "/thorchain/mimir/key/MaxUTXOsToSpend":
 			_, err := rw.Write([]byte(`-1`))
 			c.Assert(err, IsNil)
@@ -222,22 +264,22 @@ func (s *AvalancheSuite) TestNewClient(c *C) {
 	poolMgr := mayaclient.NewPoolMgr(s.bridge)
 
 	// bridge is nil
-	e, err := NewAvalancheClient(s.thorKeys, config.BifrostChainConfiguration{}, nil, nil, s.m, pubkeyMgr, poolMgr)
+	e, err := newAVAXClientForTest(s.thorKeys, config.BifrostChainConfiguration{}, nil, nil, s.m, pubkeyMgr, poolMgr)
 	c.Assert(e, IsNil)
 	c.Assert(err, NotNil)
 
 	// pubkey manager is nil
-	e, err = NewAvalancheClient(s.thorKeys, config.BifrostChainConfiguration{}, nil, s.bridge, s.m, nil, poolMgr)
+	e, err = newAVAXClientForTest(s.thorKeys, config.BifrostChainConfiguration{}, nil, s.bridge, s.m, nil, poolMgr)
 	c.Assert(e, IsNil)
 	c.Assert(err, NotNil)
 
 	// pubkey manager is nil
-	e, err = NewAvalancheClient(s.thorKeys, config.BifrostChainConfiguration{}, nil, s.bridge, s.m, pubkeyMgr, nil)
+	e, err = newAVAXClientForTest(s.thorKeys, config.BifrostChainConfiguration{}, nil, s.bridge, s.m, pubkeyMgr, nil)
 	c.Assert(e, IsNil)
 	c.Assert(err, NotNil)
 
 	// pubkey manager is nil
-	e, err = NewAvalancheClient(nil, config.BifrostChainConfiguration{}, nil, s.bridge, s.m, pubkeyMgr, poolMgr)
+	e, err = newAVAXClientForTest(nil, config.BifrostChainConfiguration{}, nil, s.bridge, s.m, pubkeyMgr, poolMgr)
 	c.Assert(e, IsNil)
 	c.Assert(err, NotNil)
 }
@@ -246,7 +288,7 @@ func (s *AvalancheSuite) TestConvertSigningAmount(c *C) {
 	pubkeyMgr, err := pubkeymanager.NewPubKeyManager(s.bridge, s.m)
 	c.Assert(err, IsNil)
 	poolMgr := mayaclient.NewPoolMgr(s.bridge)
-	a, err := NewAvalancheClient(s.thorKeys, config.BifrostChainConfiguration{
+	chainCfg := config.BifrostChainConfiguration{
 		RPCHost: "http://" + s.server.Listener.Addr().String(),
 		BlockScanner: config.BifrostBlockScannerConfiguration{
 			RPCHost:             "http://" + s.server.Listener.Addr().String(),
@@ -254,23 +296,30 @@ func (s *AvalancheSuite) TestConvertSigningAmount(c *C) {
 			HTTPRequestTimeout:  time.Second,
 			SuggestedFeeVersion: 1,
 		},
-	}, nil, s.bridge, s.m, pubkeyMgr, poolMgr)
+	}
+	// token metas are only read for whitelisted tokens
+	evmCfg := newAVAXChainConfigForTest(chainCfg.BlockScanner)
+	evmCfg.Tokens = append(evmCfg.Tokens,
+		ERC20Token{Address: "0x3b7FA4dd21c6f9BA3ca375217EAD7CAb9D6bF483", Symbol: "TKN", Decimals: 18},
+		ERC20Token{Address: "0x3b7FA4dd21c6f9BA3ca375217EAD7CAb9D6bF482", Symbol: "TKX", Decimals: 8},
+	)
+	a, err := NewClient(evmCfg, s.thorKeys, chainCfg, nil, s.bridge, s.m, pubkeyMgr, poolMgr)
 	c.Assert(err, IsNil)
 	c.Assert(a, NotNil)
-	c.Assert(a.avaxScanner.tokenManager.SaveTokenMeta("TKN", "0x3b7FA4dd21c6f9BA3ca375217EAD7CAb9D6bF483", 18), IsNil)
-	c.Assert(a.avaxScanner.tokenManager.SaveTokenMeta("TKX", "0x3b7FA4dd21c6f9BA3ca375217EAD7CAb9D6bF482", 8), IsNil)
+	c.Assert(a.evmScanner.tokenManager.SaveTokenMeta("TKN", "0x3b7FA4dd21c6f9BA3ca375217EAD7CAb9D6bF483", 18), IsNil)
+	c.Assert(a.evmScanner.tokenManager.SaveTokenMeta("TKX", "0x3b7FA4dd21c6f9BA3ca375217EAD7CAb9D6bF482", 8), IsNil)
 	result := a.convertSigningAmount(big.NewInt(100), "0x3b7FA4dd21c6f9BA3ca375217EAD7CAb9D6bF483")
 	c.Assert(result.Uint64(), Equals, uint64(100*common.One*100))
 	result = a.convertSigningAmount(big.NewInt(100000000), "0x3b7FA4dd21c6f9BA3ca375217EAD7CAb9D6bF482")
 	c.Assert(result.Uint64(), Equals, uint64(100000000))
 }
 
-func TestGetTokenAddressFromAsset(t *testing.T) {
-	token := getTokenAddressFromAsset(common.AVAXAsset)
-	assert.Equal(t, token, avaxToken)
+func TestGetAVAXTokenAddressFromAsset(t *testing.T) {
+	token := GetTokenAddressFromAsset(common.AVAXAsset, common.AVAXAsset)
+	assert.Equal(t, token, nativeTokenAddr)
 	a, err := common.NewAsset("AVAX.TKN-0X333C3310824B7C685133F2BEDB2CA4B8B4DF633D")
 	assert.Equal(t, err, nil)
-	token = getTokenAddressFromAsset(a)
+	token = GetTokenAddressFromAsset(common.AVAXAsset, a)
 	assert.Equal(t, token, "0X333C3310824B7C685133F2BEDB2CA4B8B4DF633D")
 }
 
@@ -278,10 +327,10 @@ func (s *AvalancheSuite) TestClient(c *C) {
 	pubkeyMgr, err := pubkeymanager.NewPubKeyManager(s.bridge, s.m)
 	c.Assert(err, IsNil)
 	poolMgr := mayaclient.NewPoolMgr(s.bridge)
-	a, err := NewAvalancheClient(s.thorKeys, config.BifrostChainConfiguration{}, nil, s.bridge, s.m, pubkeyMgr, poolMgr)
+	a, err := newAVAXClientForTest(s.thorKeys, config.BifrostChainConfiguration{}, nil, s.bridge, s.m, pubkeyMgr, poolMgr)
 	c.Assert(a, IsNil)
 	c.Assert(err, NotNil)
-	a2, err2 := NewAvalancheClient(s.thorKeys, config.BifrostChainConfiguration{
+	a2, err2 := newAVAXClientForTest(s.thorKeys, config.BifrostChainConfiguration{
 		RPCHost: "http://" + s.server.Listener.Addr().String(),
 		BlockScanner: config.BifrostBlockScannerConfiguration{
 			RPCHost:             "http://" + s.server.Listener.Addr().String(),
@@ -365,7 +414,7 @@ func (s *AvalancheSuite) TestGetAccount(c *C) {
 	pubkeyMgr, err := pubkeymanager.NewPubKeyManager(s.bridge, s.m)
 	c.Assert(err, IsNil)
 	poolMgr := mayaclient.NewPoolMgr(s.bridge)
-	e, err := NewAvalancheClient(s.thorKeys, config.BifrostChainConfiguration{
+	e, err := newAVAXClientForTest(s.thorKeys, config.BifrostChainConfiguration{
 		RPCHost: "http://" + s.server.Listener.Addr().String(),
 		BlockScanner: config.BifrostBlockScannerConfiguration{
 			RPCHost:             "http://" + s.server.Listener.Addr().String(),
@@ -390,7 +439,7 @@ func (s *AvalancheSuite) TestSignAVAXTx(c *C) {
 	pubkeyMgr, err := pubkeymanager.NewPubKeyManager(s.bridge, s.m)
 	c.Assert(err, IsNil)
 	poolMgr := mayaclient.NewPoolMgr(s.bridge)
-	e, err := NewAvalancheClient(s.thorKeys, config.BifrostChainConfiguration{
+	e, err := newAVAXClientForTest(s.thorKeys, config.BifrostChainConfiguration{
 		RPCHost: "http://" + s.server.Listener.Addr().String(),
 		BlockScanner: config.BifrostBlockScannerConfiguration{
 			RPCHost:             "http://" + s.server.Listener.Addr().String(),
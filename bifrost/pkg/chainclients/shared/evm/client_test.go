@@ -1,4 +1,4 @@
-package ethereum
+package evm
 
 import (
 	"encoding/json"
@@ -10,25 +10,29 @@ import (
 	"testing"
 	"time"
 
+	"github.com/blang/semver"
 	"github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	cKeys "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/magiconair/properties/assert"
+	tssp "gitlab.com/thorchain/tss/go-tss/tss"
 	. "gopkg.in/check.v1"
 
+	"gitlab.com/mayachain/mayanode/bifrost/blockscanner"
 	"gitlab.com/mayachain/mayanode/bifrost/mayaclient"
 	stypes "gitlab.com/mayachain/mayanode/bifrost/mayaclient/types"
 	"gitlab.com/mayachain/mayanode/bifrost/metrics"
+	"gitlab.com/mayachain/mayanode/bifrost/pkg/chainclients/signercache"
 	"gitlab.com/mayachain/mayanode/bifrost/pubkeymanager"
 	"gitlab.com/mayachain/mayanode/cmd"
 	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/common/tokenlist"
 	"gitlab.com/mayachain/mayanode/config"
 	types2 "gitlab.com/mayachain/mayanode/x/mayachain/types"
 )
 
-func TestETHPackage(t *testing.T) { TestingT(t) }
-
 type EthereumSuite struct {
 	thordir  string
 	thorKeys *mayaclient.Keys
@@ -49,7 +53,7 @@ func GetMetricForTest(c *C) *metrics.Metrics {
 			ListenPort:   9000,
 			ReadTimeout:  time.Second,
 			WriteTimeout: time.Second,
-			Chains:       common.Chains{common.ETHChain},
+			Chains:       common.Chains{common.ETHChain, common.AVAXChain},
 		})
 		c.Assert(m, NotNil)
 		c.Assert(err, IsNil)
@@ -57,6 +61,64 @@ func GetMetricForTest(c *C) *metrics.Metrics {
 	return m
 }
 
+// newETHChainConfigForTest mirrors the ethereum chain configuration, which
+// can't be imported here without an import cycle
+func newETHChainConfigForTest(cfg config.BifrostBlockScannerConfiguration) ChainConfig {
+	chainCfg := ChainConfig{
+		Chain:              common.ETHChain,
+		NativeAsset:        common.ETHAsset,
+		DefaultDecimals:    18,
+		GasStrategy:        GasStrategyBlockFees,
+		GasCacheBlocks:     40,
+		BlockLag:           1,
+		GasFee:             common.GetETHGasFee,
+		MaxGasLimit:        400000,
+		BlockReward:        big.NewInt(3 * 1e18),
+		SolvencyBlocks:     20,
+		PrefixTokenMeta:    "eth-tokenmeta-",
+		PrefixBlockMeta:    "eth-blockmeta-",
+		PrefixSignedTxItem: "signed-txitem-",
+	}
+	if cfg.SuggestedFeeVersion == 1 {
+		chainCfg.GasStrategy = GasStrategySuggested
+		chainCfg.GasCacheBlocks = 20
+		chainCfg.BlockLag = 0
+	}
+	for _, token := range tokenlist.GetETHTokenList(semver.MustParse("9999.0.0")).Tokens {
+		chainCfg.Tokens = append(chainCfg.Tokens, ERC20Token{
+			Address:  token.Address,
+			Symbol:   token.Symbol,
+			Name:     token.Name,
+			Decimals: token.Decimals,
+		})
+	}
+	return chainCfg
+}
+
+func newETHClientForTest(thorKeys *mayaclient.Keys,
+	cfg config.BifrostChainConfiguration,
+	server *tssp.TssServer,
+	bridge mayaclient.MayachainBridge,
+	m *metrics.Metrics,
+	pubkeyMgr pubkeymanager.PubKeyValidator,
+	poolMgr mayaclient.PoolManager,
+) (*Client, error) {
+	return NewClient(newETHChainConfigForTest(cfg.BlockScanner), thorKeys, cfg, server, bridge, m, pubkeyMgr, poolMgr)
+}
+
+func newETHScannerForTest(cfg config.BifrostBlockScannerConfiguration,
+	storage blockscanner.ScannerStorage,
+	chainID *big.Int,
+	client *ethclient.Client,
+	bridge mayaclient.MayachainBridge,
+	m *metrics.Metrics,
+	pubkeyMgr pubkeymanager.PubKeyValidator,
+	solvencyReporter SolvencyReporter,
+	signerCacheManager *signercache.CacheManager,
+) (*Scanner, error) {
+	return NewScanner(newETHChainConfigForTest(cfg), cfg, storage, chainID, client, bridge, m, pubkeyMgr, solvencyReporter, signerCacheManager)
+}
+
 func (s *EthereumSuite) SetUpTest(c *C) {
 	s.m = GetMetricForTest(c)
 	c.Assert(s.m, NotNil)
@@ -70,7 +132,7 @@ func (s *EthereumSuite) SetUpTest(c *C) {
 			tm, _ := codec.ToTmPubKeyInterface(priKey.PubKey())
 			pk, err := common.NewPubKeyFromCrypto(tm)
 			c.Assert(err, IsNil)
-			content, err := os.ReadFile("../../../../test/fixtures/endpoints/vaults/pubKeys.json")
+			content, err := os.ReadFile("../../../../../test/fixtures/endpoints/vaults/pubKeys.json")
 			c.Assert(err, IsNil)
 			var pubKeysVault types2.QueryVaultsPubKeys
 			c.Assert(json.Unmarshal(content, &pubKeysVault), IsNil)
@@ -88,13 +150,13 @@ func (s *EthereumSuite) SetUpTest(c *C) {
 			_, err = rw.Write(buf)
 			c.Assert(err, IsNil)
 		case mayaclient.InboundAddressesEndpoint:
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/inbound_addresses/inbound_addresses.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/inbound_addresses/inbound_addresses.json")
 		case mayaclient.AsgardVault:
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/vaults/asgard.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/vaults/asgard.json")
 		case mayaclient.LastBlockEndpoint:
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/lastblock/root.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/lastblock/root.json")
 		case mayaclient.NodeAccountEndpoint:
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/nodeaccount/template.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/nodeaccount/template.json")
 		case "/thorchain/mimir/key/MaxUTXOsToSpend":
 			_, err := rw.Write([]byte(`-1`))
 			c.Assert(err, IsNil)
@@ -223,21 +285,21 @@ func (s *EthereumSuite) TestNewClient(c *C) {
 	poolMgr := mayaclient.NewPoolMgr(s.bridge)
 
 	// bridge is nil
-	e, err := NewClient(s.thorKeys, config.BifrostChainConfiguration{}, nil, nil, s.m, pubkeyMgr, poolMgr)
+	e, err := newETHClientForTest(s.thorKeys, config.BifrostChainConfiguration{}, nil, nil, s.m, pubkeyMgr, poolMgr)
 	c.Assert(e, IsNil)
 	c.Assert(err, NotNil)
 
 	// pubkey manager is nil
-	e, err = NewClient(s.thorKeys, config.BifrostChainConfiguration{}, nil, s.bridge, s.m, nil, poolMgr)
+	e, err = newETHClientForTest(s.thorKeys, config.BifrostChainConfiguration{}, nil, s.bridge, s.m, nil, poolMgr)
 	c.Assert(e, IsNil)
 	c.Assert(err, NotNil)
 
 	// pubkey manager is nil
-	e, err = NewClient(s.thorKeys, config.BifrostChainConfiguration{}, nil, s.bridge, s.m, pubkeyMgr, nil)
+	e, err = newETHClientForTest(s.thorKeys, config.BifrostChainConfiguration{}, nil, s.bridge, s.m, pubkeyMgr, nil)
 	c.Assert(e, IsNil)
 	c.Assert(err, NotNil)
 	// pubkey manager is nil
-	e, err = NewClient(nil, config.BifrostChainConfiguration{}, nil, s.bridge, s.m, pubkeyMgr, poolMgr)
+	e, err = newETHClientForTest(nil, config.BifrostChainConfiguration{}, nil, s.bridge, s.m, pubkeyMgr, poolMgr)
 	c.Assert(e, IsNil)
 	c.Assert(err, NotNil)
 }
@@ -246,18 +308,25 @@ func (s *EthereumSuite) TestConvertSigningAmount(c *C) {
 	pubkeyMgr, err := pubkeymanager.NewPubKeyManager(s.bridge, s.m)
 	c.Assert(err, IsNil)
 	poolMgr := mayaclient.NewPoolMgr(s.bridge)
-	e, err := NewClient(s.thorKeys, config.BifrostChainConfiguration{
+	chainCfg := config.BifrostChainConfiguration{
 		RPCHost: "http://" + s.server.Listener.Addr().String(),
 		BlockScanner: config.BifrostBlockScannerConfiguration{
 			StartBlockHeight:    1, // avoids querying thorchain for block height
 			HTTPRequestTimeout:  time.Second,
 			SuggestedFeeVersion: 2,
 		},
-	}, nil, s.bridge, s.m, pubkeyMgr, poolMgr)
+	}
+	// token metas are only read for whitelisted tokens
+	evmCfg := newETHChainConfigForTest(chainCfg.BlockScanner)
+	evmCfg.Tokens = append(evmCfg.Tokens,
+		ERC20Token{Address: "0x3b7FA4dd21c6f9BA3ca375217EAD7CAb9D6bF483", Symbol: "TKN", Decimals: 18},
+		ERC20Token{Address: "0x3b7FA4dd21c6f9BA3ca375217EAD7CAb9D6bF482", Symbol: "TKX", Decimals: 8},
+	)
+	e, err := NewClient(evmCfg, s.thorKeys, chainCfg, nil, s.bridge, s.m, pubkeyMgr, poolMgr)
 	c.Assert(err, IsNil)
 	c.Assert(e, NotNil)
-	c.Assert(e.ethScanner.tokens.SaveTokenMeta("TKN", "0x3b7FA4dd21c6f9BA3ca375217EAD7CAb9D6bF483", 18), IsNil)
-	c.Assert(e.ethScanner.tokens.SaveTokenMeta("TKX", "0x3b7FA4dd21c6f9BA3ca375217EAD7CAb9D6bF482", 8), IsNil)
+	c.Assert(e.evmScanner.tokenManager.SaveTokenMeta("TKN", "0x3b7FA4dd21c6f9BA3ca375217EAD7CAb9D6bF483", 18), IsNil)
+	c.Assert(e.evmScanner.tokenManager.SaveTokenMeta("TKX", "0x3b7FA4dd21c6f9BA3ca375217EAD7CAb9D6bF482", 8), IsNil)
 	result := e.convertSigningAmount(big.NewInt(100), "0x3b7FA4dd21c6f9BA3ca375217EAD7CAb9D6bF483")
 	c.Assert(result.Uint64(), Equals, uint64(100*common.One*100))
 	result = e.convertSigningAmount(big.NewInt(100000000), "0x3b7FA4dd21c6f9BA3ca375217EAD7CAb9D6bF482")
@@ -265,11 +334,11 @@ func (s *EthereumSuite) TestConvertSigningAmount(c *C) {
 }
 
 func TestGetTokenAddressFromAsset(t *testing.T) {
-	token := getTokenAddressFromAsset(common.ETHAsset)
-	assert.Equal(t, token, ethToken)
+	token := GetTokenAddressFromAsset(common.ETHAsset, common.ETHAsset)
+	assert.Equal(t, token, nativeTokenAddr)
 	a, err := common.NewAsset("ETH.TKN-0x3b7FA4dd21c6f9BA3ca375217EAD7CAb9D6bF483")
 	assert.Equal(t, err, nil)
-	token = getTokenAddressFromAsset(a)
+	token = GetTokenAddressFromAsset(common.ETHAsset, a)
 	assert.Equal(t, token, "0X3B7FA4DD21C6F9BA3CA375217EAD7CAB9D6BF483")
 }
 
@@ -277,10 +346,10 @@ func (s *EthereumSuite) TestClient(c *C) {
 	pubkeyMgr, err := pubkeymanager.NewPubKeyManager(s.bridge, s.m)
 	c.Assert(err, IsNil)
 	poolMgr := mayaclient.NewPoolMgr(s.bridge)
-	e, err := NewClient(s.thorKeys, config.BifrostChainConfiguration{}, nil, s.bridge, s.m, pubkeyMgr, poolMgr)
+	e, err := newETHClientForTest(s.thorKeys, config.BifrostChainConfiguration{}, nil, s.bridge, s.m, pubkeyMgr, poolMgr)
 	c.Assert(e, IsNil)
 	c.Assert(err, NotNil)
-	e2, err2 := NewClient(s.thorKeys, config.BifrostChainConfiguration{
+	e2, err2 := newETHClientForTest(s.thorKeys, config.BifrostChainConfiguration{
 		RPCHost: "http://" + s.server.Listener.Addr().String(),
 		BlockScanner: config.BifrostBlockScannerConfiguration{
 			StartBlockHeight:    1, // avoids querying thorchain for block height
@@ -297,7 +366,7 @@ func (s *EthereumSuite) TestClient(c *C) {
 	c.Assert(err, IsNil)
 	c.Check(height, Equals, int64(6))
 	gasPrice := e2.GetGasPrice()
-	c.Check(gasPrice.Uint64(), Equals, uint64(initialGasPrice))
+	c.Check(gasPrice.Uint64(), Equals, uint64(0))
 
 	acct, err := e2.GetAccount(types2.GetRandomPubKey(), nil)
 	c.Assert(err, IsNil)
@@ -363,7 +432,7 @@ func (s *EthereumSuite) TestGetAccount(c *C) {
 	pubkeyMgr, err := pubkeymanager.NewPubKeyManager(s.bridge, s.m)
 	c.Assert(err, IsNil)
 	poolMgr := mayaclient.NewPoolMgr(s.bridge)
-	e, err := NewClient(s.thorKeys, config.BifrostChainConfiguration{
+	e, err := newETHClientForTest(s.thorKeys, config.BifrostChainConfiguration{
 		RPCHost: "http://" + s.server.Listener.Addr().String(),
 		BlockScanner: config.BifrostBlockScannerConfiguration{
 			StartBlockHeight:    1, // avoids querying thorchain for block height
@@ -387,7 +456,7 @@ func (s *EthereumSuite) TestSignETHTx(c *C) {
 	pubkeyMgr, err := pubkeymanager.NewPubKeyManager(s.bridge, s.m)
 	c.Assert(err, IsNil)
 	poolMgr := mayaclient.NewPoolMgr(s.bridge)
-	e, err := NewClient(s.thorKeys, config.BifrostChainConfiguration{
+	e, err := newETHClientForTest(s.thorKeys, config.BifrostChainConfiguration{
 		RPCHost: "http://" + s.server.Listener.Addr().String(),
 		BlockScanner: config.BifrostBlockScannerConfiguration{
 			StartBlockHeight:    1, // avoids querying thorchain for block height
@@ -614,7 +683,7 @@ func (s *EthereumSuite) TestGetAsgardAddresses(c *C) {
 	pubkeyMgr, err := pubkeymanager.NewPubKeyManager(s.bridge, s.m)
 	c.Assert(err, IsNil)
 	poolMgr := mayaclient.NewPoolMgr(s.bridge)
-	e, err := NewClient(s.thorKeys, config.BifrostChainConfiguration{
+	e, err := newETHClientForTest(s.thorKeys, config.BifrostChainConfiguration{
 		RPCHost: "http://" + s.server.Listener.Addr().String(),
 		BlockScanner: config.BifrostBlockScannerConfiguration{
 			StartBlockHeight:    1, // avoids querying thorchain for block height
@@ -635,7 +704,7 @@ func (s *EthereumSuite) TestGetConfirmationCount(c *C) {
 	pubkeyMgr, err := pubkeymanager.NewPubKeyManager(s.bridge, s.m)
 	c.Assert(err, IsNil)
 	poolMgr := mayaclient.NewPoolMgr(s.bridge)
-	e, err := NewClient(s.thorKeys, config.BifrostChainConfiguration{
+	e, err := newETHClientForTest(s.thorKeys, config.BifrostChainConfiguration{
 		RPCHost: "http://" + s.server.Listener.Addr().String(),
 		BlockScanner: config.BifrostBlockScannerConfiguration{
 			StartBlockHeight:    1, // avoids querying thorchain for block height
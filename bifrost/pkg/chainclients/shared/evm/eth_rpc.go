@@ -17,7 +17,6 @@ import (
 
 // EthRPC is a struct that interacts with an ETH RPC compatible blockchain
 type EthRPC struct {
-	host    string
 	client  *ethclient.Client
 	timeout time.Duration
 	logger  zerolog.Logger
@@ -28,13 +27,16 @@ func NewEthRPC(host string, timeout time.Duration, chain string) (*EthRPC, error
 	if err != nil {
 		return nil, fmt.Errorf("fail to dial ETH rpc host(%s): %w", host, err)
 	}
+	return newEthRPC(ethClient, timeout, chain), nil
+}
 
+// newEthRPC create a new instance of EthRPC on an existing client
+func newEthRPC(ethClient *ethclient.Client, timeout time.Duration, chain string) *EthRPC {
 	return &EthRPC{
-		host:    host,
 		client:  ethClient,
 		timeout: timeout,
 		logger:  log.Logger.With().Str("module", "eth_rpc").Str("chain", chain).Logger(),
-	}, nil
+	}
 }
 
 func (e *EthRPC) getContext() (context.Context, context.CancelFunc) {
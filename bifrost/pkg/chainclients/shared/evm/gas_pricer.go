@@ -0,0 +1,135 @@
+package evm
+
+import (
+	"math/big"
+	"sort"
+)
+
+// tenGwei is the lowest gas price suggested by the node that is followed
+const tenGwei = 10000000000
+
+// GasPricer keeps the gas price of an EVM chain, derived from a cache of the
+// recent blocks according to the gas strategy of the chain
+type GasPricer struct {
+	strategy    GasStrategy
+	cacheBlocks int
+	resolution  int64
+	cache       []*big.Int
+	price       *big.Int
+}
+
+// NewGasPricer create a new instance of GasPricer
+func NewGasPricer(strategy GasStrategy, cacheBlocks uint64, resolution int64, initialPrice *big.Int) *GasPricer {
+	return &GasPricer{
+		strategy:    strategy,
+		cacheBlocks: int(cacheBlocks),
+		resolution:  resolution,
+		cache:       make([]*big.Int, 0),
+		price:       initialPrice,
+	}
+}
+
+// Strategy returns the gas strategy of the pricer
+func (g *GasPricer) Strategy() GasStrategy {
+	return g.strategy
+}
+
+// Price returns the current gas price, in wei
+func (g *GasPricer) Price() *big.Int {
+	return g.price
+}
+
+// CacheSize returns the number of blocks in the cache
+func (g *GasPricer) CacheSize() int {
+	return len(g.cache)
+}
+
+func (g *GasPricer) add(price *big.Int) {
+	g.cache = append(g.cache, price)
+	if len(g.cache) > g.cacheBlocks {
+		g.cache = g.cache[(len(g.cache) - g.cacheBlocks):]
+	}
+}
+
+// AddSuggested adds the gas price suggested by the node, used with
+// GasStrategySuggested. It returns true when the gas price changed.
+func (g *GasPricer) AddSuggested(suggested *big.Int) bool {
+	// make sure the gas price is at least ten Gwei
+	gasPrice := suggested
+	if gasPrice.Cmp(big.NewInt(tenGwei)) < 0 {
+		gasPrice = big.NewInt(tenGwei)
+	}
+	// gasPrice = gasPrice * 1.5
+	gasPrice = big.NewInt(1).Mul(gasPrice, big.NewInt(3))
+	gasPrice = big.NewInt(1).Div(gasPrice, big.NewInt(2))
+	g.add(gasPrice)
+
+	// the highest gas price in the cache, make sure we can pay enough fee
+	highest := big.NewInt(0)
+	for _, v := range g.cache {
+		if v.Cmp(highest) > 0 {
+			highest = v
+		}
+	}
+	if g.price.Cmp(highest) == 0 {
+		return false
+	}
+	g.price = highest
+	return true
+}
+
+// AddBlockFees adds the gas prices paid by the txs of a block, used with
+// GasStrategyBlockFees. It returns true when the gas price was updated, which
+// happens once the cache is full.
+func (g *GasPricer) AddBlockFees(prices []*big.Int) bool {
+	// skip empty blocks
+	if len(prices) == 0 {
+		return false
+	}
+
+	// find the 25th percentile gas price in the block
+	sort.Slice(prices, func(i, j int) bool { return prices[i].Cmp(prices[j]) == -1 })
+	g.add(prices[len(prices)/4])
+
+	// skip update unless cache is full
+	if len(g.cache) < g.cacheBlocks {
+		return false
+	}
+
+	// compute the mean of the 25th percentiles in the cache
+	sum := new(big.Int)
+	for _, fee := range g.cache {
+		sum.Add(sum, fee)
+	}
+	mean := new(big.Int).Quo(sum, big.NewInt(int64(g.cacheBlocks)))
+
+	// compute the standard deviation of the 25th percentiles in cache
+	std := new(big.Int)
+	for _, fee := range g.cache {
+		v := new(big.Int).Sub(fee, mean)
+		v.Mul(v, v)
+		std.Add(std, v)
+	}
+	std.Quo(std, big.NewInt(int64(g.cacheBlocks)))
+	std.Sqrt(std)
+
+	// mean + 3x standard deviation of the 25th percentile fee over blocks
+	mean.Add(mean, std.Mul(std, big.NewInt(3)))
+	if g.resolution == 0 {
+		g.price = mean
+		return true
+	}
+
+	// round the price up to avoid fee noise
+	resolution := big.NewInt(g.resolution)
+	if mean.Cmp(resolution) != 1 {
+		g.price = resolution
+	} else {
+		mean.Sub(mean, big.NewInt(1))
+		mean.Quo(mean, resolution)
+		mean.Add(mean, big.NewInt(1))
+		mean.Mul(mean, resolution)
+		g.price = mean
+	}
+	return true
+}
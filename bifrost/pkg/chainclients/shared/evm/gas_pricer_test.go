@@ -0,0 +1,84 @@
+package evm
+
+import (
+	"math/big"
+
+	. "gopkg.in/check.v1"
+)
+
+type GasPricerTestSuite struct{}
+
+var _ = Suite(&GasPricerTestSuite{})
+
+func (s *GasPricerTestSuite) TestNewGasPricer(c *C) {
+	pricer := NewGasPricer(GasStrategyBlockFees, 40, 0, big.NewInt(100))
+	c.Assert(pricer, NotNil)
+	c.Check(pricer.Strategy(), Equals, GasStrategyBlockFees)
+	c.Check(pricer.Price().Int64(), Equals, int64(100))
+	c.Check(pricer.CacheSize(), Equals, 0)
+}
+
+func (s *GasPricerTestSuite) TestAddSuggested(c *C) {
+	pricer := NewGasPricer(GasStrategySuggested, 2, 0, big.NewInt(0))
+
+	// suggested price lower than ten Gwei is raised to ten Gwei, then 1.5x
+	c.Check(pricer.AddSuggested(big.NewInt(1)), Equals, true)
+	c.Check(pricer.Price().Int64(), Equals, int64(15000000000))
+	c.Check(pricer.CacheSize(), Equals, 1)
+
+	// same price, no change
+	c.Check(pricer.AddSuggested(big.NewInt(10000000000)), Equals, false)
+	c.Check(pricer.Price().Int64(), Equals, int64(15000000000))
+	c.Check(pricer.CacheSize(), Equals, 2)
+
+	// the highest price in the cache is used
+	c.Check(pricer.AddSuggested(big.NewInt(20000000000)), Equals, true)
+	c.Check(pricer.Price().Int64(), Equals, int64(30000000000))
+	c.Check(pricer.CacheSize(), Equals, 2)
+	c.Check(pricer.AddSuggested(big.NewInt(10000000000)), Equals, false)
+	c.Check(pricer.Price().Int64(), Equals, int64(30000000000))
+
+	// the highest price dropped out of the cache
+	c.Check(pricer.AddSuggested(big.NewInt(10000000000)), Equals, true)
+	c.Check(pricer.Price().Int64(), Equals, int64(15000000000))
+	c.Check(pricer.CacheSize(), Equals, 2)
+}
+
+func (s *GasPricerTestSuite) TestAddBlockFees(c *C) {
+	pricer := NewGasPricer(GasStrategyBlockFees, 3, 0, big.NewInt(100))
+
+	// empty blocks are skipped
+	c.Check(pricer.AddBlockFees([]*big.Int{}), Equals, false)
+	c.Check(pricer.CacheSize(), Equals, 0)
+
+	// no update until the cache is full
+	c.Check(pricer.AddBlockFees([]*big.Int{big.NewInt(4), big.NewInt(1), big.NewInt(3), big.NewInt(2)}), Equals, false)
+	c.Check(pricer.AddBlockFees([]*big.Int{big.NewInt(4), big.NewInt(1), big.NewInt(3), big.NewInt(2)}), Equals, false)
+	c.Check(pricer.Price().Int64(), Equals, int64(100))
+
+	// 25th percentile of every block is 2, no deviation
+	c.Check(pricer.AddBlockFees([]*big.Int{big.NewInt(4), big.NewInt(1), big.NewInt(3), big.NewInt(2)}), Equals, true)
+	c.Check(pricer.CacheSize(), Equals, 3)
+	c.Check(pricer.Price().Int64(), Equals, int64(2))
+
+	// 25th percentiles of 2, 2 and 8: mean 4 + 3x stddev (2)
+	c.Check(pricer.AddBlockFees([]*big.Int{big.NewInt(8), big.NewInt(8), big.NewInt(8), big.NewInt(8)}), Equals, true)
+	c.Check(pricer.CacheSize(), Equals, 3)
+	c.Check(pricer.Price().Int64(), Equals, int64(10))
+}
+
+func (s *GasPricerTestSuite) TestAddBlockFeesResolution(c *C) {
+	pricer := NewGasPricer(GasStrategyBlockFees, 1, 5, big.NewInt(0))
+
+	// prices below the resolution are raised to the resolution
+	c.Check(pricer.AddBlockFees([]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4)}), Equals, true)
+	c.Check(pricer.Price().Int64(), Equals, int64(5))
+
+	// prices are rounded up to the resolution
+	c.Check(pricer.AddBlockFees([]*big.Int{big.NewInt(20), big.NewInt(21), big.NewInt(22), big.NewInt(23)}), Equals, true)
+	c.Check(pricer.Price().Int64(), Equals, int64(25))
+
+	// multiples of the resolution are kept
+	c.Check(pricer.AddBlockFees([]*big.Int{big.NewInt(10), big.NewInt(10)}), Equals, true)
+	c.Check(pricer.Price().Int64(), Equals, int64(10))
+}
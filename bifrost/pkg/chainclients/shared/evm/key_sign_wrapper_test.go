@@ -18,8 +18,6 @@ import (
 	"gitlab.com/mayachain/mayanode/x/mayachain/types"
 )
 
-const MaxContractGas = 80000
-
 type KeysignWrapperTestSuite struct {
 	thorKeys *mayaclient.Keys
 	wrapper  *KeySignWrapper
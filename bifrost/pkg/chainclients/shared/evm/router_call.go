@@ -0,0 +1,157 @@
+package evm
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ecommon "github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	stypes "gitlab.com/mayachain/mayanode/bifrost/mayaclient/types"
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	mem "gitlab.com/mayachain/mayanode/x/mayachain/memo"
+)
+
+// GetTokenAddressFromAsset returns the token address of the given asset, the
+// native asset is the zero address
+func GetTokenAddressFromAsset(nativeAsset, asset common.Asset) string {
+	if asset.Equals(nativeAsset) {
+		return nativeTokenAddr
+	}
+	allParts := strings.Split(asset.Symbol.String(), "-")
+	return allParts[len(allParts)-1]
+}
+
+// RouterCallBuilder encodes outbound transactions as calls of the router contract
+type RouterCallBuilder struct {
+	nativeAsset  common.Asset
+	vaultABI     *abi.ABI
+	tokenManager *TokenManager
+	logger       zerolog.Logger
+}
+
+// NewRouterCallBuilder create a new instance of RouterCallBuilder
+func NewRouterCallBuilder(nativeAsset common.Asset, vaultABI *abi.ABI, tokenManager *TokenManager) *RouterCallBuilder {
+	return &RouterCallBuilder{
+		nativeAsset:  nativeAsset,
+		vaultABI:     vaultABI,
+		tokenManager: tokenManager,
+		logger:       log.Logger.With().Str("module", "router_call").Str("chain", nativeAsset.Chain.String()).Logger(),
+	}
+}
+
+// Build returns the router call data of the given outbound, whether the
+// outbound moves the funds to a different router, and the native value sent
+// along with the call. contractAddr is the router of the vault, routerOf
+// returns the router of the vault with the given address. No data is returned
+// when the outbound has to be dropped.
+func (b *RouterCallBuilder) Build(txOutItem stypes.TxOutItem, memo mem.Memo, contractAddr common.Address, routerOf func(common.Address) common.Address) ([]byte, bool, *big.Int, error) {
+	var data []byte
+	var err error
+	var tokenAddr string
+	value := big.NewInt(0)
+	nativeValue := big.NewInt(0)
+	hasRouterUpdated := false
+
+	if len(txOutItem.Coins) == 1 {
+		coin := txOutItem.Coins[0]
+		tokenAddr = GetTokenAddressFromAsset(b.nativeAsset, coin.Asset)
+		value = value.Add(value, coin.Amount.BigInt())
+		value = b.tokenManager.ConvertSigningAmount(value, tokenAddr)
+		if IsNative(tokenAddr) {
+			nativeValue = value
+		}
+	}
+
+	toAddr := ecommon.HexToAddress(txOutItem.ToAddress.String())
+
+	switch memo.GetType() {
+	case mem.TxOutbound, mem.TxRefund, mem.TxRagnarok:
+		if txOutItem.Aggregator == "" {
+			data, err = b.vaultABI.Pack("transferOut", toAddr, ecommon.HexToAddress(tokenAddr), value, txOutItem.Memo)
+			if err != nil {
+				return nil, hasRouterUpdated, nil, fmt.Errorf("fail to create data to call smart contract(transferOut): %w", err)
+			}
+		} else {
+			memoType := memo.GetType()
+			if memoType == mem.TxRefund || memoType == mem.TxRagnarok {
+				return nil, hasRouterUpdated, nil, fmt.Errorf("%s can't use transferOutAndCall", memoType)
+			}
+			b.logger.Info().Msgf("aggregator target asset address: %s", txOutItem.AggregatorTargetAsset)
+			if nativeValue.Uint64() == 0 {
+				return nil, hasRouterUpdated, nil, fmt.Errorf("transferOutAndCall can only be used when outbound asset is %s", b.nativeAsset.Symbol)
+			}
+			targetLimit := txOutItem.AggregatorTargetLimit
+			if targetLimit == nil {
+				zeroLimit := cosmos.ZeroUint()
+				targetLimit = &zeroLimit
+			}
+			aggAddr := ecommon.HexToAddress(txOutItem.Aggregator)
+			targetAddr := ecommon.HexToAddress(txOutItem.AggregatorTargetAsset)
+			// when address can't be round trip , the tx out item will be dropped
+			if !strings.EqualFold(aggAddr.String(), txOutItem.Aggregator) {
+				b.logger.Error().Msgf("aggregator address can't roundtrip , ignore tx (%s != %s)", txOutItem.Aggregator, aggAddr.String())
+				return nil, hasRouterUpdated, nil, nil
+			}
+			if !strings.EqualFold(targetAddr.String(), txOutItem.AggregatorTargetAsset) {
+				b.logger.Error().Msgf("aggregator target asset address can't roundtrip , ignore tx (%s != %s)", txOutItem.AggregatorTargetAsset, targetAddr.String())
+				return nil, hasRouterUpdated, nil, nil
+			}
+			data, err = b.vaultABI.Pack("transferOutAndCall", aggAddr, targetAddr, toAddr, targetLimit.BigInt(), txOutItem.Memo)
+			if err != nil {
+				return nil, hasRouterUpdated, nil, fmt.Errorf("fail to create data to call smart contract(transferOutAndCall): %w", err)
+			}
+		}
+	case mem.TxMigrate, mem.TxYggdrasilFund:
+		if txOutItem.Aggregator != "" || txOutItem.AggregatorTargetAsset != "" {
+			return nil, hasRouterUpdated, nil, fmt.Errorf("migration / yggdrasil+ can't use aggregator")
+		}
+		if IsNative(tokenAddr) {
+			data, err = b.vaultABI.Pack("transferOut", toAddr, ecommon.HexToAddress(tokenAddr), value, txOutItem.Memo)
+			if err != nil {
+				return nil, hasRouterUpdated, nil, fmt.Errorf("fail to create data to call smart contract(transferOut): %w", err)
+			}
+		} else {
+			newSmartContractAddr := routerOf(txOutItem.ToAddress)
+			if newSmartContractAddr.IsEmpty() {
+				return nil, hasRouterUpdated, nil, fmt.Errorf("fail to get new smart contract address")
+			}
+			data, err = b.vaultABI.Pack("transferAllowance", ecommon.HexToAddress(newSmartContractAddr.String()), toAddr, ecommon.HexToAddress(tokenAddr), value, txOutItem.Memo)
+			if err != nil {
+				return nil, hasRouterUpdated, nil, fmt.Errorf("fail to create data to call smart contract(transferAllowance): %w", err)
+			}
+		}
+	case mem.TxYggdrasilReturn:
+		if txOutItem.Aggregator != "" || txOutItem.AggregatorTargetAsset != "" {
+			return nil, hasRouterUpdated, nil, fmt.Errorf("yggdrasil- can't use aggregator")
+		}
+		newSmartContractAddr := routerOf(txOutItem.ToAddress)
+		if newSmartContractAddr.IsEmpty() {
+			return nil, hasRouterUpdated, nil, fmt.Errorf("fail to get new smart contract address")
+		}
+		hasRouterUpdated = !newSmartContractAddr.Equals(contractAddr)
+
+		var coins []RouterCoin
+		for _, item := range txOutItem.Coins {
+			assetAddr := GetTokenAddressFromAsset(b.nativeAsset, item.Asset)
+			assetAmt := b.tokenManager.ConvertSigningAmount(item.Amount.BigInt(), assetAddr)
+			if IsNative(assetAddr) {
+				nativeValue = assetAmt
+				continue
+			}
+			coins = append(coins, RouterCoin{
+				Asset:  ecommon.HexToAddress(assetAddr),
+				Amount: assetAmt,
+			})
+		}
+		data, err = b.vaultABI.Pack("returnVaultAssets", ecommon.HexToAddress(newSmartContractAddr.String()), toAddr, coins, txOutItem.Memo)
+		if err != nil {
+			return nil, hasRouterUpdated, nil, fmt.Errorf("fail to create data to call smart contract(transferVaultAssets): %w", err)
+		}
+	}
+	return data, hasRouterUpdated, nativeValue, nil
+}
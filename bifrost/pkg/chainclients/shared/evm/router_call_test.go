@@ -0,0 +1,196 @@
+package evm
+
+import (
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ecommon "github.com/ethereum/go-ethereum/common"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	. "gopkg.in/check.v1"
+
+	stypes "gitlab.com/mayachain/mayanode/bifrost/mayaclient/types"
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	mem "gitlab.com/mayachain/mayanode/x/mayachain/memo"
+)
+
+type RouterCallBuilderTestSuite struct {
+	vaultABI *abi.ABI
+	builder  *RouterCallBuilder
+}
+
+var _ = Suite(&RouterCallBuilderTestSuite{})
+
+const (
+	testRouter    = "0xe65e9d372f8cacc7b6dfcd4af6507851ed31bb44"
+	testNewRouter = "0xd7f6bb6b4bd3ab8b5e05a7e1be1e4d1e7e5c9a3b"
+	testUSDC      = "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+	testToAddress = "0x3fd2d4ce97b082d4bce3f9fee2a3d60668d2f473"
+	testInHash    = "B9FB37A8DB7DB1E3BCF6AC6E8AE71D2C7E1F1D7F8ECB7C1E6C8D4A1E5F2B3C4D"
+)
+
+func (s *RouterCallBuilderTestSuite) SetUpSuite(c *C) {
+	ethClient, err := getTestEthClient(c)
+	c.Assert(err, IsNil)
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	c.Assert(err, IsNil)
+	tokenManager, err := NewTokenManager(db, "eth-tokenmeta-", common.ETHAsset, 18, time.Second, NewTokenWhitelist(common.ETHChain, nil, testWhiteList), ethClient, routerContractABI, erc20ContractABI)
+	c.Assert(err, IsNil)
+	c.Assert(tokenManager.SaveTokenMeta("USDC", testUSDC, 6), IsNil)
+	s.vaultABI, _, err = GetContractABI(routerContractABI, erc20ContractABI)
+	c.Assert(err, IsNil)
+	s.builder = NewRouterCallBuilder(common.ETHAsset, s.vaultABI, tokenManager)
+}
+
+func (s *RouterCallBuilderTestSuite) routerOf(router string) func(common.Address) common.Address {
+	return func(common.Address) common.Address {
+		return common.Address(router)
+	}
+}
+
+func (s *RouterCallBuilderTestSuite) unpack(c *C, method string, data []byte) []interface{} {
+	m, ok := s.vaultABI.Methods[method]
+	c.Assert(ok, Equals, true)
+	c.Assert(len(data) > 4, Equals, true)
+	c.Assert(data[:4], DeepEquals, m.ID)
+	args, err := m.Inputs.Unpack(data[4:])
+	c.Assert(err, IsNil)
+	return args
+}
+
+func (s *RouterCallBuilderTestSuite) txOutItem(c *C, memo string, coins ...common.Coin) (stypes.TxOutItem, mem.Memo) {
+	item := stypes.TxOutItem{
+		Chain:     common.ETHChain,
+		ToAddress: common.Address(testToAddress),
+		Coins:     coins,
+		Memo:      memo,
+	}
+	m, err := mem.ParseMemo(common.LatestVersion, memo)
+	c.Assert(err, IsNil)
+	return item, m
+}
+
+func (s *RouterCallBuilderTestSuite) usdcAsset(c *C) common.Asset {
+	asset, err := common.NewAsset("ETH.USDC-" + strings.ToUpper(testUSDC))
+	c.Assert(err, IsNil)
+	return asset
+}
+
+func (s *RouterCallBuilderTestSuite) TestTransferOut(c *C) {
+	// native asset, 1e8 is converted to 1e18 and sent along with the call
+	item, memo := s.txOutItem(c, "OUT:"+testInHash, common.NewCoin(common.ETHAsset, cosmos.NewUint(common.One)))
+	data, hasRouterUpdated, nativeValue, err := s.builder.Build(item, memo, common.Address(testRouter), s.routerOf(testRouter))
+	c.Assert(err, IsNil)
+	c.Check(hasRouterUpdated, Equals, false)
+	c.Check(nativeValue.String(), Equals, "1000000000000000000")
+	args := s.unpack(c, "transferOut", data)
+	c.Check(args[0].(ecommon.Address), Equals, ecommon.HexToAddress(testToAddress))
+	c.Check(args[1].(ecommon.Address), Equals, ecommon.HexToAddress(nativeTokenAddr))
+	c.Check(args[2].(*big.Int).String(), Equals, "1000000000000000000")
+	c.Check(args[3].(string), Equals, "OUT:"+testInHash)
+
+	// token, the amount follows the token decimals and no native value is sent
+	item, memo = s.txOutItem(c, "REFUND:"+testInHash, common.NewCoin(s.usdcAsset(c), cosmos.NewUint(common.One)))
+	data, hasRouterUpdated, nativeValue, err = s.builder.Build(item, memo, common.Address(testRouter), s.routerOf(testRouter))
+	c.Assert(err, IsNil)
+	c.Check(hasRouterUpdated, Equals, false)
+	c.Check(nativeValue.Uint64(), Equals, uint64(0))
+	args = s.unpack(c, "transferOut", data)
+	c.Check(args[1].(ecommon.Address), Equals, ecommon.HexToAddress(testUSDC))
+	c.Check(args[2].(*big.Int).Uint64(), Equals, uint64(1000000))
+}
+
+func (s *RouterCallBuilderTestSuite) TestTransferOutAndCall(c *C) {
+	aggregator := ecommon.HexToAddress("0x69800327b38a4ceef75a2d1c4ac4b4b2b4ea2bb6").String()
+	target := ecommon.HexToAddress(testUSDC).String()
+	limit := cosmos.NewUint(100)
+
+	item, memo := s.txOutItem(c, "OUT:"+testInHash, common.NewCoin(common.ETHAsset, cosmos.NewUint(common.One)))
+	item.Aggregator = aggregator
+	item.AggregatorTargetAsset = target
+	item.AggregatorTargetLimit = &limit
+	data, _, nativeValue, err := s.builder.Build(item, memo, common.Address(testRouter), s.routerOf(testRouter))
+	c.Assert(err, IsNil)
+	c.Check(nativeValue.String(), Equals, "1000000000000000000")
+	args := s.unpack(c, "transferOutAndCall", data)
+	c.Check(args[0].(ecommon.Address).String(), Equals, aggregator)
+	c.Check(args[1].(ecommon.Address).String(), Equals, target)
+	c.Check(args[2].(ecommon.Address), Equals, ecommon.HexToAddress(testToAddress))
+	c.Check(args[3].(*big.Int).Uint64(), Equals, uint64(100))
+
+	// aggregator address that doesn't roundtrip, the outbound is dropped
+	item.Aggregator = "0x6980"
+	data, _, _, err = s.builder.Build(item, memo, common.Address(testRouter), s.routerOf(testRouter))
+	c.Assert(err, IsNil)
+	c.Check(data, IsNil)
+
+	// only the native asset can be swapped by an aggregator
+	item, memo = s.txOutItem(c, "OUT:"+testInHash, common.NewCoin(s.usdcAsset(c), cosmos.NewUint(common.One)))
+	item.Aggregator = aggregator
+	_, _, _, err = s.builder.Build(item, memo, common.Address(testRouter), s.routerOf(testRouter))
+	c.Assert(err, NotNil)
+
+	// refunds can't use an aggregator
+	item, memo = s.txOutItem(c, "REFUND:"+testInHash, common.NewCoin(common.ETHAsset, cosmos.NewUint(common.One)))
+	item.Aggregator = aggregator
+	_, _, _, err = s.builder.Build(item, memo, common.Address(testRouter), s.routerOf(testRouter))
+	c.Assert(err, NotNil)
+}
+
+func (s *RouterCallBuilderTestSuite) TestMigrate(c *C) {
+	// native asset is transferred out
+	item, memo := s.txOutItem(c, "MIGRATE:100", common.NewCoin(common.ETHAsset, cosmos.NewUint(common.One)))
+	data, hasRouterUpdated, nativeValue, err := s.builder.Build(item, memo, common.Address(testRouter), s.routerOf(testNewRouter))
+	c.Assert(err, IsNil)
+	c.Check(hasRouterUpdated, Equals, false)
+	c.Check(nativeValue.String(), Equals, "1000000000000000000")
+	s.unpack(c, "transferOut", data)
+
+	// tokens are moved with an allowance on the router of the new vault
+	item, memo = s.txOutItem(c, "MIGRATE:100", common.NewCoin(s.usdcAsset(c), cosmos.NewUint(common.One)))
+	data, _, nativeValue, err = s.builder.Build(item, memo, common.Address(testRouter), s.routerOf(testNewRouter))
+	c.Assert(err, IsNil)
+	c.Check(nativeValue.Uint64(), Equals, uint64(0))
+	args := s.unpack(c, "transferAllowance", data)
+	c.Check(args[0].(ecommon.Address), Equals, ecommon.HexToAddress(testNewRouter))
+	c.Check(args[1].(ecommon.Address), Equals, ecommon.HexToAddress(testToAddress))
+	c.Check(args[2].(ecommon.Address), Equals, ecommon.HexToAddress(testUSDC))
+	c.Check(args[3].(*big.Int).Uint64(), Equals, uint64(1000000))
+
+	// the router of the new vault is unknown
+	_, _, _, err = s.builder.Build(item, memo, common.Address(testRouter), s.routerOf(""))
+	c.Assert(err, NotNil)
+
+	// migrations can't use an aggregator
+	item.Aggregator = "0x69800327b38a4ceef75a2d1c4ac4b4b2b4ea2bb6"
+	_, _, _, err = s.builder.Build(item, memo, common.Address(testRouter), s.routerOf(testNewRouter))
+	c.Assert(err, NotNil)
+}
+
+func (s *RouterCallBuilderTestSuite) TestYggdrasilReturn(c *C) {
+	item, memo := s.txOutItem(c, "YGGDRASIL-:100",
+		common.NewCoin(common.ETHAsset, cosmos.NewUint(common.One)),
+		common.NewCoin(s.usdcAsset(c), cosmos.NewUint(common.One)),
+	)
+
+	// same router
+	data, hasRouterUpdated, nativeValue, err := s.builder.Build(item, memo, common.Address(testRouter), s.routerOf(testRouter))
+	c.Assert(err, IsNil)
+	c.Check(hasRouterUpdated, Equals, false)
+	c.Check(nativeValue.String(), Equals, "1000000000000000000")
+	args := s.unpack(c, "returnVaultAssets", data)
+	c.Check(args[0].(ecommon.Address), Equals, ecommon.HexToAddress(testRouter))
+	c.Check(args[1].(ecommon.Address), Equals, ecommon.HexToAddress(testToAddress))
+
+	// the vault moved to a new router
+	_, hasRouterUpdated, _, err = s.builder.Build(item, memo, common.Address(testRouter), s.routerOf(testNewRouter))
+	c.Assert(err, IsNil)
+	c.Check(hasRouterUpdated, Equals, true)
+
+	// the router of the vault is unknown
+	_, _, _, err = s.builder.Build(item, memo, common.Address(testRouter), s.routerOf(""))
+	c.Assert(err, NotNil)
+}
@@ -0,0 +1,747 @@
+package evm
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ecommon "github.com/ethereum/go-ethereum/common"
+	etypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+
+	"gitlab.com/mayachain/mayanode/bifrost/blockscanner"
+	"gitlab.com/mayachain/mayanode/bifrost/mayaclient"
+	stypes "gitlab.com/mayachain/mayanode/bifrost/mayaclient/types"
+	"gitlab.com/mayachain/mayanode/bifrost/metrics"
+	evmtypes "gitlab.com/mayachain/mayanode/bifrost/pkg/chainclients/shared/evm/types"
+	"gitlab.com/mayachain/mayanode/bifrost/pkg/chainclients/shared/inclusion"
+	"gitlab.com/mayachain/mayanode/bifrost/pkg/chainclients/signercache"
+	"gitlab.com/mayachain/mayanode/bifrost/pubkeymanager"
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/config"
+	"gitlab.com/mayachain/mayanode/constants"
+	memo "gitlab.com/mayachain/mayanode/x/mayachain/memo"
+)
+
+// SolvencyReporter is to report solvency info to THORNode
+type SolvencyReporter func(int64) error
+
+const (
+	// BlockCacheSize is the number of block metas kept to detect re-orgs
+	BlockCacheSize = 6000
+	// MaxContractGas is the gas units a router call is reported to need
+	MaxContractGas = 80000
+)
+
+// Scanner is a scanner that understand how to interact with an EVM chain ,and scan block , parse smart contract etc
+type Scanner struct {
+	cfg                  config.BifrostBlockScannerConfiguration
+	logger               zerolog.Logger
+	db                   blockscanner.ScannerStorage
+	m                    *metrics.Metrics
+	errCounter           *prometheus.CounterVec
+	chainCfg             ChainConfig
+	gasPriceChanged      bool
+	gasPricer            *GasPricer
+	lastReportedGasPrice uint64
+	client               *ethclient.Client
+	rpc                  *EthRPC
+	blockMetaAccessor    BlockMetaAccessor
+	globalErrataQueue    chan<- stypes.ErrataBlock
+	vaultABI             *abi.ABI
+	tokenManager         *TokenManager
+	bridge               mayaclient.MayachainBridge
+	pubkeyMgr            pubkeymanager.PubKeyValidator
+	eipSigner            etypes.Signer
+	currentBlockHeight   int64
+	solvencyReporter     SolvencyReporter
+	signerCacheManager   *signercache.CacheManager
+}
+
+// NewScanner create a new instance of Scanner for the chain of the given chain config
+func NewScanner(chainCfg ChainConfig,
+	cfg config.BifrostBlockScannerConfiguration,
+	storage blockscanner.ScannerStorage,
+	chainID *big.Int,
+	client *ethclient.Client,
+	bridge mayaclient.MayachainBridge,
+	m *metrics.Metrics,
+	pubkeyMgr pubkeymanager.PubKeyValidator,
+	solvencyReporter SolvencyReporter,
+	signerCacheManager *signercache.CacheManager,
+) (*Scanner, error) {
+	if storage == nil {
+		return nil, errors.New("storage is nil")
+	}
+	if m == nil {
+		return nil, errors.New("metrics manager is nil")
+	}
+	if client == nil {
+		return nil, errors.New("ETH RPC client is nil")
+	}
+	if pubkeyMgr == nil {
+		return nil, errors.New("pubkey manager is nil")
+	}
+	blockMetaAccessor, err := chainCfg.NewBlockMetaAccessor(storage.GetInternalDb())
+	if err != nil {
+		return nil, fmt.Errorf("fail to create block meta accessor: %w", err)
+	}
+	tokenManager, err := chainCfg.NewTokenManager(storage.GetInternalDb(), cfg.HTTPRequestTimeout, bridge, client)
+	if err != nil {
+		return nil, err
+	}
+	vaultABI, _, err := GetContractABI(routerContractABI, erc20ContractABI)
+	if err != nil {
+		return nil, fmt.Errorf("fail to create contract abi: %w", err)
+	}
+
+	return &Scanner{
+		cfg:                  cfg,
+		logger:               log.Logger.With().Str("module", "block_scanner").Str("chain", chainCfg.Chain.String()).Logger(),
+		errCounter:           m.GetCounterVec(metrics.BlockScanError(chainCfg.Chain)),
+		client:               client,
+		rpc:                  newEthRPC(client, cfg.HTTPRequestTimeout, chainCfg.Chain.String()),
+		db:                   storage,
+		m:                    m,
+		chainCfg:             chainCfg,
+		gasPricer:            NewGasPricer(chainCfg.GasStrategy, chainCfg.GasCacheBlocks, chainCfg.GasPriceResolution, big.NewInt(chainCfg.InitialGasPrice)),
+		lastReportedGasPrice: 0,
+		gasPriceChanged:      false,
+		blockMetaAccessor:    blockMetaAccessor,
+		tokenManager:         tokenManager,
+		bridge:               bridge,
+		vaultABI:             vaultABI,
+		eipSigner:            etypes.NewLondonSigner(chainID),
+		pubkeyMgr:            pubkeyMgr,
+		solvencyReporter:     solvencyReporter,
+		signerCacheManager:   signerCacheManager,
+	}, nil
+}
+
+// GetGasPrice returns current gas price
+func (e *Scanner) GetGasPrice() *big.Int {
+	return e.gasPricer.Price()
+}
+
+// GetHeight return latest block height
+func (e *Scanner) GetHeight() (int64, error) {
+	height, err := e.rpc.GetBlockHeight()
+	if err != nil {
+		return -1, err
+	}
+	return height - int64(e.chainCfg.BlockLag), nil
+}
+
+// GetNonce returns the pending nonce of the given address
+func (e *Scanner) GetNonce(addr string) (uint64, error) {
+	return e.rpc.GetNonce(addr)
+}
+
+// FetchMemPool get tx from mempool
+func (e *Scanner) FetchMemPool(_ int64) (stypes.TxIn, error) {
+	return stypes.TxIn{}, nil
+}
+
+// GetTokens return all the token meta data
+func (e *Scanner) GetTokens() ([]*evmtypes.TokenMeta, error) {
+	return e.tokenManager.GetTokens()
+}
+
+// FetchTxs query the chain to get txs in the given block height
+func (e *Scanner) FetchTxs(height int64) (stypes.TxIn, error) {
+	block, err := e.rpc.GetRPCBlock(height)
+	if err != nil {
+		return stypes.TxIn{}, err
+	}
+	txIn, err := e.processBlock(block)
+	if err != nil {
+		e.logger.Error().Err(err).Int64("height", height).Msg("fail to search tx in block")
+		return stypes.TxIn{}, fmt.Errorf("fail to process block: %d, err:%w", height, err)
+	}
+	// blockMeta need to be saved , even there is no transactions found on this block at the time of scan
+	// because at the time of scan , so the block hash will be stored, and it can be used to detect re-org
+	blockMeta := evmtypes.NewBlockMeta(block.Header(), txIn)
+	if err := e.blockMetaAccessor.SaveBlockMeta(blockMeta.Height, blockMeta); err != nil {
+		e.logger.Err(err).Msgf("fail to save block meta of height: %d ", blockMeta.Height)
+	}
+
+	e.currentBlockHeight = height
+	pruneHeight := height - BlockCacheSize
+	if pruneHeight > 0 {
+		defer func() {
+			if err := e.blockMetaAccessor.PruneBlockMeta(pruneHeight); err != nil {
+				e.logger.Err(err).Msgf("fail to prune block meta, height(%d)", pruneHeight)
+			}
+		}()
+	}
+
+	e.reportNetworkFee(height)
+
+	if e.solvencyReporter != nil {
+		if err := e.solvencyReporter(height); err != nil {
+			e.logger.Err(err).Msg("fail to report Solvency info to THORNode")
+		}
+	}
+	return txIn, nil
+}
+
+// reportNetworkFee posts the gas price to THORNode when it changed
+func (e *Scanner) reportNetworkFee(height int64) {
+	switch e.chainCfg.GasStrategy {
+	case GasStrategySuggested:
+		if !e.gasPriceChanged {
+			return
+		}
+		// only send the network fee to THORNode when the price get changed
+		gasPrice := e.GetGasPrice() // gas price is in wei
+		// convert the gas price to 1E8 , the decimals used in thorchain
+		gasPriceForThorchain := big.NewInt(0).Div(gasPrice, big.NewInt(common.One*100))
+		gasValue := gasPriceForThorchain.Uint64()
+		if gasValue == 0 {
+			gasValue = 1
+		}
+		// make it to round up
+		if big.NewInt(1).Mul(big.NewInt(int64(gasValue)), big.NewInt(common.One*100)).Cmp(gasPrice) < 0 {
+			gasValue++
+		}
+		// only report the gas price when it actually get changed
+		if gasValue != e.lastReportedGasPrice {
+			e.lastReportedGasPrice = gasValue
+			if _, err := e.bridge.PostNetworkFee(height, e.chainCfg.Chain, MaxContractGas, gasValue); err != nil {
+				e.logger.Err(err).Msg("fail to post network fee to THORNode")
+			}
+		}
+	case GasStrategyBlockFees:
+		gasPrice := e.GetGasPrice()
+
+		// skip posting if there is not yet a fee
+		if gasPrice.Cmp(big.NewInt(0)) == 0 {
+			return
+		}
+
+		// gas price to 1e8, the price is rounded to the resolution of the
+		// chain, so it only changes by at least one resolution
+		tcGasPrice := new(big.Int).Div(gasPrice, big.NewInt(common.One*100)).Uint64()
+		if tcGasPrice == 0 {
+			tcGasPrice = 1
+		}
+
+		// skip posting if the fee has not changed
+		if tcGasPrice == e.lastReportedGasPrice {
+			return
+		}
+
+		// post to thorchain
+		if _, err := e.bridge.PostNetworkFee(height, e.chainCfg.Chain, MaxContractGas, tcGasPrice); err != nil {
+			e.logger.Err(err).Msg("fail to post network fee to THORNode")
+		} else {
+			e.lastReportedGasPrice = tcGasPrice
+		}
+	}
+}
+
+// updateGasPrice updates the gas price according to the gas strategy of the chain
+func (e *Scanner) updateGasPrice(block *etypes.Block) {
+	switch e.chainCfg.GasStrategy {
+	case GasStrategySuggested:
+		e.updateSuggestedGasPrice()
+	case GasStrategyBlockFees:
+		var txsGas []*big.Int
+		for _, tx := range block.Transactions() {
+			txsGas = append(txsGas, tx.GasPrice())
+		}
+		e.updateBlockFeesGasPrice(txsGas)
+	}
+}
+
+func (e *Scanner) updateSuggestedGasPrice() {
+	ctx, cancel := context.WithTimeout(context.Background(), e.cfg.HTTPRequestTimeout)
+	defer cancel()
+	gasPrice, err := e.client.SuggestGasPrice(ctx)
+	if err != nil {
+		e.logger.Err(err).Msg("fail to get suggest gas price")
+		return
+	}
+
+	gasPriceFloat, _ := new(big.Float).SetInt(gasPrice).Float64()
+	e.m.GetGauge(metrics.GasPriceSuggested(e.chainCfg.Chain)).Set(gasPriceFloat)
+
+	if gasPrice.Uint64() == 0 {
+		e.logger.Info().Msg("gas price is zero , not valid")
+		return
+	}
+
+	e.gasPriceChanged = e.gasPricer.AddSuggested(gasPrice)
+	if !e.gasPriceChanged {
+		return
+	}
+
+	gasPriceFloat, _ = new(big.Float).SetInt(e.GetGasPrice()).Float64()
+	e.m.GetGauge(metrics.GasPrice(e.chainCfg.Chain)).Set(gasPriceFloat)
+	e.m.GetCounter(metrics.GasPriceChange(e.chainCfg.Chain)).Inc()
+}
+
+func (e *Scanner) updateBlockFeesGasPrice(prices []*big.Int) {
+	if !e.gasPricer.AddBlockFees(prices) {
+		return
+	}
+
+	// record metrics
+	gasPriceFloat, _ := new(big.Float).SetInt64(e.GetGasPrice().Int64()).Float64()
+	e.m.GetGauge(metrics.GasPrice(e.chainCfg.Chain)).Set(gasPriceFloat)
+	e.m.GetCounter(metrics.GasPriceChange(e.chainCfg.Chain)).Inc()
+}
+
+// processBlock extracts transactions from block
+func (e *Scanner) processBlock(block *etypes.Block) (stypes.TxIn, error) {
+	height := int64(block.NumberU64())
+	txIn := stypes.TxIn{
+		Chain:           e.chainCfg.Chain,
+		TxArray:         nil,
+		Filtered:        false,
+		MemPool:         false,
+		SentUnFinalised: false,
+		Finalised:       false,
+	}
+	e.updateGasPrice(block)
+
+	reorgedTxIns, err := e.processReorg(block.Header())
+	if err != nil {
+		e.logger.Error().Err(err).Msgf("fail to process reorg for block %d", height)
+		return txIn, err
+	}
+	if len(reorgedTxIns) > 0 {
+		for _, item := range reorgedTxIns {
+			if len(item.TxArray) == 0 {
+				continue
+			}
+			txIn.TxArray = append(txIn.TxArray, item.TxArray...)
+		}
+	}
+
+	if block.Transactions().Len() == 0 {
+		return txIn, nil
+	}
+
+	txInBlock, err := e.extractTxs(block)
+	if err != nil {
+		return txIn, err
+	}
+	if len(txInBlock.TxArray) > 0 {
+		e.attachInclusionProofs(block, txInBlock.TxArray)
+		txIn.TxArray = append(txIn.TxArray, txInBlock.TxArray...)
+	}
+	return txIn, nil
+}
+
+// attachInclusionProofs proves the receipts of the observed txs against the
+// receipts root of the block, observations are still reported when they can't
+// be proven
+func (e *Scanner) attachInclusionProofs(block *etypes.Block, items []stypes.TxInItem) {
+	receipts, err := e.getBlockReceipts(block)
+	if err != nil {
+		e.logger.Err(err).Uint64("height", block.NumberU64()).Msg("fail to get block receipts")
+		return
+	}
+	if err := inclusion.AttachEVMReceiptProofs(items, block, receipts); err != nil {
+		e.logger.Err(err).Uint64("height", block.NumberU64()).Msg("fail to attach inclusion proofs")
+	}
+}
+
+// getBlockReceipts fetches the receipts of all the txs of the block, in block
+// order, the receipts trie is built over every one of them
+func (e *Scanner) getBlockReceipts(block *etypes.Block) (etypes.Receipts, error) {
+	txs := block.Transactions()
+	receipts := make(etypes.Receipts, len(txs))
+	sem := semaphore.NewWeighted(e.cfg.Concurrency)
+	g, ctx := errgroup.WithContext(context.Background())
+	for i, tx := range txs {
+		i, hash := i, tx.Hash().Hex()
+		g.Go(func() error {
+			if err := sem.Acquire(ctx, 1); err != nil {
+				return fmt.Errorf("fail to acquire semaphore: %w", err)
+			}
+			defer sem.Release(1)
+			receipt, err := e.rpc.GetReceipt(hash)
+			if err != nil {
+				return fmt.Errorf("fail to get receipt of tx %s: %w", hash, err)
+			}
+			receipts[i] = receipt
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return receipts, nil
+}
+
+func (e *Scanner) extractTxs(block *etypes.Block) (stypes.TxIn, error) {
+	txInbound := stypes.TxIn{
+		Chain:    e.chainCfg.Chain,
+		Filtered: false,
+		MemPool:  false,
+	}
+
+	sem := semaphore.NewWeighted(e.cfg.Concurrency)
+	mu := sync.Mutex{}
+	wg := sync.WaitGroup{}
+
+	processTx := func(tx *etypes.Transaction) {
+		defer wg.Done()
+		if err := sem.Acquire(context.Background(), 1); err != nil {
+			e.logger.Err(err).Msg("fail to acquire semaphore")
+			return
+		}
+		defer sem.Release(1)
+
+		if tx.To() == nil {
+			return
+		}
+
+		// just try to remove the transaction hash from key value store
+		// it doesn't matter whether the transaction is ours or not , success or failure
+		// as long as the transaction id matches
+		if err := e.blockMetaAccessor.RemoveSignedTxItem(tx.Hash().String()); err != nil {
+			e.logger.Err(err).Msgf("fail to remove signed tx item, hash:%s", tx.Hash().String())
+		}
+
+		txInItem, err := e.fromTxToTxIn(tx)
+		if err != nil {
+			e.logger.Error().Err(err).Str("hash", tx.Hash().Hex()).Msg("fail to get one tx from server")
+			return
+		}
+		if txInItem == nil {
+			return
+		}
+		// sometimes if a transaction failed due to gas problem , it will have no `to` address
+		if len(txInItem.To) == 0 {
+			return
+		}
+		if len([]byte(txInItem.Memo)) > constants.MaxMemoSize {
+			return
+		}
+		txInItem.BlockHeight = block.Number().Int64()
+		mu.Lock()
+		txInbound.TxArray = append(txInbound.TxArray, *txInItem)
+		mu.Unlock()
+		e.logger.Debug().Str("hash", tx.Hash().Hex()).Msgf("%s got %d tx", e.cfg.ChainID, 1)
+	}
+
+	// process txs in parallel
+	for _, tx := range block.Transactions() {
+		wg.Add(1)
+		go processTx(tx)
+	}
+	wg.Wait()
+
+	if len(txInbound.TxArray) == 0 {
+		e.logger.Debug().Int64("block", int64(block.NumberU64())).Msg("no tx need to be processed in this block")
+		return stypes.TxIn{}, nil
+	}
+	txInbound.Count = strconv.Itoa(len(txInbound.TxArray))
+	e.logger.Debug().Int64("block", int64(block.NumberU64())).Msgf("there are %s tx in this block need to process", txInbound.Count)
+	return txInbound, nil
+}
+
+func (e *Scanner) onObservedTxIn(txIn stypes.TxInItem, blockHeight int64) {
+	blockMeta, err := e.blockMetaAccessor.GetBlockMeta(blockHeight)
+	if err != nil {
+		e.logger.Err(err).Msgf("fail to get block meta on block height(%d)", blockHeight)
+		return
+	}
+
+	if blockMeta == nil {
+		e.logger.Error().Msgf("block meta for height:%d is nil", blockHeight)
+		return
+	}
+	for _, item := range blockMeta.Transactions {
+		if item.Hash == txIn.Tx {
+			return
+		}
+	}
+
+	blockMeta.Transactions = append(blockMeta.Transactions, evmtypes.TransactionMeta{
+		Hash:        txIn.Tx,
+		BlockHeight: blockHeight,
+	})
+	if err := e.blockMetaAccessor.SaveBlockMeta(blockHeight, blockMeta); err != nil {
+		e.logger.Err(err).Msgf("fail to save block meta to storage,block height(%d)", blockHeight)
+	}
+}
+
+// processReorg will compare block's parent hash and the block hash we have in store
+// when there is a reorg detected , it will return true, other false
+func (e *Scanner) processReorg(block *etypes.Header) ([]stypes.TxIn, error) {
+	previousHeight := block.Number.Int64() - 1
+	prevBlockMeta, err := e.blockMetaAccessor.GetBlockMeta(previousHeight)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get block meta of height(%d) : %w", previousHeight, err)
+	}
+	if prevBlockMeta == nil {
+		return nil, nil
+	}
+	// the block's previous hash need to be the same as the block hash chain client recorded in block meta
+	// blockMetas[PreviousHeight].BlockHash == Block.PreviousHash
+	if strings.EqualFold(prevBlockMeta.BlockHash, block.ParentHash.Hex()) {
+		return nil, nil
+	}
+	e.logger.Info().Msgf("re-org detected, current block height:%d ,previous block hash is : %s , however block meta at height: %d, block hash is %s", block.Number.Int64(), block.ParentHash.Hex(), prevBlockMeta.Height, prevBlockMeta.BlockHash)
+	heights, err := e.reprocessTxs()
+	if err != nil {
+		e.logger.Err(err).Msg("fail to reprocess all txs")
+	}
+	var txIns []stypes.TxIn
+	for _, item := range heights {
+		e.logger.Info().Msgf("rescan block height: %d", item)
+		block, err := e.rpc.GetRPCBlock(item)
+		if err != nil {
+			e.logger.Err(err).Msgf("fail to get block from RPC endpoint, height:%d", item)
+			continue
+		}
+		if block.Transactions().Len() == 0 {
+			continue
+		}
+		txIn, err := e.extractTxs(block)
+		if err != nil {
+			e.logger.Err(err).Msgf("fail to extract txs from block (%d)", item)
+			continue
+		}
+		if len(txIn.TxArray) > 0 {
+			txIns = append(txIns, txIn)
+		}
+	}
+	return txIns, nil
+}
+
+// reprocessTx will be kicked off only when chain client detected a re-org on the chain
+// it will read through all the block meta data from local storage, and go through all the txs.
+// For each transaction, it will send a RPC request to the chain, double check whether the TX exist or not
+// if the tx still exist, then it is all good, if a transaction previous we detected, however doesn't exist anymore, that means
+// the transaction had been removed from chain, chain client should report to thorchain
+// []int64 is the block heights that need to be rescanned
+func (e *Scanner) reprocessTxs() ([]int64, error) {
+	blockMetas, err := e.blockMetaAccessor.GetBlockMetas()
+	if err != nil {
+		return nil, fmt.Errorf("fail to get block metas from local storage: %w", err)
+	}
+	var rescanBlockHeights []int64
+	for _, blockMeta := range blockMetas {
+		metaTxs := make([]evmtypes.TransactionMeta, 0)
+		var errataTxs []stypes.ErrataTx
+		for _, tx := range blockMeta.Transactions {
+			if e.rpc.CheckTransaction(tx.Hash) {
+				e.logger.Debug().Msgf("block height: %d, tx: %s still exist", blockMeta.Height, tx.Hash)
+				metaTxs = append(metaTxs, tx)
+				continue
+			}
+			// this means the tx doesn't exist in chain ,thus should errata it
+			errataTxs = append(errataTxs, stypes.ErrataTx{
+				TxID:  common.TxID(tx.Hash),
+				Chain: e.chainCfg.Chain,
+			})
+		}
+		if len(errataTxs) > 0 {
+			e.globalErrataQueue <- stypes.ErrataBlock{
+				Height: blockMeta.Height,
+				Txs:    errataTxs,
+			}
+		}
+		// Let's get the block again to fix the block hash
+		block, err := e.rpc.GetHeader(blockMeta.Height)
+		if err != nil {
+			e.logger.Err(err).Msgf("fail to get block verbose tx result: %d", blockMeta.Height)
+		}
+
+		if !strings.EqualFold(blockMeta.BlockHash, block.Hash().Hex()) {
+			// if the block hash is different as previously recorded , then the block should be rescanned
+			rescanBlockHeights = append(rescanBlockHeights, blockMeta.Height)
+		}
+		blockMeta.PreviousHash = block.ParentHash.Hex()
+		blockMeta.BlockHash = block.Hash().Hex()
+		blockMeta.Transactions = metaTxs
+		if err := e.blockMetaAccessor.SaveBlockMeta(blockMeta.Height, blockMeta); err != nil {
+			e.logger.Err(err).Msgf("fail to save block meta of height: %d ", blockMeta.Height)
+		}
+	}
+	return rescanBlockHeights, nil
+}
+
+// isToValidContractAddress this method make sure the transaction to address is to BASEChain router or a whitelist address
+func (e *Scanner) isToValidContractAddress(addr *ecommon.Address, includeWhiteList bool) bool {
+	return e.chainCfg.IsValidContract(addr, e.pubkeyMgr.GetContracts(e.chainCfg.Chain), includeWhiteList)
+}
+
+// convertAmount will convert the amount to 1e8 , the decimals used by BASEChain
+func (e *Scanner) convertAmount(token string, amt *big.Int) cosmos.Uint {
+	return e.tokenManager.ConvertAmount(token, amt)
+}
+
+// txGasPrice returns the gas price of the given tx, under no circumstance the
+// gas price will be less than 10 Gwei , unless it is in dev environment
+func txGasPrice(tx *etypes.Transaction) *big.Int {
+	gasPrice := tx.GasPrice()
+	if gasPrice.Cmp(big.NewInt(tenGwei)) < 0 {
+		return big.NewInt(tenGwei)
+	}
+	return gasPrice
+}
+
+// getTxInFromSmartContract returns txInItem
+func (e *Scanner) getTxInFromSmartContract(tx *etypes.Transaction, receipt *etypes.Receipt) (*stypes.TxInItem, error) {
+	e.logger.Debug().Msg("parse tx from smart contract")
+	txInItem := &stypes.TxInItem{
+		Tx: tx.Hash().Hex()[2:],
+	}
+	sender, err := e.eipSigner.Sender(tx)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get sender: %w", err)
+	}
+	txInItem.Sender = strings.ToLower(sender.String())
+	// 1 is Transaction success state
+	if receipt.Status != 1 {
+		e.logger.Info().Msgf("tx(%s) state: %d means failed , ignore", tx.Hash().String(), receipt.Status)
+		return nil, nil
+	}
+	nativeAsset := e.chainCfg.NativeAsset
+	p := NewSmartContractLogParser(e.isToValidContractAddress,
+		e.tokenManager.GetAssetFromTokenAddress,
+		e.tokenManager.GetTokenDecimalsForTHORChain,
+		e.tokenManager.ConvertAmount,
+		e.vaultABI,
+		nativeAsset)
+	// txInItem will be changed in p.GetTxInItem function, so if the function return an error
+	// txInItem should be abandoned
+	isVaultTransfer, err := p.GetTxInItem(receipt.Logs, txInItem)
+	if err != nil {
+		return nil, fmt.Errorf("fail to parse logs, err: %w", err)
+	}
+	if isVaultTransfer {
+		contractAddresses := e.pubkeyMgr.GetContracts(e.chainCfg.Chain)
+		isDirectlyToRouter := false
+		for _, item := range contractAddresses {
+			if strings.EqualFold(item.String(), tx.To().String()) {
+				isDirectlyToRouter = true
+				break
+			}
+		}
+		if isDirectlyToRouter {
+			// it is important to keep this part outside the above loop, as when we do router upgrade , which might generate multiple deposit event , along with tx that has native value in it
+			nativeValue := cosmos.NewUintFromBigInt(tx.Value())
+			if !nativeValue.IsZero() {
+				nativeValue = e.convertAmount(nativeTokenAddr, tx.Value())
+				if txInItem.Coins.GetCoin(nativeAsset).IsEmpty() && !nativeValue.IsZero() {
+					txInItem.Coins = append(txInItem.Coins, common.NewCoin(nativeAsset, nativeValue))
+				}
+			}
+		}
+	}
+	e.logger.Info().Msgf("tx: %s, gas price: %s, gas used: %d,receipt status:%d", txInItem.Tx, tx.GasPrice().String(), receipt.GasUsed, receipt.Status)
+	txInItem.Gas = e.chainCfg.makeGas(txGasPrice(tx), receipt.GasUsed)
+	if txInItem.Coins.IsEmpty() {
+		e.logger.Debug().Msgf("there is no coin in this tx, ignore, %+v", txInItem)
+		return nil, nil
+	}
+	e.logger.Debug().Msgf("tx in item: %+v", txInItem)
+	return txInItem, nil
+}
+
+func (e *Scanner) getTxInFromTransaction(tx *etypes.Transaction) (*stypes.TxInItem, error) {
+	txInItem := &stypes.TxInItem{
+		Tx: tx.Hash().Hex()[2:],
+	}
+	sender, err := e.eipSigner.Sender(tx)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get sender: %w", err)
+	}
+	txInItem.Sender = strings.ToLower(sender.String())
+	txInItem.To = strings.ToLower(tx.To().String())
+	// this is native , thus memo is data field
+	data := tx.Data()
+	if len(data) > 0 {
+		memo, err := hex.DecodeString(string(data))
+		if err != nil {
+			txInItem.Memo = string(data)
+		} else {
+			txInItem.Memo = string(memo)
+		}
+	}
+	nativeValue := e.convertAmount(nativeTokenAddr, tx.Value())
+	txInItem.Coins = append(txInItem.Coins, common.NewCoin(e.chainCfg.NativeAsset, nativeValue))
+	txInItem.Gas = e.chainCfg.makeGas(txGasPrice(tx), tx.Gas())
+	if txInItem.Coins.IsEmpty() {
+		e.logger.Debug().Msgf("there is no coin in this tx, ignore, %+v", txInItem)
+		return nil, nil
+	}
+	return txInItem, nil
+}
+
+func (e *Scanner) fromTxToTxIn(tx *etypes.Transaction) (*stypes.TxInItem, error) {
+	if tx == nil || tx.To() == nil {
+		return nil, nil
+	}
+	receipt, err := e.rpc.GetReceipt(tx.Hash().Hex())
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("fail to get transaction receipt: %w", err)
+	}
+	if receipt.Status != 1 {
+		// a transaction that is failed
+		// remove the Signer cache , so the tx out item can be retried
+		if e.signerCacheManager != nil {
+			e.signerCacheManager.RemoveSigned(tx.Hash().String())
+		}
+		e.logger.Debug().Msgf("tx(%s) state: %d means failed , ignore", tx.Hash().String(), receipt.Status)
+		return e.getTxInFromFailedTransaction(tx, receipt), nil
+	}
+
+	if e.isToValidContractAddress(tx.To(), true) {
+		return e.getTxInFromSmartContract(tx, receipt)
+	}
+	return e.getTxInFromTransaction(tx)
+}
+
+// getTxInFromFailedTransaction when a transaction failed due to out of gas, this method will check whether the transaction is an outbound
+// it fake a txInItem if the failed transaction is an outbound , and report it back to THORNode , thus the gas fee can be subsidised
+// need to know that this will also cause the yggdrasil / asgard that send out the outbound to be slashed 1.5x gas
+// it is for security purpose
+func (e *Scanner) getTxInFromFailedTransaction(tx *etypes.Transaction, receipt *etypes.Receipt) *stypes.TxInItem {
+	if receipt.Status == 1 {
+		e.logger.Info().Str("hash", tx.Hash().String()).Msg("success transaction should not get into getTxInFromFailedTransaction")
+		return nil
+	}
+	fromAddr, err := e.eipSigner.Sender(tx)
+	if err != nil {
+		e.logger.Err(err).Msg("fail to get from address")
+		return nil
+	}
+	ok, cif := e.pubkeyMgr.IsValidPoolAddress(fromAddr.String(), e.chainCfg.Chain)
+	if !ok || cif.IsEmpty() {
+		return nil
+	}
+	txHash := tx.Hash().Hex()[2:]
+
+	return &stypes.TxInItem{
+		Tx:     txHash,
+		Memo:   memo.NewOutboundMemo(common.TxID(txHash)).String(),
+		Sender: strings.ToLower(fromAddr.String()),
+		To:     strings.ToLower(tx.To().String()),
+		Coins:  common.NewCoins(common.NewCoin(e.chainCfg.NativeAsset, cosmos.NewUint(1))),
+		Gas:    e.chainCfg.makeGas(txGasPrice(tx), tx.Gas()),
+	}
+}
@@ -1,9 +1,8 @@
-package avalanche
+package evm
 
 import (
 	_ "embed"
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"math/big"
 	"net/http"
@@ -21,7 +20,6 @@ import (
 	"gitlab.com/mayachain/mayanode/bifrost/mayaclient"
 	"gitlab.com/mayachain/mayanode/bifrost/metrics"
 	"gitlab.com/mayachain/mayanode/bifrost/pkg/chainclients/ethereum/types"
-	"gitlab.com/mayachain/mayanode/bifrost/pkg/chainclients/shared/evm"
 	"gitlab.com/mayachain/mayanode/bifrost/pubkeymanager"
 	"gitlab.com/mayachain/mayanode/cmd"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
@@ -31,59 +29,31 @@ import (
 )
 
 var (
-	//go:embed test/deposit_avax_transaction.json
+	//go:embed test/avax/deposit_avax_transaction.json
 	depositAVAXTx []byte
-	//go:embed test/deposit_avax_receipt.json
+	//go:embed test/avax/deposit_avax_receipt.json
 	depositAVAXReceipt []byte
-	//go:embed test/transfer_out_transaction.json
+	//go:embed test/avax/transfer_out_transaction.json
 	transferOutTx []byte
-	//go:embed test/transfer_out_receipt.json
+	//go:embed test/avax/transfer_out_receipt.json
 	transferOutReceipt []byte
-	//go:embed test/deposit_tkn_transaction.json
+	//go:embed test/avax/deposit_tkn_transaction.json
 	depositTknTx []byte
-	//go:embed test/deposit_tkn_receipt.json
+	//go:embed test/avax/deposit_tkn_receipt.json
 	depositTknReceipt []byte
-	//go:embed test/block_by_number.json
+	//go:embed test/avax/block_by_number.json
 	blockByNumberResp []byte
 )
 
-func CreateBlock(height int) (*etypes.Header, error) {
-	strHeight := fmt.Sprintf("%x", height)
-	blockJson := `{
-		"parentHash":"0x8b535592eb3192017a527bbf8e3596da86b3abea51d6257898b2ced9d3a83826",
-		"difficulty": "0x31962a3fc82b",
-		"extraData": "0x4477617266506f6f6c",
-		"gasLimit": "0x47c3d8",
-		"gasUsed": "0x0",
-		"hash": "0x78bfef68fccd4507f9f4804ba5c65eb2f928ea45b3383ade88aaa720f1209cba",
-		"logsBloom": "0x00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
-		"miner": "0x2a65aca4d5fc5b5c859090a6c34d164135398226",
-		"nonce": "0xa5e8fb780cc2cd5e",
-		"number": "0x` + strHeight + `",
-		"receiptsRoot": "0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421",
-		"sha3Uncles": "0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347",
-		"size": "0x20e",
-		"stateRoot": "0xdc6ed0a382e50edfedb6bd296892690eb97eb3fc88fd55088d5ea753c48253dc",
-		"timestamp": "0x579f4981",
-		"totalDifficulty": "0x25cff06a0d96f4bee",
-		"transactionsRoot": "0x88df016429689c079f3b2f6ad39fa052532c56795b733da78a91ebe6a713944b"
-	}`
-	var header *etypes.Header
-	if err := json.Unmarshal([]byte(blockJson), &header); err != nil {
-		return nil, err
-	}
-	return header, nil
-}
-
-type BlockScannerTestSuite struct {
+type AvalancheScannerTestSuite struct {
 	m      *metrics.Metrics
 	bridge mayaclient.MayachainBridge
 	keys   *mayaclient.Keys
 }
 
-var _ = Suite(&BlockScannerTestSuite{})
+var _ = Suite(&AvalancheScannerTestSuite{})
 
-func (s *BlockScannerTestSuite) SetUpSuite(c *C) {
+func (s *AvalancheScannerTestSuite) SetUpSuite(c *C) {
 	mayachain.SetupConfigForTest()
 	s.m = GetMetricForTest(c)
 	c.Assert(s.m, NotNil)
@@ -105,7 +75,7 @@ func (s *BlockScannerTestSuite) SetUpSuite(c *C) {
 	c.Assert(err, IsNil)
 }
 
-func getConfigForTest(rpcHost string) config.BifrostBlockScannerConfiguration {
+func getAVAXConfigForTest(rpcHost string) config.BifrostBlockScannerConfiguration {
 	return config.BifrostBlockScannerConfiguration{
 		RPCHost:                    rpcHost,
 		StartBlockHeight:           1, // avoids querying thorchain for block height
@@ -122,7 +92,7 @@ func getConfigForTest(rpcHost string) config.BifrostBlockScannerConfiguration {
 	}
 }
 
-func (s *BlockScannerTestSuite) TestNewBlockScanner(c *C) {
+func (s *AvalancheScannerTestSuite) TestNewBlockScanner(c *C) {
 	storage, err := blockscanner.NewBlockScannerStorage("")
 	c.Assert(err, IsNil)
 	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
@@ -148,49 +118,47 @@ func (s *BlockScannerTestSuite) TestNewBlockScanner(c *C) {
 	}))
 	ethClient, err := ethclient.Dial(server.URL)
 	c.Assert(err, IsNil)
-	rpcClient, err := evm.NewEthRPC(server.URL, time.Second, "AVAX")
-	c.Assert(err, IsNil)
 	pubKeyManager, err := pubkeymanager.NewPubKeyManager(s.bridge, s.m)
 	c.Assert(err, IsNil)
 	solvencyReporter := func(height int64) error {
 		return nil
 	}
-	bs, err := NewAVAXScanner(getConfigForTest(""), nil, big.NewInt(int64(types.Mainnet)), ethClient, rpcClient, s.bridge, s.m, pubKeyManager, solvencyReporter, nil)
+	bs, err := newAVAXScannerForTest(getAVAXConfigForTest(""), nil, big.NewInt(int64(types.Mainnet)), ethClient, s.bridge, s.m, pubKeyManager, solvencyReporter, nil)
 	c.Assert(err, NotNil)
 	c.Assert(bs, IsNil)
 
-	bs, err = NewAVAXScanner(getConfigForTest("http://"+server.Listener.Addr().String()), storage, big.NewInt(int64(types.Mainnet)), ethClient, rpcClient, s.bridge, nil, pubKeyManager, solvencyReporter, nil)
+	bs, err = newAVAXScannerForTest(getAVAXConfigForTest("http://"+server.Listener.Addr().String()), storage, big.NewInt(int64(types.Mainnet)), ethClient, s.bridge, nil, pubKeyManager, solvencyReporter, nil)
 	c.Assert(err, NotNil)
 	c.Assert(bs, IsNil)
 
-	bs, err = NewAVAXScanner(getConfigForTest("http://"+server.Listener.Addr().String()), storage, big.NewInt(int64(types.Mainnet)), nil, rpcClient, s.bridge, s.m, pubKeyManager, solvencyReporter, nil)
+	bs, err = newAVAXScannerForTest(getAVAXConfigForTest("http://"+server.Listener.Addr().String()), storage, big.NewInt(int64(types.Mainnet)), nil, s.bridge, s.m, pubKeyManager, solvencyReporter, nil)
 	c.Assert(err, NotNil)
 	c.Assert(bs, IsNil)
 
-	bs, err = NewAVAXScanner(getConfigForTest("http://"+server.Listener.Addr().String()), storage, big.NewInt(int64(types.Mainnet)), ethClient, rpcClient, s.bridge, s.m, nil, solvencyReporter, nil)
+	bs, err = newAVAXScannerForTest(getAVAXConfigForTest("http://"+server.Listener.Addr().String()), storage, big.NewInt(int64(types.Mainnet)), ethClient, s.bridge, s.m, nil, solvencyReporter, nil)
 	c.Assert(err, NotNil)
 	c.Assert(bs, IsNil)
 
-	bs, err = NewAVAXScanner(getConfigForTest("http://"+server.Listener.Addr().String()), storage, big.NewInt(int64(types.Mainnet)), ethClient, rpcClient, s.bridge, s.m, pubKeyManager, solvencyReporter, nil)
+	bs, err = newAVAXScannerForTest(getAVAXConfigForTest("http://"+server.Listener.Addr().String()), storage, big.NewInt(int64(types.Mainnet)), ethClient, s.bridge, s.m, pubKeyManager, solvencyReporter, nil)
 	c.Assert(err, IsNil)
 	c.Assert(bs, NotNil)
 }
 
-func (s *BlockScannerTestSuite) TestProcessBlock(c *C) {
+func (s *AvalancheScannerTestSuite) TestProcessBlock(c *C) {
 	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		switch {
 		case req.RequestURI == mayaclient.PubKeysEndpoint:
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/vaults/pubKeys.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/vaults/pubKeys.json")
 		case req.RequestURI == mayaclient.InboundAddressesEndpoint:
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/inbound_addresses/inbound_addresses.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/inbound_addresses/inbound_addresses.json")
 		case req.RequestURI == mayaclient.AsgardVault:
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/vaults/asgard.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/vaults/asgard.json")
 		case strings.HasPrefix(req.RequestURI, mayaclient.NodeAccountEndpoint):
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/nodeaccount/template.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/nodeaccount/template.json")
 		case strings.HasPrefix(req.RequestURI, mayaclient.LastBlockEndpoint):
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/lastblock/bnb.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/lastblock/bnb.json")
 		case strings.HasPrefix(req.RequestURI, mayaclient.AuthAccountEndpoint):
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/auth/accounts/template.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/auth/accounts/template.json")
 		default:
 			body, err := ioutil.ReadAll(req.Body)
 			c.Assert(err, IsNil)
@@ -233,8 +201,6 @@ func (s *BlockScannerTestSuite) TestProcessBlock(c *C) {
 	ethClient, err := ethclient.Dial(server.URL)
 	c.Assert(err, IsNil)
 	c.Assert(ethClient, NotNil)
-	rpcClient, err := evm.NewEthRPC(server.URL, time.Second, "AVAX")
-	c.Assert(err, IsNil)
 	storage, err := blockscanner.NewBlockScannerStorage("")
 	c.Assert(err, IsNil)
 	u, err := url.Parse(server.URL)
@@ -254,49 +220,29 @@ func (s *BlockScannerTestSuite) TestProcessBlock(c *C) {
 		c.Assert(pubKeyMgr.Stop(), IsNil)
 	}()
 
-	config := getConfigForTest(server.URL)
-	bs, err := NewAVAXScanner(config, storage, big.NewInt(43112), ethClient, rpcClient, bridge, s.m, pubKeyMgr, func(height int64) error {
+	config := getAVAXConfigForTest(server.URL)
+	bs, err := newAVAXScannerForTest(config, storage, big.NewInt(43112), ethClient, bridge, s.m, pubKeyMgr, func(height int64) error {
 		return nil
 	}, nil)
 	c.Assert(err, IsNil)
 	c.Assert(bs, NotNil)
-	whitelistSmartContractAddress = append(whitelistSmartContractAddress, "0x40bcd4dB8889a8Bf0b1391d0c819dcd9627f9d0a")
+	bs.chainCfg.Aggregators = append(bs.chainCfg.Aggregators, "0x40bcd4dB8889a8Bf0b1391d0c819dcd9627f9d0a")
 	txIn, err := bs.FetchTxs(int64(1))
 	c.Assert(err, IsNil)
 	c.Check(len(txIn.TxArray), Equals, 1)
 }
 
-func httpTestHandler(c *C, rw http.ResponseWriter, fixture string) {
-	var content []byte
-	var err error
-
-	switch fixture {
-	case "500":
-		rw.WriteHeader(http.StatusInternalServerError)
-	default:
-		content, err = ioutil.ReadFile(fixture)
-		if err != nil {
-			c.Fatal(err)
-		}
-	}
-
-	rw.Header().Set("Content-Type", "application/json")
-	if _, err := rw.Write(content); err != nil {
-		c.Fatal(err)
-	}
-}
-
-func (s *BlockScannerTestSuite) TestGetTxInItem(c *C) {
+func (s *AvalancheScannerTestSuite) TestGetTxInItem(c *C) {
 	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		switch {
 		case req.RequestURI == mayaclient.PubKeysEndpoint:
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/vaults/pubKeys.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/vaults/pubKeys.json")
 		case req.RequestURI == mayaclient.InboundAddressesEndpoint:
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/inbound_addresses/inbound_addresses.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/inbound_addresses/inbound_addresses.json")
 		case req.RequestURI == mayaclient.AsgardVault:
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/vaults/asgard.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/vaults/asgard.json")
 		case strings.HasPrefix(req.RequestURI, mayaclient.NodeAccountEndpoint):
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/nodeaccount/template.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/nodeaccount/template.json")
 		default:
 			body, err := ioutil.ReadAll(req.Body)
 			c.Assert(err, IsNil)
@@ -366,8 +312,6 @@ func (s *BlockScannerTestSuite) TestGetTxInItem(c *C) {
 	ethClient, err := ethclient.Dial(server.URL)
 	c.Assert(err, IsNil)
 	c.Assert(ethClient, NotNil)
-	rpcClient, err := evm.NewEthRPC(server.URL, time.Second, "AVAX")
-	c.Assert(err, IsNil)
 	storage, err := blockscanner.NewBlockScannerStorage("")
 	c.Assert(err, IsNil)
 	c.Assert(storage, NotNil)
@@ -390,8 +334,8 @@ func (s *BlockScannerTestSuite) TestGetTxInItem(c *C) {
 		c.Assert(pkeyMgr.Stop(), IsNil)
 	}()
 	c.Assert(err, IsNil)
-	config := getConfigForTest(server.URL)
-	bs, err := NewAVAXScanner(config, storage, big.NewInt(int64(types.Mainnet)), ethClient, rpcClient, s.bridge, s.m, pkeyMgr, func(height int64) error {
+	config := getAVAXConfigForTest(server.URL)
+	bs, err := newAVAXScannerForTest(config, storage, big.NewInt(int64(types.Mainnet)), ethClient, s.bridge, s.m, pkeyMgr, func(height int64) error {
 		return nil
 	}, nil)
 	c.Assert(err, IsNil)
@@ -414,11 +358,11 @@ func (s *BlockScannerTestSuite) TestGetTxInItem(c *C) {
 		"r":"0x1b5e176d927f8e9ab405058b2d2457392da3e20f328b16ddabcebc33eaac5fea",
 		"s":"0x4ba69724e8f69de52f0125ad8b3c5c2cef33019bac3249e2c0a2192766d1721c"
 	}`
-	tx := etypes.NewTransaction(0, common.HexToAddress(avaxToken), nil, 0, nil, nil)
+	tx := etypes.NewTransaction(0, common.HexToAddress(nativeTokenAddr), nil, 0, nil, nil)
 	err = tx.UnmarshalJSON([]byte(encodedTx))
 	c.Assert(err, IsNil)
 
-	txInItem, err := bs.getTxInItem(tx)
+	txInItem, err := bs.fromTxToTxIn(tx)
 	c.Assert(err, IsNil)
 	c.Assert(txInItem, NotNil)
 	c.Check(txInItem.Sender, Equals, "0xa7d9ddbe1f17865597fbd27ec712455208b6b76d")
@@ -437,14 +381,14 @@ func (s *BlockScannerTestSuite) TestGetTxInItem(c *C) {
 		true,
 	)
 
-	bs, err = NewAVAXScanner(config, storage, big.NewInt(43112), ethClient, rpcClient, s.bridge, s.m, pkeyMgr, func(height int64) error {
+	bs, err = newAVAXScannerForTest(config, storage, big.NewInt(43112), ethClient, s.bridge, s.m, pkeyMgr, func(height int64) error {
 		return nil
 	}, nil)
 	c.Assert(err, IsNil)
 	c.Assert(bs, NotNil)
-	tx = etypes.NewTransaction(0, common.HexToAddress(avaxToken), nil, 0, nil, nil)
+	tx = etypes.NewTransaction(0, common.HexToAddress(nativeTokenAddr), nil, 0, nil, nil)
 	c.Assert(tx.UnmarshalJSON(depositAVAXTx), IsNil)
-	txInItem, err = bs.getTxInItem(tx)
+	txInItem, err = bs.fromTxToTxIn(tx)
 	c.Assert(err, IsNil)
 	c.Assert(txInItem, NotNil)
 	c.Assert(txInItem.Sender, Equals, "0x970e8128ab834e8eac17ab8e3812f010678cf791")
@@ -454,18 +398,18 @@ func (s *BlockScannerTestSuite) TestGetTxInItem(c *C) {
 	c.Assert(txInItem.Coins[0].Asset.String(), Equals, "AVAX.AVAX")
 	c.Assert(txInItem.Coins[0].Amount.Uint64(), Equals, cosmos.NewUint(200000000).Uint64())
 
-	bs, err = NewAVAXScanner(config, storage, big.NewInt(43112), ethClient, rpcClient, s.bridge, s.m, pkeyMgr, func(height int64) error {
+	bs, err = newAVAXScannerForTest(config, storage, big.NewInt(43112), ethClient, s.bridge, s.m, pkeyMgr, func(height int64) error {
 		return nil
 	}, nil)
 	// whitelist the address for test
-	whitelistSmartContractAddress = append(whitelistSmartContractAddress, "0x17aB05351fC94a1a67Bf3f56DdbB941aE6c63E25")
+	bs.chainCfg.Aggregators = append(bs.chainCfg.Aggregators, "0x17aB05351fC94a1a67Bf3f56DdbB941aE6c63E25")
 	c.Assert(err, IsNil)
 	c.Assert(bs, NotNil)
 
 	// smart contract - depositTKN
 	tx = &etypes.Transaction{}
 	c.Assert(tx.UnmarshalJSON(depositTknTx), IsNil)
-	txInItem, err = bs.getTxInItem(tx)
+	txInItem, err = bs.fromTxToTxIn(tx)
 	c.Assert(err, IsNil)
 	c.Assert(txInItem, NotNil)
 	c.Assert(txInItem.Sender, Equals, "0x970e8128ab834e8eac17ab8e3812f010678cf791")
@@ -478,7 +422,7 @@ func (s *BlockScannerTestSuite) TestGetTxInItem(c *C) {
 	// smart contract - transferOut
 	tx = &etypes.Transaction{}
 	c.Assert(tx.UnmarshalJSON(transferOutTx), IsNil)
-	txInItem, err = bs.getTxInItem(tx)
+	txInItem, err = bs.fromTxToTxIn(tx)
 	c.Assert(err, IsNil)
 	c.Assert(txInItem, NotNil)
 	c.Assert(txInItem.Sender, Equals, "0xb8bc698bc9c1ed0df7efc37d7367886602361ee5")
@@ -493,7 +437,7 @@ func (s *BlockScannerTestSuite) TestGetTxInItem(c *C) {
 // GasPriceV2
 // -------------------------------------------------------------------------------------
 
-func (s *BlockScannerTestSuite) TestUpdateGasPrice(c *C) {
+func (s *AvalancheScannerTestSuite) TestUpdateGasPrice(c *C) {
 	storage, err := blockscanner.NewBlockScannerStorage("")
 	c.Assert(err, IsNil)
 	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
@@ -519,76 +463,74 @@ func (s *BlockScannerTestSuite) TestUpdateGasPrice(c *C) {
 	}))
 	ethClient, err := ethclient.Dial(server.URL)
 	c.Assert(err, IsNil)
-	rpcClient, err := evm.NewEthRPC(server.URL, time.Second, "AVAX")
-	c.Assert(err, IsNil)
 	pubKeyManager, err := pubkeymanager.NewPubKeyManager(s.bridge, s.m)
 	c.Assert(err, IsNil)
 	solvencyReporter := func(height int64) error {
 		return nil
 	}
-	conf := getConfigForTest("http://" + server.Listener.Addr().String())
-	bs, err := NewAVAXScanner(conf, storage, big.NewInt(int64(types.Mainnet)), ethClient, rpcClient, s.bridge, s.m, pubKeyManager, solvencyReporter, nil)
+	conf := getAVAXConfigForTest("http://" + server.Listener.Addr().String())
+	bs, err := newAVAXScannerForTest(conf, storage, big.NewInt(int64(types.Mainnet)), ethClient, s.bridge, s.m, pubKeyManager, solvencyReporter, nil)
 	c.Assert(err, IsNil)
 	c.Assert(bs, NotNil)
 
 	// almost fill gas cache
 	for i := 0; i < 99; i++ {
-		bs.updateGasPrice([]*big.Int{
-			big.NewInt(1 * GasPriceResolution),
-			big.NewInt(2 * GasPriceResolution),
-			big.NewInt(3 * GasPriceResolution),
-			big.NewInt(4 * GasPriceResolution),
+		bs.updateBlockFeesGasPrice([]*big.Int{
+			big.NewInt(1 * avaxGasPriceResolution),
+			big.NewInt(2 * avaxGasPriceResolution),
+			big.NewInt(3 * avaxGasPriceResolution),
+			big.NewInt(4 * avaxGasPriceResolution),
 		})
 	}
 
 	// empty blocks should not count
-	bs.updateGasPrice([]*big.Int{})
-	c.Assert(len(bs.gasCache), Equals, 99)
-	c.Assert(bs.gasPrice.Cmp(big.NewInt(0)), Equals, 0)
+	bs.updateBlockFeesGasPrice([]*big.Int{})
+	c.Assert(bs.gasPricer.CacheSize(), Equals, 99)
+	c.Assert(bs.GetGasPrice().Cmp(big.NewInt(0)), Equals, 0)
 
 	// now we should get the average of the 25th percentile gas (2)
-	bs.updateGasPrice([]*big.Int{
-		big.NewInt(1 * GasPriceResolution),
-		big.NewInt(2 * GasPriceResolution),
-		big.NewInt(3 * GasPriceResolution),
-		big.NewInt(4 * GasPriceResolution),
+	bs.updateBlockFeesGasPrice([]*big.Int{
+		big.NewInt(1 * avaxGasPriceResolution),
+		big.NewInt(2 * avaxGasPriceResolution),
+		big.NewInt(3 * avaxGasPriceResolution),
+		big.NewInt(4 * avaxGasPriceResolution),
 	})
-	c.Assert(len(bs.gasCache), Equals, 100)
-	c.Assert(bs.gasPrice.String(), Equals, big.NewInt(2*GasPriceResolution).String())
+	c.Assert(bs.gasPricer.CacheSize(), Equals, 100)
+	c.Assert(bs.GetGasPrice().String(), Equals, big.NewInt(2*avaxGasPriceResolution).String())
 
 	// add 50 more blocks with 2x the 25th percentile and we should get 6 (3 + 3x stddev)
 	for i := 0; i < 50; i++ {
-		bs.updateGasPrice([]*big.Int{
-			big.NewInt(2 * GasPriceResolution),
-			big.NewInt(4 * GasPriceResolution),
-			big.NewInt(6 * GasPriceResolution),
-			big.NewInt(8 * GasPriceResolution),
+		bs.updateBlockFeesGasPrice([]*big.Int{
+			big.NewInt(2 * avaxGasPriceResolution),
+			big.NewInt(4 * avaxGasPriceResolution),
+			big.NewInt(6 * avaxGasPriceResolution),
+			big.NewInt(8 * avaxGasPriceResolution),
 		})
 	}
-	c.Assert(len(bs.gasCache), Equals, 100)
-	c.Assert(bs.gasPrice.String(), Equals, big.NewInt(6*GasPriceResolution).String())
+	c.Assert(bs.gasPricer.CacheSize(), Equals, 100)
+	c.Assert(bs.GetGasPrice().String(), Equals, big.NewInt(6*avaxGasPriceResolution).String())
 
 	// add 50 more blocks with 2x the 25th percentile and we should get 4
 	for i := 0; i < 50; i++ {
-		bs.updateGasPrice([]*big.Int{
-			big.NewInt(2 * GasPriceResolution),
-			big.NewInt(4 * GasPriceResolution),
-			big.NewInt(6 * GasPriceResolution),
-			big.NewInt(8 * GasPriceResolution),
+		bs.updateBlockFeesGasPrice([]*big.Int{
+			big.NewInt(2 * avaxGasPriceResolution),
+			big.NewInt(4 * avaxGasPriceResolution),
+			big.NewInt(6 * avaxGasPriceResolution),
+			big.NewInt(8 * avaxGasPriceResolution),
 		})
 	}
-	c.Assert(len(bs.gasCache), Equals, 100)
-	c.Assert(bs.gasPrice.String(), Equals, big.NewInt(4*GasPriceResolution).String())
+	c.Assert(bs.gasPricer.CacheSize(), Equals, 100)
+	c.Assert(bs.GetGasPrice().String(), Equals, big.NewInt(4*avaxGasPriceResolution).String())
 
 	// add 50 more blocks with 2x the 25th percentile and we should get 12 (6 + 3x stddev)
 	for i := 0; i < 50; i++ {
-		bs.updateGasPrice([]*big.Int{
-			big.NewInt(4 * GasPriceResolution),
-			big.NewInt(8 * GasPriceResolution),
-			big.NewInt(12 * GasPriceResolution),
-			big.NewInt(16 * GasPriceResolution),
+		bs.updateBlockFeesGasPrice([]*big.Int{
+			big.NewInt(4 * avaxGasPriceResolution),
+			big.NewInt(8 * avaxGasPriceResolution),
+			big.NewInt(12 * avaxGasPriceResolution),
+			big.NewInt(16 * avaxGasPriceResolution),
 		})
 	}
-	c.Assert(len(bs.gasCache), Equals, 100)
-	c.Assert(bs.gasPrice.String(), Equals, big.NewInt(12*GasPriceResolution).String())
+	c.Assert(bs.gasPricer.CacheSize(), Equals, 100)
+	c.Assert(bs.GetGasPrice().String(), Equals, big.NewInt(12*avaxGasPriceResolution).String())
 }
@@ -1,4 +1,4 @@
-package ethereum
+package evm
 
 import (
 	"encoding/json"
@@ -22,6 +22,7 @@ import (
 	stypes "gitlab.com/mayachain/mayanode/bifrost/mayaclient/types"
 	"gitlab.com/mayachain/mayanode/bifrost/metrics"
 	"gitlab.com/mayachain/mayanode/bifrost/pkg/chainclients/ethereum/types"
+	evmtypes "gitlab.com/mayachain/mayanode/bifrost/pkg/chainclients/shared/evm/types"
 	"gitlab.com/mayachain/mayanode/bifrost/pubkeymanager"
 	"gitlab.com/mayachain/mayanode/cmd"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
@@ -106,23 +107,23 @@ func (s *BlockScannerTestSuite) TestNewBlockScanner(c *C) {
 	solvencyReporter := func(height int64) error {
 		return nil
 	}
-	bs, err := NewETHScanner(getConfigForTest(""), nil, big.NewInt(int64(types.Mainnet)), ethClient, s.bridge, s.m, pubKeyManager, solvencyReporter, nil)
+	bs, err := newETHScannerForTest(getConfigForTest(""), nil, big.NewInt(int64(types.Mainnet)), ethClient, s.bridge, s.m, pubKeyManager, solvencyReporter, nil)
 	c.Assert(err, NotNil)
 	c.Assert(bs, IsNil)
 
-	bs, err = NewETHScanner(getConfigForTest("127.0.0.1"), storage, big.NewInt(int64(types.Mainnet)), ethClient, s.bridge, nil, pubKeyManager, solvencyReporter, nil)
+	bs, err = newETHScannerForTest(getConfigForTest("127.0.0.1"), storage, big.NewInt(int64(types.Mainnet)), ethClient, s.bridge, nil, pubKeyManager, solvencyReporter, nil)
 	c.Assert(err, NotNil)
 	c.Assert(bs, IsNil)
 
-	bs, err = NewETHScanner(getConfigForTest("127.0.0.1"), storage, big.NewInt(int64(types.Mainnet)), nil, s.bridge, s.m, pubKeyManager, solvencyReporter, nil)
+	bs, err = newETHScannerForTest(getConfigForTest("127.0.0.1"), storage, big.NewInt(int64(types.Mainnet)), nil, s.bridge, s.m, pubKeyManager, solvencyReporter, nil)
 	c.Assert(err, NotNil)
 	c.Assert(bs, IsNil)
 
-	bs, err = NewETHScanner(getConfigForTest("127.0.0.1"), storage, big.NewInt(int64(types.Mainnet)), ethClient, s.bridge, s.m, nil, solvencyReporter, nil)
+	bs, err = newETHScannerForTest(getConfigForTest("127.0.0.1"), storage, big.NewInt(int64(types.Mainnet)), ethClient, s.bridge, s.m, nil, solvencyReporter, nil)
 	c.Assert(err, NotNil)
 	c.Assert(bs, IsNil)
 
-	bs, err = NewETHScanner(getConfigForTest("127.0.0.1"), storage, big.NewInt(int64(types.Mainnet)), ethClient, s.bridge, s.m, pubKeyManager, solvencyReporter, nil)
+	bs, err = newETHScannerForTest(getConfigForTest("127.0.0.1"), storage, big.NewInt(int64(types.Mainnet)), ethClient, s.bridge, s.m, pubKeyManager, solvencyReporter, nil)
 	c.Assert(err, IsNil)
 	c.Assert(bs, NotNil)
 }
@@ -131,17 +132,17 @@ func (s *BlockScannerTestSuite) TestProcessBlock(c *C) {
 	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		switch {
 		case req.RequestURI == mayaclient.PubKeysEndpoint:
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/vaults/pubKeys.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/vaults/pubKeys.json")
 		case req.RequestURI == mayaclient.InboundAddressesEndpoint:
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/inbound_addresses/inbound_addresses.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/inbound_addresses/inbound_addresses.json")
 		case req.RequestURI == mayaclient.AsgardVault:
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/vaults/asgard.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/vaults/asgard.json")
 		case strings.HasPrefix(req.RequestURI, mayaclient.NodeAccountEndpoint):
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/nodeaccount/template.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/nodeaccount/template.json")
 		case strings.HasPrefix(req.RequestURI, mayaclient.LastBlockEndpoint):
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/lastblock/bnb.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/lastblock/bnb.json")
 		case strings.HasPrefix(req.RequestURI, mayaclient.AuthAccountEndpoint):
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/auth/accounts/template.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/auth/accounts/template.json")
 		default:
 			body, err := ioutil.ReadAll(req.Body)
 			c.Assert(err, IsNil)
@@ -203,12 +204,12 @@ func (s *BlockScannerTestSuite) TestProcessBlock(c *C) {
 		c.Assert(pubKeyMgr.Stop(), IsNil)
 	}()
 
-	bs, err := NewETHScanner(getConfigForTest(server.URL), storage, big.NewInt(1337), ethClient, bridge, s.m, pubKeyMgr, func(height int64) error {
+	bs, err := newETHScannerForTest(getConfigForTest(server.URL), storage, big.NewInt(1337), ethClient, bridge, s.m, pubKeyMgr, func(height int64) error {
 		return nil
 	}, nil)
 	c.Assert(err, IsNil)
 	c.Assert(bs, NotNil)
-	whitelistSmartContractAddress = append(whitelistSmartContractAddress, "0x40bcd4dB8889a8Bf0b1391d0c819dcd9627f9d0a")
+	bs.chainCfg.Aggregators = append(bs.chainCfg.Aggregators, "0x40bcd4dB8889a8Bf0b1391d0c819dcd9627f9d0a")
 	txIn, err := bs.FetchTxs(int64(1))
 	c.Assert(err, IsNil)
 	c.Check(len(txIn.TxArray), Equals, 1)
@@ -238,13 +239,13 @@ func (s *BlockScannerTestSuite) TestFromTxToTxIn(c *C) {
 	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		switch {
 		case req.RequestURI == mayaclient.PubKeysEndpoint:
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/vaults/pubKeys.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/vaults/pubKeys.json")
 		case req.RequestURI == mayaclient.InboundAddressesEndpoint:
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/inbound_addresses/inbound_addresses.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/inbound_addresses/inbound_addresses.json")
 		case req.RequestURI == mayaclient.AsgardVault:
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/vaults/asgard.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/vaults/asgard.json")
 		case strings.HasPrefix(req.RequestURI, mayaclient.NodeAccountEndpoint):
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/nodeaccount/template.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/nodeaccount/template.json")
 		default:
 			body, err := ioutil.ReadAll(req.Body)
 			c.Assert(err, IsNil)
@@ -344,7 +345,7 @@ func (s *BlockScannerTestSuite) TestFromTxToTxIn(c *C) {
 		c.Assert(pkeyMgr.Stop(), IsNil)
 	}()
 	c.Assert(err, IsNil)
-	bs, err := NewETHScanner(getConfigForTest(server.URL), storage, big.NewInt(int64(types.Mainnet)), ethClient, s.bridge, s.m, pkeyMgr, func(height int64) error {
+	bs, err := newETHScannerForTest(getConfigForTest(server.URL), storage, big.NewInt(int64(types.Mainnet)), ethClient, s.bridge, s.m, pkeyMgr, func(height int64) error {
 		return nil
 	}, nil)
 	c.Assert(err, IsNil)
@@ -367,7 +368,7 @@ func (s *BlockScannerTestSuite) TestFromTxToTxIn(c *C) {
 		"r":"0x1b5e176d927f8e9ab405058b2d2457392da3e20f328b16ddabcebc33eaac5fea",
 		"s":"0x4ba69724e8f69de52f0125ad8b3c5c2cef33019bac3249e2c0a2192766d1721c"
 	}`
-	tx := etypes.NewTransaction(0, common.HexToAddress(ethToken), nil, 0, nil, nil)
+	tx := etypes.NewTransaction(0, common.HexToAddress(nativeTokenAddr), nil, 0, nil, nil)
 	err = tx.UnmarshalJSON([]byte(encodedTx))
 	c.Assert(err, IsNil)
 
@@ -390,14 +391,14 @@ func (s *BlockScannerTestSuite) TestFromTxToTxIn(c *C) {
 		true,
 	)
 
-	bs, err = NewETHScanner(getConfigForTest(server.URL), storage, big.NewInt(1337), ethClient, s.bridge, s.m, pkeyMgr, func(height int64) error {
+	bs, err = newETHScannerForTest(getConfigForTest(server.URL), storage, big.NewInt(1337), ethClient, s.bridge, s.m, pkeyMgr, func(height int64) error {
 		return nil
 	}, nil)
 	c.Assert(err, IsNil)
 	c.Assert(bs, NotNil)
 	// smart contract - deposit
 	encodedTx = `{"nonce":"0x4","gasPrice":"0x1","gas":"0x177b8","to":"0xe65e9d372f8cacc7b6dfcd4af6507851ed31bb44","value":"0x0","input":"0x1fece7b400000000000000000000000058e99c9c4a20f5f054c737389fdd51d7ed9c7d2a0000000000000000000000003b7fa4dd21c6f9ba3ca375217ead7cab9d6bf4830000000000000000000000000000000000000000000000004563918244f40000000000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000000000000000000000000000634144443a4554482e544b4e2d3078336237464134646432316336663942413363613337353231374541443743416239443662463438333a7474686f72313678786e30636164727575773661327177707633356176306d6568727976647a7a6a7a3361660000000000000000000000000000000000000000000000000000000000","v":"0xa95","r":"0x8a82b49901d67748c6840d7417d7307a40e6093579f6f73f7222cb52622f92cd","s":"0x21a1097c02306b177a0ca1a6e9f9599a8c4bab9926893493e966253c436977fd","hash":"0x817665ed5d08f6bcc47e409c147187fe0450201152ea1c80c85edf103d623acd"}`
-	tx = etypes.NewTransaction(0, common.HexToAddress(ethToken), nil, 0, nil, nil)
+	tx = etypes.NewTransaction(0, common.HexToAddress(nativeTokenAddr), nil, 0, nil, nil)
 	c.Assert(tx.UnmarshalJSON([]byte(encodedTx)), IsNil)
 	txInItem, err = bs.fromTxToTxIn(tx)
 	c.Assert(err, IsNil)
@@ -409,18 +410,18 @@ func (s *BlockScannerTestSuite) TestFromTxToTxIn(c *C) {
 	c.Assert(txInItem.Coins[0].Asset.String(), Equals, "ETH.TKN-0X3B7FA4DD21C6F9BA3CA375217EAD7CAB9D6BF483")
 	c.Assert(txInItem.Coins[0].Amount.Equal(cosmos.NewUint(500000000)), Equals, true)
 
-	bs, err = NewETHScanner(getConfigForTest(server.URL), storage, big.NewInt(1337), ethClient, s.bridge, s.m, pkeyMgr, func(height int64) error {
+	bs, err = newETHScannerForTest(getConfigForTest(server.URL), storage, big.NewInt(1337), ethClient, s.bridge, s.m, pkeyMgr, func(height int64) error {
 		return nil
 	}, nil)
+	c.Assert(err, IsNil)
+	c.Assert(bs, NotNil)
 	// whitelist the address for test
-	whitelistSmartContractAddress = append(whitelistSmartContractAddress,
+	bs.chainCfg.Aggregators = append(bs.chainCfg.Aggregators,
 		"0xe65e9d372f8cacc7b6dfcd4af6507851ed31bb44",
 		"0x81a392e6a757d58a7eb6781a775a3449da3b9df5")
-	c.Assert(err, IsNil)
-	c.Assert(bs, NotNil)
 	// smart contract - deposit via smart contract (transaction to != router)
 	encodedTx = `{"nonce":"0x4","gasPrice":"0x1","gas":"0x177b8","to":"0x81a392e6a757d58a7eb6781a775a3449da3b9df5","value":"0x0","input":"0x1fece7b400000000000000000000000058e99c9c4a20f5f054c737389fdd51d7ed9c7d2a0000000000000000000000003b7fa4dd21c6f9ba3ca375217ead7cab9d6bf4830000000000000000000000000000000000000000000000004563918244f40000000000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000000000000000000000000000634144443a4554482e544b4e2d3078336237464134646432316336663942413363613337353231374541443743416239443662463438333a7474686f72313678786e30636164727575773661327177707633356176306d6568727976647a7a6a7a3361660000000000000000000000000000000000000000000000000000000000","v":"0xa95","r":"0x8a82b49901d67748c6840d7417d7307a40e6093579f6f73f7222cb52622f92cd","s":"0x21a1097c02306b177a0ca1a6e9f9599a8c4bab9926893493e966253c436977fd","hash":"0x94ac3936bf227f830e21f9f852bec127086024f327d41862455b3d5f101d18c5"}`
-	tx = etypes.NewTransaction(0, common.HexToAddress(ethToken), nil, 0, nil, nil)
+	tx = etypes.NewTransaction(0, common.HexToAddress(nativeTokenAddr), nil, 0, nil, nil)
 	c.Assert(tx.UnmarshalJSON([]byte(encodedTx)), IsNil)
 	txInItem, err = bs.fromTxToTxIn(tx)
 	c.Assert(err, IsNil)
@@ -480,13 +481,13 @@ func (s *BlockScannerTestSuite) TestProcessReOrg(c *C) {
 	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		switch {
 		case req.RequestURI == mayaclient.PubKeysEndpoint:
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/vaults/pubKeys.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/vaults/pubKeys.json")
 		case req.RequestURI == mayaclient.InboundAddressesEndpoint:
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/inbound_addresses/inbound_addresses.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/inbound_addresses/inbound_addresses.json")
 		case req.RequestURI == mayaclient.AsgardVault:
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/vaults/asgard.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/vaults/asgard.json")
 		case strings.HasPrefix(req.RequestURI, mayaclient.NodeAccountEndpoint):
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/nodeaccount/template.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/nodeaccount/template.json")
 		default:
 			body, err := ioutil.ReadAll(req.Body)
 			c.Assert(err, IsNil)
@@ -576,7 +577,7 @@ func (s *BlockScannerTestSuite) TestProcessReOrg(c *C) {
 	defer func() {
 		c.Assert(pkeyMgr.Stop(), IsNil)
 	}()
-	bs, err := NewETHScanner(getConfigForTest(server.URL), storage, big.NewInt(int64(types.Mainnet)), ethClient, s.bridge, s.m, pkeyMgr, func(height int64) error {
+	bs, err := newETHScannerForTest(getConfigForTest(server.URL), storage, big.NewInt(int64(types.Mainnet)), ethClient, s.bridge, s.m, pkeyMgr, func(height int64) error {
 		return nil
 	}, nil)
 	c.Assert(err, IsNil)
@@ -587,8 +588,8 @@ func (s *BlockScannerTestSuite) TestProcessReOrg(c *C) {
 	blockNew, err := CreateBlock(1)
 	c.Assert(err, IsNil)
 	c.Assert(blockNew, NotNil)
-	blockMeta := types.NewBlockMeta(block, stypes.TxIn{TxArray: []stypes.TxInItem{{Tx: "0x88df016429689c079f3b2f6ad39fa052532c56795b733da78a91ebe6a713944b"}}})
-	blockMeta.Transactions = append(blockMeta.Transactions, types.TransactionMeta{
+	blockMeta := evmtypes.NewBlockMeta(block, stypes.TxIn{TxArray: []stypes.TxInItem{{Tx: "0x88df016429689c079f3b2f6ad39fa052532c56795b733da78a91ebe6a713944b"}}})
+	blockMeta.Transactions = append(blockMeta.Transactions, evmtypes.TransactionMeta{
 		Hash:        "0x88df016429689c079f3b2f6ad39fa052532c56795b733da78a91ebe6a713944b",
 		BlockHeight: block.Number.Int64(),
 	})
@@ -642,43 +643,43 @@ func (s *BlockScannerTestSuite) TestGasPriceV2(c *C) {
 	}
 	conf := getConfigForTest("127.0.0.1")
 	conf.SuggestedFeeVersion = 2
-	bs, err := NewETHScanner(conf, storage, big.NewInt(int64(types.Mainnet)), ethClient, s.bridge, s.m, pubKeyManager, solvencyReporter, nil)
+	bs, err := newETHScannerForTest(conf, storage, big.NewInt(int64(types.Mainnet)), ethClient, s.bridge, s.m, pubKeyManager, solvencyReporter, nil)
 	c.Assert(err, IsNil)
 	c.Assert(bs, NotNil)
 
 	// almost fill gas cache
 	for i := 0; i < 39; i++ {
-		bs.updateGasPriceV2([]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4)})
+		bs.updateBlockFeesGasPrice([]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4)})
 	}
 
 	// empty blocks should not count
-	bs.updateGasPriceV2([]*big.Int{})
-	c.Assert(len(bs.gasCache), Equals, 39)
-	c.Assert(bs.gasPrice.Cmp(big.NewInt(initialGasPrice)), Equals, 0)
+	bs.updateBlockFeesGasPrice([]*big.Int{})
+	c.Assert(bs.gasPricer.CacheSize(), Equals, 39)
+	c.Assert(bs.GetGasPrice().Cmp(big.NewInt(0)), Equals, 0)
 
 	// now we should get the average of the 25th percentile gas (2)
-	bs.updateGasPriceV2([]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4)})
-	c.Assert(len(bs.gasCache), Equals, 40)
-	c.Assert(bs.gasPrice.Uint64(), Equals, big.NewInt(2).Uint64())
+	bs.updateBlockFeesGasPrice([]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4)})
+	c.Assert(bs.gasPricer.CacheSize(), Equals, 40)
+	c.Assert(bs.GetGasPrice().Uint64(), Equals, big.NewInt(2).Uint64())
 
 	// add 20 more blocks with 2x the 25th percentile and we should get 6 (3 + 3x stddev)
 	for i := 0; i < 20; i++ {
-		bs.updateGasPriceV2([]*big.Int{big.NewInt(2), big.NewInt(4), big.NewInt(6), big.NewInt(8)})
+		bs.updateBlockFeesGasPrice([]*big.Int{big.NewInt(2), big.NewInt(4), big.NewInt(6), big.NewInt(8)})
 	}
-	c.Assert(len(bs.gasCache), Equals, 40)
-	c.Assert(bs.gasPrice.Uint64(), Equals, big.NewInt(6).Uint64())
+	c.Assert(bs.gasPricer.CacheSize(), Equals, 40)
+	c.Assert(bs.GetGasPrice().Uint64(), Equals, big.NewInt(6).Uint64())
 
 	// add 20 more blocks with 2x the 25th percentile and we should get 4
 	for i := 0; i < 20; i++ {
-		bs.updateGasPriceV2([]*big.Int{big.NewInt(2), big.NewInt(4), big.NewInt(6), big.NewInt(8)})
+		bs.updateBlockFeesGasPrice([]*big.Int{big.NewInt(2), big.NewInt(4), big.NewInt(6), big.NewInt(8)})
 	}
-	c.Assert(len(bs.gasCache), Equals, 40)
-	c.Assert(bs.gasPrice.Uint64(), Equals, big.NewInt(4).Uint64())
+	c.Assert(bs.gasPricer.CacheSize(), Equals, 40)
+	c.Assert(bs.GetGasPrice().Uint64(), Equals, big.NewInt(4).Uint64())
 
 	// add 20 more blocks with 2x the 25th percentile and we should get 12 (6 + 3x stddev)
 	for i := 0; i < 20; i++ {
-		bs.updateGasPriceV2([]*big.Int{big.NewInt(4), big.NewInt(8), big.NewInt(12), big.NewInt(16)})
+		bs.updateBlockFeesGasPrice([]*big.Int{big.NewInt(4), big.NewInt(8), big.NewInt(12), big.NewInt(16)})
 	}
-	c.Assert(len(bs.gasCache), Equals, 40)
-	c.Assert(bs.gasPrice.Uint64(), Equals, big.NewInt(12).Uint64())
+	c.Assert(bs.gasPricer.CacheSize(), Equals, 40)
+	c.Assert(bs.GetGasPrice().Uint64(), Equals, big.NewInt(12).Uint64())
 }
@@ -31,7 +31,7 @@ type TokenManager struct {
 	defaultDecimals uint64
 	nativeAsset     common.Asset
 	requestTimeout  time.Duration
-	tokenWhitelist  []ERC20Token
+	tokenWhitelist  *TokenWhitelist
 	erc20ABI        *abi.ABI
 	vaultABI        *abi.ABI
 	client          *ethclient.Client
@@ -44,7 +44,7 @@ func NewTokenManager(db *leveldb.DB,
 	nativeAsset common.Asset,
 	defaultDecimals uint64,
 	requestTimeout time.Duration,
-	tokenWhitelist []ERC20Token,
+	tokenWhitelist *TokenWhitelist,
 	ethClient *ethclient.Client,
 	routerContractABI,
 	erc20ContractABI string,
@@ -85,31 +85,37 @@ func (h *TokenManager) GetTokens() ([]*types.TokenMeta, error) {
 	return h.tokenDb.GetTokens()
 }
 
+// GetTokenMeta returns the meta data of a whitelisted token, the whitelist is
+// checked on every lookup so a token delisted on mayachain isn't served from
+// the cache
 func (h *TokenManager) GetTokenMeta(token string) (types.TokenMeta, error) {
+	whitelisted, ok := h.tokenWhitelist.Get(token)
+	if !ok {
+		h.logger.Info().Str("token", token).Msg("TM: token not whitelisted")
+		return types.TokenMeta{}, fmt.Errorf("token: %s is not whitelisted", token)
+	}
 	tokenMeta, err := h.tokenDb.GetTokenMeta(token)
 	if err != nil {
 		return types.TokenMeta{}, fmt.Errorf("fail to get token meta: %w", err)
 	}
 	if tokenMeta.IsEmpty() {
-		isWhiteListToken := false
-		for _, item := range h.tokenWhitelist {
-			if strings.EqualFold(item.Address, token) {
-				isWhiteListToken = true
-				break
-			}
-		}
-		if !isWhiteListToken {
-			h.logger.Info().Str("token", token).Msg("TM: token not whitelisted")
-			return types.TokenMeta{}, fmt.Errorf("token: %s is not whitelisted", token)
-		}
 		symbol, err := h.getSymbol(token)
 		if err != nil {
-			h.logger.Info().Str("token", token).Msg("fail to get symbol")
-			return types.TokenMeta{}, fmt.Errorf("fail to get symbol: %w", err)
+			if len(whitelisted.Symbol) == 0 {
+				h.logger.Info().Str("token", token).Msg("fail to get symbol")
+				return types.TokenMeta{}, fmt.Errorf("fail to get symbol: %w", err)
+			}
+			h.logger.Err(err).Str("symbol", whitelisted.Symbol).Msg("failed to get symbol from smart contract, returning whitelisted symbol")
+			symbol = whitelisted.Symbol
 		}
 		decimals, err := h.getDecimals(token)
 		if err != nil {
-			h.logger.Err(err).Uint64("default decimals", h.defaultDecimals).Msg("failed to get decimals from smart contract, returning default")
+			if whitelisted.Decimals > 0 {
+				h.logger.Err(err).Int("whitelisted decimals", whitelisted.Decimals).Msg("failed to get decimals from smart contract, returning whitelisted decimals")
+				decimals = uint64(whitelisted.Decimals)
+			} else {
+				h.logger.Err(err).Uint64("default decimals", h.defaultDecimals).Msg("failed to get decimals from smart contract, returning default")
+			}
 		}
 		tokenMeta = types.NewTokenMeta(symbol, token, decimals)
 		if err = h.tokenDb.SaveTokenMeta(symbol, token, decimals); err != nil {
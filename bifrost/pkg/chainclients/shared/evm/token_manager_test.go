@@ -1,27 +1,25 @@
 package evm
 
 import (
-	_ "embed"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/storage"
 	"gitlab.com/mayachain/mayanode/common"
+	stypes "gitlab.com/mayachain/mayanode/x/mayachain/types"
 	. "gopkg.in/check.v1"
 )
 
-//go:embed abi/router.json
-var routerContractABI string
-
-//go:embed abi/erc20.json
-var erc20ContractABI string
+func TestPackage(t *testing.T) { TestingT(t) }
 
 type TokenManagerTestSuite struct {
 	prefix string
@@ -100,7 +98,7 @@ func (s *TokenManagerTestSuite) TestSaveAndGet(c *C) {
 	memStorage := storage.NewMemStorage()
 	db, err := leveldb.Open(memStorage, nil)
 	c.Assert(err, IsNil)
-	manager, err := NewTokenManager(db, s.prefix, common.ETHAsset, 18, time.Second, testWhiteList, s.client, routerContractABI, erc20ContractABI)
+	manager, err := NewTokenManager(db, s.prefix, common.ETHAsset, 18, time.Second, NewTokenWhitelist(common.ETHChain, nil, testWhiteList), s.client, routerContractABI, erc20ContractABI)
 	c.Assert(err, IsNil)
 
 	// Test non-whitelisted token gets rejected
@@ -116,11 +114,37 @@ func (s *TokenManagerTestSuite) TestSaveAndGet(c *C) {
 	c.Assert(meta.Symbol, Equals, "TKN")
 }
 
+func (s *TokenManagerTestSuite) TestGetTokenMetaDelisted(c *C) {
+	memStorage := storage.NewMemStorage()
+	db, err := leveldb.Open(memStorage, nil)
+	c.Assert(err, IsNil)
+	bridge := &fakeTokenWhitelistBridge{err: errors.New("kaboom")}
+	whitelist := NewTokenWhitelist(common.ETHChain, bridge, testWhiteList)
+	manager, err := NewTokenManager(db, s.prefix, common.ETHAsset, 18, time.Second, whitelist, s.client, routerContractABI, erc20ContractABI)
+	c.Assert(err, IsNil)
+
+	usdc := "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+	c.Assert(manager.SaveTokenMeta("USDC", usdc, 6), IsNil)
+	meta, err := manager.GetTokenMeta(usdc)
+	c.Assert(err, IsNil)
+	c.Check(meta.Decimal, Equals, uint64(6))
+
+	// a token delisted on mayachain isn't served from the cache
+	bridge.err = nil
+	bridge.tokens = stypes.EVMTokens{
+		stypes.NewEVMToken(common.ETHChain, "0xdAC17F958D2ee523a2206206994597C13D831ec7", "USDT", 6),
+	}
+	whitelist.lastRefresh = time.Now().Add(-tokenWhitelistRefreshInterval)
+	_, err = manager.GetTokenMeta(usdc)
+	c.Check(err, NotNil)
+	c.Check(manager.GetTokenDecimalsForTHORChain(usdc), Equals, int64(0))
+}
+
 func (s *TokenManagerTestSuite) TestConvertAmounts(c *C) {
 	memStorage := storage.NewMemStorage()
 	db, err := leveldb.Open(memStorage, nil)
 	c.Assert(err, IsNil)
-	manager, err := NewTokenManager(db, s.prefix, common.ETHAsset, 18, time.Second, testWhiteList, s.client, routerContractABI, erc20ContractABI)
+	manager, err := NewTokenManager(db, s.prefix, common.ETHAsset, 18, time.Second, NewTokenWhitelist(common.ETHChain, nil, testWhiteList), s.client, routerContractABI, erc20ContractABI)
 	c.Assert(err, IsNil)
 
 	err = manager.SaveTokenMeta("0xB0b86991c6218b36c1d19D4a2e9Eb0cE3606eB49", "TKN", 9)
@@ -134,7 +158,7 @@ func (s *TokenManagerTestSuite) TestGetDecimals(c *C) {
 	memStorage := storage.NewMemStorage()
 	db, err := leveldb.Open(memStorage, nil)
 	c.Assert(err, IsNil)
-	manager, err := NewTokenManager(db, s.prefix, common.ETHAsset, 18, time.Second, testWhiteList, s.client, routerContractABI, erc20ContractABI)
+	manager, err := NewTokenManager(db, s.prefix, common.ETHAsset, 18, time.Second, NewTokenWhitelist(common.ETHChain, nil, testWhiteList), s.client, routerContractABI, erc20ContractABI)
 	c.Assert(err, IsNil)
 
 	err = manager.SaveTokenMeta("TKN", "0xB0b86991c6218b36c1d19D4a2e9Eb0cE3606eB49", 9)
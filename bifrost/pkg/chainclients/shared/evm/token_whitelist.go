@@ -0,0 +1,94 @@
+package evm
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"gitlab.com/mayachain/mayanode/common"
+	stypes "gitlab.com/mayachain/mayanode/x/mayachain/types"
+)
+
+// tokenWhitelistRefreshInterval how often the token whitelist is read from mayachain
+const tokenWhitelistRefreshInterval = 5 * time.Minute
+
+// TokenWhitelistBridge is the part of the mayachain bridge the token whitelist
+// is read through
+type TokenWhitelistBridge interface {
+	GetEVMTokens(chain common.Chain) (stypes.EVMTokens, error)
+}
+
+// TokenWhitelist is the list of tokens observed on an EVM chain. Tokens are
+// listed and delisted on mayachain, the whitelist is read from there and
+// refreshed periodically. The token list compiled into the binary is used until
+// mayachain could be reached.
+type TokenWhitelist struct {
+	chain       common.Chain
+	bridge      TokenWhitelistBridge
+	lock        sync.RWMutex
+	tokens      map[string]ERC20Token
+	lastRefresh time.Time
+	logger      zerolog.Logger
+}
+
+// NewTokenWhitelist create a new instance of TokenWhitelist, the bridge can be
+// nil in which case only the default tokens are whitelisted
+func NewTokenWhitelist(chain common.Chain, bridge TokenWhitelistBridge, defaults []ERC20Token) *TokenWhitelist {
+	tokens := make(map[string]ERC20Token, len(defaults))
+	for _, token := range defaults {
+		tokens[strings.ToLower(token.Address)] = token
+	}
+	return &TokenWhitelist{
+		chain:  chain,
+		bridge: bridge,
+		tokens: tokens,
+		logger: log.Logger.With().Str("module", "token_whitelist").Str("chain", chain.String()).Logger(),
+	}
+}
+
+// Get returns the whitelist entry of the given token address, and false when
+// the token is not whitelisted
+func (w *TokenWhitelist) Get(address string) (ERC20Token, bool) {
+	w.refresh()
+	w.lock.RLock()
+	defer w.lock.RUnlock()
+	token, ok := w.tokens[strings.ToLower(address)]
+	return token, ok
+}
+
+// refresh reads the whitelist from mayachain when it is due, the current
+// whitelist is kept when mayachain can't be reached. mayachain is queried
+// without holding the lock, lookups keep using the current whitelist meanwhile.
+func (w *TokenWhitelist) refresh() {
+	if w.bridge == nil {
+		return
+	}
+	w.lock.Lock()
+	if time.Since(w.lastRefresh) < tokenWhitelistRefreshInterval {
+		w.lock.Unlock()
+		return
+	}
+	// claim the refresh, so concurrent lookups don't query mayachain as well
+	w.lastRefresh = time.Now()
+	w.lock.Unlock()
+
+	items, err := w.bridge.GetEVMTokens(w.chain)
+	if err != nil {
+		w.logger.Err(err).Msg("fail to get token whitelist from mayachain, keep using the current one")
+		return
+	}
+	tokens := make(map[string]ERC20Token, len(items))
+	for _, item := range items {
+		tokens[strings.ToLower(item.Address.String())] = ERC20Token{
+			Address:  item.Address.String(),
+			Symbol:   item.Symbol,
+			Decimals: int(item.Decimals),
+		}
+	}
+	w.lock.Lock()
+	w.tokens = tokens
+	w.lock.Unlock()
+}
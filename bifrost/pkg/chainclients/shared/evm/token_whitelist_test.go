@@ -0,0 +1,66 @@
+package evm
+
+import (
+	"errors"
+	"time"
+
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/mayachain/mayanode/common"
+	stypes "gitlab.com/mayachain/mayanode/x/mayachain/types"
+)
+
+type TokenWhitelistTestSuite struct{}
+
+var _ = Suite(&TokenWhitelistTestSuite{})
+
+type fakeTokenWhitelistBridge struct {
+	tokens stypes.EVMTokens
+	err    error
+	calls  int
+}
+
+func (b *fakeTokenWhitelistBridge) GetEVMTokens(chain common.Chain) (stypes.EVMTokens, error) {
+	b.calls++
+	return b.tokens, b.err
+}
+
+func (s *TokenWhitelistTestSuite) TestTokenWhitelist(c *C) {
+	usdc := "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+	uni := "0x1f9840a85d5aF5bf1D1762F925BDADdC4201F984"
+
+	// no bridge, only the defaults
+	whitelist := NewTokenWhitelist(common.ETHChain, nil, testWhiteList)
+	token, ok := whitelist.Get("0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48")
+	c.Assert(ok, Equals, true)
+	c.Check(token.Symbol, Equals, "USDC")
+	_, ok = whitelist.Get("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	c.Check(ok, Equals, false)
+
+	// mayachain can't be reached, keep the defaults
+	bridge := &fakeTokenWhitelistBridge{err: errors.New("kaboom")}
+	whitelist = NewTokenWhitelist(common.ETHChain, bridge, testWhiteList)
+	_, ok = whitelist.Get(usdc)
+	c.Check(ok, Equals, true)
+	c.Check(bridge.calls, Equals, 1)
+	// not retried until the next refresh is due
+	_, ok = whitelist.Get(uni)
+	c.Check(ok, Equals, true)
+	c.Check(bridge.calls, Equals, 1)
+
+	// the whitelist on mayachain replaces the defaults
+	bridge.err = nil
+	bridge.tokens = stypes.EVMTokens{
+		stypes.NewEVMToken(common.ETHChain, common.Address(usdc), "USDC", 6),
+		stypes.NewEVMToken(common.ETHChain, "0xdAC17F958D2ee523a2206206994597C13D831ec7", "USDT", 6),
+	}
+	whitelist.lastRefresh = time.Now().Add(-tokenWhitelistRefreshInterval)
+	token, ok = whitelist.Get("0xdac17f958d2ee523a2206206994597c13d831ec7")
+	c.Assert(ok, Equals, true)
+	c.Check(token.Symbol, Equals, "USDT")
+	c.Check(token.Decimals, Equals, 6)
+	c.Check(bridge.calls, Equals, 2)
+	// delisted on mayachain
+	_, ok = whitelist.Get(uni)
+	c.Check(ok, Equals, false)
+}
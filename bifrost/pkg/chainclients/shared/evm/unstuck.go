@@ -1,4 +1,4 @@
-package ethereum
+package evm
 
 import (
 	"errors"
@@ -12,26 +12,24 @@ import (
 	"github.com/ethereum/go-ethereum/core/txpool"
 	etypes "github.com/ethereum/go-ethereum/core/types"
 
+	evmtypes "gitlab.com/mayachain/mayanode/bifrost/pkg/chainclients/shared/evm/types"
 	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/constants"
 )
 
-type SignedTxItem struct {
-	Hash        string `json:"hash,omitempty"`
-	Height      int64  `json:"height,omitempty"`
-	VaultPubKey string `json:"vault_pub_key,omitempty"`
-}
-
+// TxWaitBlocks is the number of BASEChain blocks to wait before
+// re-broadcasting a stuck tx with more gas. 150 was chosen
+// because the signing period for outbounds is 300 blocks.
+// After 300 blocks the tx will be re-assigned to a different vault, so
+// we want to try to push the tx through before that
 const TxWaitBlocks = 150
 
-// String implement fmt.Stringer
-func (st SignedTxItem) String() string {
-	return st.Hash
-}
-
+// unstuck watches outbound txs to ensure they go through before being
+// re-assigned to a different vault. Txs can get stuck because of a sudden
+// increase in gas prices, so a stuck tx is cancelled with more gas
 func (c *Client) unstuck() {
-	c.logger.Info().Msg("start ETH chain unstuck process")
-	defer c.logger.Info().Msg("stop ETH chain unstock process")
+	c.logger.Info().Msg("start unstuck process")
+	defer c.logger.Info().Msg("stop unstuck process")
 	defer c.wg.Done()
 	for {
 		select {
@@ -50,7 +48,7 @@ func (c *Client) unstuckAction() {
 		c.logger.Err(err).Msg("fail to get BASEChain block height")
 		return
 	}
-	signedTxItems, err := c.ethScanner.blockMetaAccessor.GetSignedTxItems()
+	signedTxItems, err := c.evmScanner.blockMetaAccessor.GetSignedTxItems()
 	if err != nil {
 		c.logger.Err(err).Msg("fail to get all signed tx items")
 		return
@@ -70,13 +68,13 @@ func (c *Client) unstuckAction() {
 			continue
 		}
 		// remove it
-		if err := c.ethScanner.blockMetaAccessor.RemoveSignedTxItem(item.Hash); err != nil {
+		if err := c.evmScanner.blockMetaAccessor.RemoveSignedTxItem(item.Hash); err != nil {
 			c.logger.Err(err).Msgf("fail to remove signed tx item with hash:%s vaultPubKey:%s", item.Hash, item.VaultPubKey)
 		}
 	}
 }
 
-// unstuckTx is the method used to unstuck ETH address
+// unstuckTx is the method used to unstuck a vault address
 // when unstuckTx return an err , then the same hash should retry otherwise it can be removed
 func (c *Client) unstuckTx(vaultPubKey, hash string) error {
 	ctx, cancel := c.getContext()
@@ -84,7 +82,7 @@ func (c *Client) unstuckTx(vaultPubKey, hash string) error {
 	tx, pending, err := c.client.TransactionByHash(ctx, ecommon.HexToHash(hash))
 	if err != nil {
 		if errors.Is(err, ethereum.NotFound) {
-			c.logger.Err(err).Msgf("Transaction with hash: %s doesn't exist on ETH chain anymore", hash)
+			c.logger.Err(err).Msgf("Transaction with hash: %s doesn't exist on %s chain anymore", hash, c.chainCfg.Chain)
 			return nil
 		}
 		return fmt.Errorf("fail to get transaction by hash:%s, error:: %w", hash, err)
@@ -101,16 +99,16 @@ func (c *Client) unstuckTx(vaultPubKey, hash string) error {
 		// this should not happen , and if it does , there is no point to try it again , just remove it
 		return nil
 	}
-	address, err := pubKey.GetAddress(common.ETHChain)
+	address, err := pubKey.GetAddress(c.chainCfg.Chain)
 	if err != nil {
-		c.logger.Err(err).Msgf("fail to get ETH address")
+		c.logger.Err(err).Msgf("fail to get %s address", c.chainCfg.Chain)
 		return nil
 	}
 
 	c.logger.Info().Msgf("cancel tx hash: %s, nonce: %d", hash, tx.Nonce())
 	// double the current suggest gas price
 	currentGasRate := big.NewInt(1).Mul(c.GetGasPrice(), big.NewInt(2))
-	// inflate the originGasPrice by 10% as per ETH chain , the transaction to cancel an existing tx in the mempool
+	// inflate the originGasPrice by 10% as per EVM chains , the transaction to cancel an existing tx in the mempool
 	// need to pay at least 10% more than the original price , otherwise it will not allow it.
 	// the error will be "replacement transaction underpriced"
 	// this is the way how to get 110% of the original gas price
@@ -139,7 +137,7 @@ func (c *Client) unstuckTx(vaultPubKey, hash string) error {
 
 // AddSignedTxItem add the transaction to key value store
 func (c *Client) AddSignedTxItem(hash string, height int64, vaultPubKey string) error {
-	return c.ethScanner.blockMetaAccessor.AddSignedTxItem(SignedTxItem{
+	return c.evmScanner.blockMetaAccessor.AddSignedTxItem(evmtypes.SignedTxItem{
 		Hash:        hash,
 		Height:      height,
 		VaultPubKey: vaultPubKey,
@@ -1,4 +1,4 @@
-package ethereum
+package evm
 
 import (
 	"encoding/json"
@@ -14,6 +14,7 @@ import (
 	cKeys "github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"gitlab.com/mayachain/mayanode/bifrost/mayaclient"
 	"gitlab.com/mayachain/mayanode/bifrost/metrics"
+	evmtypes "gitlab.com/mayachain/mayanode/bifrost/pkg/chainclients/shared/evm/types"
 	"gitlab.com/mayachain/mayanode/bifrost/pubkeymanager"
 	"gitlab.com/mayachain/mayanode/cmd"
 	"gitlab.com/mayachain/mayanode/common"
@@ -44,7 +45,7 @@ func (s *UnstuckTestSuite) SetUpTest(c *C) {
 			tm, _ := codec.ToTmPubKeyInterface(priKey.PubKey())
 			pk, err := common.NewPubKeyFromCrypto(tm)
 			c.Assert(err, IsNil)
-			content, err := ioutil.ReadFile("../../../../test/fixtures/endpoints/vaults/pubKeys.json")
+			content, err := ioutil.ReadFile("../../../../../test/fixtures/endpoints/vaults/pubKeys.json")
 			c.Assert(err, IsNil)
 			var pubKeysVault types2.QueryVaultsPubKeys
 			c.Assert(json.Unmarshal(content, &pubKeysVault), IsNil)
@@ -62,13 +63,13 @@ func (s *UnstuckTestSuite) SetUpTest(c *C) {
 			_, err = rw.Write(buf)
 			c.Assert(err, IsNil)
 		case mayaclient.LastBlockEndpoint:
-			httpTestHandler(c, rw, "../../../../test/fixtures/eth/last_block_height.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/eth/last_block_height.json")
 		case mayaclient.InboundAddressesEndpoint:
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/inbound_addresses/inbound_addresses.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/inbound_addresses/inbound_addresses.json")
 		case mayaclient.AsgardVault:
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/vaults/asgard.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/vaults/asgard.json")
 		case mayaclient.NodeAccountEndpoint:
-			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/nodeaccount/template.json")
+			httpTestHandler(c, rw, "../../../../../test/fixtures/endpoints/nodeaccount/template.json")
 		default:
 			body, err := ioutil.ReadAll(req.Body)
 			c.Assert(err, IsNil)
@@ -176,7 +177,7 @@ func (s *UnstuckTestSuite) TestUnstuckProcess(c *C) {
 	pubkeyMgr, err := pubkeymanager.NewPubKeyManager(s.bridge, s.m)
 	c.Assert(err, IsNil)
 	poolMgr := mayaclient.NewPoolMgr(s.bridge)
-	e, err := NewClient(s.thorKeys, config.BifrostChainConfiguration{
+	e, err := newETHClientForTest(s.thorKeys, config.BifrostChainConfiguration{
 		RPCHost: "http://" + s.server.Listener.Addr().String(),
 		BlockScanner: config.BifrostBlockScannerConfiguration{
 			StartBlockHeight:    1, // avoids querying thorchain for block height
@@ -193,36 +194,36 @@ func (s *UnstuckTestSuite) TestUnstuckProcess(c *C) {
 	txID1 := types2.GetRandomTxHash().String()
 	txID2 := types2.GetRandomTxHash().String()
 	// add some thing here
-	c.Assert(e.ethScanner.blockMetaAccessor.AddSignedTxItem(SignedTxItem{
+	c.Assert(e.evmScanner.blockMetaAccessor.AddSignedTxItem(evmtypes.SignedTxItem{
 		Hash:        txID1,
 		Height:      1022,
 		VaultPubKey: pubkey,
 	}), IsNil)
-	c.Assert(e.ethScanner.blockMetaAccessor.AddSignedTxItem(SignedTxItem{
+	c.Assert(e.evmScanner.blockMetaAccessor.AddSignedTxItem(evmtypes.SignedTxItem{
 		Hash:        txID2,
 		Height:      1024,
 		VaultPubKey: pubkey,
 	}), IsNil)
 	// this should not do anything , because because all the tx has not been
 	e.unstuckAction()
-	items, err := e.ethScanner.blockMetaAccessor.GetSignedTxItems()
+	items, err := e.evmScanner.blockMetaAccessor.GetSignedTxItems()
 	c.Assert(err, IsNil)
 	c.Assert(items, HasLen, 2)
-	c.Assert(e.ethScanner.blockMetaAccessor.RemoveSignedTxItem(txID1), IsNil)
-	c.Assert(e.ethScanner.blockMetaAccessor.RemoveSignedTxItem(txID2), IsNil)
-	c.Assert(e.ethScanner.blockMetaAccessor.AddSignedTxItem(SignedTxItem{
+	c.Assert(e.evmScanner.blockMetaAccessor.RemoveSignedTxItem(txID1), IsNil)
+	c.Assert(e.evmScanner.blockMetaAccessor.RemoveSignedTxItem(txID2), IsNil)
+	c.Assert(e.evmScanner.blockMetaAccessor.AddSignedTxItem(evmtypes.SignedTxItem{
 		Hash:        "0x88df016429689c079f3b2f6ad39fa052532c56795b733da78a91ebe6a713944b",
 		Height:      800,
 		VaultPubKey: pubkey,
 	}), IsNil)
-	c.Assert(e.ethScanner.blockMetaAccessor.AddSignedTxItem(SignedTxItem{
+	c.Assert(e.evmScanner.blockMetaAccessor.AddSignedTxItem(evmtypes.SignedTxItem{
 		Hash:        "0x96395fbdb39e33293999dc1a0a3b87c8a9e51185e177760d1482c2155bb35b87",
 		Height:      800,
 		VaultPubKey: pubkey,
 	}), IsNil)
 	// this should try to check 0x88df016429689c079f3b2f6ad39fa052532c56795b733da78a91ebe6a713944b
 	e.unstuckAction()
-	items, err = e.ethScanner.blockMetaAccessor.GetSignedTxItems()
+	items, err = e.evmScanner.blockMetaAccessor.GetSignedTxItems()
 	c.Assert(err, IsNil)
 	c.Assert(items, HasLen, 0)
 }
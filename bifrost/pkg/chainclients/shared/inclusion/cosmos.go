@@ -0,0 +1,47 @@
+package inclusion
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"gitlab.com/mayachain/mayanode/bifrost/mayaclient/types"
+	"gitlab.com/mayachain/mayanode/common"
+	mayainclusion "gitlab.com/mayachain/mayanode/x/mayachain/inclusion"
+)
+
+// AttachCosmosTxProofs sets the block hash and the merkle branch on each of the
+// observed items, the branches are checked against the data hash of the block
+func AttachCosmosTxProofs(items []types.TxInItem, block *tmproto.Block) error {
+	header, err := tmtypes.HeaderFromProto(&block.Header)
+	if err != nil {
+		return fmt.Errorf("fail to parse block header: %w", err)
+	}
+	blockTxs := make(tmtypes.Txs, len(block.Data.Txs))
+	indexes := make(map[string]int, len(block.Data.Txs))
+	for i, tx := range block.Data.Txs {
+		blockTxs[i] = tx
+		indexes[hex.EncodeToString(blockTxs[i].Hash())] = i
+	}
+	if !bytes.Equal(blockTxs.Hash(), header.DataHash) {
+		return fmt.Errorf("block %d data hash is %s, txs resolve to %X", header.Height, header.DataHash, blockTxs.Hash())
+	}
+	for i := range items {
+		// all the observations of a multi msg tx are proven by the same tx
+		index, ok := indexes[strings.ToLower(common.TxID(items[i].Tx).BaseID().String())]
+		if !ok {
+			return fmt.Errorf("tx %s is not in block %d", items[i].Tx, header.Height)
+		}
+		proof, err := mayainclusion.CosmosTxProof(block.Data.Txs, index)
+		if err != nil {
+			return err
+		}
+		items[i].BlockHash = header.Hash().String()
+		items[i].Proof = proof
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package inclusion
+
+import (
+	"fmt"
+	"strings"
+
+	etypes "github.com/ethereum/go-ethereum/core/types"
+
+	"gitlab.com/mayachain/mayanode/bifrost/mayaclient/types"
+	mayainclusion "gitlab.com/mayachain/mayanode/x/mayachain/inclusion"
+)
+
+// AttachEVMReceiptProofs sets the block hash and the receipts trie path on each
+// of the observed items, the paths are checked against the receipts and
+// transactions roots of the block. receipts are all the receipts of the block
+// in block order.
+func AttachEVMReceiptProofs(items []types.TxInItem, block *etypes.Block, receipts etypes.Receipts) error {
+	txs := block.Transactions()
+	indexes := make(map[string]int, len(txs))
+	for i, tx := range txs {
+		indexes[strings.ToLower(tx.Hash().Hex())] = i
+	}
+	for i := range items {
+		hash := strings.ToLower(items[i].Tx)
+		if !strings.HasPrefix(hash, "0x") {
+			hash = "0x" + hash
+		}
+		index, ok := indexes[hash]
+		if !ok {
+			return fmt.Errorf("tx %s is not in block %d", items[i].Tx, block.NumberU64())
+		}
+		proof, err := mayainclusion.EVMReceiptProof(txs, receipts, index)
+		if err != nil {
+			return err
+		}
+		if !strings.EqualFold(proof.Root, block.ReceiptHash().Hex()) {
+			return fmt.Errorf("block %d receipts root is %s, receipts resolve to %s", block.NumberU64(), block.ReceiptHash(), proof.Root)
+		}
+		if !strings.EqualFold(proof.TxRoot, block.TxHash().Hex()) {
+			return fmt.Errorf("block %d transactions root is %s, txs resolve to %s", block.NumberU64(), block.TxHash(), proof.TxRoot)
+		}
+		items[i].BlockHash = block.Hash().Hex()
+		items[i].Proof = proof
+	}
+	return nil
+}
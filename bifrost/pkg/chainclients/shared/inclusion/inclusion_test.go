@@ -0,0 +1,122 @@
+package inclusion
+
+import (
+	"encoding/hex"
+	"testing"
+
+	ecommon "github.com/ethereum/go-ethereum/common"
+	etypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	tmtypes "github.com/tendermint/tendermint/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/mayachain/mayanode/bifrost/mayaclient/types"
+	mayainclusion "gitlab.com/mayachain/mayanode/x/mayachain/inclusion"
+)
+
+func TestPackage(t *testing.T) { TestingT(t) }
+
+type InclusionSuite struct{}
+
+var _ = Suite(&InclusionSuite{})
+
+// txids and merkle root of bitcoin block 100000
+var (
+	btcBlockTxIDs = []string{
+		"8c14f0db3df150123e6f3dbbf30f8b955a8249b62ac1d1ff16284aefa3d06d87",
+		"fff2525b8931402dd09222c50775608f75787bd2b87e56995a7bdd30f79702c4",
+		"6359f0868171b1d194cbee1af2f16ea598ae8fad666d9b012c8ed2b79a236ec4",
+		"e9a66845e05d5abc0ad04ec80f774a7e585c6e8db975962d069a522137b80c1d",
+	}
+	btcBlockMerkleRoot = "f3e94742aca4b5ef85488dc37c06c3282295ffec960994b2c0d5ac2a25a95766"
+)
+
+func (s *InclusionSuite) TestAttachUTXOProofs(c *C) {
+	items := []types.TxInItem{{Tx: btcBlockTxIDs[2]}, {Tx: btcBlockTxIDs[3]}}
+	c.Assert(AttachUTXOProofs(items, "blockhash", btcBlockMerkleRoot, btcBlockTxIDs), IsNil)
+	for _, item := range items {
+		c.Check(item.BlockHash, Equals, "blockhash")
+		c.Assert(item.Proof, NotNil)
+		c.Check(mayainclusion.Verify(item.Tx, item.Proof), IsNil)
+	}
+
+	items = []types.TxInItem{{Tx: btcBlockTxIDs[2]}}
+	c.Check(AttachUTXOProofs(items, "blockhash", btcBlockTxIDs[0], btcBlockTxIDs), NotNil)
+	c.Check(items[0].Proof, IsNil)
+}
+
+func (s *InclusionSuite) TestAttachEVMReceiptProofs(c *C) {
+	var txs etypes.Transactions
+	var receipts etypes.Receipts
+	for i := 0; i < 3; i++ {
+		tx := etypes.NewTransaction(uint64(i), ecommon.Address{}, ecommon.Big1, 21000, ecommon.Big1, nil)
+		txs = append(txs, tx)
+		receipts = append(receipts, &etypes.Receipt{
+			Status:            etypes.ReceiptStatusSuccessful,
+			CumulativeGasUsed: uint64(21000 * (i + 1)),
+			Logs:              []*etypes.Log{},
+			TxHash:            tx.Hash(),
+		})
+	}
+	block := etypes.NewBlock(&etypes.Header{Number: ecommon.Big1}, txs, nil, receipts, trie.NewStackTrie(nil))
+
+	items := []types.TxInItem{{Tx: txs[1].Hash().Hex()[2:]}, {Tx: txs[2].Hash().Hex()}}
+	c.Assert(AttachEVMReceiptProofs(items, block, receipts), IsNil)
+	for i, item := range items {
+		c.Check(item.BlockHash, Equals, block.Hash().Hex())
+		c.Assert(item.Proof, NotNil)
+		c.Check(item.Proof.Index, Equals, int64(i+1))
+		c.Check(item.Proof.Root, Equals, block.ReceiptHash().Hex())
+		c.Check(item.Proof.TxRoot, Equals, block.TxHash().Hex())
+		c.Check(mayainclusion.Verify(item.Tx, item.Proof), IsNil)
+	}
+
+	items = []types.TxInItem{{Tx: ecommon.BigToHash(ecommon.Big1).Hex()}}
+	c.Check(AttachEVMReceiptProofs(items, block, receipts), NotNil)
+	c.Check(items[0].Proof, IsNil)
+
+	// receipts that don't resolve to the receipts root of the block
+	receipts[0].Status = etypes.ReceiptStatusFailed
+	items = []types.TxInItem{{Tx: txs[1].Hash().Hex()}}
+	c.Check(AttachEVMReceiptProofs(items, block, receipts), NotNil)
+	c.Check(items[0].Proof, IsNil)
+}
+
+func (s *InclusionSuite) TestAttachCosmosTxProofs(c *C) {
+	txs := [][]byte{[]byte("tx1"), []byte("tx2"), []byte("tx3")}
+	blockTxs := tmtypes.Txs{}
+	for _, tx := range txs {
+		blockTxs = append(blockTxs, tx)
+	}
+	block := &tmproto.Block{
+		Header: tmproto.Header{
+			Version:         tmversion.Consensus{Block: 11},
+			ChainID:         "cosmoshub-4",
+			Height:          10,
+			DataHash:        blockTxs.Hash(),
+			ProposerAddress: make([]byte, 20),
+		},
+		Data: tmproto.Data{Txs: txs},
+	}
+	header, err := tmtypes.HeaderFromProto(&block.Header)
+	c.Assert(err, IsNil)
+
+	txID := hex.EncodeToString(blockTxs[2].Hash())
+	items := []types.TxInItem{{Tx: txID}}
+	c.Assert(AttachCosmosTxProofs(items, block), IsNil)
+	c.Check(items[0].BlockHash, Equals, header.Hash().String())
+	c.Assert(items[0].Proof, NotNil)
+	c.Check(items[0].Proof.Index, Equals, int64(2))
+	c.Check(mayainclusion.Verify(txID, items[0].Proof), IsNil)
+
+	// observations split from a multi msg tx share its proof
+	items = []types.TxInItem{{Tx: txID + "-1"}}
+	c.Assert(AttachCosmosTxProofs(items, block), IsNil)
+	c.Check(items[0].Proof.Index, Equals, int64(2))
+	c.Check(mayainclusion.Verify(items[0].Tx, items[0].Proof), IsNil)
+
+	block.Header.DataHash = blockTxs[:2].Hash()
+	c.Check(AttachCosmosTxProofs(items, block), NotNil)
+}
@@ -0,0 +1,26 @@
+package inclusion
+
+import (
+	"fmt"
+	"strings"
+
+	"gitlab.com/mayachain/mayanode/bifrost/mayaclient/types"
+	mayainclusion "gitlab.com/mayachain/mayanode/x/mayachain/inclusion"
+)
+
+// AttachUTXOProofs sets the block hash and the merkle branch on each of the
+// observed items, the branches are checked against the merkle root of the block
+func AttachUTXOProofs(items []types.TxInItem, blockHash, merkleRoot string, txIDs []string) error {
+	for i := range items {
+		proof, err := mayainclusion.UTXOMerkleProof(txIDs, items[i].Tx)
+		if err != nil {
+			return err
+		}
+		if !strings.EqualFold(proof.Root, merkleRoot) {
+			return fmt.Errorf("block %s merkle root is %s, txs resolve to %s", blockHash, merkleRoot, proof.Root)
+		}
+		items[i].BlockHash = blockHash
+		items[i].Proof = proof
+	}
+	return nil
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
 	rpcclient "github.com/tendermint/tendermint/rpc/client/http"
 
+	"github.com/blang/semver"
 	ctypes "github.com/cosmos/cosmos-sdk/types"
 	btypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/rs/zerolog"
@@ -31,6 +32,7 @@ import (
 	"gitlab.com/mayachain/mayanode/bifrost/mayaclient"
 	"gitlab.com/mayachain/mayanode/bifrost/mayaclient/types"
 	"gitlab.com/mayachain/mayanode/bifrost/metrics"
+	"gitlab.com/mayachain/mayanode/bifrost/pkg/chainclients/shared/inclusion"
 	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/config"
 )
@@ -286,6 +288,8 @@ func (c *CosmosBlockScanner) processTxs(height int64, rawTxs [][]byte) ([]types.
 		return []types.TxInItem{}, fmt.Errorf("unable to get BlockResults: %w", err)
 	}
 
+	observeAllMsgs := c.observeAllMsgs()
+	fakeGas := common.NewCoin(c.cfg.ChainID.GetGasAsset(), cosmos.NewUint(2000000))
 	var txIn []types.TxInItem
 	for i, rawTx := range rawTxs {
 		hash := hex.EncodeToString(tmhash.Sum(rawTx))
@@ -311,6 +315,7 @@ func (c *CosmosBlockScanner) processTxs(height int64, rawTxs [][]byte) ([]types.
 		memo := mem.GetMemo()
 		// c.updateGasCache(feeTx)
 
+		subIndex := 0
 		for _, msg := range tx.GetMsgs() {
 			if msg, isMsgSend := msg.(*tcTypes.MsgSend); isMsgSend {
 				// Transaction contains a relevant MsgSend, check if the transaction was successful...
@@ -347,7 +352,7 @@ func (c *CosmosBlockScanner) processTxs(height int64, rawTxs [][]byte) ([]types.
 				}
 
 				if gasFees.IsEmpty() {
-					gasFees = append(gasFees, common.NewCoin(c.cfg.ChainID.GetGasAsset(), cosmos.NewUint(2000000)))
+					gasFees = append(gasFees, fakeGas)
 				}
 
 				// Change AccAddress to strings
@@ -364,20 +369,46 @@ func (c *CosmosBlockScanner) processTxs(height int64, rawTxs [][]byte) ([]types.
 					continue
 				}
 
-				txIn = append(txIn, types.TxInItem{
-					Tx:          hash,
-					BlockHeight: height,
-					Memo:        memo,
-					Sender:      fromAddr,
-					To:          toAddr,
-					Coins:       coins,
-					Gas:         gasFees,
-				})
-
-				// If there are more than one TxIn item per transaction hash,
-				// thornode will fail to process any after the first.
-				// Therefore, limit to 1 MsgSend per transaction.
-				break
+				if !observeAllMsgs {
+					txIn = append(txIn, types.TxInItem{
+						Tx:          hash,
+						BlockHeight: height,
+						Memo:        memo,
+						Sender:      fromAddr,
+						To:          toAddr,
+						Coins:       coins,
+						Gas:         gasFees,
+					})
+
+					// If there are more than one TxIn item per transaction hash,
+					// thornode will fail to process any after the first.
+					// Therefore, limit to 1 MsgSend per transaction.
+					break
+				}
+
+				// Every coin of every MsgSend is observed on its own, the fee is
+				// paid once so only the first observation of the tx carries it
+				for _, coin := range coins {
+					txID, err := common.NewSubTxID(hash, subIndex)
+					if err != nil {
+						c.logger.Error().Err(err).Str("txhash", hash).Msg("fail to build sub indexed tx id")
+						break
+					}
+					gas := gasFees
+					if subIndex > 0 {
+						gas = common.Gas{fakeGas}
+					}
+					subIndex++
+					txIn = append(txIn, types.TxInItem{
+						Tx:          strings.ToLower(txID.String()),
+						BlockHeight: height,
+						Memo:        memo,
+						Sender:      fromAddr,
+						To:          toAddr,
+						Coins:       common.Coins{coin},
+						Gas:         gas,
+					})
+				}
 			}
 		}
 
@@ -386,6 +417,20 @@ func (c *CosmosBlockScanner) processTxs(height int64, rawTxs [][]byte) ([]types.
 	return txIn, nil
 }
 
+// observeAllMsgs returns true once BASEChain accepts sub indexed tx ids, before
+// that only the first MsgSend of a tx is observed
+func (c *CosmosBlockScanner) observeAllMsgs() bool {
+	if c.bridge == nil {
+		return false
+	}
+	version, err := c.bridge.GetMayachainVersion()
+	if err != nil {
+		c.logger.Err(err).Msg("fail to get BASEChain version")
+		return false
+	}
+	return version.GTE(semver.MustParse("1.106.0"))
+}
+
 func (c *CosmosBlockScanner) FetchTxs(height int64) (types.TxIn, error) {
 	block, err := c.GetBlock(height)
 	if err != nil {
@@ -397,6 +442,11 @@ func (c *CosmosBlockScanner) FetchTxs(height int64) (types.TxIn, error) {
 		c.logger.Debug().Err(err).Msg("error processing txs")
 		return types.TxIn{}, err
 	}
+	if len(txs) > 0 {
+		if err := inclusion.AttachCosmosTxProofs(txs, block); err != nil {
+			c.logger.Err(err).Int64("height", height).Msg("fail to attach inclusion proofs")
+		}
+	}
 
 	txIn := types.TxIn{
 		Count:    strconv.Itoa(len(txs)),
@@ -0,0 +1,206 @@
+package signer
+
+import (
+	"sort"
+	"sync"
+
+	"gitlab.com/mayachain/mayanode/bifrost/metrics"
+	"gitlab.com/mayachain/mayanode/common"
+)
+
+// signerLane is the ordered list of outbound items of a single chain and vault
+// pubkey. Items within a lane are signed sequentially, lanes are independent
+// from each other.
+type signerLane struct {
+	key   string
+	chain common.Chain
+	vault common.PubKey
+	items []TxOutStoreItem
+	// tss is set when the vault is signed by a TSS keysign
+	tss bool
+}
+
+// oldestHeight returns the mayachain height of the first item in the lane
+func (l signerLane) oldestHeight() int64 {
+	if len(l.items) == 0 {
+		return 0
+	}
+	return l.items[0].Height
+}
+
+// newSignerLanes converts the ordered lists of the signer store into lanes,
+// sorted by age so the lane holding the oldest outbound comes first. Lanes of
+// the same age are sorted by key so every node walks them in the same order.
+func newSignerLanes(lists map[string][]TxOutStoreItem) []signerLane {
+	lanes := make([]signerLane, 0, len(lists))
+	for key, items := range lists {
+		if len(items) == 0 {
+			continue
+		}
+		lanes = append(lanes, signerLane{
+			key:   key,
+			chain: items[0].TxOutItem.Chain,
+			vault: items[0].TxOutItem.VaultPubKey,
+			items: items,
+		})
+	}
+	sort.SliceStable(lanes, func(i, j int) bool {
+		if lanes[i].oldestHeight() != lanes[j].oldestHeight() {
+			return lanes[i].oldestHeight() < lanes[j].oldestHeight()
+		}
+		return lanes[i].key < lanes[j].key
+	})
+	return lanes
+}
+
+// laneScheduler keeps track of the lanes currently being signed, so a slow
+// chain or a stuck keysign only holds up its own lane. The zero value is ready
+// to use.
+type laneScheduler struct {
+	lock     sync.Mutex
+	wg       sync.WaitGroup
+	active   map[string]common.Chain
+	limited  int
+	perChain map[common.Chain]int
+	reported map[string]signerLane
+}
+
+// acquire reserves a worker for the given lane. It returns false when the lane
+// is already being worked on, or when either the total or the per chain
+// concurrency limit has been reached. A limit of zero means no limit. TSS lanes
+// are neither held back by nor counted against the limits, every member of the
+// keysign party has to start them, and nodes at different points of their
+// backlog would otherwise pick different lanes.
+func (ls *laneScheduler) acquire(lane signerLane, maxLanes, maxChainLanes int) bool {
+	ls.lock.Lock()
+	defer ls.lock.Unlock()
+	if ls.active == nil {
+		ls.active = make(map[string]common.Chain)
+		ls.perChain = make(map[common.Chain]int)
+	}
+	if _, ok := ls.active[lane.key]; ok {
+		return false
+	}
+	if !lane.tss {
+		if maxLanes > 0 && ls.limited >= maxLanes {
+			return false
+		}
+		if maxChainLanes > 0 && ls.perChain[lane.chain] >= maxChainLanes {
+			return false
+		}
+		ls.limited++
+		ls.perChain[lane.chain]++
+	}
+	ls.active[lane.key] = lane.chain
+	ls.wg.Add(1)
+	return true
+}
+
+// release frees the worker held by the given lane
+func (ls *laneScheduler) release(lane signerLane) {
+	ls.lock.Lock()
+	defer ls.lock.Unlock()
+	if _, ok := ls.active[lane.key]; !ok {
+		return
+	}
+	delete(ls.active, lane.key)
+	if !lane.tss {
+		ls.limited--
+		ls.perChain[lane.chain]--
+		if ls.perChain[lane.chain] <= 0 {
+			delete(ls.perChain, lane.chain)
+		}
+	}
+	ls.wg.Done()
+}
+
+// isActive returns true when the given lane is currently being worked on
+func (ls *laneScheduler) isActive(key string) bool {
+	ls.lock.Lock()
+	defer ls.lock.Unlock()
+	_, ok := ls.active[key]
+	return ok
+}
+
+// wait blocks until all lanes have been released
+func (ls *laneScheduler) wait() {
+	ls.wg.Wait()
+}
+
+// startLanes spawns a worker for every lane that has pending items, is not
+// already being worked on, and fits into the configured concurrency. Lanes are
+// started oldest first. It does not block, the returned WaitGroup is done once
+// all lanes started by this call have finished.
+func (s *Signer) startLanes() *sync.WaitGroup {
+	wg := &sync.WaitGroup{}
+	lanes := newSignerLanes(s.storage.OrderedLists())
+	waiting := make(map[common.Chain]int)
+	for _, lane := range lanes {
+		lane.tss = s.isTssKeysign(lane.vault)
+		if !s.lanes.acquire(lane, s.cfg.Concurrency, s.cfg.ChainConcurrency) {
+			if !s.lanes.isActive(lane.key) {
+				waiting[lane.chain]++
+			}
+			continue
+		}
+		wg.Add(1)
+		go func(lane signerLane) {
+			defer wg.Done()
+			defer s.lanes.release(lane)
+			s.processLane(lane.items)
+		}(lane)
+	}
+	s.reportLanes(lanes, waiting)
+	return wg
+}
+
+// reportLanes exports the backlog of every lane, and drops the metrics of the
+// lanes that have been emptied since the last report
+func (s *Signer) reportLanes(lanes []signerLane, waiting map[common.Chain]int) {
+	if s.m == nil {
+		return
+	}
+	pending := s.m.GetGaugeVec(metrics.SignerLanePending)
+	active := s.m.GetGaugeVec(metrics.SignerLaneActive)
+	oldest := s.m.GetGaugeVec(metrics.SignerLaneOldestHeight)
+	waitingLanes := s.m.GetGaugeVec(metrics.SignerLanesWaiting)
+	if pending == nil || active == nil || oldest == nil || waitingLanes == nil {
+		return
+	}
+
+	s.lanes.lock.Lock()
+	defer s.lanes.lock.Unlock()
+	current := make(map[string]signerLane, len(lanes))
+	for _, lane := range lanes {
+		current[lane.key] = lane
+		chain, vault := lane.chain.String(), lane.vault.String()
+		pending.WithLabelValues(chain, vault).Set(float64(len(lane.items)))
+		oldest.WithLabelValues(chain, vault).Set(float64(lane.oldestHeight()))
+		if _, ok := s.lanes.active[lane.key]; ok {
+			active.WithLabelValues(chain, vault).Set(1)
+		} else {
+			active.WithLabelValues(chain, vault).Set(0)
+		}
+	}
+	for key, lane := range s.lanes.reported {
+		if _, ok := current[key]; ok {
+			continue
+		}
+		chain, vault := lane.chain.String(), lane.vault.String()
+		pending.DeleteLabelValues(chain, vault)
+		oldest.DeleteLabelValues(chain, vault)
+		active.DeleteLabelValues(chain, vault)
+		if _, ok := waiting[lane.chain]; !ok {
+			waitingLanes.DeleteLabelValues(chain)
+		}
+	}
+	for chain, count := range waiting {
+		waitingLanes.WithLabelValues(chain.String()).Set(float64(count))
+	}
+	for _, lane := range lanes {
+		if _, ok := waiting[lane.chain]; !ok {
+			waitingLanes.WithLabelValues(lane.chain.String()).Set(0)
+		}
+	}
+	s.lanes.reported = current
+}
@@ -0,0 +1,97 @@
+package signer
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/mayachain/mayanode/bifrost/mayaclient/types"
+	"gitlab.com/mayachain/mayanode/common"
+)
+
+type LanesSuite struct{}
+
+var _ = Suite(&LanesSuite{})
+
+func (s *LanesSuite) TestNewSignerLanes(c *C) {
+	item := func(chain common.Chain, height int64) TxOutStoreItem {
+		return NewTxOutStoreItem(height, types.TxOutItem{Chain: chain, Memo: "foo"}, 0)
+	}
+	lanes := newSignerLanes(map[string][]TxOutStoreItem{
+		"ETH-b": {item(common.ETHChain, 20), item(common.ETHChain, 30)},
+		"BTC-a": {item(common.BTCChain, 10)},
+		"ETH-a": {item(common.ETHChain, 20)},
+		"DASH-": {},
+	})
+	c.Assert(lanes, HasLen, 3)
+	c.Check(lanes[0].key, Equals, "BTC-a")
+	c.Check(lanes[0].chain.Equals(common.BTCChain), Equals, true)
+	c.Check(lanes[0].oldestHeight(), Equals, int64(10))
+	// same age, sorted by key
+	c.Check(lanes[1].key, Equals, "ETH-a")
+	c.Check(lanes[2].key, Equals, "ETH-b")
+	c.Check(lanes[2].items, HasLen, 2)
+}
+
+func (s *LanesSuite) TestLaneScheduler(c *C) {
+	btc1 := signerLane{key: "BTC-1", chain: common.BTCChain}
+	btc2 := signerLane{key: "BTC-2", chain: common.BTCChain}
+	eth1 := signerLane{key: "ETH-1", chain: common.ETHChain}
+	eth2 := signerLane{key: "ETH-2", chain: common.ETHChain}
+
+	var ls laneScheduler
+	c.Check(ls.acquire(btc1, 3, 1), Equals, true)
+	c.Check(ls.isActive(btc1.key), Equals, true)
+	// lane already being worked on
+	c.Check(ls.acquire(btc1, 3, 1), Equals, false)
+	// per chain limit reached
+	c.Check(ls.acquire(btc2, 3, 1), Equals, false)
+	c.Check(ls.isActive(btc2.key), Equals, false)
+	// a stuck BTC lane doesn't hold up ETH
+	c.Check(ls.acquire(eth1, 3, 0), Equals, true)
+	c.Check(ls.acquire(eth2, 3, 0), Equals, true)
+	// total limit reached
+	c.Check(ls.acquire(btc2, 3, 0), Equals, false)
+
+	ls.release(eth1)
+	ls.release(eth1) // releasing twice is a noop
+	c.Check(ls.isActive(eth1.key), Equals, false)
+	c.Check(ls.acquire(btc2, 3, 0), Equals, true)
+
+	ls.release(btc1)
+	ls.release(btc2)
+	ls.release(eth2)
+	ls.wait()
+	c.Check(ls.active, HasLen, 0)
+	c.Check(ls.perChain, HasLen, 0)
+
+	// zero limits means no limit
+	var unlimited laneScheduler
+	for _, lane := range []signerLane{btc1, btc2, eth1, eth2} {
+		c.Check(unlimited.acquire(lane, 0, 0), Equals, true)
+	}
+}
+
+func (s *LanesSuite) TestLaneSchedulerTSS(c *C) {
+	btc1 := signerLane{key: "BTC-1", chain: common.BTCChain}
+	btcTSS1 := signerLane{key: "BTC-tss1", chain: common.BTCChain, tss: true}
+	btcTSS2 := signerLane{key: "BTC-tss2", chain: common.BTCChain, tss: true}
+	eth1 := signerLane{key: "ETH-1", chain: common.ETHChain}
+
+	var ls laneScheduler
+	c.Check(ls.acquire(btc1, 1, 1), Equals, true)
+	// TSS lanes are started past the limits
+	c.Check(ls.acquire(btcTSS1, 1, 1), Equals, true)
+	c.Check(ls.acquire(btcTSS2, 1, 1), Equals, true)
+	c.Check(ls.acquire(btcTSS1, 1, 1), Equals, false)
+	c.Check(ls.acquire(eth1, 1, 1), Equals, false)
+
+	// and don't take up room of the other lanes
+	ls.release(btc1)
+	c.Check(ls.acquire(eth1, 1, 1), Equals, true)
+	ls.release(eth1)
+	ls.release(btcTSS1)
+	ls.release(btcTSS2)
+	ls.wait()
+	c.Check(ls.active, HasLen, 0)
+	c.Check(ls.limited, Equals, 0)
+	c.Check(ls.perChain, HasLen, 0)
+}
@@ -1,13 +1,22 @@
 package signer
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	abci "github.com/tendermint/tendermint/abci/types"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	"gitlab.com/mayachain/mayanode/bifrost/blockscanner"
 	btypes "gitlab.com/mayachain/mayanode/bifrost/blockscanner/types"
@@ -15,21 +24,51 @@ import (
 	"gitlab.com/mayachain/mayanode/bifrost/mayaclient/types"
 	"gitlab.com/mayachain/mayanode/bifrost/metrics"
 	"gitlab.com/mayachain/mayanode/bifrost/pubkeymanager"
+	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/config"
+	"gitlab.com/mayachain/mayanode/constants"
 	ttypes "gitlab.com/mayachain/mayanode/x/mayachain/types"
 )
 
+// eventsSubscriber is the name the signer subscribes to mayanode events with
+const eventsSubscriber = "bifrost-signer"
+
+// newBlockTimeout is how long the subscription may go without a new block
+// before it is considered dead and re-established
+var newBlockTimeout = 6 * constants.MayachainBlockTime
+
+// eventsClient is the part of the tendermint rpc client used to follow new blocks
+type eventsClient interface {
+	Start() error
+	Stop() error
+	Subscribe(ctx context.Context, subscriber, query string, outCapacity ...int) (<-chan ctypes.ResultEvent, error)
+}
+
 type ThorchainBlockScan struct {
 	logger         zerolog.Logger
 	wg             *sync.WaitGroup
 	stopChan       chan struct{}
 	txOutChan      chan types.TxOut
 	keygenChan     chan ttypes.KeygenBlock
+	blockChan      chan int64
 	cfg            config.BifrostBlockScannerConfiguration
 	scannerStorage blockscanner.ScannerStorage
 	thorchain      mayaclient.MayachainBridge
 	errCounter     *prometheus.CounterVec
 	pubkeyMgr      pubkeymanager.PubKeyValidator
+	newEventClient func() (eventsClient, error)
+	// subscribedHeight is the last height all the events of were received
+	// from the subscription, it is zero while there is no subscription
+	subscribedHeight int64
+
+	lock sync.Mutex
+	// subscribedFrom is the first height covered by the subscription, the
+	// heights before it are polled
+	subscribedFrom int64
+	// pendingTxOuts are the vaults with outbounds to sign per height
+	pendingTxOuts map[int64]map[string]bool
+	// pendingKeygens are the heights with a keygen
+	pendingKeygens map[int64]bool
 }
 
 // NewThorchainBlockScan create a new instance of thorchain block scanner
@@ -46,14 +85,254 @@ func NewThorchainBlockScan(cfg config.BifrostBlockScannerConfiguration, scanStor
 		stopChan:       make(chan struct{}),
 		txOutChan:      make(chan types.TxOut),
 		keygenChan:     make(chan ttypes.KeygenBlock),
+		blockChan:      make(chan int64, 1),
 		cfg:            cfg,
 		scannerStorage: scanStorage,
 		thorchain:      thorchain,
 		errCounter:     m.GetCounterVec(metrics.MayachainBlockScannerError),
 		pubkeyMgr:      pubkeyMgr,
+		newEventClient: func() (eventsClient, error) {
+			remote := cfg.RPCHost
+			if !strings.Contains(remote, "://") {
+				remote = fmt.Sprintf("tcp://%s", remote)
+			}
+			return rpchttp.New(remote, "/websocket")
+		},
+		pendingTxOuts:  make(map[int64]map[string]bool),
+		pendingKeygens: make(map[int64]bool),
 	}, nil
 }
 
+// Start subscribes to the new blocks and transactions of the tendermint rpc,
+// their scheduled outbound and keygen events tell the block scanner which
+// vaults and keygens to fetch for a block. Without a subscription the block
+// scanner polls every block.
+func (b *ThorchainBlockScan) Start() {
+	if len(b.cfg.RPCHost) == 0 {
+		b.logger.Info().Msg("no rpc host, poll for new blocks")
+		return
+	}
+	b.wg.Add(1)
+	go b.followBlocks()
+}
+
+// Stop the new block subscription
+func (b *ThorchainBlockScan) Stop() {
+	close(b.stopChan)
+	b.wg.Wait()
+}
+
+// BlockNotifications return the channel the heights of new blocks are sent to
+func (b *ThorchainBlockScan) BlockNotifications() <-chan int64 {
+	return b.blockChan
+}
+
+// followBlocks keeps the subscription alive, the block scanner falls back to
+// polling while it is down and catches up on the missed blocks from its scan
+// position once it is back
+func (b *ThorchainBlockScan) followBlocks() {
+	defer b.wg.Done()
+	for {
+		if err := b.subscribe(); err != nil {
+			b.errCounter.WithLabelValues("fail_subscribe_new_block", "").Inc()
+			b.logger.Error().Err(err).Msg("event subscription failed, fall back to polling")
+		}
+		b.unsubscribed()
+		select {
+		case <-b.stopChan:
+			return
+		case <-time.After(b.cfg.BlockHeightDiscoverBackoff):
+		}
+	}
+}
+
+func (b *ThorchainBlockScan) subscribe() error {
+	client, err := b.newEventClient()
+	if err != nil {
+		return fmt.Errorf("fail to create rpc client: %w", err)
+	}
+	if err := client.Start(); err != nil {
+		return fmt.Errorf("fail to start rpc client: %w", err)
+	}
+	defer func() {
+		if err := client.Stop(); err != nil {
+			b.logger.Error().Err(err).Msg("fail to stop rpc client")
+		}
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	txEvents, err := client.Subscribe(ctx, eventsSubscriber, tmtypes.EventQueryTx.String())
+	if err != nil {
+		return fmt.Errorf("fail to subscribe to txs: %w", err)
+	}
+	blockEvents, err := client.Subscribe(ctx, eventsSubscriber, tmtypes.EventQueryNewBlock.String())
+	if err != nil {
+		return fmt.Errorf("fail to subscribe to new blocks: %w", err)
+	}
+	// the outbounds delayed before the subscription don't have events anymore,
+	// the blocks up to the current one are polled
+	if err := b.loadScheduledOutbounds(); err != nil {
+		return err
+	}
+	height, err := b.thorchain.GetBlockHeight()
+	if err != nil {
+		return fmt.Errorf("fail to get block height: %w", err)
+	}
+	b.lock.Lock()
+	b.subscribedFrom = height + 1
+	b.lock.Unlock()
+	b.logger.Info().Int64("height", height+1).Msg("subscribed to mayanode events")
+
+	timer := time.NewTimer(newBlockTimeout)
+	defer timer.Stop()
+	for {
+		select {
+		case <-b.stopChan:
+			return nil
+		case <-timer.C:
+			return fmt.Errorf("no new block in %s", newBlockTimeout)
+		case event, ok := <-txEvents:
+			if !ok {
+				return errors.New("tx subscription closed")
+			}
+			if data, ok := event.Data.(tmtypes.EventDataTx); ok {
+				b.recordEvents(data.Height, data.Result.Events)
+			}
+		case event, ok := <-blockEvents:
+			if !ok {
+				return errors.New("new block subscription closed")
+			}
+			data, ok := event.Data.(tmtypes.EventDataNewBlock)
+			if !ok || data.Block == nil {
+				continue
+			}
+			if !timer.Stop() {
+				<-timer.C
+			}
+			timer.Reset(newBlockTimeout)
+			height := data.Block.Height
+			b.recordEvents(height, data.ResultBeginBlock.Events)
+			b.recordEvents(height, data.ResultEndBlock.Events)
+			// the tx events of a block are published after the block itself,
+			// so a block is complete once the next one is received
+			if height-1 < b.getSubscribedFrom() {
+				continue
+			}
+			atomic.StoreInt64(&b.subscribedHeight, height-1)
+			// the block scanner only needs to know there is a new block, it
+			// scans every height from its own position
+			select {
+			case b.blockChan <- height - 1:
+			default:
+			}
+		}
+	}
+}
+
+// unsubscribed resets the subscription state, the blocks are polled again
+func (b *ThorchainBlockScan) unsubscribed() {
+	atomic.StoreInt64(&b.subscribedHeight, 0)
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.subscribedFrom = 0
+}
+
+func (b *ThorchainBlockScan) getSubscribedFrom() int64 {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.subscribedFrom
+}
+
+// isSubscribed returns true when the events of the given height were
+// received from the subscription
+func (b *ThorchainBlockScan) isSubscribed(height int64) bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.subscribedFrom > 0 && height >= b.subscribedFrom && height <= atomic.LoadInt64(&b.subscribedHeight)
+}
+
+// loadScheduledOutbounds records the vaults of the outbounds already
+// scheduled for the coming blocks
+func (b *ThorchainBlockScan) loadScheduledOutbounds() error {
+	items, err := b.thorchain.GetScheduledOutbounds()
+	if err != nil {
+		return fmt.Errorf("fail to get scheduled outbounds: %w", err)
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	for _, item := range items {
+		b.addPendingTxOut(item.Height, item.VaultPubKey.String())
+	}
+	return nil
+}
+
+// recordEvents records the vaults of the scheduled outbounds and the keygens
+// found in the events of the given height
+func (b *ThorchainBlockScan) recordEvents(height int64, events []abci.Event) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	for _, evt := range events {
+		switch evt.Type {
+		case ttypes.ScheduledOutboundEventType:
+			outboundHeight := height
+			var vault string
+			for _, attr := range evt.Attributes {
+				switch string(attr.Key) {
+				case "vault_pub_key":
+					vault = string(attr.Value)
+				case "height":
+					if h, err := strconv.ParseInt(string(attr.Value), 10, 64); err == nil {
+						outboundHeight = h
+					}
+				}
+			}
+			b.addPendingTxOut(outboundHeight, vault)
+		case ttypes.KeygenEventType:
+			b.pendingKeygens[height] = true
+		}
+	}
+}
+
+func (b *ThorchainBlockScan) addPendingTxOut(height int64, vault string) {
+	if len(vault) == 0 {
+		return
+	}
+	if _, ok := b.pendingTxOuts[height]; !ok {
+		b.pendingTxOuts[height] = make(map[string]bool)
+	}
+	b.pendingTxOuts[height][vault] = true
+}
+
+// getPending returns the vaults with outbounds and whether there is a keygen
+// at the given height
+func (b *ThorchainBlockScan) getPending(height int64) (map[string]bool, bool) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	vaults := make(map[string]bool, len(b.pendingTxOuts[height]))
+	for vault := range b.pendingTxOuts[height] {
+		vaults[vault] = true
+	}
+	return vaults, b.pendingKeygens[height]
+}
+
+// clearPending forgets the outbounds and keygens up to the given height, once
+// it is scanned
+func (b *ThorchainBlockScan) clearPending(height int64) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	for h := range b.pendingTxOuts {
+		if h <= height {
+			delete(b.pendingTxOuts, h)
+		}
+	}
+	for h := range b.pendingKeygens {
+		if h <= height {
+			delete(b.pendingKeygens, h)
+		}
+	}
+}
+
 // GetMessages return the channel
 func (b *ThorchainBlockScan) GetTxOutMessages() <-chan types.TxOut {
 	return b.txOutChan
@@ -63,7 +342,12 @@ func (b *ThorchainBlockScan) GetKeygenMessages() <-chan ttypes.KeygenBlock {
 	return b.keygenChan
 }
 
+// GetHeight return the height of the last block, while subscribed it is the
+// last block all the events of were received, otherwise mayanode is queried
 func (b *ThorchainBlockScan) GetHeight() (int64, error) {
+	if height := atomic.LoadInt64(&b.subscribedHeight); height > 0 {
+		return height, nil
+	}
 	return b.thorchain.GetBlockHeight()
 }
 
@@ -71,13 +355,32 @@ func (c *ThorchainBlockScan) FetchMemPool(height int64) (types.TxIn, error) {
 	return types.TxIn{}, nil
 }
 
+// FetchTxs sends the outbounds and keygens of the given height to the signer.
+// The heights covered by the subscription only query the vaults and keygens
+// their events mention, the other heights query every vault.
 func (b *ThorchainBlockScan) FetchTxs(height int64) (types.TxIn, error) {
-	if err := b.processTxOutBlock(height); err != nil {
-		return types.TxIn{}, err
+	pubKeys := b.pubkeyMgr.GetSignPubKeys()
+	keygen := true
+	if b.isSubscribed(height) {
+		vaults, hasKeygen := b.getPending(height)
+		keygen = hasKeygen
+		var pending common.PubKeys
+		for _, pk := range pubKeys {
+			if vaults[pk.String()] {
+				pending = append(pending, pk)
+			}
+		}
+		pubKeys = pending
 	}
-	if err := b.processKeygenBlock(height); err != nil {
+	if err := b.processTxOutBlock(height, pubKeys); err != nil {
 		return types.TxIn{}, err
 	}
+	if keygen {
+		if err := b.processKeygenBlock(height); err != nil {
+			return types.TxIn{}, err
+		}
+	}
+	b.clearPending(height)
 	return types.TxIn{}, nil
 }
 
@@ -100,8 +403,8 @@ func (b *ThorchainBlockScan) processKeygenBlock(blockHeight int64) error {
 	return nil
 }
 
-func (b *ThorchainBlockScan) processTxOutBlock(blockHeight int64) error {
-	for _, pk := range b.pubkeyMgr.GetSignPubKeys() {
+func (b *ThorchainBlockScan) processTxOutBlock(blockHeight int64, pubKeys common.PubKeys) error {
+	for _, pk := range pubKeys {
 		if len(pk.String()) == 0 {
 			continue
 		}
@@ -1,12 +1,14 @@
 package signer
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -14,12 +16,16 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	cKeys "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	abci "github.com/tendermint/tendermint/abci/types"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 	ctypes "gitlab.com/thorchain/binance-sdk/common/types"
 
 	"gitlab.com/mayachain/mayanode/bifrost/mayaclient"
 	"gitlab.com/mayachain/mayanode/bifrost/metrics"
 	"gitlab.com/mayachain/mayanode/bifrost/pubkeymanager"
 	"gitlab.com/mayachain/mayanode/cmd"
+	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/config"
 	"gitlab.com/mayachain/mayanode/x/mayachain"
 	types2 "gitlab.com/mayachain/mayanode/x/mayachain/types"
@@ -46,6 +52,7 @@ func (s *ThorchainBlockScanSuite) SetUpSuite(c *C) {
 	types2.SetupConfigForTest()
 	ctypes.Network = ctypes.TestNetwork
 	c.Assert(os.Setenv("NET", "testnet"), IsNil)
+	signPubKey = types2.GetRandomPubKey()
 
 	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		c.Logf("requestUri:%s", req.RequestURI)
@@ -56,7 +63,7 @@ func (s *ThorchainBlockScanSuite) SetUpSuite(c *C) {
 			_, err := rw.Write([]byte(`{ "jsonrpc": "2.0", "id": "", "result": { "chain": "BNB", "lastobservedin": "1", "lastsignedout": "1", "thorchain": "1" } }`))
 			c.Assert(err, IsNil)
 		} else if strings.HasPrefix(req.RequestURI, "/mayachain/lastblock") {
-			_, err := rw.Write([]byte(`{ "jsonrpc": "2.0", "id": "", "result": { "chain": "ThorChain", "lastobservedin": "1", "lastsignedout": "1", "thorchain": "1" } }`))
+			_, err := rw.Write([]byte(`[{ "chain": "BTC", "last_observed_in": 1, "last_signed_out": 1, "mayachain": 1 }]`))
 			c.Assert(err, IsNil)
 		} else if strings.HasPrefix(req.RequestURI, "/auth/accounts/") {
 			_, err := rw.Write([]byte(`{ "jsonrpc": "2.0", "id": "", "result": { "height": "1", "result": { "value": { "account_number": "0", "sequence": "0" } } } |`))
@@ -105,6 +112,16 @@ func (s *ThorchainBlockScanSuite) SetUpSuite(c *C) {
 			]
 		}]}`))
 			c.Assert(err, IsNil)
+		} else if strings.HasPrefix(req.RequestURI, "/mayachain/queue/scheduled") {
+			_, err := rw.Write([]byte(`[{
+				"chain": "BNB",
+				"to_address": "tbnb145wcuncewfkuc4v6an0r9laswejygcul43c3wu",
+				"vault_pub_key": "` + signPubKey.String() + `",
+				"coin": { "asset": "BNB.BNB", "amount": "10000000000" },
+				"max_gas": [],
+				"height": 5
+			}]`))
+			c.Assert(err, IsNil)
 		} else if strings.HasSuffix(req.RequestURI, "/signers") {
 			_, err := rw.Write([]byte(`[
 				"thorpub1addwnpepqfgfxharps79pqv8fv9ndqh90smw8c3slrtrssn58ryc5g3p9sx856x07yn"
@@ -169,3 +186,132 @@ func (s *ThorchainBlockScanSuite) TestProcess(c *C) {
 	c.Assert(blockScan, NotNil)
 	c.Assert(err, IsNil)
 }
+
+type fakeEventsClient struct {
+	txEvents    chan coretypes.ResultEvent
+	blockEvents chan coretypes.ResultEvent
+	queries     []string
+}
+
+func (f *fakeEventsClient) Start() error { return nil }
+func (f *fakeEventsClient) Stop() error  { return nil }
+
+func (f *fakeEventsClient) Subscribe(_ context.Context, _, query string, _ ...int) (<-chan coretypes.ResultEvent, error) {
+	f.queries = append(f.queries, query)
+	if query == tmtypes.EventQueryTx.String() {
+		return f.txEvents, nil
+	}
+	return f.blockEvents, nil
+}
+
+// signPubKeyValidator signs with a vault pubkey of the test network
+type signPubKeyValidator struct {
+	*pubkeymanager.MockPoolAddressValidator
+}
+
+// signPubKey is set up along with the suite, once the bech32 prefixes are
+var signPubKey common.PubKey
+
+func (signPubKeyValidator) GetSignPubKeys() common.PubKeys { return common.PubKeys{signPubKey} }
+
+func sendEvent(c *C, events chan coretypes.ResultEvent, event coretypes.ResultEvent) {
+	select {
+	case events <- event:
+	case <-time.After(time.Second):
+		c.Fatal("event not received")
+	}
+}
+
+func newBlockEvent(height int64, endBlockEvents ...abci.Event) coretypes.ResultEvent {
+	return coretypes.ResultEvent{
+		Data: tmtypes.EventDataNewBlock{
+			Block:          &tmtypes.Block{Header: tmtypes.Header{Height: height}},
+			ResultEndBlock: abci.ResponseEndBlock{Events: endBlockEvents},
+		},
+	}
+}
+
+func newEvent(eventType string, attrs map[string]string) abci.Event {
+	evt := abci.Event{Type: eventType}
+	for key, value := range attrs {
+		evt.Attributes = append(evt.Attributes, abci.EventAttribute{Key: []byte(key), Value: []byte(value)})
+	}
+	return evt
+}
+
+func (s *ThorchainBlockScanSuite) TestFollowBlocks(c *C) {
+	cfg := config.BifrostBlockScannerConfiguration{
+		RPCHost:                    "127.0.0.1:" + s.rpcHost,
+		ChainID:                    "MAYA",
+		BlockHeightDiscoverBackoff: time.Second,
+	}
+	blockScan, err := NewThorchainBlockScan(cfg, s.storage, s.bridge, s.m, signPubKeyValidator{pubkeymanager.NewMockPoolAddressValidator()})
+	c.Assert(err, IsNil)
+	client := &fakeEventsClient{
+		txEvents:    make(chan coretypes.ResultEvent),
+		blockEvents: make(chan coretypes.ResultEvent),
+	}
+	blockScan.newEventClient = func() (eventsClient, error) {
+		return client, nil
+	}
+
+	// without a subscription the height comes from mayanode
+	height, err := blockScan.GetHeight()
+	c.Assert(err, IsNil)
+	c.Assert(height, Equals, int64(1))
+	c.Assert(blockScan.isSubscribed(1), Equals, false)
+
+	blockScan.Start()
+	// an outbound scheduled on one of our vaults in a tx of block 2
+	sendEvent(c, client.txEvents, coretypes.ResultEvent{
+		Data: tmtypes.EventDataTx{TxResult: abci.TxResult{
+			Height: 2,
+			Result: abci.ResponseDeliverTx{Events: []abci.Event{
+				newEvent(types2.ScheduledOutboundEventType, map[string]string{"vault_pub_key": signPubKey.String()}),
+			}},
+		}},
+	})
+	sendEvent(c, client.blockEvents, newBlockEvent(2))
+	// a keygen in the end block of block 3 completes block 2
+	sendEvent(c, client.blockEvents, newBlockEvent(3, newEvent(types2.KeygenEventType, map[string]string{"height": "3"})))
+	select {
+	case height := <-blockScan.BlockNotifications():
+		c.Assert(height, Equals, int64(2))
+	case <-time.After(time.Second):
+		c.Fatal("no new block notification")
+	}
+	c.Assert(client.queries, DeepEquals, []string{tmtypes.EventQueryTx.String(), tmtypes.EventQueryNewBlock.String()})
+	height, err = blockScan.GetHeight()
+	c.Assert(err, IsNil)
+	c.Assert(height, Equals, int64(2))
+	c.Assert(blockScan.isSubscribed(1), Equals, false)
+	c.Assert(blockScan.isSubscribed(2), Equals, true)
+	c.Assert(blockScan.isSubscribed(3), Equals, false)
+
+	vaults, keygen := blockScan.getPending(2)
+	c.Assert(vaults, DeepEquals, map[string]bool{signPubKey.String(): true})
+	c.Assert(keygen, Equals, false)
+	_, keygen = blockScan.getPending(3)
+	c.Assert(keygen, Equals, true)
+	// the delayed outbounds come from the scheduled queue
+	vaults, _ = blockScan.getPending(5)
+	c.Assert(vaults, DeepEquals, map[string]bool{signPubKey.String(): true})
+
+	_, err = blockScan.FetchTxs(2)
+	c.Assert(err, IsNil)
+	vaults, _ = blockScan.getPending(2)
+	c.Assert(vaults, HasLen, 0)
+	vaults, _ = blockScan.getPending(5)
+	c.Assert(vaults, HasLen, 1)
+
+	// once the subscription drops the block scanner polls mayanode again
+	close(client.blockEvents)
+	for i := 0; i < 100 && atomic.LoadInt64(&blockScan.subscribedHeight) > 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	height, err = blockScan.GetHeight()
+	c.Assert(err, IsNil)
+	c.Assert(height, Equals, int64(1))
+	c.Assert(blockScan.isSubscribed(2), Equals, false)
+	blockScan.Stop()
+}
@@ -8,6 +8,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/blang/semver"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
@@ -45,6 +46,7 @@ type Signer struct {
 	constantsProvider     *ConstantsProvider
 	localPubKey           common.PubKey
 	tssKeysignMetricMgr   *metrics.TssKeysignMetricMgr
+	lanes                 laneScheduler
 }
 
 // NewSigner create a new instance of signer
@@ -144,6 +146,7 @@ func (s *Signer) Start() error {
 	s.wg.Add(1)
 	go s.signTransactions()
 
+	s.thorchainBlockScanner.Start()
 	s.blockScanner.Start(nil)
 	return nil
 }
@@ -161,6 +164,7 @@ func (s *Signer) signTransactions() {
 	for {
 		select {
 		case <-s.stopChan:
+			s.lanes.wait()
 			return
 		default:
 			// When BASEChain is catching up , bifrost might get stale data from thornode , thus it shall pause signing
@@ -171,7 +175,9 @@ func (s *Signer) signTransactions() {
 				break // this will break select
 			}
 			if !catchingUp {
-				s.processTransactions()
+				// lanes still busy from a previous round are skipped, so a
+				// stuck chain or vault doesn't hold up the others
+				s.startLanes()
 			}
 			time.Sleep(1 * time.Second)
 		}
@@ -194,79 +200,76 @@ func runWithContext(ctx context.Context, fn func() ([]byte, error)) ([]byte, err
 	}
 }
 
+// processTransactions signs all the pending lanes and waits for them to finish
 func (s *Signer) processTransactions() {
-	wg := &sync.WaitGroup{}
-	for _, items := range s.storage.OrderedLists() {
-		wg.Add(1)
-
-		go func(items []TxOutStoreItem) {
-			defer wg.Done()
-
-			// if any tx out items are in broadcast or round 7 failure retry, only proceed with those
-			retryItems := []TxOutStoreItem{}
-			for _, item := range items {
-				if item.Round7Retry || len(item.SignedTx) > 0 {
-					retryItems = append(retryItems, item)
-				}
-			}
-			if len(retryItems) > 0 {
-				s.logger.Info().Msgf("found %d retry items", len(retryItems))
-				items = retryItems
-			}
-			if len(retryItems) > 1 {
-				s.logger.Error().Msgf("found %d retry items, there should only be one", len(retryItems))
-			}
+	s.startLanes().Wait()
+}
 
-			for i, item := range items {
-				select {
-				case <-s.stopChan:
-					return
-				default:
-					if item.Status == TxSpent { // don't rebroadcast spent transactions
-						continue
-					}
+// processLane signs and broadcasts the items of a single chain / vault lane in
+// order, it stops at the first failure so the lane is restarted from its head
+func (s *Signer) processLane(items []TxOutStoreItem) {
+	// if any tx out items are in broadcast or round 7 failure retry, only proceed with those
+	retryItems := []TxOutStoreItem{}
+	for _, item := range items {
+		if item.Round7Retry || len(item.SignedTx) > 0 {
+			retryItems = append(retryItems, item)
+		}
+	}
+	if len(retryItems) > 0 {
+		s.logger.Info().Msgf("found %d retry items", len(retryItems))
+		items = retryItems
+	}
+	if len(retryItems) > 1 {
+		s.logger.Error().Msgf("found %d retry items, there should only be one", len(retryItems))
+	}
 
-					s.logger.Info().Int("num", i).Int64("height", item.Height).Int("status", int(item.Status)).Interface("tx", item.TxOutItem).Msgf("Signing transaction")
-					// a single keysign should not take longer than 5 minutes , regardless TSS or local
-					ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
-					if checkpoint, err := runWithContext(ctx, func() ([]byte, error) {
-						return s.signAndBroadcast(item)
-					}); err != nil {
-						// mark the txout on round 7 failure to block other txs for the chain / pubkey
-						ksErr := tss.KeysignError{}
-						if errors.As(err, &ksErr) && ksErr.IsRound7() {
-							s.logger.Error().Err(err).Interface("tx", item.TxOutItem).Msg("round 7 signing error")
-							item.Round7Retry = true
-							item.Checkpoint = checkpoint
-							if err := s.storage.Set(item); err != nil {
-								s.logger.Error().Err(err).Msg("fail to update tx out store item with round 7 retry")
-							}
-						}
-
-						if errors.Is(err, context.DeadlineExceeded) {
-							panic(fmt.Errorf("tx out item: %+v , keysign timeout : %w", item.TxOutItem, err))
-						}
-						s.logger.Error().Err(err).Msg("fail to sign and broadcast tx out store item")
-						cancel()
-						return
-						// The 'item' for loop should not be items[0],
-						// because problems which return 'nil, nil' should be skipped over instead of blocking others.
-						// When signAndBroadcast returns an error (such as from a keysign timeout),
-						// a 'return' and not a 'continue' should be used so that nodes can all restart the list,
-						// for when the keysign failure was from a loss of list synchrony.
-						// Otherwise, out-of-sync lists would cycle one timeout at a time, maybe never resynchronising.
-					}
-					cancel()
+	for i, item := range items {
+		select {
+		case <-s.stopChan:
+			return
+		default:
+			if item.Status == TxSpent { // don't rebroadcast spent transactions
+				continue
+			}
 
-					// We have a successful broadcast! Remove the item from our store
-					if err := s.storage.Remove(item); err != nil {
-						s.logger.Error().Err(err).Msg("fail to update tx out store item")
+			s.logger.Info().Int("num", i).Int64("height", item.Height).Int("status", int(item.Status)).Interface("tx", item.TxOutItem).Msgf("Signing transaction")
+			// a single keysign should not take longer than 5 minutes , regardless TSS or local
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+			if checkpoint, err := runWithContext(ctx, func() ([]byte, error) {
+				return s.signAndBroadcast(item)
+			}); err != nil {
+				// mark the txout on round 7 failure to block other txs for the chain / pubkey
+				ksErr := tss.KeysignError{}
+				if errors.As(err, &ksErr) && ksErr.IsRound7() {
+					s.logger.Error().Err(err).Interface("tx", item.TxOutItem).Msg("round 7 signing error")
+					item.Round7Retry = true
+					item.Checkpoint = checkpoint
+					if err := s.storage.Set(item); err != nil {
+						s.logger.Error().Err(err).Msg("fail to update tx out store item with round 7 retry")
 					}
 				}
+
+				if errors.Is(err, context.DeadlineExceeded) {
+					panic(fmt.Errorf("tx out item: %+v , keysign timeout : %w", item.TxOutItem, err))
+				}
+				s.logger.Error().Err(err).Msg("fail to sign and broadcast tx out store item")
+				cancel()
+				return
+				// The 'item' for loop should not be items[0],
+				// because problems which return 'nil, nil' should be skipped over instead of blocking others.
+				// When signAndBroadcast returns an error (such as from a keysign timeout),
+				// a 'return' and not a 'continue' should be used so that nodes can all restart the list,
+				// for when the keysign failure was from a loss of list synchrony.
+				// Otherwise, out-of-sync lists would cycle one timeout at a time, maybe never resynchronising.
+			}
+			cancel()
+
+			// We have a successful broadcast! Remove the item from our store
+			if err := s.storage.Remove(item); err != nil {
+				s.logger.Error().Err(err).Msg("fail to update tx out store item")
 			}
-		}(items)
+		}
 	}
-	wg.Wait()
 }
 
 // processTxnOut processes outbound TxOuts and save them to storage
@@ -431,6 +434,21 @@ func (s *Signer) signAndBroadcast(item TxOutStoreItem) ([]byte, error) {
 		return nil, nil
 	}
 
+	// in asgard only mode the yggdrasil vault is retired, it only returns its
+	// funds and leaves any other outbound to be rescheduled on asgard
+	if tx.VaultPubKey.Equals(s.localPubKey) && !tx.Coins.IsEmpty() && s.isAsgardOnlyEnforced() {
+		mimirKey = "ASGARDONLY"
+		asgardOnly, err := s.mayachainBridge.GetMimir(mimirKey)
+		if err != nil {
+			s.logger.Err(err).Msgf("fail to get %s", mimirKey)
+			return nil, err
+		}
+		if asgardOnly > 0 && asgardOnly <= blockHeight {
+			s.logger.Info().Msg("yggdrasil vault is retired, ignore")
+			return nil, nil
+		}
+	}
+
 	if len(tx.ToAddress) == 0 {
 		s.logger.Info().Msg("To address is empty, THORNode don't know where to send the fund , ignore")
 		return nil, nil // return nil and discard item
@@ -558,6 +576,17 @@ func (s *Signer) handleYggReturn(height int64, tx types.TxOutItem) (types.TxOutI
 	return tx, nil
 }
 
+// isAsgardOnlyEnforced returns true once MAYAChain reschedules the outbounds
+// of the retired yggdrasil vaults, before that they are still signed
+func (s *Signer) isAsgardOnlyEnforced() bool {
+	version, err := s.mayachainBridge.GetMayachainVersion()
+	if err != nil {
+		s.logger.Err(err).Msg("fail to get MAYAChain version")
+		return false
+	}
+	return version.GTE(semver.MustParse("1.106.0"))
+}
+
 func (s *Signer) isTssKeysign(pubKey common.PubKey) bool {
 	return !s.localPubKey.Equals(pubKey)
 }
@@ -572,5 +601,6 @@ func (s *Signer) Stop() error {
 		s.logger.Error().Err(err).Msg("fail to stop metric server")
 	}
 	s.blockScanner.Stop()
+	s.thorchainBlockScanner.Stop()
 	return s.storage.Close()
 }
@@ -63,6 +63,10 @@ func (b fakeBridge) GetThorchainVersion() (semver.Version, error) {
 	return semver.MustParse("1.0.0"), nil
 }
 
+func (b fakeBridge) GetMayachainVersion() (semver.Version, error) {
+	return semver.MustParse("1.0.0"), nil
+}
+
 func (b fakeBridge) GetConstants() (map[string]int64, error) {
 	return map[string]int64{
 		constants.SigningTransactionPeriod.String(): 300,
@@ -70,7 +74,7 @@ func (b fakeBridge) GetConstants() (map[string]int64, error) {
 }
 
 func (b fakeBridge) GetMimir(key string) (int64, error) {
-	if strings.HasPrefix(key, "HALT") {
+	if strings.HasPrefix(key, "HALT") || key == "ASGARDONLY" {
 		return 0, nil
 	}
 	panic("not implemented")
@@ -404,6 +408,26 @@ func (s *SignSuite) TearDownSuite(c *C) {
 	}
 }
 
+type versionBridge struct {
+	mayaclient.MayachainBridge
+	version semver.Version
+	err     error
+}
+
+func (b versionBridge) GetMayachainVersion() (semver.Version, error) {
+	return b.version, b.err
+}
+
+func (s *SignSuite) TestIsAsgardOnlyEnforced(c *C) {
+	sign := &Signer{logger: log.With().Logger()}
+	sign.mayachainBridge = versionBridge{version: semver.MustParse("1.105.0")}
+	c.Check(sign.isAsgardOnlyEnforced(), Equals, false)
+	sign.mayachainBridge = versionBridge{version: semver.MustParse("1.106.0")}
+	c.Check(sign.isAsgardOnlyEnforced(), Equals, true)
+	sign.mayachainBridge = versionBridge{err: fmt.Errorf("fail to get version")}
+	c.Check(sign.isAsgardOnlyEnforced(), Equals, false)
+}
+
 func (s *SignSuite) TestHandleYggReturn_Success_FeeSingleton(c *C) {
 	sign := &Signer{
 		chains: map[common.Chain]chainclients.ChainClient{
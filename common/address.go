@@ -17,6 +17,7 @@ import (
 	ltcchaincfg "github.com/ltcsuite/ltcd/chaincfg"
 	"github.com/ltcsuite/ltcutil"
 	dashutil "gitlab.com/mayachain/dashd-go/btcutil"
+	bech32m "gitlab.com/mayachain/dashd-go/btcutil/bech32"
 	dashchaincfg "gitlab.com/mayachain/dashd-go/chaincfg"
 
 	"gitlab.com/mayachain/mayanode/common/cosmos"
@@ -133,6 +134,51 @@ func NewAddress(address string) (Address, error) {
 	return NoAddress, fmt.Errorf("address format not supported: %s", address)
 }
 
+// NewAddressV106 create a new Address, on top of the formats supported by
+// NewAddress it accepts BTC taproot (P2TR) addresses of the current network
+func NewAddressV106(address string) (Address, error) {
+	addr, err := NewAddress(address)
+	if err == nil {
+		return addr, nil
+	}
+	if isTaprootAddress(address) {
+		// bech32m accepts an all uppercase address, the signer only pays to
+		// the lowercase form, which is the one kept
+		return Address(strings.ToLower(address)), nil
+	}
+	return NoAddress, err
+}
+
+// isTaprootAddress returns true when the given address is a BTC taproot
+// address using the bech32 prefix of the current network
+func isTaprootAddress(address string) bool {
+	hrp, _, err := DecodeTaprootAddress(address)
+	return err == nil && hrp == BTCChain.AddressPrefix(GetCurrentChainNetwork())
+}
+
+// DecodeTaprootAddress decodes a bech32m encoded witness v1 (P2TR) address, and
+// returns its human readable part along with the 32 bytes witness program
+func DecodeTaprootAddress(address string) (string, []byte, error) {
+	hrp, data, version, err := bech32m.DecodeGeneric(address)
+	if err != nil {
+		return "", nil, err
+	}
+	if version != bech32m.VersionM {
+		return "", nil, fmt.Errorf("invalid checksum, expected bech32m encoding")
+	}
+	if len(data) < 1 || data[0] != 1 {
+		return "", nil, fmt.Errorf("invalid witness version, expected witness v1")
+	}
+	program, err := bech32m.ConvertBits(data[1:], 5, 8, false)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(program) != 32 {
+		return "", nil, fmt.Errorf("invalid witness program length: %d", len(program))
+	}
+	return strings.ToLower(hrp), program, nil
+}
+
 // IsValidBCHAddress determinate whether the address is a valid new BCH address format
 func (addr Address) IsValidBCHAddress() bool {
 	// Check mainnet other formats
@@ -251,109 +297,11 @@ func (addr Address) IsChain(chain Chain) bool {
 	if chain.IsEVM() {
 		return strings.HasPrefix(addr.String(), "0x")
 	}
-	switch chain {
-	case BNBChain:
-		prefix, _, _ := bech32.Decode(addr.String())
-		return prefix == "bnb" || prefix == "tbnb"
-	case AZTECChain:
-		prefix, _, _ := bech32.Decode(addr.String())
-		return prefix == "aztec" || prefix == "taztec" || prefix == "saztec"
-	case BASEChain:
-		prefix, _, _ := bech32.Decode(addr.String())
-		return prefix == "maya" || prefix == "tmaya" || prefix == "smaya"
-	case GAIAChain:
-		// Note: Gaia does not use a special prefix for testnet
-		prefix, _, _ := bech32.Decode(addr.String())
-		return prefix == "cosmos"
-	case THORChain:
-		prefix, _, _ := bech32.Decode(addr.String())
-		return prefix == "thor" || prefix == "tthor"
-	case BTCChain:
-		prefix, _, err := bech32.Decode(addr.String())
-		if err == nil && (prefix == "bc" || prefix == "tb") {
-			return true
-		}
-		// Check mainnet other formats
-		_, err = btcutil.DecodeAddress(addr.String(), &chaincfg.MainNetParams)
-		if err == nil {
-			return true
-		}
-		// Check testnet other formats
-		_, err = btcutil.DecodeAddress(addr.String(), &chaincfg.TestNet3Params)
-		if err == nil {
-			return true
-		}
-		return false
-	case LTCChain:
-		prefix, _, err := bech32.Decode(addr.String())
-		if err == nil && (prefix == "ltc" || prefix == "tltc" || prefix == "rltc") {
-			return true
-		}
-		// Check mainnet other formats
-		_, err = ltcutil.DecodeAddress(addr.String(), &ltcchaincfg.MainNetParams)
-		if err == nil {
-			return true
-		}
-		// Check testnet other formats
-		_, err = ltcutil.DecodeAddress(addr.String(), &ltcchaincfg.TestNet4Params)
-		if err == nil {
-			return true
-		}
-		return false
-	case BCHChain:
-		// Check mainnet other formats
-		_, err := bchutil.DecodeAddress(addr.String(), &bchchaincfg.MainNetParams)
-		if err == nil {
-			return true
-		}
-		// Check testnet other formats
-		_, err = bchutil.DecodeAddress(addr.String(), &bchchaincfg.TestNet3Params)
-		if err == nil {
-			return true
-		}
-		// Check mocknet / regression other formats
-		_, err = bchutil.DecodeAddress(addr.String(), &bchchaincfg.RegressionNetParams)
-		if err == nil {
-			return true
-		}
-		return false
-	case DASHChain:
-		// Check mainnet other formats
-		_, err := dashutil.DecodeAddress(addr.String(), &dashchaincfg.MainNetParams)
-		if err == nil {
-			return true
-		}
-		// Check testnet other formats
-		_, err = dashutil.DecodeAddress(addr.String(), &dashchaincfg.TestNet3Params)
-		if err == nil {
-			return true
-		}
-		// Check mocknet / regression other formats
-		_, err = dashutil.DecodeAddress(addr.String(), &dashchaincfg.RegressionNetParams)
-		if err == nil {
-			return true
-		}
-		return false
-	case DOGEChain:
-		// Check mainnet other formats
-		_, err := dogutil.DecodeAddress(addr.String(), &dogchaincfg.MainNetParams)
-		if err == nil {
-			return true
-		}
-		// Check testnet other formats
-		_, err = dogutil.DecodeAddress(addr.String(), &dogchaincfg.TestNet3Params)
-		if err == nil {
-			return true
-		}
-		// Check mocknet / regression other formats
-		_, err = dogutil.DecodeAddress(addr.String(), &dogchaincfg.RegressionNetParams)
-		if err == nil {
-			return true
-		}
-		return false
-	default:
+	d, ok := GetChainDescriptor(chain)
+	if !ok || d.IsAddress == nil {
 		return true // if THORNode don't specifically check a chain yet, assume its ok.
 	}
+	return d.IsAddress(addr)
 }
 
 func (addr Address) GetChain() Chain {
@@ -365,141 +313,30 @@ func (addr Address) GetChain() Chain {
 	return EmptyChain
 }
 
-func (addr Address) GetNetwork(ver semver.Version, chain Chain) ChainNetwork {
-	currentNetwork := GetCurrentChainNetwork()
-	mainNetPredicate := func() ChainNetwork {
-		if currentNetwork == StageNet {
-			return StageNet
+// GetChainV106 returns the first registered chain the address belongs to, EVM
+// addresses resolve to the first registered EVM chain
+func (addr Address) GetChainV106() Chain {
+	for _, d := range chainDescriptors {
+		// chains that don't check their addresses would match anything
+		if !d.EVM && d.IsAddress == nil {
+			continue
+		}
+		if addr.IsChain(d.Chain) {
+			return d.Chain
 		}
-		return MainNet
 	}
+	return EmptyChain
+}
+
+func (addr Address) GetNetwork(ver semver.Version, chain Chain) ChainNetwork {
+	currentNetwork := GetCurrentChainNetwork()
 	// EVM addresses don't have different prefixes per network
 	if chain.IsEVM() {
 		return currentNetwork
 	}
-	switch chain {
-	case BNBChain:
-		prefix, _, _ := bech32.Decode(addr.String())
-		if strings.EqualFold(prefix, "bnb") {
-			return mainNetPredicate()
-		}
-		if strings.EqualFold(prefix, "tbnb") {
-			return TestNet
-		}
-	case AZTECChain:
-		return currentNetwork
-	case BASEChain:
-		prefix, _, _ := bech32.Decode(addr.String())
-		if strings.EqualFold(prefix, "maya") {
-			return mainNetPredicate()
-		}
-		if strings.EqualFold(prefix, "tmaya") {
-			return TestNet
-		}
-		if strings.EqualFold(prefix, "smaya") {
-			return StageNet
-		}
-	case THORChain:
-		prefix, _, _ := bech32.Decode(addr.String())
-		if strings.EqualFold(prefix, "thor") {
-			return mainNetPredicate()
-		}
-		if strings.EqualFold(prefix, "tthor") {
-			return TestNet
-		}
-	case BTCChain:
-		prefix, _, _ := bech32.Decode(addr.String())
-		switch prefix {
-		case "bc":
-			return mainNetPredicate()
-		case "tb":
-			return TestNet
-		case "bcrt":
-			return MockNet
-		default:
-			_, err := btcutil.DecodeAddress(addr.String(), &chaincfg.MainNetParams)
-			if err == nil {
-				return mainNetPredicate()
-			}
-			_, err = btcutil.DecodeAddress(addr.String(), &chaincfg.TestNet3Params)
-			if err == nil {
-				return TestNet
-			}
-			_, err = btcutil.DecodeAddress(addr.String(), &chaincfg.RegressionNetParams)
-			if err == nil {
-				return MockNet
-			}
-		}
-	case LTCChain:
-		prefix, _, _ := bech32.Decode(addr.String())
-		switch prefix {
-		case "ltc":
-			return mainNetPredicate()
-		case "tltc":
-			return TestNet
-		case "rltc":
-			return MockNet
-		default:
-			_, err := ltcutil.DecodeAddress(addr.String(), &ltcchaincfg.MainNetParams)
-			if err == nil {
-				return mainNetPredicate()
-			}
-			_, err = ltcutil.DecodeAddress(addr.String(), &ltcchaincfg.TestNet4Params)
-			if err == nil {
-				return TestNet
-			}
-			_, err = ltcutil.DecodeAddress(addr.String(), &ltcchaincfg.RegressionNetParams)
-			if err == nil {
-				return MockNet
-			}
-		}
-	case BCHChain:
-		// Check mainnet other formats
-		_, err := bchutil.DecodeAddress(addr.String(), &bchchaincfg.MainNetParams)
-		if err == nil {
-			return mainNetPredicate()
-		}
-		// Check testnet other formats
-		_, err = bchutil.DecodeAddress(addr.String(), &bchchaincfg.TestNet3Params)
-		if err == nil {
-			return TestNet
-		}
-		// Check mocknet / regression other formats
-		_, err = bchutil.DecodeAddress(addr.String(), &bchchaincfg.RegressionNetParams)
-		if err == nil {
-			return MockNet
-		}
-	case DASHChain:
-		// Check mainnet other formats
-		_, err := dashutil.DecodeAddress(addr.String(), &dashchaincfg.MainNetParams)
-		if err == nil {
-			return MainNet
-		}
-		// Check testnet other formats
-		_, err = dashutil.DecodeAddress(addr.String(), &dashchaincfg.TestNet3Params)
-		if err == nil {
-			return TestNet
-		}
-		// Check mocknet / regression other formats
-		_, err = dashutil.DecodeAddress(addr.String(), &dashchaincfg.RegressionNetParams)
-		if err == nil {
-			return MockNet
-		}
-	case DOGEChain:
-		// Check mainnet other formats
-		_, err := dogutil.DecodeAddress(addr.String(), &dogchaincfg.MainNetParams)
-		if err == nil {
-			return mainNetPredicate()
-		}
-		// Check testnet other formats
-		_, err = dogutil.DecodeAddress(addr.String(), &dogchaincfg.TestNet3Params)
-		if err == nil {
-			return TestNet
-		}
-		// Check mocknet / regression other formats
-		_, err = dogutil.DecodeAddress(addr.String(), &dogchaincfg.RegressionNetParams)
-		if err == nil {
-			return MockNet
+	if d, ok := GetChainDescriptor(chain); ok && d.AddressNetwork != nil {
+		if network, ok := d.AddressNetwork(addr); ok {
+			return network
 		}
 	}
 	switch {
@@ -1,6 +1,8 @@
 package common
 
 import (
+	"os"
+
 	"github.com/blang/semver"
 	. "gopkg.in/check.v1"
 )
@@ -356,10 +358,14 @@ func (s *AddressSuite) TestAddress(c *C) {
 	c.Check(addr.IsChain(DOGEChain), Equals, false)
 	c.Check(addr.GetNetwork(semver.MustParse("999.0.0"), BTCChain), Equals, MainNet)
 
-	// segwit mainnet p2tr v1
-	addr, err = NewAddress("bc1ppgj0l0jng3s2t54h5tckjxjdv4zkzpcwfdd4u7vhp63dgml96hds9eyvn6")
+	// p2tr v1 addresses are only parsed from 1.106.0 onwards
+	_, err = NewAddress("bc1ppgj0l0jng3s2t54h5tckjxjdv4zkzpcwfdd4u7vhp63dgml96hds9eyvn6")
 	c.Check(err, NotNil)
-	c.Check(addr.IsChain(BTCChain), Equals, false)
+
+	// segwit mainnet p2tr v1
+	addr, err = NewAddressV106("bc1ppgj0l0jng3s2t54h5tckjxjdv4zkzpcwfdd4u7vhp63dgml96hds9eyvn6")
+	c.Check(err, IsNil)
+	c.Check(addr.IsChain(BTCChain), Equals, true)
 	c.Check(addr.IsChain(LTCChain), Equals, false)
 	c.Check(addr.IsChain(ETHChain), Equals, false)
 	c.Check(addr.IsChain(BNBChain), Equals, false)
@@ -370,6 +376,20 @@ func (s *AddressSuite) TestAddress(c *C) {
 	c.Check(addr.IsChain(DOGEChain), Equals, false)
 	c.Check(addr.GetNetwork(semver.MustParse("999.0.0"), BTCChain), Equals, MainNet)
 
+	// p2tr v1 addresses of another network are rejected
+	_, err = NewAddressV106("tb1pqqqqp399et2xygdj5xreqhjjvcmzhxw4aywxecjdzew6hylgvsesf3hn0c")
+	c.Check(err, NotNil)
+	_, err = NewAddressV106("bcrt1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqc8gma6")
+	c.Check(err, NotNil)
+
+	// p2tr v1 addresses with a non bitcoin prefix are rejected
+	_, err = NewAddressV106("ltc1pqqqsyqcyq5rqwzqfpg9scrgwpugpzysnzs23v9ccrydpk8qarc0sts9tf8")
+	c.Check(err, NotNil)
+
+	// p2tr with an invalid checksum
+	_, err = NewAddressV106("bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj1")
+	c.Check(err, NotNil)
+
 	// segwit mainnet witness v16
 	addr, err = NewAddress("BC1SW50QA3JX3S")
 	c.Check(err, IsNil)
@@ -488,3 +508,39 @@ func (s *AddressSuite) TestAddress(c *C) {
 	c.Check(addr.IsChain(DASHChain), Equals, true)
 	c.Check(addr.GetNetwork(semver.MustParse("999.0.0"), DASHChain), Equals, TestNet)
 }
+
+func (s *AddressSuite) TestTaprootAddressNetwork(c *C) {
+	c.Assert(os.Setenv("NET", "testnet"), IsNil)
+	defer func() { c.Assert(os.Unsetenv("NET"), IsNil) }()
+
+	addr, err := NewAddressV106("tb1pqqqqp399et2xygdj5xreqhjjvcmzhxw4aywxecjdzew6hylgvsesf3hn0c")
+	c.Check(err, IsNil)
+	c.Check(addr.IsChain(BTCChain), Equals, true)
+	c.Check(addr.GetNetwork(semver.MustParse("999.0.0"), BTCChain), Equals, TestNet)
+
+	// uppercase addresses are kept lowercase
+	addr, err = NewAddressV106("TB1PQQQQP399ET2XYGDJ5XREQHJJVCMZHXW4AYWXECJDZEW6HYLGVSESF3HN0C")
+	c.Check(err, IsNil)
+	c.Check(addr.String(), Equals, "tb1pqqqqp399et2xygdj5xreqhjjvcmzhxw4aywxecjdzew6hylgvsesf3hn0c")
+
+	_, err = NewAddressV106("bc1ppgj0l0jng3s2t54h5tckjxjdv4zkzpcwfdd4u7vhp63dgml96hds9eyvn6")
+	c.Check(err, NotNil)
+}
+
+func (s *AddressSuite) TestAddressGetChain(c *C) {
+	c.Check(Address("bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6").GetChain(), Equals, BNBChain)
+	c.Check(Address("maya1kljxxccrheghavaw97u78le6yy3sdj7h6jylf9").GetChain(), Equals, BASEChain)
+	c.Check(Address("0x90f2b1ae50e6018230e90a33f98c7844a0ab635a").GetChain(), Equals, ETHChain)
+	c.Check(Address("bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4").GetChain(), Equals, BTCChain)
+	c.Check(Address("cosmos1xv9tklw7d82sezh9haa573wufgy59vmwe6xxe5").GetChain(), Equals, GAIAChain)
+	c.Check(Address("thor1x0jkvqdh2hlpeztd5zyyk70n3efx6mhudkmnn2").GetChain(), Equals, EmptyChain)
+	c.Check(Address("noop").GetChain(), Equals, EmptyChain)
+
+	c.Check(Address("bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6").GetChainV106(), Equals, BNBChain)
+	c.Check(Address("maya1kljxxccrheghavaw97u78le6yy3sdj7h6jylf9").GetChainV106(), Equals, BASEChain)
+	c.Check(Address("0x90f2b1ae50e6018230e90a33f98c7844a0ab635a").GetChainV106(), Equals, ETHChain)
+	c.Check(Address("bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4").GetChainV106(), Equals, BTCChain)
+	c.Check(Address("cosmos1xv9tklw7d82sezh9haa573wufgy59vmwe6xxe5").GetChainV106(), Equals, GAIAChain)
+	c.Check(Address("thor1x0jkvqdh2hlpeztd5zyyk70n3efx6mhudkmnn2").GetChainV106(), Equals, THORChain)
+	c.Check(Address("noop").GetChainV106(), Equals, EmptyChain)
+}
@@ -4,13 +4,7 @@ import (
 	"errors"
 	"strings"
 
-	"github.com/btcsuite/btcd/chaincfg"
-	"github.com/cosmos/cosmos-sdk/types"
-	dogchaincfg "github.com/eager7/dogd/chaincfg"
 	"github.com/hashicorp/go-multierror"
-	ltcchaincfg "github.com/ltcsuite/ltcd/chaincfg"
-	dashchaincfg "gitlab.com/mayachain/dashd-go/chaincfg"
-	btypes "gitlab.com/thorchain/binance-sdk/common/types"
 
 	"gitlab.com/mayachain/mayanode/common/cosmos"
 )
@@ -81,7 +75,13 @@ func (c Chain) IsBASEChain() bool {
 // - uses 0x as an address prefix
 // - has a "Router" Smart Contract
 func GetEVMChains() []Chain {
-	return []Chain{ETHChain, AVAXChain}
+	var chains []Chain
+	for _, d := range chainDescriptors {
+		if d.EVM {
+			chains = append(chains, d.Chain)
+		}
+	}
+	return chains
 }
 
 // IsEVM returns true if given chain is an EVM chain.
@@ -115,55 +115,28 @@ func (c Chain) IsBNB() bool {
 
 // GetSigningAlgo get the signing algorithm for the given chain
 func (c Chain) GetSigningAlgo() SigningAlgo {
+	if d, ok := GetChainDescriptor(c); ok {
+		return d.SigningAlgo
+	}
 	// Only SigningAlgoSecp256k1 is supported for now
 	return SigningAlgoSecp256k1
 }
 
 // GetGasAsset chain's base asset
 func (c Chain) GetGasAsset() Asset {
-	switch c {
-	case AZTECChain:
-		return BaseNative
-	case BASEChain:
-		return BaseNative
-	case BNBChain:
-		return BNBAsset
-	case BTCChain:
-		return BTCAsset
-	case LTCChain:
-		return LTCAsset
-	case BCHChain:
-		return BCHAsset
-	case DASHChain:
-		return DASHAsset
-	case DOGEChain:
-		return DOGEAsset
-	case ETHChain:
-		return ETHAsset
-	case THORChain:
-		return RUNEAsset
-	case AVAXChain:
-		return AVAXAsset
-	case GAIAChain:
-		return ATOMAsset
-	case KUJIChain:
-		return KUJIAsset
-	default:
-		return EmptyAsset
+	if d, ok := GetChainDescriptor(c); ok {
+		return d.GasAsset
 	}
+	return EmptyAsset
 }
 
 // GetGasAssetDecimal for the gas asset of given chain , what kind of precision it is using
 // BASEChain is using 1E8, if an external chain's gas asset is larger than 1E8, just return cosmos.DefaultCoinDecimals
 func (c Chain) GetGasAssetDecimal() int64 {
-	switch c {
-	case BASEChain, THORChain:
-		return 8
-	case GAIAChain:
-		return 6
-	default:
-		return cosmos.DefaultCoinDecimals
+	if d, ok := GetChainDescriptor(c); ok {
+		return d.GasAssetDecimals
 	}
+	return cosmos.DefaultCoinDecimals
 }
 
 // IsValidAddress make sure the address is correct for the chain
@@ -179,109 +152,8 @@ func (c Chain) AddressPrefix(cn ChainNetwork) string {
 	if c.IsEVM() {
 		return "0x"
 	}
-	switch cn {
-	case MockNet:
-		switch c {
-		case AZTECChain:
-			return "taztec"
-		case BNBChain:
-			return btypes.TestNetwork.Bech32Prefixes()
-		case THORChain:
-			return "tthor"
-		case GAIAChain:
-			return "cosmos"
-		case KUJIChain:
-			return "kujira"
-		case ETHChain:
-			return "0x"
-		case BASEChain:
-			// TODO update this to use testnet address prefix
-			return types.GetConfig().GetBech32AccountAddrPrefix()
-		case BTCChain:
-			return chaincfg.RegressionNetParams.Bech32HRPSegwit
-		case LTCChain:
-			return ltcchaincfg.RegressionNetParams.Bech32HRPSegwit
-		case DASHChain:
-			return dashchaincfg.RegressionNetParams.Bech32HRPSegwit
-		case DOGEChain:
-			return dogchaincfg.RegressionNetParams.Bech32HRPSegwit
-		}
-	case TestNet:
-		switch c {
-		case AZTECChain:
-			return "taztec"
-		case BNBChain:
-			return btypes.TestNetwork.Bech32Prefixes()
-		case THORChain:
-			return "tthor"
-		case GAIAChain:
-			return "cosmos"
-		case KUJIChain:
-			return "kujira"
-		case ETHChain:
-			return "0x"
-		case BASEChain:
-			// TODO update this to use testnet address prefix
-			return types.GetConfig().GetBech32AccountAddrPrefix()
-		case BTCChain:
-			return chaincfg.TestNet3Params.Bech32HRPSegwit
-		case LTCChain:
-			return ltcchaincfg.TestNet4Params.Bech32HRPSegwit
-		case DASHChain:
-			return dashchaincfg.TestNet3Params.Bech32HRPSegwit
-		case DOGEChain:
-			return dogchaincfg.TestNet3Params.Bech32HRPSegwit
-		}
-	case StageNet:
-		switch c {
-		case AZTECChain:
-			return "saztec"
-		case BNBChain:
-			return btypes.ProdNetwork.Bech32Prefixes()
-		case THORChain:
-			return "thor"
-		case GAIAChain:
-			return "cosmos"
-		case KUJIChain:
-			return "kujira"
-		case ETHChain:
-			return "0x"
-		case BASEChain:
-			return types.GetConfig().GetBech32AccountAddrPrefix()
-		case BTCChain:
-			return chaincfg.MainNetParams.Bech32HRPSegwit
-		case LTCChain:
-			return ltcchaincfg.MainNetParams.Bech32HRPSegwit
-		case DASHChain:
-			return dashchaincfg.MainNetParams.Bech32HRPSegwit
-		case DOGEChain:
-			return dogchaincfg.MainNetParams.Bech32HRPSegwit
-		}
-	case MainNet:
-		switch c {
-		case AZTECChain:
-			return "aztec"
-		case BNBChain:
-			return btypes.ProdNetwork.Bech32Prefixes()
-		case THORChain:
-			return "thor"
-		case GAIAChain:
-			return "cosmos"
-		case KUJIChain:
-			return "kujira"
-		case ETHChain:
-			return "0x"
-		case BASEChain:
-			return types.GetConfig().GetBech32AccountAddrPrefix()
-		case BTCChain:
-			return chaincfg.MainNetParams.Bech32HRPSegwit
-		case LTCChain:
-			return ltcchaincfg.MainNetParams.Bech32HRPSegwit
-		case DASHChain:
-			return dashchaincfg.MainNetParams.Bech32HRPSegwit
-		case DOGEChain:
-			return dogchaincfg.MainNetParams.Bech32HRPSegwit
-		}
+	if d, ok := GetChainDescriptor(c); ok && d.AddressPrefix != nil {
+		return d.AddressPrefix(cn)
 	}
 	return ""
 }
@@ -293,86 +165,42 @@ func (c Chain) AddressPrefix(cn ChainNetwork) string {
 // Add range: dust_threshold -> Inf
 // NOTE: these should all be in 8 decimal places
 func (c Chain) DustThreshold() cosmos.Uint {
-	switch c {
-	case BTCChain, LTCChain, BCHChain:
-		return cosmos.NewUint(10_000)
-	case DOGEChain:
-		return cosmos.NewUint(100_000_000)
-	case ETHChain, BNBChain, BASEChain, THORChain, AZTECChain, GAIAChain, KUJIChain:
-		return cosmos.NewUint(0)
-	default:
-		return cosmos.NewUint(0)
+	if d, ok := GetChainDescriptor(c); ok {
+		return cosmos.NewUint(d.DustThreshold)
 	}
+	return cosmos.NewUint(0)
 }
 
 // MaxMemoLength returns the max memo length for each chain. Returns 0 if no max is configured.
 func (c Chain) MaxMemoLength() int {
-	switch c {
-	case BTCChain, LTCChain, BCHChain, DOGEChain:
-		return 80
-	default:
-		return 0
+	if d, ok := GetChainDescriptor(c); ok {
+		return d.MaxMemoLength
 	}
+	return 0
 }
 
 // DefaultCoinbase returns the default coinbase address for each chain, returns 0 if no
 // coinbase emission is used. This is used used at the time of writing as a fallback
 // value in Bifrost, and for inbound confirmation count estimates in the quote APIs.
 func (c Chain) DefaultCoinbase() float64 {
-	switch c {
-	case BTCChain:
-		return 6.25
-	case LTCChain:
-		return 12.5
-	case BCHChain:
-		return 6.25
-	case DOGEChain:
-		return 10000
-	default:
-		return 0
+	if d, ok := GetChainDescriptor(c); ok {
+		return d.DefaultCoinbase
 	}
+	return 0
 }
 
 func (c Chain) ApproximateBlockMilliseconds() int64 {
-	switch c {
-	case BTCChain:
-		return 600_000
-	case LTCChain:
-		return 150_000
-	case BCHChain:
-		return 600_000
-	case DOGEChain:
-		return 60_000
-	case ETHChain:
-		return 15_000
-	case AVAXChain:
-		return 3_000
-	case BNBChain:
-		return 500
-	case GAIAChain:
-		return 6_000
-	case KUJIChain:
-		return 6_000
-	case THORChain:
-		return 6_000
-	case BASEChain:
-		return 6_000
-	default:
-		return 0
+	if d, ok := GetChainDescriptor(c); ok {
+		return d.ApproximateBlockMilliseconds
 	}
+	return 0
 }
 
 func (c Chain) InboundNotes() string {
-	switch c {
-	case BTCChain, LTCChain, BCHChain, DOGEChain:
-		return "First output should be to inbound_address, second output should be change back to self, third output should be OP_RETURN, limited to 80 bytes. Do not send below the dust threshold. Do not use exotic spend scripts, locks or address formats (P2WSH with Bech32 address format preferred)."
-	case ETHChain, AVAXChain:
-		return "Base Asset: Send the inbound_address the asset with the memo encoded in hex in the data field. Tokens: First approve router to spend tokens from user: asset.approve(router, amount). Then call router.depositWithExpiry(inbound_address, asset, amount, memo, expiry). Asset is the token contract address. Amount should be in native asset decimals (eg 1e18 for most tokens). Do not send to or from contract addresses."
-	case BNBChain, GAIAChain, THORChain, KUJIChain:
-		return "Transfer the inbound_address the asset with the memo. Do not use multi-in, multi-out transactions."
-	default:
-		return ""
+	if d, ok := GetChainDescriptor(c); ok {
+		return d.InboundNotes
 	}
+	return ""
 }
 
 func NewChains(raw []string) (Chains, error) {
@@ -0,0 +1,557 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/bech32"
+	"github.com/cosmos/cosmos-sdk/types"
+	dogchaincfg "github.com/eager7/dogd/chaincfg"
+	"github.com/eager7/dogutil"
+	bchchaincfg "github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchutil"
+	ltcchaincfg "github.com/ltcsuite/ltcd/chaincfg"
+	"github.com/ltcsuite/ltcutil"
+	dashutil "gitlab.com/mayachain/dashd-go/btcutil"
+	dashchaincfg "gitlab.com/mayachain/dashd-go/chaincfg"
+	btypes "gitlab.com/thorchain/binance-sdk/common/types"
+
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+const (
+	utxoInboundNotes   = "First output should be to inbound_address, second output should be change back to self, third output should be OP_RETURN, limited to 80 bytes. Do not send below the dust threshold. Do not use exotic spend scripts, locks or address formats (P2WSH with Bech32 address format preferred)."
+	evmInboundNotes    = "Base Asset: Send the inbound_address the asset with the memo encoded in hex in the data field. Tokens: First approve router to spend tokens from user: asset.approve(router, amount). Then call router.depositWithExpiry(inbound_address, asset, amount, memo, expiry). Asset is the token contract address. Amount should be in native asset decimals (eg 1e18 for most tokens). Do not send to or from contract addresses."
+	cosmosInboundNotes = "Transfer the inbound_address the asset with the memo. Do not use multi-in, multi-out transactions."
+)
+
+// ChainDescriptor describes everything the protocol needs to know about a chain,
+// the Chain, Address and PubKey methods delegate to the descriptor of the chain.
+// Adding a chain only requires adding its descriptor to chainDescriptors
+type ChainDescriptor struct {
+	Chain                        Chain
+	GasAsset                     Asset
+	GasAssetDecimals             int64
+	SigningAlgo                  SigningAlgo
+	EVM                          bool
+	DustThreshold                uint64
+	MaxMemoLength                int
+	DefaultCoinbase              float64
+	ApproximateBlockMilliseconds int64
+	InboundNotes                 string
+
+	// AddressPrefix returns the address prefix used by the chain on the given network
+	AddressPrefix func(cn ChainNetwork) string
+	// IsAddress returns true when the address belongs to the chain, nil accepts any address
+	IsAddress func(addr Address) bool
+	// AddressNetwork returns the network the address belongs to, false when it can't be determined
+	AddressNetwork func(addr Address) (ChainNetwork, bool)
+	// PubKeyToAddress derives the address of the pubkey on the given network, nil means no address
+	PubKeyToAddress func(pubKey PubKey, cn ChainNetwork) (Address, error)
+}
+
+var (
+	chainDescriptors        []ChainDescriptor
+	chainDescriptorsByChain map[Chain]*ChainDescriptor
+)
+
+// registered in init, as the address helpers of the descriptors refer back to the registry
+func init() {
+	chainDescriptors = []ChainDescriptor{
+		{
+			Chain:                        BASEChain,
+			GasAsset:                     BaseNative,
+			GasAssetDecimals:             8,
+			SigningAlgo:                  SigningAlgoSecp256k1,
+			ApproximateBlockMilliseconds: 6_000,
+			AddressPrefix: func(cn ChainNetwork) string {
+				// TODO update this to use testnet address prefix
+				return types.GetConfig().GetBech32AccountAddrPrefix()
+			},
+			IsAddress: bech32PrefixMatcher("maya", "tmaya", "smaya"),
+			AddressNetwork: bech32PrefixNetwork(map[string]ChainNetwork{
+				"maya":  MainNet,
+				"tmaya": TestNet,
+				"smaya": StageNet,
+			}),
+			PubKeyToAddress: cosmosPubKeyToAddress(BASEChain),
+		},
+		{
+			Chain:            AZTECChain,
+			GasAsset:         BaseNative,
+			GasAssetDecimals: cosmos.DefaultCoinDecimals,
+			SigningAlgo:      SigningAlgoSecp256k1,
+			AddressPrefix: networkPrefixes(map[ChainNetwork]string{
+				MockNet:  "taztec",
+				TestNet:  "taztec",
+				StageNet: "saztec",
+				MainNet:  "aztec",
+			}),
+			IsAddress: bech32PrefixMatcher("aztec", "taztec", "saztec"),
+			AddressNetwork: func(addr Address) (ChainNetwork, bool) {
+				return GetCurrentChainNetwork(), true
+			},
+		},
+		{
+			Chain:                        BNBChain,
+			GasAsset:                     BNBAsset,
+			GasAssetDecimals:             cosmos.DefaultCoinDecimals,
+			SigningAlgo:                  SigningAlgoSecp256k1,
+			ApproximateBlockMilliseconds: 500,
+			InboundNotes:                 cosmosInboundNotes,
+			AddressPrefix: networkPrefixes(map[ChainNetwork]string{
+				MockNet:  btypes.TestNetwork.Bech32Prefixes(),
+				TestNet:  btypes.TestNetwork.Bech32Prefixes(),
+				StageNet: btypes.ProdNetwork.Bech32Prefixes(),
+				MainNet:  btypes.ProdNetwork.Bech32Prefixes(),
+			}),
+			IsAddress: bech32PrefixMatcher("bnb", "tbnb"),
+			AddressNetwork: bech32PrefixNetwork(map[string]ChainNetwork{
+				"bnb":  MainNet,
+				"tbnb": TestNet,
+			}),
+			PubKeyToAddress: cosmosPubKeyToAddress(BNBChain),
+		},
+		{
+			Chain:                        BTCChain,
+			GasAsset:                     BTCAsset,
+			GasAssetDecimals:             cosmos.DefaultCoinDecimals,
+			SigningAlgo:                  SigningAlgoSecp256k1,
+			DustThreshold:                10_000,
+			MaxMemoLength:                80,
+			DefaultCoinbase:              6.25,
+			ApproximateBlockMilliseconds: 600_000,
+			InboundNotes:                 utxoInboundNotes,
+			AddressPrefix: networkPrefixes(map[ChainNetwork]string{
+				MockNet:  chaincfg.RegressionNetParams.Bech32HRPSegwit,
+				TestNet:  chaincfg.TestNet3Params.Bech32HRPSegwit,
+				StageNet: chaincfg.MainNetParams.Bech32HRPSegwit,
+				MainNet:  chaincfg.MainNetParams.Bech32HRPSegwit,
+			}),
+			IsAddress: func(addr Address) bool {
+				prefix, _, err := bech32.Decode(addr.String())
+				if err == nil && (prefix == "bc" || prefix == "tb") {
+					return true
+				}
+				// Check taproot addresses of the current network
+				if isTaprootAddress(addr.String()) {
+					return true
+				}
+				// Check mainnet other formats
+				_, err = btcutil.DecodeAddress(addr.String(), &chaincfg.MainNetParams)
+				if err == nil {
+					return true
+				}
+				// Check testnet other formats
+				_, err = btcutil.DecodeAddress(addr.String(), &chaincfg.TestNet3Params)
+				return err == nil
+			},
+			AddressNetwork: func(addr Address) (ChainNetwork, bool) {
+				prefix, _, err := bech32.Decode(addr.String())
+				if err != nil && isTaprootAddress(addr.String()) {
+					prefix, _, _ = DecodeTaprootAddress(addr.String())
+				}
+				switch prefix {
+				case "bc":
+					return mainNetPredicate(), true
+				case "tb":
+					return TestNet, true
+				case "bcrt":
+					return MockNet, true
+				}
+				if _, err := btcutil.DecodeAddress(addr.String(), &chaincfg.MainNetParams); err == nil {
+					return mainNetPredicate(), true
+				}
+				if _, err := btcutil.DecodeAddress(addr.String(), &chaincfg.TestNet3Params); err == nil {
+					return TestNet, true
+				}
+				if _, err := btcutil.DecodeAddress(addr.String(), &chaincfg.RegressionNetParams); err == nil {
+					return MockNet, true
+				}
+				return MockNet, false
+			},
+			PubKeyToAddress: func(pubKey PubKey, cn ChainNetwork) (Address, error) {
+				net := &chaincfg.MainNetParams
+				switch cn {
+				case MockNet:
+					net = &chaincfg.RegressionNetParams
+				case TestNet:
+					net = &chaincfg.TestNet3Params
+				}
+				return utxoPubKeyToAddress(pubKey, "bech32 encode", func(pkh []byte) (fmt.Stringer, error) {
+					return btcutil.NewAddressWitnessPubKeyHash(pkh, net)
+				})
+			},
+		},
+		{
+			Chain:                        LTCChain,
+			GasAsset:                     LTCAsset,
+			GasAssetDecimals:             cosmos.DefaultCoinDecimals,
+			SigningAlgo:                  SigningAlgoSecp256k1,
+			DustThreshold:                10_000,
+			MaxMemoLength:                80,
+			DefaultCoinbase:              12.5,
+			ApproximateBlockMilliseconds: 150_000,
+			InboundNotes:                 utxoInboundNotes,
+			AddressPrefix: networkPrefixes(map[ChainNetwork]string{
+				MockNet:  ltcchaincfg.RegressionNetParams.Bech32HRPSegwit,
+				TestNet:  ltcchaincfg.TestNet4Params.Bech32HRPSegwit,
+				StageNet: ltcchaincfg.MainNetParams.Bech32HRPSegwit,
+				MainNet:  ltcchaincfg.MainNetParams.Bech32HRPSegwit,
+			}),
+			IsAddress: func(addr Address) bool {
+				prefix, _, err := bech32.Decode(addr.String())
+				if err == nil && (prefix == "ltc" || prefix == "tltc" || prefix == "rltc") {
+					return true
+				}
+				// Check mainnet other formats
+				_, err = ltcutil.DecodeAddress(addr.String(), &ltcchaincfg.MainNetParams)
+				if err == nil {
+					return true
+				}
+				// Check testnet other formats
+				_, err = ltcutil.DecodeAddress(addr.String(), &ltcchaincfg.TestNet4Params)
+				return err == nil
+			},
+			AddressNetwork: func(addr Address) (ChainNetwork, bool) {
+				prefix, _, _ := bech32.Decode(addr.String())
+				switch prefix {
+				case "ltc":
+					return mainNetPredicate(), true
+				case "tltc":
+					return TestNet, true
+				case "rltc":
+					return MockNet, true
+				}
+				if _, err := ltcutil.DecodeAddress(addr.String(), &ltcchaincfg.MainNetParams); err == nil {
+					return mainNetPredicate(), true
+				}
+				if _, err := ltcutil.DecodeAddress(addr.String(), &ltcchaincfg.TestNet4Params); err == nil {
+					return TestNet, true
+				}
+				if _, err := ltcutil.DecodeAddress(addr.String(), &ltcchaincfg.RegressionNetParams); err == nil {
+					return MockNet, true
+				}
+				return MockNet, false
+			},
+			PubKeyToAddress: func(pubKey PubKey, cn ChainNetwork) (Address, error) {
+				net := &ltcchaincfg.MainNetParams
+				switch cn {
+				case MockNet:
+					net = &ltcchaincfg.RegressionNetParams
+				case TestNet:
+					net = &ltcchaincfg.TestNet4Params
+				}
+				return utxoPubKeyToAddress(pubKey, "bech32 encode", func(pkh []byte) (fmt.Stringer, error) {
+					return ltcutil.NewAddressWitnessPubKeyHash(pkh, net)
+				})
+			},
+		},
+		{
+			Chain:                        BCHChain,
+			GasAsset:                     BCHAsset,
+			GasAssetDecimals:             cosmos.DefaultCoinDecimals,
+			SigningAlgo:                  SigningAlgoSecp256k1,
+			DustThreshold:                10_000,
+			MaxMemoLength:                80,
+			DefaultCoinbase:              6.25,
+			ApproximateBlockMilliseconds: 600_000,
+			InboundNotes:                 utxoInboundNotes,
+			IsAddress: func(addr Address) bool {
+				_, ok := bchAddressNetwork(addr)
+				return ok
+			},
+			AddressNetwork: bchAddressNetwork,
+			PubKeyToAddress: func(pubKey PubKey, cn ChainNetwork) (Address, error) {
+				net := &bchchaincfg.MainNetParams
+				switch cn {
+				case MockNet:
+					net = &bchchaincfg.RegressionNetParams
+				case TestNet:
+					net = &bchchaincfg.TestNet3Params
+				}
+				return utxoPubKeyToAddress(pubKey, "encode", func(pkh []byte) (fmt.Stringer, error) {
+					return bchutil.NewAddressPubKeyHash(pkh, net)
+				})
+			},
+		},
+		{
+			Chain:            DASHChain,
+			GasAsset:         DASHAsset,
+			GasAssetDecimals: cosmos.DefaultCoinDecimals,
+			SigningAlgo:      SigningAlgoSecp256k1,
+			AddressPrefix: networkPrefixes(map[ChainNetwork]string{
+				MockNet:  dashchaincfg.RegressionNetParams.Bech32HRPSegwit,
+				TestNet:  dashchaincfg.TestNet3Params.Bech32HRPSegwit,
+				StageNet: dashchaincfg.MainNetParams.Bech32HRPSegwit,
+				MainNet:  dashchaincfg.MainNetParams.Bech32HRPSegwit,
+			}),
+			IsAddress: func(addr Address) bool {
+				_, ok := dashAddressNetwork(addr)
+				return ok
+			},
+			AddressNetwork: dashAddressNetwork,
+			PubKeyToAddress: func(pubKey PubKey, cn ChainNetwork) (Address, error) {
+				net := &dashchaincfg.MainNetParams
+				switch cn {
+				case MockNet:
+					net = &dashchaincfg.RegressionNetParams
+				case TestNet:
+					net = &dashchaincfg.TestNet3Params
+				}
+				return utxoPubKeyToAddress(pubKey, "encode", func(pkh []byte) (fmt.Stringer, error) {
+					return dashutil.NewAddressPubKeyHash(pkh, net)
+				})
+			},
+		},
+		{
+			Chain:                        DOGEChain,
+			GasAsset:                     DOGEAsset,
+			GasAssetDecimals:             cosmos.DefaultCoinDecimals,
+			SigningAlgo:                  SigningAlgoSecp256k1,
+			DustThreshold:                100_000_000,
+			MaxMemoLength:                80,
+			DefaultCoinbase:              10000,
+			ApproximateBlockMilliseconds: 60_000,
+			InboundNotes:                 utxoInboundNotes,
+			AddressPrefix: networkPrefixes(map[ChainNetwork]string{
+				MockNet:  dogchaincfg.RegressionNetParams.Bech32HRPSegwit,
+				TestNet:  dogchaincfg.TestNet3Params.Bech32HRPSegwit,
+				StageNet: dogchaincfg.MainNetParams.Bech32HRPSegwit,
+				MainNet:  dogchaincfg.MainNetParams.Bech32HRPSegwit,
+			}),
+			IsAddress: func(addr Address) bool {
+				_, ok := dogeAddressNetwork(addr)
+				return ok
+			},
+			AddressNetwork: dogeAddressNetwork,
+			PubKeyToAddress: func(pubKey PubKey, cn ChainNetwork) (Address, error) {
+				net := &dogchaincfg.MainNetParams
+				switch cn {
+				case MockNet:
+					net = &dogchaincfg.RegressionNetParams
+				case TestNet:
+					net = &dogchaincfg.TestNet3Params
+				}
+				return utxoPubKeyToAddress(pubKey, "encode", func(pkh []byte) (fmt.Stringer, error) {
+					return dogutil.NewAddressPubKeyHash(pkh, net)
+				})
+			},
+		},
+		{
+			Chain:                        ETHChain,
+			GasAsset:                     ETHAsset,
+			GasAssetDecimals:             cosmos.DefaultCoinDecimals,
+			SigningAlgo:                  SigningAlgoSecp256k1,
+			EVM:                          true,
+			ApproximateBlockMilliseconds: 15_000,
+			InboundNotes:                 evmInboundNotes,
+		},
+		{
+			Chain:                        AVAXChain,
+			GasAsset:                     AVAXAsset,
+			GasAssetDecimals:             cosmos.DefaultCoinDecimals,
+			SigningAlgo:                  SigningAlgoSecp256k1,
+			EVM:                          true,
+			ApproximateBlockMilliseconds: 3_000,
+			InboundNotes:                 evmInboundNotes,
+		},
+		{
+			Chain:                        THORChain,
+			GasAsset:                     RUNEAsset,
+			GasAssetDecimals:             8,
+			SigningAlgo:                  SigningAlgoSecp256k1,
+			ApproximateBlockMilliseconds: 6_000,
+			InboundNotes:                 cosmosInboundNotes,
+			AddressPrefix: networkPrefixes(map[ChainNetwork]string{
+				MockNet:  "tthor",
+				TestNet:  "tthor",
+				StageNet: "thor",
+				MainNet:  "thor",
+			}),
+			IsAddress: bech32PrefixMatcher("thor", "tthor"),
+			AddressNetwork: bech32PrefixNetwork(map[string]ChainNetwork{
+				"thor":  MainNet,
+				"tthor": TestNet,
+			}),
+			PubKeyToAddress: cosmosPubKeyToAddress(THORChain),
+		},
+		{
+			Chain:                        GAIAChain,
+			GasAsset:                     ATOMAsset,
+			GasAssetDecimals:             6,
+			SigningAlgo:                  SigningAlgoSecp256k1,
+			ApproximateBlockMilliseconds: 6_000,
+			InboundNotes:                 cosmosInboundNotes,
+			// Note: Gaia does not use a special prefix for testnet
+			AddressPrefix: networkPrefixes(map[ChainNetwork]string{
+				MockNet:  "cosmos",
+				TestNet:  "cosmos",
+				StageNet: "cosmos",
+				MainNet:  "cosmos",
+			}),
+			IsAddress:       bech32PrefixMatcher("cosmos"),
+			PubKeyToAddress: cosmosPubKeyToAddress(GAIAChain),
+		},
+		{
+			Chain:                        KUJIChain,
+			GasAsset:                     KUJIAsset,
+			GasAssetDecimals:             cosmos.DefaultCoinDecimals,
+			SigningAlgo:                  SigningAlgoSecp256k1,
+			ApproximateBlockMilliseconds: 6_000,
+			InboundNotes:                 cosmosInboundNotes,
+			AddressPrefix: networkPrefixes(map[ChainNetwork]string{
+				MockNet:  "kujira",
+				TestNet:  "kujira",
+				StageNet: "kujira",
+				MainNet:  "kujira",
+			}),
+			PubKeyToAddress: cosmosPubKeyToAddress(KUJIChain),
+		},
+	}
+
+	chainDescriptorsByChain = make(map[Chain]*ChainDescriptor, len(chainDescriptors))
+	for i := range chainDescriptors {
+		chainDescriptorsByChain[chainDescriptors[i].Chain] = &chainDescriptors[i]
+	}
+}
+
+// GetChainDescriptor returns the descriptor of the given chain
+func GetChainDescriptor(c Chain) (ChainDescriptor, bool) {
+	d, ok := chainDescriptorsByChain[c]
+	if !ok {
+		return ChainDescriptor{}, false
+	}
+	return *d, true
+}
+
+// GetChainDescriptors returns the descriptors of all known chains
+func GetChainDescriptors() []ChainDescriptor {
+	descriptors := make([]ChainDescriptor, len(chainDescriptors))
+	copy(descriptors, chainDescriptors)
+	return descriptors
+}
+
+// mainNetPredicate returns StageNet when running on stagenet, MainNet otherwise
+func mainNetPredicate() ChainNetwork {
+	if GetCurrentChainNetwork() == StageNet {
+		return StageNet
+	}
+	return MainNet
+}
+
+// networkPrefixes builds an AddressPrefix func from a fixed prefix per network
+func networkPrefixes(prefixes map[ChainNetwork]string) func(ChainNetwork) string {
+	return func(cn ChainNetwork) string {
+		return prefixes[cn]
+	}
+}
+
+// bech32PrefixMatcher builds an IsAddress func accepting bech32 addresses with one of the given prefixes
+func bech32PrefixMatcher(prefixes ...string) func(Address) bool {
+	return func(addr Address) bool {
+		prefix, _, _ := bech32.Decode(addr.String())
+		for _, p := range prefixes {
+			if prefix == p {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// bech32PrefixNetwork builds an AddressNetwork func from the network of each bech32 prefix,
+// MainNet prefixes resolve to StageNet when running on stagenet
+func bech32PrefixNetwork(networks map[string]ChainNetwork) func(Address) (ChainNetwork, bool) {
+	return func(addr Address) (ChainNetwork, bool) {
+		prefix, _, _ := bech32.Decode(addr.String())
+		for p, cn := range networks {
+			if !strings.EqualFold(prefix, p) {
+				continue
+			}
+			if cn == MainNet {
+				return mainNetPredicate(), true
+			}
+			return cn, true
+		}
+		return MockNet, false
+	}
+}
+
+// cosmosPubKeyToAddress builds a PubKeyToAddress func encoding the account address with the chain prefix
+func cosmosPubKeyToAddress(chain Chain) func(PubKey, ChainNetwork) (Address, error) {
+	return func(pubKey PubKey, cn ChainNetwork) (Address, error) {
+		pk, err := cosmos.GetPubKeyFromBech32(cosmos.Bech32PubKeyTypeAccPub, string(pubKey))
+		if err != nil {
+			return NoAddress, err
+		}
+		str, err := ConvertAndEncode(chain.AddressPrefix(cn), pk.Address().Bytes())
+		if err != nil {
+			return NoAddress, fmt.Errorf("fail to bech32 encode the address, err: %w", err)
+		}
+		return NewAddress(str)
+	}
+}
+
+// utxoPubKeyToAddress encodes the pubkey hash of the given pubkey with the given encoder
+func utxoPubKeyToAddress(pubKey PubKey, action string, encode func(pkh []byte) (fmt.Stringer, error)) (Address, error) {
+	pk, err := cosmos.GetPubKeyFromBech32(cosmos.Bech32PubKeyTypeAccPub, string(pubKey))
+	if err != nil {
+		return NoAddress, err
+	}
+	addr, err := encode(pk.Address().Bytes())
+	if err != nil {
+		return NoAddress, fmt.Errorf("fail to %s the address, err: %w", action, err)
+	}
+	return NewAddress(addr.String())
+}
+
+func bchAddressNetwork(addr Address) (ChainNetwork, bool) {
+	// Check mainnet other formats
+	if _, err := bchutil.DecodeAddress(addr.String(), &bchchaincfg.MainNetParams); err == nil {
+		return mainNetPredicate(), true
+	}
+	// Check testnet other formats
+	if _, err := bchutil.DecodeAddress(addr.String(), &bchchaincfg.TestNet3Params); err == nil {
+		return TestNet, true
+	}
+	// Check mocknet / regression other formats
+	if _, err := bchutil.DecodeAddress(addr.String(), &bchchaincfg.RegressionNetParams); err == nil {
+		return MockNet, true
+	}
+	return MockNet, false
+}
+
+func dashAddressNetwork(addr Address) (ChainNetwork, bool) {
+	// Check mainnet other formats
+	if _, err := dashutil.DecodeAddress(addr.String(), &dashchaincfg.MainNetParams); err == nil {
+		return MainNet, true
+	}
+	// Check testnet other formats
+	if _, err := dashutil.DecodeAddress(addr.String(), &dashchaincfg.TestNet3Params); err == nil {
+		return TestNet, true
+	}
+	// Check mocknet / regression other formats
+	if _, err := dashutil.DecodeAddress(addr.String(), &dashchaincfg.RegressionNetParams); err == nil {
+		return MockNet, true
+	}
+	return MockNet, false
+}
+
+func dogeAddressNetwork(addr Address) (ChainNetwork, bool) {
+	// Check mainnet other formats
+	if _, err := dogutil.DecodeAddress(addr.String(), &dogchaincfg.MainNetParams); err == nil {
+		return mainNetPredicate(), true
+	}
+	// Check testnet other formats
+	if _, err := dogutil.DecodeAddress(addr.String(), &dogchaincfg.TestNet3Params); err == nil {
+		return TestNet, true
+	}
+	// Check mocknet / regression other formats
+	if _, err := dogutil.DecodeAddress(addr.String(), &dogchaincfg.RegressionNetParams); err == nil {
+		return MockNet, true
+	}
+	return MockNet, false
+}
@@ -0,0 +1,44 @@
+package common
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type ChainDescriptorSuite struct{}
+
+var _ = Suite(&ChainDescriptorSuite{})
+
+func (s ChainDescriptorSuite) TestChainDescriptors(c *C) {
+	seen := make(map[Chain]bool)
+	for _, d := range GetChainDescriptors() {
+		c.Check(d.Chain.Validate(), IsNil, Commentf("%s", d.Chain))
+		c.Check(seen[d.Chain], Equals, false, Commentf("%s registered twice", d.Chain))
+		seen[d.Chain] = true
+
+		c.Check(d.GasAsset.IsEmpty(), Equals, false, Commentf("%s", d.Chain))
+		c.Check(d.GasAssetDecimals > 0, Equals, true, Commentf("%s", d.Chain))
+		c.Check(d.SigningAlgo, Equals, SigningAlgoSecp256k1, Commentf("%s", d.Chain))
+
+		// the chain methods delegate to the descriptor
+		c.Check(d.Chain.GetGasAsset().Equals(d.GasAsset), Equals, true)
+		c.Check(d.Chain.GetGasAssetDecimal(), Equals, d.GasAssetDecimals)
+		c.Check(d.Chain.MaxMemoLength(), Equals, d.MaxMemoLength)
+		c.Check(d.Chain.ApproximateBlockMilliseconds(), Equals, d.ApproximateBlockMilliseconds)
+		c.Check(d.Chain.IsEVM(), Equals, d.EVM)
+	}
+
+	d, ok := GetChainDescriptor(BTCChain)
+	c.Assert(ok, Equals, true)
+	c.Check(d.GasAsset.Equals(BTCAsset), Equals, true)
+	c.Check(d.DustThreshold, Equals, uint64(10_000))
+
+	// unknown chains fall back to the defaults
+	_, ok = GetChainDescriptor(Chain("FOO"))
+	c.Check(ok, Equals, false)
+	c.Check(Chain("FOO").GetGasAsset().IsEmpty(), Equals, true)
+	c.Check(Chain("FOO").GetSigningAlgo(), Equals, SigningAlgoSecp256k1)
+	c.Check(Chain("FOO").AddressPrefix(MainNet), Equals, "")
+	c.Check(Chain("FOO").DustThreshold().IsZero(), Equals, true)
+
+	c.Check(GetEVMChains(), DeepEquals, []Chain{ETHChain, AVAXChain})
+}
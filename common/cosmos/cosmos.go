@@ -59,6 +59,7 @@ var (
 	AttributeKeyModule           = sdk.AttributeKeyModule
 	KVStorePrefixIterator        = sdk.KVStorePrefixIterator
 	KVStoreReversePrefixIterator = sdk.KVStoreReversePrefixIterator
+	PrefixEndBytes               = sdk.PrefixEndBytes
 	NewKVStoreKey                = sdk.NewKVStoreKey
 	NewTransientStoreKey         = sdk.NewTransientStoreKey
 	StoreTypeTransient           = sdk.StoreTypeTransient
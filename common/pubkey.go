@@ -6,21 +6,9 @@ import (
 	"sort"
 	"strings"
 
-	dashutil "gitlab.com/mayachain/dashd-go/btcutil"
-	dashchaincfg "gitlab.com/mayachain/dashd-go/chaincfg"
-
 	secp256k1 "github.com/btcsuite/btcd/btcec"
-	"github.com/btcsuite/btcd/chaincfg"
-	"github.com/btcsuite/btcutil"
 	"github.com/btcsuite/btcutil/bech32"
 	"github.com/cosmos/cosmos-sdk/crypto/codec"
-	dogchaincfg "github.com/eager7/dogd/chaincfg"
-	"github.com/eager7/dogutil"
-	ltcchaincfg "github.com/ltcsuite/ltcd/chaincfg"
-	"github.com/ltcsuite/ltcutil"
-
-	bchchaincfg "github.com/gcash/bchd/chaincfg"
-	"github.com/gcash/bchutil"
 
 	eth "github.com/ethereum/go-ethereum/crypto"
 	"github.com/tendermint/tendermint/crypto"
@@ -103,119 +91,14 @@ func (pubKey PubKey) GetAddress(chain Chain) (Address, error) {
 	if pubKey.IsEmpty() {
 		return NoAddress, nil
 	}
-	chainNetwork := GetCurrentChainNetwork()
 	if chain.IsEVM() {
 		return pubKey.EVMPubkeyToAddress()
 	}
-	switch chain {
-	case BNBChain, BASEChain, THORChain, GAIAChain, KUJIChain:
-		pk, err := cosmos.GetPubKeyFromBech32(cosmos.Bech32PubKeyTypeAccPub, string(pubKey))
-		if err != nil {
-			return NoAddress, err
-		}
-		str, err := ConvertAndEncode(chain.AddressPrefix(chainNetwork), pk.Address().Bytes())
-		if err != nil {
-			return NoAddress, fmt.Errorf("fail to bech32 encode the address, err: %w", err)
-		}
-		return NewAddress(str)
-	case BTCChain:
-		pk, err := cosmos.GetPubKeyFromBech32(cosmos.Bech32PubKeyTypeAccPub, string(pubKey))
-		if err != nil {
-			return NoAddress, err
-		}
-		var net *chaincfg.Params
-		switch chainNetwork {
-		case MockNet:
-			net = &chaincfg.RegressionNetParams
-		case TestNet:
-			net = &chaincfg.TestNet3Params
-		case MainNet, StageNet:
-			net = &chaincfg.MainNetParams
-		}
-		addr, err := btcutil.NewAddressWitnessPubKeyHash(pk.Address().Bytes(), net)
-		if err != nil {
-			return NoAddress, fmt.Errorf("fail to bech32 encode the address, err: %w", err)
-		}
-		return NewAddress(addr.String())
-	case LTCChain:
-		pk, err := cosmos.GetPubKeyFromBech32(cosmos.Bech32PubKeyTypeAccPub, string(pubKey))
-		if err != nil {
-			return NoAddress, err
-		}
-		var net *ltcchaincfg.Params
-		switch chainNetwork {
-		case MockNet:
-			net = &ltcchaincfg.RegressionNetParams
-		case TestNet:
-			net = &ltcchaincfg.TestNet4Params
-		case MainNet, StageNet:
-			net = &ltcchaincfg.MainNetParams
-		}
-		addr, err := ltcutil.NewAddressWitnessPubKeyHash(pk.Address().Bytes(), net)
-		if err != nil {
-			return NoAddress, fmt.Errorf("fail to bech32 encode the address, err: %w", err)
-		}
-		return NewAddress(addr.String())
-	case DASHChain:
-		pk, err := cosmos.GetPubKeyFromBech32(cosmos.Bech32PubKeyTypeAccPub, string(pubKey))
-		if err != nil {
-			return NoAddress, err
-		}
-		var net *dashchaincfg.Params
-		switch chainNetwork {
-		case MockNet:
-			net = &dashchaincfg.RegressionNetParams
-		case TestNet:
-			net = &dashchaincfg.TestNet3Params
-		case MainNet, StageNet:
-			net = &dashchaincfg.MainNetParams
-		}
-		addr, err := dashutil.NewAddressPubKeyHash(pk.Address().Bytes(), net)
-		if err != nil {
-			return NoAddress, fmt.Errorf("fail to encode the address, err: %w", err)
-		}
-		return NewAddress(addr.String())
-	case DOGEChain:
-		pk, err := cosmos.GetPubKeyFromBech32(cosmos.Bech32PubKeyTypeAccPub, string(pubKey))
-		if err != nil {
-			return NoAddress, err
-		}
-		var net *dogchaincfg.Params
-		switch chainNetwork {
-		case MockNet:
-			net = &dogchaincfg.RegressionNetParams
-		case TestNet:
-			net = &dogchaincfg.TestNet3Params
-		case MainNet, StageNet:
-			net = &dogchaincfg.MainNetParams
-		}
-		addr, err := dogutil.NewAddressPubKeyHash(pk.Address().Bytes(), net)
-		if err != nil {
-			return NoAddress, fmt.Errorf("fail to encode the address, err: %w", err)
-		}
-		return NewAddress(addr.String())
-	case BCHChain:
-		pk, err := cosmos.GetPubKeyFromBech32(cosmos.Bech32PubKeyTypeAccPub, string(pubKey))
-		if err != nil {
-			return NoAddress, err
-		}
-		var net *bchchaincfg.Params
-		switch chainNetwork {
-		case MockNet:
-			net = &bchchaincfg.RegressionNetParams
-		case TestNet:
-			net = &bchchaincfg.TestNet3Params
-		case MainNet, StageNet:
-			net = &bchchaincfg.MainNetParams
-		}
-		addr, err := bchutil.NewAddressPubKeyHash(pk.Address().Bytes(), net)
-		if err != nil {
-			return NoAddress, fmt.Errorf("fail to encode the address, err: %w", err)
-		}
-		return NewAddress(addr.String())
+	d, ok := GetChainDescriptor(chain)
+	if !ok || d.PubKeyToAddress == nil {
+		return NoAddress, nil
 	}
-
-	return NoAddress, nil
+	return d.PubKeyToAddress(pubKey, GetCurrentChainNetwork())
 }
 
 func (pubKey PubKey) GetThorAddress() (cosmos.AccAddress, error) {
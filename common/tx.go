@@ -4,6 +4,7 @@ import (
 	"crypto/sha256"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"gitlab.com/mayachain/mayanode/common/cosmos"
@@ -21,6 +22,10 @@ type (
 // BlankTxID represent blank
 var BlankTxID = TxID("0000000000000000000000000000000000000000000000000000000000000000")
 
+// TxIDSubIndexSeparator separates the hash of a tx from the sub index of one of
+// its observations, see NewSubTxID
+const TxIDSubIndexSeparator = "-"
+
 // NewTxID parse the input hash as TxID
 func NewTxID(hash string) (TxID, error) {
 	switch len(hash) {
@@ -39,6 +44,56 @@ func NewTxID(hash string) (TxID, error) {
 	return TxID(strings.ToUpper(hash)), nil
 }
 
+// NewTxIDV106 parse the input hash as TxID, from 1.106.0 the hash may carry the
+// sub index suffix of NewSubTxID
+func NewTxIDV106(hash string) (TxID, error) {
+	base, suffix, hasSuffix := strings.Cut(hash, TxIDSubIndexSeparator)
+	if !hasSuffix {
+		return NewTxID(hash)
+	}
+	index, err := strconv.Atoi(suffix)
+	// the suffix must be canonical, so the same observation has one id
+	if err != nil || index <= 0 || strconv.Itoa(index) != suffix {
+		return TxID(""), fmt.Errorf("txid error: invalid sub index %q", suffix)
+	}
+	if _, err := NewTxID(base); err != nil {
+		return TxID(""), err
+	}
+	return TxID(strings.ToUpper(hash)), nil
+}
+
+// NewSubTxID returns the id of the index-th observation of a tx carrying
+// several inbounds (e.g. a cosmos tx with several MsgSend), the first
+// observation keeps the plain hash and the following ones get a suffix
+func NewSubTxID(hash string, index int) (TxID, error) {
+	if index < 0 {
+		return TxID(""), fmt.Errorf("txid error: negative sub index %d", index)
+	}
+	if index == 0 {
+		return NewTxID(hash)
+	}
+	return NewTxIDV106(fmt.Sprintf("%s%s%d", hash, TxIDSubIndexSeparator, index))
+}
+
+// BaseID returns the tx hash without the sub index
+func (tx TxID) BaseID() TxID {
+	base, _, _ := strings.Cut(tx.String(), TxIDSubIndexSeparator)
+	return TxID(base)
+}
+
+// SubIndex returns the sub index of the tx id, zero when it has none
+func (tx TxID) SubIndex() int {
+	_, suffix, hasSuffix := strings.Cut(tx.String(), TxIDSubIndexSeparator)
+	if !hasSuffix {
+		return 0
+	}
+	index, err := strconv.Atoi(suffix)
+	if err != nil {
+		return 0
+	}
+	return index
+}
+
 // Equals check whether two TxID are the same
 func (tx TxID) Equals(tx2 TxID) bool {
 	return strings.EqualFold(tx.String(), tx2.String())
@@ -1,6 +1,8 @@
 package common
 
 import (
+	"strings"
+
 	cosmos "gitlab.com/mayachain/mayanode/common/cosmos"
 	. "gopkg.in/check.v1"
 )
@@ -25,6 +27,40 @@ func (s TxSuite) TestTxID(c *C) {
 	c.Check(err, NotNil)
 }
 
+func (s TxSuite) TestSubTxID(c *C) {
+	hash := "a7da8ff1b7c290616d68a276f30ac618315e6cce982eb8f7a79339e163798f49"
+	tx, err := NewSubTxID(hash, 0)
+	c.Assert(err, IsNil)
+	c.Check(tx.String(), Equals, strings.ToUpper(hash))
+	c.Check(tx.SubIndex(), Equals, 0)
+	c.Check(tx.BaseID().Equals(tx), Equals, true)
+
+	tx, err = NewSubTxID(hash, 12)
+	c.Assert(err, IsNil)
+	c.Check(tx.String(), Equals, strings.ToUpper(hash)+"-12")
+	c.Check(tx.SubIndex(), Equals, 12)
+	c.Check(tx.BaseID().String(), Equals, strings.ToUpper(hash))
+
+	parsed, err := NewTxIDV106(hash + "-12")
+	c.Assert(err, IsNil)
+	c.Check(parsed.Equals(tx), Equals, true)
+	parsed, err = NewTxIDV106(hash)
+	c.Assert(err, IsNil)
+	c.Check(parsed.String(), Equals, strings.ToUpper(hash))
+	// the sub index is only accepted by the versioned parser
+	_, err = NewTxID(hash + "-12")
+	c.Check(err, NotNil)
+
+	_, err = NewSubTxID(hash, -1)
+	c.Check(err, NotNil)
+	for _, bogus := range []string{"-0", "-01", "-a", "-", "-1-2", "--1"} {
+		_, err = NewTxIDV106(hash + bogus)
+		c.Check(err, NotNil, Commentf(bogus))
+	}
+	_, err = NewTxIDV106("bogus-1")
+	c.Check(err, NotNil)
+}
+
 func (s TxSuite) TestTx(c *C) {
 	id, err := NewTxID("0xb41cf456e942f3430681298c503def54b79a96e3373ef9d44ea314d7eae41952")
 	c.Assert(err, IsNil)
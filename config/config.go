@@ -526,6 +526,14 @@ type BifrostSignerConfiguration struct {
 	SignerDbPath  string                           `mapstructure:"signer_db_path"`
 	BlockScanner  BifrostBlockScannerConfiguration `mapstructure:"block_scanner"`
 	RetryInterval time.Duration                    `mapstructure:"retry_interval"`
+
+	// Concurrency is the maximum number of chain / vault lanes signed in
+	// parallel, zero means no limit.
+	Concurrency int `mapstructure:"concurrency"`
+
+	// ChainConcurrency is the maximum number of lanes of a single chain signed
+	// in parallel, zero means no limit.
+	ChainConcurrency int `mapstructure:"chain_concurrency"`
 }
 
 type BifrostBackOff struct {
@@ -113,6 +113,14 @@ const (
 	FullImpLossProtectionBlocksTimes4
 	ZeroImpLossProtectionBlocks
 	AllowWideBlame
+	IBCTransferTimeout
+	BlameHistoryBlocks
+	PoolSnapshotInterval
+	PoolSnapshotRetention
+	MinOutboundFeeMultiplier
+	MaxOutboundFeeMultiplier
+	TargetOutboundFeeSurplusCacao
+	OutboundFeeHistoryRetention
 )
 
 var nameToString = map[ConstantName]string{
@@ -219,6 +227,14 @@ var nameToString = map[ConstantName]string{
 	FullImpLossProtectionBlocksTimes4:  "FullImpLossProtectionBlocksTimes4",
 	ZeroImpLossProtectionBlocks:        "ZeroImpLossProtectionBlocks",
 	AllowWideBlame:                     "AllowWideBlame",
+	IBCTransferTimeout:                 "IBCTransferTimeout",
+	BlameHistoryBlocks:                 "BlameHistoryBlocks",
+	PoolSnapshotInterval:               "PoolSnapshotInterval",
+	PoolSnapshotRetention:              "PoolSnapshotRetention",
+	MinOutboundFeeMultiplier:           "MinOutboundFeeMultiplier",
+	MaxOutboundFeeMultiplier:           "MaxOutboundFeeMultiplier",
+	TargetOutboundFeeSurplusCacao:      "TargetOutboundFeeSurplusCacao",
+	OutboundFeeHistoryRetention:        "OutboundFeeHistoryRetention",
 }
 
 // String implement fmt.stringer
@@ -93,6 +93,14 @@ func NewConstantValue010() *ConstantVals {
 			MinimumL1OutboundFeeUSD:            1,                // Minimum fee in USD to charge for LP swap, default to $0.01 , nodes need to vote it to a larger value
 			MinimumPoolLiquidityFee:            0,                // Minimum liquidity fee made by the pool,active pool fail to meet this within a PoolCycle will be demoted
 			SubsidizeReserveMultiplier:         100,              // Multiplier for the needed reserve amount to subsidize pools
+			IBCTransferTimeout:                 600,              // number of seconds an outbound IBC transfer can take before it times out and gets refunded
+			BlameHistoryBlocks:                 432000,           // number of blocks the keysign / keygen blame history of the nodes is kept for
+			PoolSnapshotInterval:               14400,            // number of blocks between two pool snapshots used for the yield queries, 0 to disable
+			PoolSnapshotRetention:              90,               // number of pool snapshots kept per pool
+			MinOutboundFeeMultiplier:           15_000,           // multiplier in basis points of the network fee charged on outbounds once the outbound fee surplus of a chain reaches its target
+			MaxOutboundFeeMultiplier:           30_000,           // multiplier in basis points of the network fee charged on outbounds while a chain has no outbound fee surplus
+			TargetOutboundFeeSurplusCacao:      100_000_00000000, // surplus of the outbound fees withheld over the gas spent on a chain at which the min multiplier applies
+			OutboundFeeHistoryRetention:        90,               // number of daily outbound fee records kept per chain
 		},
 		boolValues: map[ConstantName]bool{
 			StrictBondLiquidityRatio: false,
@@ -0,0 +1,313 @@
+package constants
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// MimirType the kind of value a mimir key holds
+type MimirType string
+
+const (
+	MimirTypeBool   MimirType = "bool"   // 0 is off, 1 is on
+	MimirTypeAmount MimirType = "amount" // an amount in 1e8 notation
+	MimirTypeBlocks MimirType = "blocks" // a number of blocks
+	MimirTypeHeight MimirType = "height" // a block height, the key is active once it is reached
+	MimirTypeBps    MimirType = "bps"    // basis points
+	MimirTypeInt    MimirType = "int"    // a plain number
+)
+
+// MimirSetter who is allowed to set a mimir key
+type MimirSetter string
+
+const (
+	MimirSetterAny   MimirSetter = "any"
+	MimirSetterAdmin MimirSetter = "admin"
+	MimirSetterNode  MimirSetter = "node"
+)
+
+const maxMimirValue = math.MaxInt64
+
+// MimirKey describes a mimir key. Patterned keys hold a placeholder such as
+// <chain> or <asset>, which matches the chain or the asset (in its mimir form,
+// ie BTC-BTC) of the key.
+type MimirKey struct {
+	Key         string      `json:"key"`
+	Description string      `json:"description"`
+	Type        MimirType   `json:"type"`
+	Min         int64       `json:"min"`
+	Max         int64       `json:"max"`
+	Setter      MimirSetter `json:"setter"`
+	match       *regexp.Regexp
+}
+
+var mimirPlaceholders = map[string]string{
+	"<CHAIN>": `[A-Z0-9]+`,
+	"<ASSET>": `[A-Z0-9]+-[A-Z0-9-]+`,
+}
+
+func newMimirKey(key, description string, t MimirType, min, max int64) MimirKey {
+	pattern := regexp.QuoteMeta(strings.ToUpper(key))
+	// analyze-ignore(map-iteration)
+	for placeholder, value := range mimirPlaceholders {
+		pattern = strings.ReplaceAll(pattern, placeholder, value)
+	}
+	return MimirKey{
+		Key:         key,
+		Description: description,
+		Type:        t,
+		Min:         min,
+		Max:         max,
+		Setter:      MimirSetterAny,
+		match:       regexp.MustCompile("^" + pattern + "$"),
+	}
+}
+
+func boolMimir(key, description string) MimirKey {
+	return newMimirKey(key, description, MimirTypeBool, 0, 1)
+}
+
+func amountMimir(key, description string) MimirKey {
+	return newMimirKey(key, description, MimirTypeAmount, 0, maxMimirValue)
+}
+
+func blocksMimir(key, description string, min int64) MimirKey {
+	return newMimirKey(key, description, MimirTypeBlocks, min, maxMimirValue)
+}
+
+func heightMimir(key, description string) MimirKey {
+	return newMimirKey(key, description, MimirTypeHeight, 0, maxMimirValue)
+}
+
+func bpsMimir(key, description string, max int64) MimirKey {
+	return newMimirKey(key, description, MimirTypeBps, 0, max)
+}
+
+func intMimir(key, description string, min, max int64) MimirKey {
+	return newMimirKey(key, description, MimirTypeInt, min, max)
+}
+
+func adminMimir(m MimirKey) MimirKey {
+	m.Setter = MimirSetterAdmin
+	return m
+}
+
+// IsPattern whether the key holds a placeholder
+func (m MimirKey) IsPattern() bool {
+	return strings.Contains(m.Key, "<")
+}
+
+// Matches whether the given mimir key is described by m
+func (m MimirKey) Matches(key string) bool {
+	return m.match.MatchString(strings.ToUpper(key))
+}
+
+// ValidateValue checks the value is within the bounds of the key, negative
+// values unset the key and are always valid
+func (m MimirKey) ValidateValue(value int64) error {
+	if value < 0 {
+		return nil
+	}
+	if value < m.Min || value > m.Max {
+		return fmt.Errorf("%s value %d is out of bounds [%d, %d]", m.Key, value, m.Min, m.Max)
+	}
+	return nil
+}
+
+// mimirConstantKeys describes the constants, which can all be overridden by
+// a mimir key of the same name
+var mimirConstantKeys = map[ConstantName]MimirKey{
+	BlocksPerDay:                       blocksMimir("", "Number of blocks in a day", 1),
+	BlocksPerYear:                      blocksMimir("", "Number of blocks in a year", 1),
+	OutboundTransactionFee:             amountMimir("", "Amount of cacao to withhold on all outbound transactions"),
+	NativeTransactionFee:               amountMimir("", "Amount of cacao charged on all native transactions"),
+	KillSwitchStart:                    heightMimir("", "Block height to start the kill switch of the old tokens"),
+	KillSwitchDuration:                 blocksMimir("", "Number of blocks until the kill switch no longer works", 0),
+	PoolCycle:                          blocksMimir("", "Number of blocks between each pool being made available", 1),
+	MinCacaoPoolDepth:                  amountMimir("", "Minimum cacao depth of a pool to be made available"),
+	MaxAvailablePools:                  intMimir("", "Maximum number of available pools, gas pools are excluded", 0, maxMimirValue),
+	StagedPoolCost:                     amountMimir("", "Amount of cacao taken from a staged pool every pool cycle"),
+	MinimumNodesForYggdrasil:           intMimir("", "Minimum number of active nodes to fund yggdrasil vaults", 0, maxMimirValue),
+	MinimumNodesForBFT:                 intMimir("", "Minimum number of active nodes to keep the network running", 1, maxMimirValue),
+	DesiredValidatorSet:                intMimir("", "Maximum number of validators", 1, maxMimirValue),
+	AsgardSize:                         intMimir("", "Number of members of an asgard vault", 1, maxMimirValue),
+	ChurnInterval:                      blocksMimir("", "Number of blocks between each churn", 1),
+	ChurnRetryInterval:                 blocksMimir("", "Number of blocks before a failed churn is retried", 1),
+	ValidatorsChangeWindow:             blocksMimir("", "Number of blocks the validator set can change in", 0),
+	LeaveProcessPerBlockHeight:         blocksMimir("", "Number of blocks between processing node leave requests", 0),
+	BadValidatorRedline:                intMimir("", "Redline multiplier to find a multitude of bad actors", 0, maxMimirValue),
+	BadValidatorRate:                   blocksMimir("", "Number of blocks between marking a bad validator to churn out", 1),
+	OldValidatorRate:                   blocksMimir("", "Number of blocks between marking an old validator to churn out", 1),
+	LowBondValidatorRate:               blocksMimir("", "Number of blocks between marking a low bond validator to churn out", 1),
+	LackOfObservationPenalty:           intMimir("", "Slash points for each block a node does not observe", 0, maxMimirValue),
+	SigningTransactionPeriod:           blocksMimir("", "Number of blocks before a signing request is counted as delinquent", 1),
+	DoubleSignMaxAge:                   blocksMimir("", "Number of blocks double signing is slashed for", 0),
+	PauseBond:                          boolMimir("", "Pause bonding"),
+	PauseUnbond:                        boolMimir("", "Pause unbonding"),
+	MinimumBondInCacao:                 amountMimir("", "Minimum bond for a node to be churned in"),
+	FundMigrationInterval:              blocksMimir("", "Number of blocks between each fund migration of a retiring vault", 1),
+	ArtificialRagnarokBlockHeight:      heightMimir("", "Block height to start ragnarok"),
+	MaximumLiquidityCacao:              amountMimir("", "Maximum cacao liquidity of the pools"),
+	StrictBondLiquidityRatio:           boolMimir("", "Enforce the bond to liquidity ratio"),
+	MaxOutboundAttempts:                intMimir("", "Maximum number of times an outbound is rescheduled", 0, maxMimirValue),
+	SlashPenalty:                       bpsMimir("", "Penalty paid for the theft of assets", 100_000),
+	PauseOnSlashThreshold:              amountMimir("", "Amount of cacao slashed for theft which pauses the chain"),
+	FailKeygenSlashPoints:              intMimir("", "Slash points for failing a keygen", 0, maxMimirValue),
+	FailKeysignSlashPoints:             intMimir("", "Slash points for failing a keysign", 0, maxMimirValue),
+	LiquidityLockUpBlocks:              blocksMimir("", "Number of blocks before an LP can withdraw their liquidity", 0),
+	ObserveSlashPoints:                 intMimir("", "Slash points for making an observation, redeemed on consensus", 0, maxMimirValue),
+	ObservationDelayFlexibility:        blocksMimir("", "Number of blocks an observation can lag the consensus without being slashed", 0),
+	ForgiveSlashPeriod:                 blocksMimir("", "Number of blocks a forgive slash request has to reach consensus", 0),
+	YggFundLimit:                       intMimir("", "Funding limit of the yggdrasil vaults, in percent", 0, 100),
+	YggFundRetry:                       blocksMimir("", "Number of blocks before retrying to fund a yggdrasil vault", 0),
+	JailTimeKeygen:                     blocksMimir("", "Number of blocks a node is jailed for failing a keygen", 0),
+	JailTimeKeysign:                    blocksMimir("", "Number of blocks a node is jailed for failing a keysign", 0),
+	NodePauseChainBlocks:               blocksMimir("", "Number of blocks a node operator can pause the chains for", 0),
+	MinSwapsPerBlock:                   intMimir("", "Process all the swaps when the queue is not larger than this", 0, maxMimirValue),
+	MaxSwapsPerBlock:                   intMimir("", "Maximum number of swaps processed in a block", 0, maxMimirValue),
+	MaxSlashRatio:                      intMimir("", "Maximum percentage of its bond a node can be slashed before being banned", 0, 100),
+	MaxSynthPerAssetDepth:              bpsMimir("", "Amount of synths allowed relative to the asset depth of the pool", 10_000),
+	VirtualMultSynths:                  intMimir("", "Pool depth multiplier of the synth swaps", 0, maxMimirValue),
+	VirtualMultSynthsBasisPoints:       bpsMimir("", "Pool depth multiplier of the synth swaps", maxMimirValue),
+	MinSlashPointsForBadValidator:      intMimir("", "Minimum slash points for a node to be considered bad", 0, maxMimirValue),
+	FullImpLossProtectionBlocks:        blocksMimir("", "Number of blocks before an LP gets full impermanent loss protection", 0),
+	BondLockupPeriod:                   blocksMimir("", "Number of blocks a node must wait before unbonding", 0),
+	MaxBondProviders:                   intMimir("", "Maximum number of bond providers of a node", 0, maxMimirValue),
+	NumberOfNewNodesPerChurn:           intMimir("", "Number of additional nodes targeted each churn", 0, maxMimirValue),
+	MinTxOutVolumeThreshold:            amountMimir("", "Outbound value in a block before it is considered full"),
+	TxOutDelayRate:                     newMimirKey("", "Outbound value per block of the scheduled outbounds", MimirTypeAmount, 1, maxMimirValue),
+	TxOutDelayMax:                      blocksMimir("", "Maximum number of blocks an outbound can be delayed", 0),
+	MaxTxOutOffset:                     blocksMimir("", "Maximum number of blocks an outbound can be offset", 0),
+	TNSRegisterFee:                     amountMimir("", "Registration fee of a new MAYAName"),
+	TNSFeeOnSale:                       bpsMimir("", "Fee on the sale of a MAYAName", 10_000),
+	TNSFeePerBlock:                     amountMimir("", "Cost per block of a MAYAName"),
+	PermittedSolvencyGap:               bpsMimir("", "Insolvency permitted before the chain is halted", 10_000),
+	NodeOperatorFee:                    bpsMimir("", "Default fee of the node operators", 10_000),
+	ValidatorMaxRewardRatio:            intMimir("", "Ratio to the minimum bond at which the validator rewards stop following the bond", 0, maxMimirValue),
+	PoolDepthForYggFundingMin:          amountMimir("", "Minimum pool depth for yggdrasil funding"),
+	MaxNodeToChurnOutForLowVersion:     intMimir("", "Maximum number of nodes churned out for low version each churn", 0, maxMimirValue),
+	MayaFundPerc:                       intMimir("", "Percentage of the gas for the Maya Fund", 0, 100),
+	MinCacaoForMayaFundDist:            amountMimir("", "Minimum amount to distribute the Maya Fund"),
+	WithdrawLimitTier1:                 bpsMimir("", "Withdraw limit of tier 1", 10_000),
+	WithdrawLimitTier2:                 bpsMimir("", "Withdraw limit of tier 2", 10_000),
+	WithdrawLimitTier3:                 bpsMimir("", "Withdraw limit of tier 3", 10_000),
+	WithdrawDaysTier1:                  intMimir("", "Days the withdraw limit of tier 1 is active", 0, maxMimirValue),
+	WithdrawDaysTier2:                  intMimir("", "Days the withdraw limit of tier 2 is active", 0, maxMimirValue),
+	WithdrawDaysTier3:                  intMimir("", "Days the withdraw limit of tier 3 is active", 0, maxMimirValue),
+	WithdrawTier1:                      intMimir("", "Value of withdraw tier 1", 0, maxMimirValue),
+	WithdrawTier2:                      intMimir("", "Value of withdraw tier 2", 0, maxMimirValue),
+	WithdrawTier3:                      intMimir("", "Value of withdraw tier 3", 0, maxMimirValue),
+	InflationPercentageThreshold:       bpsMimir("", "Threshold over which the inflation is zero", 10_000),
+	InflationPoolPercentage:            intMimir("", "Percentage of the inflation that goes to the pools", 0, 100),
+	InflationFormulaMulValue:           bpsMimir("", "Multiplier of the dynamic inflation formula", 10_000),
+	InflationFormulaSumValue:           bpsMimir("", "Addend of the dynamic inflation formula", 10_000),
+	IBCReceiveEnabled:                  boolMimir("", "Enable receiving IBC transfers"),
+	IBCSendEnabled:                     boolMimir("", "Enable sending IBC transfers"),
+	RagnarokProcessNumOfLPPerIteration: intMimir("", "Number of LPs processed per iteration of a pool ragnarok", 1, maxMimirValue),
+	SwapOutDexAggregationDisabled:      boolMimir("", "Disable the swap out DEX aggregation"),
+	POLMaxNetworkDeposit:               amountMimir("", "Maximum amount of cacao deposited into the pools by the POL"),
+	POLMaxPoolMovement:                 bpsMimir("", "Maximum cacao depth of a pool the POL can move per iteration", 10_000),
+	POLSynthUtilization:                bpsMimir("", "Target synth utilization of the POL", 10_000),
+	POLBuffer:                          bpsMimir("", "Buffer around the POL synth utilization", 10_000),
+	SynthYieldBasisPoints:              bpsMimir("", "Share of the yield the synth holders receive", 10_000),
+	SynthYieldCycle:                    blocksMimir("", "Number of blocks between the yield payouts of the synths", 0),
+	MinimumL1OutboundFeeUSD:            amountMimir("", "Minimum fee in USD of an L1 outbound"),
+	MinimumPoolLiquidityFee:            amountMimir("", "Minimum liquidity fee an available pool must make each pool cycle"),
+	SubsidizeReserveMultiplier:         intMimir("", "Multiplier of the reserve amount needed to subsidize the pools", 0, maxMimirValue),
+	LiquidityAuction:                   heightMimir("", "Block height the liquidity auction ends at"),
+	IncentiveCurveControl:              bpsMimir("", "Share of the rewards going to the nodes while the network is balanced", 10_000),
+	FullImpLossProtectionBlocksTimes4:  blocksMimir("", "Number of blocks before an LP gets four times the full impermanent loss protection", 0),
+	ZeroImpLossProtectionBlocks:        blocksMimir("", "Number of blocks before an LP gets any impermanent loss protection", 0),
+	AllowWideBlame:                     boolMimir("", "Allow blaming a large share of the signing parties"),
+	IBCTransferTimeout:                 intMimir("", "Number of seconds an outbound IBC transfer can take before it is refunded", 1, maxMimirValue),
+	BlameHistoryBlocks:                 blocksMimir("", "Number of blocks the blame history of the nodes is kept for", 0),
+	PoolSnapshotInterval:               blocksMimir("", "Number of blocks between two pool snapshots, 0 to disable them", 0),
+	PoolSnapshotRetention:              intMimir("", "Number of pool snapshots kept per pool", 1, 10_000),
+	MinOutboundFeeMultiplier:           bpsMimir("", "Multiplier of the network fee charged on outbounds once the outbound fee surplus of a chain reaches its target", 100_000),
+	MaxOutboundFeeMultiplier:           bpsMimir("", "Multiplier of the network fee charged on outbounds while a chain has no outbound fee surplus", 100_000),
+	TargetOutboundFeeSurplusCacao:      amountMimir("", "Outbound fee surplus of a chain at which the min outbound fee multiplier applies"),
+	OutboundFeeHistoryRetention:        intMimir("", "Number of daily outbound fee records kept per chain", 1, 10_000),
+}
+
+// mimirKeys are the mimir keys which aren't constants
+var mimirKeys = []MimirKey{
+	heightMimir("HaltTrading", "Pause all trading"),
+	heightMimir("Halt<chain>Trading", "Pause trading on a chain"),
+	heightMimir("HaltChainGlobal", "Pause all chain clients"),
+	heightMimir("Halt<chain>Chain", "Pause a chain"),
+	heightMimir("SolvencyHalt<chain>Chain", "Pause a chain, set by the solvency checker"),
+	heightMimir("NodePauseChainGlobal", "Pause all chain clients, set by a node operator"),
+	heightMimir("HaltChurning", "Pause churning"),
+	boolMimir("HaltSigning", "Pause all signing"),
+	boolMimir("HaltSigning<chain>", "Pause signing on a chain"),
+	heightMimir("PauseLP", "Pause adding and withdrawing liquidity"),
+	heightMimir("PauseLP<chain>", "Pause adding and withdrawing liquidity on a chain"),
+	boolMimir("PauseAsymWithdrawal-<chain>", "Pause asymmetric withdrawals on a chain"),
+	heightMimir("StopSolvencyCheck", "Stop the solvency checker"),
+	heightMimir("StopSolvencyCheck<chain>", "Stop the solvency checker on a chain"),
+	heightMimir("StopFundYggdrasil", "Stop funding the yggdrasil vaults"),
+	heightMimir("AsgardOnly", "Retire the yggdrasil vaults and recall their funds to asgard"),
+	heightMimir("BurnSynths", "Block height from which synths can be burned"),
+	heightMimir("MintSynths", "Block height from which synths can be minted"),
+	boolMimir("MAYANames", "Enable MAYANames"),
+	boolMimir("EnsureLiquidityNoLargerThanBond", "Keep the liquidity of the pools below the total bond"),
+	amountMimir("MaximumBondInRune", "Maximum bond of a node"),
+	intMimir("MaximumLPBondedNodes", "Maximum number of nodes an LP can bond to", 0, maxMimirValue),
+	amountMimir("MaxRuneSupply", "Maximum supply of cacao"),
+	amountMimir("DollarInRune", "Amount of cacao worth one USD"),
+	boolMimir("ILP-DISABLED-<asset>", "Disable the impermanent loss protection of a pool"),
+	intMimir("POL-<asset>", "POL of a pool: 0 paused, 1 enabled, 2 forced withdrawal", 0, 2),
+	amountMimir("POLMaxPoolDeposit-<asset>", "Maximum amount of cacao the POL deposits into a pool"),
+	boolMimir("RAGNAROK-<asset>", "Ragnarok a pool"),
+	intMimir("MaxUTXOsToSpend", "Maximum number of UTXOs an outbound of a UTXO chain spends, 0 for the bifrost default", 0, maxMimirValue),
+	adminMimir(boolMimir("MimirRecallFund", "Recall the funds of the yggdrasil vaults on ETH")),
+	adminMimir(boolMimir("MimirRecallFund<chain>", "Recall the funds of the yggdrasil vaults on a chain")),
+	adminMimir(boolMimir("MimirUpgradeContract", "Upgrade the router contract on ETH")),
+	adminMimir(boolMimir("MimirUpgradeContract<chain>", "Upgrade the router contract on a chain")),
+}
+
+var mimirRegistry = buildMimirRegistry()
+
+func buildMimirRegistry() []MimirKey {
+	registry := make([]MimirKey, 0, len(mimirConstantKeys)+len(mimirKeys))
+	// analyze-ignore(map-iteration)
+	for name, m := range mimirConstantKeys {
+		key := newMimirKey(name.String(), m.Description, m.Type, m.Min, m.Max)
+		key.Setter = m.Setter
+		registry = append(registry, key)
+	}
+	registry = append(registry, mimirKeys...)
+	sort.SliceStable(registry, func(i, j int) bool {
+		return registry[i].Key < registry[j].Key
+	})
+	return registry
+}
+
+// GetMimirRegistry returns all the known mimir keys, sorted by key
+func GetMimirRegistry() []MimirKey {
+	return mimirRegistry
+}
+
+// GetMimirKey returns the registered mimir key describing the given key,
+// plain keys take precedence over patterned ones
+func GetMimirKey(key string) (MimirKey, bool) {
+	var pattern *MimirKey
+	for i, m := range mimirRegistry {
+		if !m.Matches(key) {
+			continue
+		}
+		if !m.IsPattern() {
+			return m, true
+		}
+		if pattern == nil {
+			pattern = &mimirRegistry[i]
+		}
+	}
+	if pattern == nil {
+		return MimirKey{}, false
+	}
+	return *pattern, true
+}
@@ -0,0 +1,235 @@
+package constants
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	. "gopkg.in/check.v1"
+)
+
+type MimirRegistryTestSuite struct{}
+
+var _ = Suite(&MimirRegistryTestSuite{})
+
+func (MimirRegistryTestSuite) TestConstantsRegistered(c *C) {
+	for name := range nameToString {
+		if name == DefaultPoolStatus {
+			continue
+		}
+		m, ok := GetMimirKey(name.String())
+		c.Check(ok, Equals, true, Commentf("%s", name))
+		c.Check(m.Key, Equals, name.String())
+		c.Check(len(m.Description) > 0, Equals, true, Commentf("%s", name))
+	}
+}
+
+func (MimirRegistryTestSuite) TestDefaultsWithinBounds(c *C) {
+	keyRegex := regexp.MustCompile(MimirKeyRegex).MatchString
+	for _, cv := range []*ConstantVals{NewConstantValue010(), NewConstantValue102()} {
+		for name, value := range cv.int64values {
+			m, ok := GetMimirKey(name.String())
+			c.Assert(ok, Equals, true, Commentf("%s", name))
+			c.Check(m.ValidateValue(value), IsNil)
+		}
+	}
+	for _, m := range GetMimirRegistry() {
+		c.Check(m.Min <= m.Max, Equals, true, Commentf("%s", m.Key))
+		if !m.IsPattern() {
+			c.Check(keyRegex(m.Key), Equals, true, Commentf("%s", m.Key))
+		}
+	}
+}
+
+func (MimirRegistryTestSuite) TestGetMimirKey(c *C) {
+	m, ok := GetMimirKey("haltbtctrading")
+	c.Assert(ok, Equals, true)
+	c.Check(m.Key, Equals, "Halt<chain>Trading")
+	c.Check(m.Type, Equals, MimirTypeHeight)
+
+	m, ok = GetMimirKey("HALTTRADING")
+	c.Assert(ok, Equals, true)
+	c.Check(m.Key, Equals, "HaltTrading")
+
+	m, ok = GetMimirKey("POL-ETH-ETH")
+	c.Assert(ok, Equals, true)
+	c.Check(m.Key, Equals, "POL-<asset>")
+	c.Check(m.ValidateValue(2), IsNil)
+	c.Check(m.ValidateValue(3), NotNil)
+	c.Check(m.ValidateValue(-1), IsNil)
+
+	m, ok = GetMimirKey("MimirUpgradeContractAVAX")
+	c.Assert(ok, Equals, true)
+	c.Check(m.Setter, Equals, MimirSetterAdmin)
+
+	_, ok = GetMimirKey("POL-")
+	c.Check(ok, Equals, false)
+	_, ok = GetMimirKey("HaltTradin")
+	c.Check(ok, Equals, false)
+	_, ok = GetMimirKey("ChurnIntervall")
+	c.Check(ok, Equals, false)
+}
+
+// TestReadKeysRegistered walks the sources of x/ and bifrost/ and checks every
+// mimir key read through GetMimir is in the registry. Keys built from a
+// variable part, such as Halt<chain>Chain or POL-<asset>, are checked with a
+// chain and an asset in place of that part. Keys the test can't resolve, such
+// as function parameters, are skipped.
+func (MimirRegistryTestSuite) TestReadKeysRegistered(c *C) {
+	checked := 0
+	for _, dir := range []string{"../x", "../bifrost"} {
+		err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+				return nil
+			}
+			fset := token.NewFileSet()
+			f, err := parser.ParseFile(fset, path, nil, 0)
+			if err != nil {
+				return err
+			}
+			for _, key := range mimirKeysRead(f) {
+				checked++
+				c.Check(isRegisteredKey(key), Equals, true, Commentf("%s reads unregistered mimir key %s", path, strings.Join(key, "<?>")))
+			}
+			return nil
+		})
+		c.Assert(err, IsNil)
+	}
+	c.Check(checked > 0, Equals, true)
+}
+
+// mimirKeysRead returns the keys passed to GetMimir in the file, as their
+// literal parts split around the parts only known at runtime
+func mimirKeysRead(f *ast.File) [][]string {
+	values := make(map[string][]ast.Expr)
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.ValueSpec:
+			for i, name := range n.Names {
+				if i < len(n.Values) {
+					values[name.Name] = append(values[name.Name], n.Values[i])
+				}
+			}
+		case *ast.AssignStmt:
+			for i, lhs := range n.Lhs {
+				if ident, ok := lhs.(*ast.Ident); ok && i < len(n.Rhs) && len(n.Lhs) == len(n.Rhs) {
+					values[ident.Name] = append(values[ident.Name], n.Rhs[i])
+				}
+			}
+		}
+		return true
+	})
+
+	var keys [][]string
+	ast.Inspect(f, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) == 0 {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "GetMimir" {
+			return true
+		}
+		keys = append(keys, resolveKey(call.Args[len(call.Args)-1], values, 0)...)
+		return true
+	})
+	return keys
+}
+
+// resolveKey returns the possible values of a key expression, nothing when it
+// can't be resolved. An empty string stands for a part only known at runtime.
+func resolveKey(expr ast.Expr, values map[string][]ast.Expr, depth int) [][]string {
+	if depth > 5 {
+		return nil
+	}
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		if e.Kind != token.STRING {
+			return nil
+		}
+		value, err := strconv.Unquote(e.Value)
+		if err != nil {
+			return nil
+		}
+		return [][]string{{value}}
+	case *ast.Ident:
+		var keys [][]string
+		for _, v := range values[e.Name] {
+			keys = append(keys, resolveKey(v, values, depth+1)...)
+		}
+		return keys
+	case *ast.BinaryExpr:
+		if e.Op != token.ADD {
+			return nil
+		}
+		var keys [][]string
+		for _, x := range resolvePart(e.X, values, depth) {
+			for _, y := range resolvePart(e.Y, values, depth) {
+				keys = append(keys, append(append([]string{}, x...), y...))
+			}
+		}
+		return keys
+	case *ast.CallExpr:
+		sel, ok := e.Fun.(*ast.SelectorExpr)
+		if !ok || len(e.Args) == 0 {
+			return nil
+		}
+		if pkg, ok := sel.X.(*ast.Ident); !ok || pkg.Name != "fmt" || sel.Sel.Name != "Sprintf" {
+			return nil
+		}
+		var keys [][]string
+		for _, format := range resolveKey(e.Args[0], values, depth+1) {
+			var key []string
+			for _, part := range format {
+				if len(part) == 0 {
+					key = append(key, part)
+					continue
+				}
+				for i, literal := range regexp.MustCompile(`%[sv]`).Split(part, -1) {
+					if i > 0 {
+						key = append(key, "")
+					}
+					key = append(key, literal)
+				}
+			}
+			keys = append(keys, key)
+		}
+		return keys
+	}
+	return nil
+}
+
+// resolvePart resolves one operand of a concatenation, operands that can't be
+// resolved are only known at runtime
+func resolvePart(expr ast.Expr, values map[string][]ast.Expr, depth int) [][]string {
+	if keys := resolveKey(expr, values, depth+1); len(keys) > 0 {
+		return keys
+	}
+	return [][]string{{""}}
+}
+
+// isRegisteredKey checks the key is registered, with a chain or an asset in
+// place of the parts only known at runtime
+func isRegisteredKey(parts []string) bool {
+	for _, sample := range []string{"BTC", "BTC-BTC"} {
+		var key strings.Builder
+		for _, part := range parts {
+			if len(part) == 0 {
+				part = sample
+			}
+			key.WriteString(part)
+		}
+		if _, ok := GetMimirKey(key.String()); ok {
+			return true
+		}
+	}
+	return false
+}
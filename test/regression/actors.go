@@ -0,0 +1,109 @@
+package regression
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+
+	"gitlab.com/mayachain/mayanode/cmd"
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/x/mayachain/types"
+)
+
+// actorMnemonics are the well known mnemonics of the simulated participants.
+// Suites refer to them by name through the template functions, e.g.
+// {{ addr_maya_dog }} or {{ pubkey_cat }}.
+var actorMnemonics = map[string]string{
+	"dog":  "dog dog dog dog dog dog dog dog dog dog dog dog dog dog dog dog dog dog dog dog dog dog dog cable",
+	"cat":  "cat cat cat cat cat cat cat cat cat cat cat cat cat cat cat cat cat cat cat cat cat cat cat blanket",
+	"fox":  "fox fox fox fox fox fox fox fox fox fox fox fox fox fox fox fox fox fox fox fox fox fox fox awake",
+	"pig":  "pig pig pig pig pig pig pig pig pig pig pig pig pig pig pig pig pig pig pig pig pig pig pig blade",
+	"frog": "frog frog frog frog frog frog frog frog frog frog frog frog frog frog frog frog frog frog frog frog frog frog frog blossom",
+	"goat": "goat goat goat goat goat goat goat goat goat goat goat goat goat goat goat goat goat goat goat goat goat goat goat answer",
+}
+
+// actor is a simulated participant. Every actor can act as a user and as a
+// node operator, the keys are derived the same way a real node derives them.
+type actor struct {
+	name       string
+	mnemonic   string
+	privKey    cryptotypes.PrivKey
+	pubKey     common.PubKey
+	consPubKey string
+	address    cosmos.AccAddress
+}
+
+func newActor(name, mnemonic string) (*actor, error) {
+	derived, err := hd.Secp256k1.Derive()(mnemonic, "", cmd.BASEChainHDPath)
+	if err != nil {
+		return nil, fmt.Errorf("fail to derive key for %s: %w", name, err)
+	}
+	privKey := hd.Secp256k1.Generate()(derived)
+	pubKey, err := cosmos.Bech32ifyPubKey(cosmos.Bech32PubKeyTypeAccPub, privKey.PubKey())
+	if err != nil {
+		return nil, fmt.Errorf("fail to bech32 encode pub key of %s: %w", name, err)
+	}
+	consPubKey, err := cosmos.Bech32ifyPubKey(cosmos.Bech32PubKeyTypeConsPub, ed25519.GenPrivKeyFromSecret([]byte(mnemonic)).PubKey())
+	if err != nil {
+		return nil, fmt.Errorf("fail to bech32 encode consensus pub key of %s: %w", name, err)
+	}
+	return &actor{
+		name:       name,
+		mnemonic:   mnemonic,
+		privKey:    privKey,
+		pubKey:     common.PubKey(pubKey),
+		consPubKey: consPubKey,
+		address:    cosmos.AccAddress(privKey.PubKey().Address()),
+	}, nil
+}
+
+// actors is the set of simulated participants indexed by name
+type actors map[string]*actor
+
+func newActors() (actors, error) {
+	// addresses are encoded with the prefixes of the network
+	types.SetupConfigForTest()
+	result := make(actors, len(actorMnemonics))
+	for name, mnemonic := range actorMnemonics {
+		a, err := newActor(name, mnemonic)
+		if err != nil {
+			return nil, err
+		}
+		result[name] = a
+	}
+	return result, nil
+}
+
+// names returns the actor names in a stable order
+func (a actors) names() []string {
+	names := make([]string, 0, len(a))
+	for name := range a {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// byAddress returns the actor owning the given native address
+func (a actors) byAddress(addr cosmos.AccAddress) (*actor, bool) {
+	for _, item := range a {
+		if item.address.Equals(addr) {
+			return item, true
+		}
+	}
+	return nil, false
+}
+
+// byPubKey returns the actor owning the given pub key
+func (a actors) byPubKey(pk common.PubKey) (*actor, bool) {
+	for _, item := range a {
+		if item.pubKey.Equals(pk) {
+			return item, true
+		}
+	}
+	return nil, false
+}
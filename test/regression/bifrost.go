@@ -0,0 +1,126 @@
+package regression
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/x/mayachain/types"
+)
+
+// bifrost simulates the bifrost of every actor running a node. It signs
+// observations on behalf of the active vault members and answers keygens with
+// a pool key derived from the keygen id, so churns complete on their own.
+type bifrost struct {
+	h *Harness
+}
+
+func newBifrost(h *Harness) *bifrost {
+	return &bifrost{h: h}
+}
+
+// activeVaults returns the active asgard vaults
+func (b *bifrost) activeVaults() ([]types.QueryVaultResp, error) {
+	var vaults []types.QueryVaultResp
+	if err := b.h.getJSON("/mayachain/vaults/asgard", &vaults); err != nil {
+		return nil, err
+	}
+	active := vaults[:0]
+	for _, vault := range vaults {
+		if vault.Status == types.VaultStatus_ActiveVault {
+			active = append(active, vault)
+		}
+	}
+	return active, nil
+}
+
+// observers returns the actors that are members of an active asgard vault,
+// those are the nodes whose bifrost observes and signs transactions
+func (b *bifrost) observers() ([]*actor, error) {
+	vaults, err := b.activeVaults()
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	var result []*actor
+	for _, vault := range vaults {
+		for _, member := range vault.Membership {
+			a, ok := b.h.actors.byPubKey(common.PubKey(member))
+			if !ok || seen[a.name] {
+				continue
+			}
+			seen[a.name] = true
+			result = append(result, a)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].name < result[j].name
+	})
+	return result, nil
+}
+
+// afterBlock answers the keygens of the last block, every member sends a
+// MsgTssPool that is delivered in the next block
+func (b *bifrost) afterBlock() error {
+	height := b.h.Height()
+	var chains []string
+	for _, name := range b.h.actors.names() {
+		a := b.h.actors[name]
+		var res types.QueryKeygenBlock
+		if err := b.h.getJSON(fmt.Sprintf("/mayachain/keygen/%d/%s", height, a.pubKey), &res); err != nil {
+			return err
+		}
+		for _, keygen := range res.KeygenBlock.Keygens {
+			if chains == nil {
+				var err error
+				if chains, err = b.vaultChains(); err != nil {
+					return err
+				}
+			}
+			poolPubKey, err := keygenPoolPubKey(keygen)
+			if err != nil {
+				return err
+			}
+			msg, err := types.NewMsgTssPool(keygen.GetMembers().Strings(), poolPubKey, keygen.Type, height, types.Blame{}, chains, a.address, 0)
+			if err != nil {
+				return fmt.Errorf("fail to create tss pool msg: %w", err)
+			}
+			b.h.Queue(msg)
+		}
+	}
+	return nil
+}
+
+// vaultChains returns the chains of the active vaults, a new vault supports
+// the same chains
+func (b *bifrost) vaultChains() ([]string, error) {
+	vaults, err := b.activeVaults()
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	chains := []string{}
+	for _, vault := range vaults {
+		for _, chain := range vault.Chains {
+			if !seen[chain] {
+				seen[chain] = true
+				chains = append(chains, chain)
+			}
+		}
+	}
+	return chains, nil
+}
+
+// keygenPoolPubKey derives the pool pub key of a keygen, all members derive
+// the same key the way a real TSS keygen agrees on one
+func keygenPoolPubKey(keygen types.Keygen) (common.PubKey, error) {
+	pk := secp256k1.GenPrivKeyFromSecret([]byte(keygen.ID)).PubKey()
+	bech, err := cosmos.Bech32ifyPubKey(cosmos.Bech32PubKeyTypeAccPub, pk)
+	if err != nil {
+		return common.EmptyPubKey, fmt.Errorf("fail to bech32 encode pool pub key: %w", err)
+	}
+	return common.NewPubKey(bech)
+}
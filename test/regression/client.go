@@ -0,0 +1,30 @@
+package regression
+
+import (
+	"context"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/bytes"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"gitlab.com/mayachain/mayanode/app"
+)
+
+// abciClient answers the ABCI queries of the REST routes straight from the
+// in-process app. The REST routes never use anything but queries, so the rest
+// of the client interface is left unimplemented.
+type abciClient struct {
+	rpcclient.Client
+	app *app.BASEChainApp
+}
+
+func (c abciClient) ABCIQueryWithOptions(_ context.Context, path string, data bytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*ctypes.ResultABCIQuery, error) {
+	res := c.app.Query(abci.RequestQuery{
+		Path:   path,
+		Data:   data,
+		Height: opts.Height,
+		Prove:  opts.Prove,
+	})
+	return &ctypes.ResultABCIQuery{Response: res}, nil
+}
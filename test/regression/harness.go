@@ -0,0 +1,434 @@
+package regression
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	ckeys "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	se "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	"github.com/gorilla/mux"
+	abci "github.com/tendermint/tendermint/abci/types"
+	cryptoenc "github.com/tendermint/tendermint/crypto/encoding"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"gitlab.com/mayachain/mayanode/app"
+	"gitlab.com/mayachain/mayanode/app/params"
+	"gitlab.com/mayachain/mayanode/cmd"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/config"
+	"gitlab.com/mayachain/mayanode/constants"
+	"gitlab.com/mayachain/mayanode/x/mayachain"
+	"gitlab.com/mayachain/mayanode/x/mayachain/client/rest"
+	"gitlab.com/mayachain/mayanode/x/mayachain/types"
+)
+
+const (
+	chainID        = "mayachain"
+	storeName      = "mayachain"
+	signerName     = "mayachain"
+	signerPassword = "password"
+)
+
+// genesisTime is the fixed time of the genesis block, every following block is
+// MayachainBlockTime later so suites are fully deterministic
+var genesisTime = time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// Harness drives an in-process mayachain app. Messages queued by scenario
+// operations are delivered in the next block, queries go through the same REST
+// routes mayanode serves, and a simulated bifrost takes care of the node side
+// of keygens.
+type Harness struct {
+	app        *app.BASEChainApp
+	encoding   params.EncodingConfig
+	handler    cosmos.Handler
+	router     *mux.Router
+	actors     actors
+	bifrost    *bifrost
+	genesis    map[string]interface{}
+	started    bool
+	header     tmproto.Header
+	validators map[string]abci.Validator
+	sequences  map[string]uint64
+	pending    []cosmos.Msg
+	lastBlock  blockResult
+}
+
+// blockResult is the outcome of the last created block, the events are
+// flattened into a type plus attribute map so they can be asserted on
+type blockResult struct {
+	Height           int64               `json:"height"`
+	BeginBlockEvents []map[string]string `json:"begin_block_events"`
+	Txs              []txResult          `json:"txs"`
+	EndBlockEvents   []map[string]string `json:"end_block_events"`
+}
+
+type txResult struct {
+	Hash   string              `json:"hash"`
+	Code   uint32              `json:"code"`
+	Log    string              `json:"log"`
+	Events []map[string]string `json:"events"`
+}
+
+// NewHarness creates a mayachain app that keeps its keyring in home and its
+// state in memory. The chain is started with InitChain once the first
+// operation that isn't a state change runs.
+func NewHarness(home string, logger log.Logger) (*Harness, error) {
+	// the querier signs keygen blocks with the node key, and the api rate
+	// limiter would throttle the simulated bifrost
+	for key, value := range map[string]string{
+		cosmos.EnvChainHome:        home,
+		cosmos.EnvSignerName:       signerName,
+		cosmos.EnvSignerPassword:   signerPassword,
+		"THORNODE_API_LIMIT_COUNT": "1000000",
+	} {
+		if err := os.Setenv(key, value); err != nil {
+			return nil, fmt.Errorf("fail to set %s: %w", key, err)
+		}
+	}
+	config.Init()
+
+	all, err := newActors()
+	if err != nil {
+		return nil, err
+	}
+	if err := setupKeyring(home, all["dog"]); err != nil {
+		return nil, err
+	}
+
+	encoding := app.MakeEncodingConfig()
+	mayaApp := app.New(chainID, logger, dbm.NewMemDB(), nil, false, map[int64]bool{}, home, 0, encoding, false)
+	// the router is only available until the app is sealed by loading the store
+	handler := mayaApp.Router().Route(cosmos.Context{}, types.RouterKey)
+	if err := mayaApp.LoadLatestVersion(); err != nil {
+		return nil, fmt.Errorf("fail to load app store: %w", err)
+	}
+
+	mayaGenesis := mayachain.DefaultGenesisState()
+	genesis := make(map[string]interface{})
+	for module, raw := range app.NewDefaultGenesisState() {
+		if len(raw) == 0 {
+			continue
+		}
+		var state interface{}
+		if err := json.Unmarshal(raw, &state); err != nil {
+			return nil, fmt.Errorf("fail to parse default %s genesis: %w", module, err)
+		}
+		genesis[module] = state
+	}
+	// the module default is empty, start from the state a new network has
+	var mayaState interface{}
+	if err := json.Unmarshal(types.ModuleCdc.MustMarshalJSON(&mayaGenesis), &mayaState); err != nil {
+		return nil, fmt.Errorf("fail to parse default mayachain genesis: %w", err)
+	}
+	genesis[types.ModuleName] = mayaState
+
+	h := &Harness{
+		app:        mayaApp,
+		encoding:   encoding,
+		handler:    handler,
+		router:     mux.NewRouter(),
+		actors:     all,
+		genesis:    genesis,
+		header:     tmproto.Header{ChainID: chainID, Time: genesisTime},
+		validators: make(map[string]abci.Validator),
+		sequences:  make(map[string]uint64),
+	}
+	h.bifrost = newBifrost(h)
+	clientCtx := client.Context{}.
+		WithClient(abciClient{app: mayaApp}).
+		WithLegacyAmino(encoding.Amino).
+		WithCodec(encoding.Marshaler).
+		WithInterfaceRegistry(encoding.InterfaceRegistry).
+		WithTxConfig(encoding.TxConfig).
+		WithChainID(chainID)
+	rest.RegisterRoutes(clientCtx, h.router, storeName)
+	return h, nil
+}
+
+// setupKeyring creates the keyring the querier uses to sign keygen blocks
+func setupKeyring(home string, signer *actor) error {
+	buf := bytes.NewBufferString(signerPassword)
+	// the library used by keyring is using ReadLine , which expect a new line
+	buf.WriteByte('\n')
+	buf.WriteString(signerPassword)
+	buf.WriteByte('\n')
+	kb, err := ckeys.New(cosmos.KeyringServiceName(), ckeys.BackendFile, home, buf)
+	if err != nil {
+		return fmt.Errorf("fail to create keyring: %w", err)
+	}
+	if _, err := kb.NewAccount(signerName, signer.mnemonic, "", cmd.BASEChainHDPath, hd.Secp256k1); err != nil {
+		return fmt.Errorf("fail to add signer key: %w", err)
+	}
+	return nil
+}
+
+// MergeState deep merges the given genesis document into the genesis of the
+// chain. Objects are merged key by key, everything else is replaced.
+func (h *Harness) MergeState(genesis map[string]interface{}) error {
+	if h.started {
+		return errors.New("state can only be changed before the first block")
+	}
+	appState, ok := genesis["app_state"]
+	if !ok {
+		return errors.New("state has no app_state")
+	}
+	state, ok := appState.(map[string]interface{})
+	if !ok {
+		return errors.New("app_state must be an object")
+	}
+	merged, _ := deepMerge(h.genesis, state).(map[string]interface{})
+	h.genesis = merged
+	return nil
+}
+
+func deepMerge(dst, src interface{}) interface{} {
+	dstMap, ok := dst.(map[string]interface{})
+	if !ok {
+		return src
+	}
+	srcMap, ok := src.(map[string]interface{})
+	if !ok {
+		return src
+	}
+	for key, value := range srcMap {
+		dstMap[key] = deepMerge(dstMap[key], value)
+	}
+	return dstMap
+}
+
+// start initialises the chain from the merged genesis state
+func (h *Harness) start() (err error) {
+	if h.started {
+		return nil
+	}
+	h.started = true
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("fail to init chain: %v", r)
+		}
+	}()
+
+	appState, err := json.Marshal(h.genesis)
+	if err != nil {
+		return fmt.Errorf("fail to marshal genesis: %w", err)
+	}
+	res := h.app.InitChain(abci.RequestInitChain{
+		ChainId:       chainID,
+		Time:          genesisTime,
+		AppStateBytes: appState,
+	})
+	h.updateValidators(res.Validators)
+	return nil
+}
+
+// Queue adds messages to be delivered in the next block
+func (h *Harness) Queue(msgs ...cosmos.Msg) {
+	h.pending = append(h.pending, msgs...)
+}
+
+// Height returns the height of the last created block
+func (h *Harness) Height() int64 {
+	return h.header.Height
+}
+
+// CreateBlocks creates count blocks, the queued messages are delivered in the
+// first one
+func (h *Harness) CreateBlocks(count int64) error {
+	if err := h.start(); err != nil {
+		return err
+	}
+	for i := int64(0); i < count; i++ {
+		if err := h.createBlock(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *Harness) createBlock() (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("block %d panicked: %v", h.header.Height, r)
+		}
+	}()
+
+	h.header.Height++
+	h.header.Time = h.header.Time.Add(constants.MayachainBlockTime)
+	result := blockResult{Height: h.header.Height}
+
+	begin := h.app.BeginBlock(abci.RequestBeginBlock{
+		Header:         h.header,
+		LastCommitInfo: abci.LastCommitInfo{Votes: h.votes()},
+	})
+	result.BeginBlockEvents = flattenEvents(begin.Events)
+
+	msgs := h.pending
+	h.pending = nil
+	for _, msg := range msgs {
+		result.Txs = append(result.Txs, h.deliver(msg))
+	}
+
+	end := h.app.EndBlock(abci.RequestEndBlock{Height: h.header.Height})
+	result.EndBlockEvents = flattenEvents(end.Events)
+	h.updateValidators(end.ValidatorUpdates)
+	h.app.Commit()
+	h.lastBlock = result
+
+	return h.bifrost.afterBlock()
+}
+
+// deliver routes the message to the mayachain handler the same way the
+// baseapp does, signatures and fees are not checked
+func (h *Harness) deliver(msg cosmos.Msg) txResult {
+	txBytes, err := h.encodeTx(msg)
+	if err != nil {
+		return txResult{Code: se.ErrTxDecode.ABCICode(), Log: err.Error()}
+	}
+	result := txResult{Hash: fmt.Sprintf("%X", tmtypes.Tx(txBytes).Hash())}
+
+	if err := msg.ValidateBasic(); err != nil {
+		_, result.Code, result.Log = se.ABCIInfo(err, false)
+		return result
+	}
+	ctx := h.app.NewContext(false, h.header).
+		WithTxBytes(txBytes).
+		WithEventManager(cosmos.NewEventManager())
+	cacheCtx, write := ctx.CacheContext()
+	res, err := h.handler(cacheCtx, msg)
+	if err != nil {
+		_, result.Code, result.Log = se.ABCIInfo(err, false)
+		return result
+	}
+	write()
+	result.Log = res.Log
+	result.Events = flattenEvents(res.Events)
+	return result
+}
+
+// encodeTx wraps the message in an unsigned transaction, the signer info
+// carries a per signer sequence so identical messages get distinct hashes
+func (h *Harness) encodeTx(msg cosmos.Msg) ([]byte, error) {
+	builder := h.encoding.TxConfig.NewTxBuilder()
+	if err := builder.SetMsgs(msg); err != nil {
+		return nil, err
+	}
+	if legacy, ok := msg.(legacytx.LegacyMsg); ok && len(legacy.GetSigners()) > 0 {
+		signer := legacy.GetSigners()[0]
+		if a, ok := h.actors.byAddress(signer); ok {
+			sequence := h.sequences[signer.String()]
+			h.sequences[signer.String()] = sequence + 1
+			if err := builder.SetSignatures(signing.SignatureV2{
+				PubKey:   a.privKey.PubKey(),
+				Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+				Sequence: sequence,
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return h.encoding.TxConfig.TxEncoder()(builder.GetTx())
+}
+
+func (h *Harness) votes() []abci.VoteInfo {
+	keys := make([]string, 0, len(h.validators))
+	for key := range h.validators {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	votes := make([]abci.VoteInfo, 0, len(keys))
+	for _, key := range keys {
+		votes = append(votes, abci.VoteInfo{Validator: h.validators[key], SignedLastBlock: true})
+	}
+	return votes
+}
+
+func (h *Harness) updateValidators(updates []abci.ValidatorUpdate) {
+	for _, update := range updates {
+		addr, err := consensusAddress(update)
+		if err != nil {
+			continue
+		}
+		key := hex.EncodeToString(addr)
+		if update.Power == 0 {
+			delete(h.validators, key)
+			continue
+		}
+		h.validators[key] = abci.Validator{Address: addr, Power: update.Power}
+	}
+}
+
+// consensusAddress returns the consensus address of the validator update
+func consensusAddress(update abci.ValidatorUpdate) ([]byte, error) {
+	pk, err := cryptoenc.PubKeyFromProto(update.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	return pk.Address(), nil
+}
+
+// Get queries the given endpoint through the mayanode REST routes and returns
+// the decoded JSON response
+func (h *Harness) Get(endpoint string) (interface{}, error) {
+	var result interface{}
+	return result, h.getJSON(endpoint, &result)
+}
+
+func (h *Harness) getJSON(endpoint string, result interface{}) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid endpoint %s: %w", endpoint, err)
+	}
+	req := httptest.NewRequest(http.MethodGet, u.RequestURI(), nil)
+	rec := httptest.NewRecorder()
+	h.router.ServeHTTP(rec, req)
+	body, err := io.ReadAll(rec.Body)
+	if err != nil {
+		return fmt.Errorf("fail to read response of %s: %w", endpoint, err)
+	}
+	if rec.Code != http.StatusOK {
+		return fmt.Errorf("%s returned %d: %s", endpoint, rec.Code, string(body))
+	}
+	if err := json.Unmarshal(body, result); err != nil {
+		return fmt.Errorf("fail to decode response of %s: %w", endpoint, err)
+	}
+	return nil
+}
+
+// LastBlock returns the result of the last created block as a JSON value
+func (h *Harness) LastBlock() (interface{}, error) {
+	buf, err := json.Marshal(h.lastBlock)
+	if err != nil {
+		return nil, err
+	}
+	var result interface{}
+	return result, json.Unmarshal(buf, &result)
+}
+
+func flattenEvents(events []abci.Event) []map[string]string {
+	result := make([]map[string]string, 0, len(events))
+	for _, event := range events {
+		item := map[string]string{"type": event.Type}
+		for _, attr := range event.Attributes {
+			item[string(attr.Key)] = string(attr.Value)
+		}
+		result = append(result, item)
+	}
+	return result
+}
@@ -0,0 +1,48 @@
+package regression
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/itchyny/gojq"
+)
+
+// compileJQ parses the given jq expression
+func compileJQ(src string) (*gojq.Code, error) {
+	query, err := gojq.Parse(src)
+	if err != nil {
+		return nil, err
+	}
+	return gojq.Compile(query)
+}
+
+// evalJQ runs the jq expression against the input. The input is normalised
+// to the types encoding/json produces, which are the ones gojq accepts.
+func evalJQ(src string, input interface{}) ([]interface{}, error) {
+	code, err := compileJQ(src)
+	if err != nil {
+		return nil, fmt.Errorf("fail to parse %q: %w", src, err)
+	}
+	buf, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+	var normalised interface{}
+	if err := json.Unmarshal(buf, &normalised); err != nil {
+		return nil, err
+	}
+
+	var values []interface{}
+	iter := code.Run(normalised)
+	for {
+		value, ok := iter.Next()
+		if !ok {
+			break
+		}
+		if err, ok := value.(error); ok {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
@@ -0,0 +1,59 @@
+package regression
+
+import (
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+func TestPackage(t *testing.T) { TestingT(t) }
+
+type JQSuite struct{}
+
+var _ = Suite(&JQSuite{})
+
+func (s *JQSuite) TestEval(c *C) {
+	input := map[string]interface{}{
+		"status": "Active",
+		"bond":   "100",
+		"coins": []interface{}{
+			map[string]interface{}{"asset": "BTC.BTC", "amount": "10"},
+			map[string]interface{}{"asset": "ETH.ETH", "amount": "20"},
+		},
+		"frozen": []interface{}{},
+	}
+	testCases := []struct {
+		expr     string
+		expected []interface{}
+	}{
+		{`.status`, []interface{}{"Active"}},
+		{`.missing`, []interface{}{nil}},
+		{`.coins|length`, []interface{}{2}},
+		{`.coins[].asset`, []interface{}{"BTC.BTC", "ETH.ETH"}},
+		{`[.coins[]|select(.asset == "BTC.BTC")]|length == 1`, []interface{}{true}},
+		{`(.bond|tonumber) > 50 and .status == "Active"`, []interface{}{true}},
+		{`.frozen|length == 0`, []interface{}{true}},
+	}
+	for _, tc := range testCases {
+		result, err := evalJQ(tc.expr, input)
+		c.Assert(err, IsNil, Commentf("%s", tc.expr))
+		c.Check(result, DeepEquals, tc.expected, Commentf("%s", tc.expr))
+	}
+}
+
+func (s *JQSuite) TestErrors(c *C) {
+	for _, expr := range []string{
+		`.foo |`,
+		`[1, 2`,
+		`unknown_function`,
+		`"unterminated`,
+	} {
+		_, err := compileJQ(expr)
+		c.Check(err, NotNil, Commentf("%s", expr))
+	}
+
+	_, err := evalJQ(`.foo|length`, map[string]interface{}{"foo": true})
+	c.Check(err, NotNil)
+	_, err = evalJQ(`.[0]`, map[string]interface{}{})
+	c.Check(err, NotNil)
+}
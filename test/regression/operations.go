@@ -0,0 +1,299 @@
+package regression
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/x/mayachain/types"
+)
+
+// Operation is a single step of a suite
+type Operation interface {
+	Execute(h *Harness) error
+}
+
+// operationTypes maps the type of an operation document to a constructor of
+// the operation it decodes into
+var operationTypes = map[string]func() Operation{
+	"state":           func() Operation { return &OpState{} },
+	"create-blocks":   func() Operation { return &OpCreateBlocks{} },
+	"check":           func() Operation { return &OpCheck{} },
+	"check-events":    func() Operation { return &OpCheckEvents{} },
+	"tx-deposit":      func() Operation { return &OpTxDeposit{} },
+	"tx-send":         func() Operation { return &OpTxSend{} },
+	"tx-mimir":        func() Operation { return &OpTxMimir{} },
+	"tx-network-fee":  func() Operation { return &OpTxNetworkFee{} },
+	"tx-observed-in":  func() Operation { return &OpTxObservedIn{} },
+	"tx-observed-out": func() Operation { return &OpTxObservedOut{} },
+	"tx-tss-keysign":  func() Operation { return &OpTxTssKeysign{} },
+}
+
+// NewOperation decodes an operation from its JSON document, unknown fields
+// are rejected so typos in suites don't go unnoticed
+func NewOperation(doc []byte) (Operation, error) {
+	var header struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(doc, &header); err != nil {
+		return nil, fmt.Errorf("fail to decode operation: %w", err)
+	}
+	newOp, ok := operationTypes[header.Type]
+	if !ok {
+		return nil, fmt.Errorf("unknown operation type %q", header.Type)
+	}
+	op := newOp()
+	dec := json.NewDecoder(bytes.NewReader(doc))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(op); err != nil {
+		return nil, fmt.Errorf("fail to decode %s operation: %w", header.Type, err)
+	}
+	return op, nil
+}
+
+// ------------------------------------------------------------------------------------------------
+// Chain
+// ------------------------------------------------------------------------------------------------
+
+// OpState merges the genesis document into the genesis state of the chain,
+// it is only valid before the first block
+type OpState struct {
+	Type    string                 `json:"type"`
+	Genesis map[string]interface{} `json:"genesis"`
+}
+
+func (op *OpState) Execute(h *Harness) error {
+	return h.MergeState(op.Genesis)
+}
+
+// OpCreateBlocks creates blocks, the transactions of the previous operations
+// are delivered in the first one
+type OpCreateBlocks struct {
+	Type  string `json:"type"`
+	Count int64  `json:"count"`
+}
+
+func (op *OpCreateBlocks) Execute(h *Harness) error {
+	if op.Count <= 0 {
+		return errors.New("count must be positive")
+	}
+	return h.CreateBlocks(op.Count)
+}
+
+// ------------------------------------------------------------------------------------------------
+// Checks
+// ------------------------------------------------------------------------------------------------
+
+// OpCheck queries an endpoint and evaluates the jq asserts on the response
+type OpCheck struct {
+	Type        string   `json:"type"`
+	Description string   `json:"description"`
+	Endpoint    string   `json:"endpoint"`
+	Asserts     []string `json:"asserts"`
+}
+
+func (op *OpCheck) Execute(h *Harness) error {
+	if err := h.start(); err != nil {
+		return err
+	}
+	res, err := h.Get(op.Endpoint)
+	if err != nil {
+		return err
+	}
+	return assertAll(op.Description, res, op.Asserts)
+}
+
+// OpCheckEvents evaluates the jq asserts on the events of the last block. The
+// input has begin_block_events, end_block_events and txs, every event is an
+// object with its type and attributes.
+type OpCheckEvents struct {
+	Type        string   `json:"type"`
+	Description string   `json:"description"`
+	Asserts     []string `json:"asserts"`
+}
+
+func (op *OpCheckEvents) Execute(h *Harness) error {
+	block, err := h.LastBlock()
+	if err != nil {
+		return err
+	}
+	return assertAll(op.Description, block, op.Asserts)
+}
+
+func assertAll(description string, input interface{}, asserts []string) error {
+	for _, assert := range asserts {
+		values, err := evalJQ(assert, input)
+		if err != nil {
+			return fmt.Errorf("%s: %w", description, err)
+		}
+		if len(values) == 0 {
+			return fmt.Errorf("%s: assert %q returned nothing", description, assert)
+		}
+		for _, value := range values {
+			if value != true {
+				buf, _ := json.MarshalIndent(input, "", "  ")
+				return fmt.Errorf("%s: assert %q returned %v\n%s", description, assert, value, string(buf))
+			}
+		}
+	}
+	return nil
+}
+
+// ------------------------------------------------------------------------------------------------
+// Transactions
+// ------------------------------------------------------------------------------------------------
+
+// OpTxDeposit is a MsgDeposit of native coins
+type OpTxDeposit struct {
+	Type   string            `json:"type"`
+	Signer cosmos.AccAddress `json:"signer"`
+	Coins  common.Coins      `json:"coins"`
+	Memo   string            `json:"memo"`
+}
+
+func (op *OpTxDeposit) Execute(h *Harness) error {
+	h.Queue(types.NewMsgDeposit(op.Coins, op.Memo, op.Signer))
+	return nil
+}
+
+// OpTxSend is a MsgSend of native coins
+type OpTxSend struct {
+	Type        string            `json:"type"`
+	FromAddress cosmos.AccAddress `json:"from_address"`
+	ToAddress   cosmos.AccAddress `json:"to_address"`
+	Amount      cosmos.Coins      `json:"amount"`
+}
+
+func (op *OpTxSend) Execute(h *Harness) error {
+	h.Queue(types.NewMsgSend(op.FromAddress, op.ToAddress, op.Amount))
+	return nil
+}
+
+// OpTxMimir is a MsgMimir, signed by an admin it sets the mimir, signed by a
+// node it is a node vote
+type OpTxMimir struct {
+	Type   string            `json:"type"`
+	Signer cosmos.AccAddress `json:"signer"`
+	Key    string            `json:"key"`
+	Value  int64             `json:"value"`
+}
+
+func (op *OpTxMimir) Execute(h *Harness) error {
+	h.Queue(types.NewMsgMimir(op.Key, op.Value, op.Signer))
+	return nil
+}
+
+// OpTxNetworkFee is a MsgNetworkFee, without signer every observing node
+// sends it
+type OpTxNetworkFee struct {
+	Type               string            `json:"type"`
+	Signer             cosmos.AccAddress `json:"signer"`
+	BlockHeight        int64             `json:"block_height"`
+	Chain              common.Chain      `json:"chain"`
+	TransactionSize    uint64            `json:"transaction_size"`
+	TransactionFeeRate uint64            `json:"transaction_rate"`
+}
+
+func (op *OpTxNetworkFee) Execute(h *Harness) error {
+	signers, err := h.signers(op.Signer)
+	if err != nil {
+		return err
+	}
+	for _, signer := range signers {
+		h.Queue(types.NewMsgNetworkFee(op.BlockHeight, op.Chain, op.TransactionSize, op.TransactionFeeRate, signer))
+	}
+	return nil
+}
+
+// OpTxObservedIn is a MsgObservedTxIn, without signer every observing node
+// sends it
+type OpTxObservedIn struct {
+	Type   string            `json:"type"`
+	Signer cosmos.AccAddress `json:"signer"`
+	Txs    types.ObservedTxs `json:"txs"`
+}
+
+func (op *OpTxObservedIn) Execute(h *Harness) error {
+	signers, err := h.signers(op.Signer)
+	if err != nil {
+		return err
+	}
+	for _, signer := range signers {
+		h.Queue(types.NewMsgObservedTxIn(op.Txs, signer))
+	}
+	return nil
+}
+
+// OpTxObservedOut is a MsgObservedTxOut, without signer every observing node
+// sends it
+type OpTxObservedOut struct {
+	Type   string            `json:"type"`
+	Signer cosmos.AccAddress `json:"signer"`
+	Txs    types.ObservedTxs `json:"txs"`
+}
+
+func (op *OpTxObservedOut) Execute(h *Harness) error {
+	signers, err := h.signers(op.Signer)
+	if err != nil {
+		return err
+	}
+	for _, signer := range signers {
+		h.Queue(types.NewMsgObservedTxOut(op.Txs, signer))
+	}
+	return nil
+}
+
+// OpTxTssKeysign is a MsgTssKeysignFail reporting a failed keysign
+type OpTxTssKeysign struct {
+	Type   string            `json:"type"`
+	Signer cosmos.AccAddress `json:"signer"`
+	PubKey common.PubKey     `json:"pub_key"`
+	Memo   string            `json:"memo"`
+	Coins  common.Coins      `json:"coins"`
+	Blame  types.Blame       `json:"blame"`
+	Height int64             `json:"height"`
+}
+
+func (op *OpTxTssKeysign) Execute(h *Harness) error {
+	msg, err := types.NewMsgTssKeysignFail(op.Height, op.Blame, op.Memo, op.Coins, op.Signer, op.PubKey)
+	if err != nil {
+		return fmt.Errorf("fail to create keysign fail msg: %w", err)
+	}
+	h.Queue(msg)
+	return nil
+}
+
+// signers returns the given signer, or the observing nodes when it is empty
+func (h *Harness) signers(signer cosmos.AccAddress) ([]cosmos.AccAddress, error) {
+	if !signer.Empty() {
+		return []cosmos.AccAddress{signer}, nil
+	}
+	if err := h.start(); err != nil {
+		return nil, err
+	}
+	observers, err := h.bifrost.observers()
+	if err != nil {
+		return nil, err
+	}
+	if len(observers) == 0 {
+		return nil, errors.New("no active vault member to sign")
+	}
+	result := make([]cosmos.AccAddress, 0, len(observers))
+	for _, a := range observers {
+		result = append(result, a.address)
+	}
+	return result, nil
+}
+
+// describe returns a short description of the operation for failure messages
+func describe(op Operation) string {
+	buf, err := json.Marshal(op)
+	if err != nil {
+		return fmt.Sprintf("%T", op)
+	}
+	return strings.TrimSpace(string(buf))
+}
@@ -0,0 +1,64 @@
+package regression
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/blang/semver"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"gitlab.com/mayachain/mayanode/constants"
+)
+
+// TestRegressionSuites runs every suite under suites/ against a fresh chain,
+// a single suite can be selected with -run 'TestRegressionSuites/core/vault-frozen'
+func TestRegressionSuites(t *testing.T) {
+	// the binary version isn't linked into test builds, nodes in the suites
+	// run the version of the repository
+	buf, err := os.ReadFile(filepath.Join("..", "..", "version"))
+	if err != nil {
+		t.Fatalf("fail to read version: %s", err)
+	}
+	constants.SWVersion = semver.MustParse(strings.TrimSpace(string(buf)))
+
+	var suites []string
+	err = filepath.WalkDir("suites", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && filepath.Ext(path) == ".yaml" {
+			suites = append(suites, path)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("fail to list suites: %s", err)
+	}
+
+	for _, path := range suites {
+		path := path
+		name := strings.TrimSuffix(strings.TrimPrefix(filepath.ToSlash(path), "suites/"), ".yaml")
+		t.Run(name, func(t *testing.T) {
+			runSuite(t, path)
+		})
+	}
+}
+
+func runSuite(t *testing.T, path string) {
+	ops, err := LoadSuite(path, "templates")
+	if err != nil {
+		t.Fatalf("fail to load suite: %s", err)
+	}
+	h, err := NewHarness(t.TempDir(), log.NewNopLogger())
+	if err != nil {
+		t.Fatalf("fail to create harness: %s", err)
+	}
+	for i, op := range ops {
+		if err := op.Execute(h); err != nil {
+			t.Fatalf("operation %d %s failed: %s", i+1, describe(op), err)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package regression
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v2"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/constants"
+	"gitlab.com/mayachain/mayanode/x/mayachain"
+)
+
+// the formatter used on the suites rewrites "{{" in unquoted YAML values to
+// "{ {", undo that before rendering the template
+var mangledTemplate = regexp.MustCompile(`\{ \{|\} \}`)
+
+// LoadSuite renders the suite at path with the shared templates of
+// templateDir and decodes its operations
+func LoadSuite(path, templateDir string) ([]Operation, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("fail to read suite: %w", err)
+	}
+	funcs, err := templateFuncs()
+	if err != nil {
+		return nil, err
+	}
+	tmpl := template.New(filepath.Base(path)).Funcs(funcs)
+	templates, err := filepath.Glob(filepath.Join(templateDir, "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range templates {
+		buf, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("fail to read template %s: %w", file, err)
+		}
+		if _, err := tmpl.New(filepath.Base(file)).Parse(unmangle(string(buf))); err != nil {
+			return nil, fmt.Errorf("fail to parse template %s: %w", file, err)
+		}
+	}
+	if _, err := tmpl.Parse(unmangle(string(content))); err != nil {
+		return nil, fmt.Errorf("fail to parse suite: %w", err)
+	}
+	var rendered bytes.Buffer
+	if err := tmpl.Execute(&rendered, nil); err != nil {
+		return nil, fmt.Errorf("fail to render suite: %w", err)
+	}
+
+	var ops []Operation
+	dec := yaml.NewDecoder(&rendered)
+	for i := 1; ; i++ {
+		var doc interface{}
+		if err := dec.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("fail to decode document %d: %w", i, err)
+		}
+		if doc == nil {
+			continue
+		}
+		buf, err := json.Marshal(jsonCompatible(doc))
+		if err != nil {
+			return nil, fmt.Errorf("fail to convert document %d: %w", i, err)
+		}
+		op, err := NewOperation(buf)
+		if err != nil {
+			return nil, fmt.Errorf("document %d: %w", i, err)
+		}
+		ops = append(ops, op)
+	}
+	return ops, nil
+}
+
+func unmangle(content string) string {
+	return mangledTemplate.ReplaceAllStringFunc(content, func(s string) string {
+		return strings.ReplaceAll(s, " ", "")
+	})
+}
+
+// jsonCompatible converts the maps the YAML decoder produces into maps with
+// string keys
+func jsonCompatible(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			result[fmt.Sprint(key)] = jsonCompatible(item)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = jsonCompatible(item)
+		}
+		return result
+	}
+	return value
+}
+
+// templateFuncs returns the functions available to suites and templates. For
+// every actor there are pubkey_<actor>, cons_pubkey_<actor> and
+// addr_<chain>_<actor> for every chain the actor pub key has an address on.
+func templateFuncs() (template.FuncMap, error) {
+	all, err := newActors()
+	if err != nil {
+		return nil, err
+	}
+	funcs := template.FuncMap{
+		"version": func() string {
+			return constants.SWVersion.String()
+		},
+		"store_version": func() uint64 {
+			return constants.SWVersion.Minor
+		},
+		"addr_admin": func() string {
+			return mayachain.ADMINS[0]
+		},
+	}
+	for _, name := range all.names() {
+		a := all[name]
+		funcs["pubkey_"+name] = constant(a.pubKey.String())
+		funcs["cons_pubkey_"+name] = constant(a.consPubKey)
+		for _, descriptor := range common.GetChainDescriptors() {
+			addr, err := a.pubKey.GetAddress(descriptor.Chain)
+			if err != nil || addr.IsEmpty() {
+				continue
+			}
+			funcs[fmt.Sprintf("addr_%s_%s", strings.ToLower(descriptor.Chain.String()), name)] = constant(addr.String())
+		}
+	}
+	return funcs, nil
+}
+
+func constant(value string) func() string {
+	return func() string {
+		return value
+	}
+}
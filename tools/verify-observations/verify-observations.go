@@ -0,0 +1,181 @@
+// verify-observations re-checks the inclusion proofs of observed txs against
+// archived source chain blocks.
+//
+// The observations are a JSON array of observed txs as posted by the observers
+// in MsgObservedTxIn and MsgObservedTxOut, the chain itself only keeps the hash
+// of a verified proof. When an observation also has the proof_hash returned by
+// the API, the proof is checked to be the one the chain accepted. The archive
+// holds one file per block at <archive>/<CHAIN>/<block hash>.json:
+//
+//	{"chain": "BTC", "hash": "...", "height": 100000, "root": "...", "txs": ["..."]}
+//
+// where root is the root committed in the block header (merkle root, receipts
+// root or data hash) and txs are the ids of all the txs of the block in block
+// order. EVM blocks also carry the transactions root of the header as tx_root,
+// it is checked against the tx path of the proof when set.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/x/mayachain/inclusion"
+	stypes "gitlab.com/mayachain/mayanode/x/mayachain/types"
+)
+
+type observation struct {
+	Tx struct {
+		ID    string `json:"id"`
+		Chain string `json:"chain"`
+	} `json:"tx"`
+	BlockHash string `json:"block_hash"`
+	Proof     *proof `json:"proof"`
+	ProofHash string `json:"proof_hash"`
+}
+
+type proof struct {
+	Type  string      `json:"type"`
+	Root  string      `json:"root"`
+	Index json.Number `json:"index,omitempty"`
+	Total json.Number `json:"total"`
+	Nodes []string    `json:"nodes"`
+
+	TxRoot  string   `json:"tx_root"`
+	TxNodes []string `json:"tx_nodes"`
+}
+
+type archivedBlock struct {
+	Chain  string   `json:"chain"`
+	Hash   string   `json:"hash"`
+	Height int64    `json:"height"`
+	Root   string   `json:"root"`
+	TxRoot string   `json:"tx_root"`
+	Txs    []string `json:"txs"`
+}
+
+func main() {
+	observations := flag.String("observations", "", "json file with the observed txs")
+	archive := flag.String("archive", "", "directory with the archived blocks")
+	flag.Parse()
+
+	if len(*observations) == 0 || len(*archive) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	buf, err := os.ReadFile(*observations)
+	if err != nil {
+		panic(err)
+	}
+	var txs []observation
+	if err := json.Unmarshal(buf, &txs); err != nil {
+		panic(fmt.Errorf("fail to parse observations: %w", err))
+	}
+
+	failed := 0
+	for _, tx := range txs {
+		if err := verify(*archive, tx); err != nil {
+			failed++
+			fmt.Printf("FAIL %s %s: %s\n", tx.Tx.Chain, tx.Tx.ID, err)
+			continue
+		}
+		fmt.Printf("OK   %s %s\n", tx.Tx.Chain, tx.Tx.ID)
+	}
+	fmt.Printf("%d observations, %d failed\n", len(txs), failed)
+	if failed > 0 {
+		os.Exit(1)
+	}
+}
+
+func verify(archive string, tx observation) error {
+	if tx.Proof == nil || len(tx.BlockHash) == 0 {
+		return fmt.Errorf("observation has no inclusion proof")
+	}
+	index, err := parseNumber(tx.Proof.Index)
+	if err != nil {
+		return fmt.Errorf("invalid proof index: %w", err)
+	}
+	total, err := parseNumber(tx.Proof.Total)
+	if err != nil {
+		return fmt.Errorf("invalid proof total: %w", err)
+	}
+	p := &stypes.InclusionProof{
+		Type:  tx.Proof.Type,
+		Root:  tx.Proof.Root,
+		Index: index,
+		Total: total,
+		Nodes: tx.Proof.Nodes,
+
+		TxRoot:  tx.Proof.TxRoot,
+		TxNodes: tx.Proof.TxNodes,
+	}
+	if err := p.Valid(); err != nil {
+		return err
+	}
+	if len(tx.ProofHash) > 0 && !strings.EqualFold(p.Hash(tx.BlockHash), tx.ProofHash) {
+		return fmt.Errorf("proof doesn't match the proof hash %s kept by the chain", tx.ProofHash)
+	}
+
+	block, err := loadBlock(archive, tx.Tx.Chain, tx.BlockHash)
+	if err != nil {
+		return err
+	}
+	if !sameHash(block.Root, p.Root) {
+		return fmt.Errorf("proof root %s doesn't match archived root %s", p.Root, block.Root)
+	}
+	if len(block.TxRoot) > 0 && !sameHash(block.TxRoot, p.TxRoot) {
+		return fmt.Errorf("proof tx root %s doesn't match archived tx root %s", p.TxRoot, block.TxRoot)
+	}
+	if int64(len(block.Txs)) != p.Total {
+		return fmt.Errorf("proof covers %d txs, archived block has %d", p.Total, len(block.Txs))
+	}
+	if !sameHash(block.Txs[p.Index], common.TxID(tx.Tx.ID).BaseID().String()) {
+		return fmt.Errorf("archived block has %s at index %d", block.Txs[p.Index], p.Index)
+	}
+	return inclusion.Verify(tx.Tx.ID, p)
+}
+
+func loadBlock(archive, chain, hash string) (archivedBlock, error) {
+	var block archivedBlock
+	c, err := common.NewChain(chain)
+	if err != nil {
+		return block, fmt.Errorf("invalid chain %s: %w", chain, err)
+	}
+	// block hashes are only ever hex, this keeps the path inside the archive
+	name := strings.ToLower(strings.TrimPrefix(hash, "0x"))
+	if strings.ContainsAny(name, `/\.`) {
+		return block, fmt.Errorf("invalid block hash %s", hash)
+	}
+	path := filepath.Join(archive, c.String(), name+".json")
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return block, fmt.Errorf("fail to read archived block: %w", err)
+	}
+	if err := json.Unmarshal(buf, &block); err != nil {
+		return block, fmt.Errorf("fail to parse archived block %s: %w", path, err)
+	}
+	if !sameHash(block.Hash, hash) {
+		return block, fmt.Errorf("archived block %s has hash %s", path, block.Hash)
+	}
+	return block, nil
+}
+
+// parseNumber parses a proof number, the API omits zero values
+func parseNumber(n json.Number) (int64, error) {
+	if len(n) == 0 {
+		return 0, nil
+	}
+	return n.Int64()
+}
+
+func sameHash(a, b string) bool {
+	trim := func(s string) string {
+		return strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	}
+	return strings.EqualFold(trim(a), trim(b))
+}
@@ -26,6 +26,11 @@ const (
 	// Admin config keys
 	MaxWithdrawBasisPoints = types.MaxWithdrawBasisPoints
 
+	// pending mimir actions
+	PendingMimirActionSchedule = types.PendingMimirActionSchedule
+	PendingMimirActionCancel   = types.PendingMimirActionCancel
+	PendingMimirActionApply    = types.PendingMimirActionApply
+
 	// Vaults
 	AsgardVault    = types.VaultType_AsgardVault
 	YggdrasilVault = types.VaultType_YggdrasilVault
@@ -63,6 +68,21 @@ const (
 	MarketOrder = types.OrderType_market
 	LimitOrder  = types.OrderType_limit
 
+	// IBC transfer status
+	IBCTransferStatusSent     = types.IBCTransferStatusSent
+	IBCTransferStatusAcked    = types.IBCTransferStatusAcked
+	IBCTransferStatusFailed   = types.IBCTransferStatusFailed
+	IBCTransferStatusTimedOut = types.IBCTransferStatusTimedOut
+
+	// Bond share types
+	BondShareReward      = types.BondShareReward
+	BondShareOperatorFee = types.BondShareOperatorFee
+	BondShareSlash       = types.BondShareSlash
+	POLActionDeposit     = types.POLActionDeposit
+	POLActionWithdraw    = types.POLActionWithdraw
+	BlameTypeKeysign     = types.BlameTypeKeysign
+	BlameTypeKeygen      = types.BlameTypeKeygen
+
 	// Memos
 	TxSwap            = mem.TxSwap
 	TxAdd             = mem.TxAdd
@@ -82,15 +102,26 @@ const (
 
 var (
 	NewPool                        = types.NewPool
+	NewPoolSnapshot                = types.NewPoolSnapshot
 	NewNetwork                     = types.NewNetwork
 	NewProtocolOwnedLiquidity      = types.NewProtocolOwnedLiquidity
+	NewPOLPool                     = types.NewPOLPool
+	NewEventPOL                    = types.NewEventPOL
 	NewObservedTx                  = types.NewObservedTx
 	NewTssVoter                    = types.NewTssVoter
+	NewBlameRecord                 = types.NewBlameRecord
 	NewBanVoter                    = types.NewBanVoter
 	NewErrataTxVoter               = types.NewErrataTxVoter
 	NewObservedTxVoter             = types.NewObservedTxVoter
 	NewMsgForgiveSlash             = types.NewMsgForgiveSlash
 	NewMsgMimir                    = types.NewMsgMimir
+	NewMsgScheduleMimir            = types.NewMsgScheduleMimir
+	NewMsgCancelMimir              = types.NewMsgCancelMimir
+	NewMsgSetLiquidityAuctionTiers = types.NewMsgSetLiquidityAuctionTiers
+	NewMsgSetEVMTokens             = types.NewMsgSetEVMTokens
+	NewEVMToken                    = types.NewEVMToken
+	NewEventEVMToken               = types.NewEventEVMToken
+	NewEventLiquidityAuctionTier   = types.NewEventLiquidityAuctionTier
 	NewMsgNodePauseChain           = types.NewMsgNodePauseChain
 	NewMsgDeposit                  = types.NewMsgDeposit
 	NewMsgTssPool                  = types.NewMsgTssPool
@@ -111,6 +142,7 @@ var (
 	NewTxOut                       = types.NewTxOut
 	NewEventRewards                = types.NewEventRewards
 	NewEventPool                   = types.NewEventPool
+	NewEventSaversYield            = types.NewEventSaversYield
 	NewEventDonate                 = types.NewEventDonate
 	NewEventSwap                   = types.NewEventSwap
 	NewEventAddLiquidity           = types.NewEventAddLiquidity
@@ -130,12 +162,17 @@ var (
 	NewEventFee                    = types.NewEventFee
 	NewEventOutbound               = types.NewEventOutbound
 	NewEventSetMimir               = types.NewEventSetMimir
+	NewEventPendingMimir           = types.NewEventPendingMimir
 	NewEventSetNodeMimir           = types.NewEventSetNodeMimir
+	NewEventKeygen                 = types.NewEventKeygen
 	NewEventTssKeygenMetric        = types.NewEventTssKeygenMetric
 	NewEventTssKeysignMetric       = types.NewEventTssKeysignMetric
 	NewEventPoolBalanceChanged     = types.NewEventPoolBalanceChanged
 	NewEventPendingLiquidity       = types.NewEventPendingLiquidity
 	NewEventMAYAName               = types.NewEventMAYAName
+	NewEventIBCTransfer            = types.NewEventIBCTransfer
+	NewEventBondShare              = types.NewEventBondShare
+	NewIBCTransfer                 = types.NewIBCTransfer
 	NewPoolMod                     = types.NewPoolMod
 	NewMsgRefundTx                 = types.NewMsgRefundTx
 	NewMsgOutboundTx               = types.NewMsgOutboundTx
@@ -162,6 +199,7 @@ var (
 	NewMsgSetIPAddress             = types.NewMsgSetIPAddress
 	NewMsgNetworkFee               = types.NewMsgNetworkFee
 	NewNetworkFee                  = types.NewNetworkFee
+	NewOutboundFeeRecord           = types.NewOutboundFeeRecord
 	NewMAYAName                    = types.NewMAYAName
 	GetPoolStatus                  = types.GetPoolStatus
 	GetRandomVault                 = types.GetRandomVault
@@ -222,6 +260,12 @@ type (
 	MsgAddLiquidity                = types.MsgAddLiquidity
 	MsgOutboundTx                  = types.MsgOutboundTx
 	MsgMimir                       = types.MsgMimir
+	MsgSetLiquidityAuctionTiers    = types.MsgSetLiquidityAuctionTiers
+	MsgSetEVMTokens                = types.MsgSetEVMTokens
+	EVMToken                       = types.EVMToken
+	EVMTokens                      = types.EVMTokens
+	EventLiquidityAuctionTier      = types.EventLiquidityAuctionTier
+	LiquidityAuctionTiers          = types.LiquidityAuctionTiers
 	MsgNodePauseChain              = types.MsgNodePauseChain
 	MsgMigrate                     = types.MsgMigrate
 	MsgRagnarok                    = types.MsgRagnarok
@@ -259,6 +303,7 @@ type (
 	PoolStatus                     = types.PoolStatus
 	Pool                           = types.Pool
 	Pools                          = types.Pools
+	PoolSnapshot                   = types.PoolSnapshot
 	LiquidityProvider              = types.LiquidityProvider
 	LPBondedNode                   = types.LPBondedNode
 	LiquidityProviders             = types.LiquidityProviders
@@ -270,6 +315,7 @@ type (
 	ErrataTxVoter                  = types.ErrataTxVoter
 	TssVoter                       = types.TssVoter
 	TssKeysignFailVoter            = types.TssKeysignFailVoter
+	BlameRecord                    = types.BlameRecord
 	TxOutItem                      = types.TxOutItem
 	TxOut                          = types.TxOut
 	Keygen                         = types.Keygen
@@ -295,16 +341,24 @@ type (
 	BondProvider                   = types.BondProvider
 	Network                        = types.Network
 	ProtocolOwnedLiquidity         = types.ProtocolOwnedLiquidity
+	POLPool                        = types.POLPool
+	EventPOL                       = types.EventPOL
 	VaultStatus                    = types.VaultStatus
 	GasPool                        = types.GasPool
 	EventGas                       = types.EventGas
 	EventPool                      = types.EventPool
+	EventSaversYield               = types.EventSaversYield
 	EventRefund                    = types.EventRefund
 	EventBond                      = types.EventBond
 	EventBondV105                  = types.EventBondV105
+	EventBondShare                 = types.EventBondShare
 	EventFee                       = types.EventFee
 	EventSlash                     = types.EventSlash
 	EventOutbound                  = types.EventOutbound
+	EventIBCTransfer               = types.EventIBCTransfer
+	EventKeygen                    = types.EventKeygen
+	IBCTransfer                    = types.IBCTransfer
+	OutboundFeeRecord              = types.OutboundFeeRecord
 	NetworkFee                     = types.NetworkFee
 	ObservedNetworkFeeVoter        = types.ObservedNetworkFeeVoter
 	Jail                           = types.Jail
@@ -316,6 +370,8 @@ type (
 	MAYANameAlias                  = types.MAYANameAlias
 	NodeMimir                      = types.NodeMimir
 	NodeMimirs                     = types.NodeMimirs
+	PendingMimir                   = types.PendingMimir
+	PendingMimirs                  = types.PendingMimirs
 
 	// Memo
 	SwapMemo              = mem.SwapMemo
@@ -32,6 +32,10 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(GetCmdBan())
 	cmd.AddCommand(GetCmdForgiveSlash())
 	cmd.AddCommand(GetCmdMimir())
+	cmd.AddCommand(GetCmdScheduleMimir())
+	cmd.AddCommand(GetCmdCancelMimir())
+	cmd.AddCommand(GetCmdSetLiquidityAuctionTiers())
+	cmd.AddCommand(GetCmdSetEVMTokens())
 	cmd.AddCommand(GetCmdNodePauseChain())
 	cmd.AddCommand(GetCmdNodeResumeChain())
 	cmd.AddCommand(GetCmdDeposit())
@@ -132,6 +136,149 @@ func GetCmdMimir() *cobra.Command {
 	}
 }
 
+// GetCmdScheduleMimir command to change a mimir attribute at a future block height
+func GetCmdScheduleMimir() *cobra.Command {
+	return &cobra.Command{
+		Use:   "schedule-mimir [key] [value] [activation-height]",
+		Short: "schedules a mimir attribute update at the given block height",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			val, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid value (must be an integer): %w", err)
+			}
+			height, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid activation height (must be an integer): %w", err)
+			}
+
+			msg := types.NewMsgScheduleMimir(strings.ToUpper(args[0]), val, height, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+// GetCmdCancelMimir command to cancel a scheduled mimir attribute update
+func GetCmdCancelMimir() *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-mimir [key] [activation-height]",
+		Short: "cancels a mimir attribute update scheduled at the given block height",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid activation height (must be an integer): %w", err)
+			}
+
+			msg := types.NewMsgCancelMimir(strings.ToUpper(args[0]), height, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+// GetCmdSetLiquidityAuctionTiers command to assign or revoke liquidity auction tiers in bulk
+func GetCmdSetLiquidityAuctionTiers() *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-liquidity-auction-tiers [address:tier]...",
+		Short: "assigns liquidity auction tiers, tier 0 revokes (admin only)",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tiers := make(types.LiquidityAuctionTiers, 0, len(args))
+			for _, arg := range args {
+				parts := strings.Split(arg, ":")
+				if len(parts) != 2 {
+					return fmt.Errorf("invalid tier (must be address:tier): %s", arg)
+				}
+				addr, err := common.NewAddress(parts[0])
+				if err != nil {
+					return fmt.Errorf("invalid address: %w", err)
+				}
+				tier, err := strconv.ParseInt(parts[1], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid tier (must be an integer): %w", err)
+				}
+				tiers = append(tiers, types.LiquidityAuctionTier{Address: addr, Tier: tier})
+			}
+
+			msg := types.NewMsgSetLiquidityAuctionTiers(tiers, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+// GetCmdSetEVMTokens command to list, update or delist EVM tokens
+func GetCmdSetEVMTokens() *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-evm-tokens [chain:address:symbol:decimals|chain:address:delist]...",
+		Short: "lists, updates or delists whitelisted EVM tokens (admin only)",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokens := make(types.EVMTokens, 0, len(args))
+			for _, arg := range args {
+				parts := strings.Split(arg, ":")
+				if len(parts) != 3 && len(parts) != 4 {
+					return fmt.Errorf("invalid token (must be chain:address:symbol:decimals or chain:address:delist): %s", arg)
+				}
+				chain, err := common.NewChain(parts[0])
+				if err != nil {
+					return fmt.Errorf("invalid chain: %w", err)
+				}
+				addr, err := common.NewAddress(parts[1])
+				if err != nil {
+					return fmt.Errorf("invalid address: %w", err)
+				}
+				if len(parts) == 3 {
+					if !strings.EqualFold(parts[2], "delist") {
+						return fmt.Errorf("invalid token (must be chain:address:symbol:decimals or chain:address:delist): %s", arg)
+					}
+					tokens = append(tokens, types.EVMToken{Chain: chain, Address: addr, Delisted: true})
+					continue
+				}
+				decimals, err := strconv.ParseInt(parts[3], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid decimals (must be an integer): %w", err)
+				}
+				tokens = append(tokens, types.NewEVMToken(chain, addr, parts[2], decimals))
+			}
+
+			msg := types.NewMsgSetEVMTokens(tokens, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
 // GetCmdNodePauseChain command to change node pause chain
 func GetCmdNodePauseChain() *cobra.Command {
 	return &cobra.Command{
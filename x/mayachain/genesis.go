@@ -94,6 +94,18 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	for _, token := range data.EvmTokens {
+		if err := token.Valid(); err != nil {
+			return fmt.Errorf("invalid evm token: %w", err)
+		}
+	}
+
+	for _, m := range data.PendingMimirs {
+		if len(m.Key) == 0 || m.ActivationHeight <= 0 {
+			return fmt.Errorf("invalid pending mimir: %s", m.Key)
+		}
+	}
+
 	for _, n := range data.Mayanames {
 		if len(n.Name) > 30 {
 			return errors.New("MAYAName cannot exceed 30 characters")
@@ -125,6 +137,8 @@ func DefaultGenesisState() GenesisState {
 		NetworkFees:         make([]NetworkFee, 0),
 		ChainContracts:      make([]ChainContract, 0),
 		Mayanames:           make([]MAYAName, 0),
+		EvmTokens:           make(EVMTokens, 0),
+		PendingMimirs:       make([]PendingMimir, 0),
 		StoreVersion:        38, // refer to func `GetStoreVersion` , let's keep it consistent
 	}
 }
@@ -209,6 +223,11 @@ func InitGenesis(ctx cosmos.Context, keeper keeper.Keeper, data GenesisState) []
 	// if err := keeper.SetPOL(ctx, data.POL); err != nil {
 	// panic(err)
 	// }
+	// for _, item := range data.PolPools {
+	// if err := keeper.SetPOLPool(ctx, item); err != nil {
+	// panic(err)
+	// }
+	// }
 
 	for _, item := range data.MsgSwaps {
 		if err := keeper.SetOrderBookItem(ctx, item); err != nil {
@@ -229,6 +248,12 @@ func InitGenesis(ctx cosmos.Context, keeper keeper.Keeper, data GenesisState) []
 		keeper.SetMAYAName(ctx, n)
 	}
 
+	for _, token := range data.EvmTokens {
+		if err := keeper.SetEVMToken(ctx, token); err != nil {
+			panic(err)
+		}
+	}
+
 	// Mint coins into the reserve
 	if data.Reserve > 0 {
 		coin := common.NewCoin(common.BaseNative, cosmos.NewUint(data.Reserve))
@@ -283,6 +308,14 @@ func InitGenesis(ctx cosmos.Context, keeper keeper.Keeper, data GenesisState) []
 		}
 		keeper.SetMimir(ctx, item.Key, item.Value)
 	}
+	for _, m := range data.PendingMimirs {
+		pending, err := keeper.GetPendingMimirs(ctx, m.ActivationHeight)
+		if err != nil {
+			panic(err)
+		}
+		pending.Set(m)
+		keeper.SetPendingMimirs(ctx, m.ActivationHeight, pending)
+	}
 	keeper.SetStoreVersion(ctx, data.StoreVersion)
 	reserveAddr, _ := keeper.GetModuleAddress(ReserveName)
 	ctx.Logger().Info("Reserve Module", "address", reserveAddr.String())
@@ -433,6 +466,15 @@ func ExportGenesis(ctx cosmos.Context, k keeper.Keeper) GenesisState {
 		panic(err)
 	}
 
+	polPools := make([]POLPool, 0)
+	iterPOLPool := k.GetPOLPoolIterator(ctx)
+	defer iterPOLPool.Close()
+	for ; iterPOLPool.Valid(); iterPOLPool.Next() {
+		var p POLPool
+		k.Cdc().MustUnmarshal(iterPOLPool.Value(), &p)
+		polPools = append(polPools, p)
+	}
+
 	vaults := make(Vaults, 0)
 	iterVault := k.GetVaultIterator(ctx)
 	defer iterVault.Close()
@@ -480,6 +522,14 @@ func ExportGenesis(ctx cosmos.Context, k keeper.Keeper) GenesisState {
 		k.Cdc().MustUnmarshal(iterNames.Value(), &n)
 		names = append(names, n)
 	}
+	evmTokens := make(EVMTokens, 0)
+	iterEVMTokens := k.GetEVMTokenIterator(ctx, common.EmptyChain)
+	defer iterEVMTokens.Close()
+	for ; iterEVMTokens.Valid(); iterEVMTokens.Next() {
+		var token EVMToken
+		k.Cdc().MustUnmarshal(iterEVMTokens.Value(), &token)
+		evmTokens = append(evmTokens, token)
+	}
 	mimirs := make([]Mimir, 0)
 	mimirIter := k.GetMimirIterator(ctx)
 	defer mimirIter.Close()
@@ -494,6 +544,14 @@ func ExportGenesis(ctx cosmos.Context, k keeper.Keeper) GenesisState {
 			Value: value.GetValue(),
 		})
 	}
+	pendingMimirs := make([]PendingMimir, 0)
+	pendingMimirIter := k.GetPendingMimirIterator(ctx)
+	defer pendingMimirIter.Close()
+	for ; pendingMimirIter.Valid(); pendingMimirIter.Next() {
+		var pending PendingMimirs
+		k.Cdc().MustUnmarshal(pendingMimirIter.Value(), &pending)
+		pendingMimirs = append(pendingMimirs, pending.Mimirs...)
+	}
 	storeVersion := k.GetStoreVersion(ctx)
 	return GenesisState{
 		Pools:              pools,
@@ -507,11 +565,14 @@ func ExportGenesis(ctx cosmos.Context, k keeper.Keeper) GenesisState {
 		LastChainHeights:   lastChainHeights,
 		Network:            network,
 		POL:                pol,
+		PolPools:           polPools,
 		MsgSwaps:           swapMsgs,
 		NetworkFees:        networkFees,
 		ChainContracts:     chainContracts,
 		Mayanames:          names,
+		EvmTokens:          evmTokens,
 		Mimirs:             mimirs,
+		PendingMimirs:      pendingMimirs,
 		StoreVersion:       storeVersion,
 	}
 }
@@ -75,6 +75,8 @@ func getHandlerMappingV65(mgr Manager) map[string]MsgHandler {
 	m[MsgSetVersion{}.Type()] = NewVersionHandler(mgr)
 	m[MsgSetIPAddress{}.Type()] = NewIPAddressHandler(mgr)
 	m[MsgNodePauseChain{}.Type()] = NewNodePauseChainHandler(mgr)
+	m[MsgSetLiquidityAuctionTiers{}.Type()] = NewSetLiquidityAuctionTiersHandler(mgr)
+	m[MsgSetEVMTokens{}.Type()] = NewSetEVMTokensHandler(mgr)
 
 	// native handlers (non-consensus)
 	m[MsgSend{}.Type()] = NewSendHandler(mgr)
@@ -144,17 +146,24 @@ func getMsgSwapFromMemo(memo SwapMemo, tx ObservedTx, signer cosmos.AccAddress)
 	if memo.Destination.IsEmpty() {
 		memo.Destination = tx.Tx.FromAddress
 	}
-	return NewMsgSwap(tx.Tx, memo.GetAsset(), memo.Destination, memo.SlipLimit, memo.AffiliateAddress, memo.AffiliateBasisPoints, memo.GetDexAggregator(), memo.GetDexTargetAddress(), memo.GetDexTargetLimit(), memo.GetOrderType(), signer), nil
+	msg := NewMsgSwap(tx.Tx, memo.GetAsset(), memo.Destination, memo.SlipLimit, memo.AffiliateAddress, memo.AffiliateBasisPoints, memo.GetDexAggregator(), memo.GetDexTargetAddress(), memo.GetDexTargetLimit(), memo.GetOrderType(), signer)
+	msg.IBCChannel = memo.GetIBCChannel()
+	msg.FeeAsset = memo.GetFeeAsset()
+	return msg, nil
 }
 
-func getMsgWithdrawFromMemo(memo WithdrawLiquidityMemo, tx ObservedTx, signer cosmos.AccAddress) (cosmos.Msg, error) {
+func getMsgWithdrawFromMemo(version semver.Version, memo WithdrawLiquidityMemo, tx ObservedTx, signer cosmos.AccAddress) (cosmos.Msg, error) {
 	withdrawAmount := cosmos.NewUint(MaxWithdrawBasisPoints)
 	if !memo.GetAmount().IsZero() {
 		withdrawAmount = memo.GetAmount()
 	}
+	getChain := common.Address.GetChain
+	if version.GTE(semver.MustParse("1.106.0")) {
+		getChain = common.Address.GetChainV106
+	}
 	fromAddress := tx.Tx.FromAddress
 	pairAddress := memo.GetPairAddress()
-	if !fromAddress.GetChain().Equals(common.BASEChain) && !pairAddress.Equals(common.NoAddress) && pairAddress.GetChain().Equals(common.BASEChain) {
+	if !getChain(fromAddress).Equals(common.BASEChain) && !pairAddress.Equals(common.NoAddress) && getChain(pairAddress).Equals(common.BASEChain) {
 		fromAddress = pairAddress
 	}
 	return NewMsgWithdrawLiquidity(tx.Tx, fromAddress, withdrawAmount, memo.GetAsset(), memo.GetWithdrawalAsset(), signer), nil
@@ -251,10 +260,10 @@ func processOneTxInV63(ctx cosmos.Context, keeper keeper.Keeper, tx ObservedTx,
 		newMsg, err = getMsgAddLiquidityFromMemo(ctx, m, tx, signer, m.Tier)
 	case WithdrawLiquidityMemo:
 		m.Asset = fuzzyAssetMatch(ctx, keeper, m.Asset)
-		newMsg, err = getMsgWithdrawFromMemo(m, tx, signer)
+		newMsg, err = getMsgWithdrawFromMemo(keeper.GetVersion(), m, tx, signer)
 	case SwapMemo:
 		m.Asset = fuzzyAssetMatch(ctx, keeper, m.Asset)
-		m.DexTargetAddress = externalAssetMatch(keeper.GetVersion(), m.Asset.GetChain(), m.DexTargetAddress)
+		m.DexTargetAddress = externalAssetMatch(ctx, keeper, m.Asset.GetChain(), m.DexTargetAddress)
 		newMsg, err = getMsgSwapFromMemo(m, tx, signer)
 	case DonateMemo:
 		m.Asset = fuzzyAssetMatch(ctx, keeper, m.Asset)
@@ -378,8 +387,11 @@ func fuzzyAssetMatchV83(ctx cosmos.Context, keeper keeper.Keeper, origAsset comm
 	return winner.Asset
 }
 
-func externalAssetMatch(version semver.Version, chain common.Chain, hint string) string {
+func externalAssetMatch(ctx cosmos.Context, keeper keeper.Keeper, chain common.Chain, hint string) string {
+	version := keeper.GetVersion()
 	switch {
+	case version.GTE(semver.MustParse("1.106.0")):
+		return externalAssetMatchV106(ctx, keeper, chain, hint)
 	case version.GTE(semver.MustParse("1.95.0")):
 		return externalAssetMatchV95(version, chain, hint)
 	case version.GTE(semver.MustParse("1.93.0")):
@@ -389,6 +401,29 @@ func externalAssetMatch(version semver.Version, chain common.Chain, hint string)
 	}
 }
 
+func externalAssetMatchV106(ctx cosmos.Context, keeper keeper.Keeper, chain common.Chain, hint string) string {
+	if len(hint) == 0 || !chain.IsEVM() {
+		return hint
+	}
+	// find all potential matches
+	matches := []string{}
+	for _, token := range getEVMTokenWhitelist(ctx, keeper, chain) {
+		if strings.HasSuffix(strings.ToLower(token.Address.String()), strings.ToLower(hint)) {
+			matches = append(matches, token.Address.String())
+			if len(matches) > 1 {
+				break
+			}
+		}
+	}
+	// if we only have one match, lets go with it, otherwise leave the
+	// user's input alone. It may still work, if it doesn't, should get the
+	// gas asset instead of the erc20 desired.
+	if len(matches) == 1 {
+		return matches[0]
+	}
+	return hint
+}
+
 func externalAssetMatchV95(version semver.Version, chain common.Chain, hint string) string {
 	if len(hint) == 0 {
 		return hint
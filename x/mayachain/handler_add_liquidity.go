@@ -649,6 +649,7 @@ func (h AddLiquidityHandler) addLiquidityV105(ctx cosmos.Context,
 		}
 	}
 
+	newSaver := asset.IsVaultAsset() && su.Units.IsZero() && !liquidityUnits.IsZero()
 	su.Units = su.Units.Add(liquidityUnits)
 	if pool.Status == PoolAvailable {
 		if su.AssetDepositValue.IsZero() && su.CacaoDepositValue.IsZero() {
@@ -661,6 +662,11 @@ func (h AddLiquidityHandler) addLiquidityV105(ctx cosmos.Context,
 	}
 
 	h.mgr.Keeper().SetLiquidityProvider(ctx, su)
+	if newSaver && h.mgr.GetVersion().GTE(semver.MustParse("1.106.0")) {
+		if err := h.mgr.Keeper().AddSaversCount(ctx, asset, 1); err != nil {
+			ctx.Logger().Error("fail to add saver to count", "bucket", asset, "error", err)
+		}
+	}
 
 	evt := NewEventAddLiquidity(asset, liquidityUnits, su.CacaoAddress, pendingCacaoAmt, pendingAssetAmt, cacaoTxID, assetTxID, su.AssetAddress)
 	if err := h.mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
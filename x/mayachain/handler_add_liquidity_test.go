@@ -1084,10 +1084,22 @@ func (HandlerAddLiquiditySuite) TestAddSynth(c *C) {
 	su, err := k.GetLiquidityProvider(ctx, asset, addr)
 	c.Assert(err, IsNil)
 	c.Check(su.Units.Uint64(), Equals, uint64(100), Commentf("%d", su.Units.Uint64()))
+	savers, err := k.GetSaversCount(ctx, asset)
+	c.Assert(err, IsNil)
+	c.Check(savers, Equals, int64(1))
 
 	pool, err = k.GetPool(ctx, asset)
 	c.Assert(err, IsNil)
 	c.Check(pool.BalanceCacao.Uint64(), Equals, uint64(0), Commentf("%d", pool.BalanceCacao.Uint64()))
 	c.Check(pool.BalanceAsset.Uint64(), Equals, uint64(200*common.One), Commentf("%d", pool.BalanceAsset.Uint64()))
 	c.Check(pool.LPUnits.Uint64(), Equals, uint64(200), Commentf("%d", pool.LPUnits.Uint64()))
+
+	// adding again to the same position doesn't count a new saver
+	addCoin = common.NewCoin(asset, cosmos.NewUint(100*common.One))
+	c.Assert(k.MintToModule(ctx, ModuleName, addCoin), IsNil)
+	c.Assert(k.SendFromModuleToModule(ctx, ModuleName, AsgardName, common.NewCoins(addCoin)), IsNil)
+	c.Assert(h.addLiquidity(ctx, asset, cosmos.ZeroUint(), addCoin.Amount, common.NoAddress, addr, tx, false, constAccessor, 0), IsNil)
+	savers, err = k.GetSaversCount(ctx, asset)
+	c.Assert(err, IsNil)
+	c.Check(savers, Equals, int64(1))
 }
@@ -15,6 +15,10 @@ import (
 
 var mimirValidKeyV95 = regexp.MustCompile(constants.MimirKeyRegex).MatchString
 
+// maxMimirActivationBlocks is how far in the future a mimir change can be
+// scheduled, about 30 days
+const maxMimirActivationBlocks int64 = 432000
+
 // MimirHandler is to handle admin messages
 type MimirHandler struct {
 	mgr Manager
@@ -48,6 +52,8 @@ func (h MimirHandler) Run(ctx cosmos.Context, m cosmos.Msg) (*cosmos.Result, err
 func (h MimirHandler) validate(ctx cosmos.Context, msg MsgMimir) error {
 	version := h.mgr.GetVersion()
 	switch {
+	case version.GTE(semver.MustParse("1.106.0")):
+		return h.validateV106(ctx, msg)
 	case version.GTE(semver.MustParse("1.95.0")):
 		return h.validateV95(ctx, msg)
 	default:
@@ -78,10 +84,38 @@ func (h MimirHandler) validateV95(ctx cosmos.Context, msg MsgMimir) error {
 	return nil
 }
 
+func (h MimirHandler) validateV106(ctx cosmos.Context, msg MsgMimir) error {
+	if err := h.validateV95(ctx, msg); err != nil {
+		return err
+	}
+	key, ok := constants.GetMimirKey(msg.Key)
+	if !ok {
+		return cosmos.ErrUnknownRequest(fmt.Sprintf("unknown mimir key: %s", msg.Key))
+	}
+	if !msg.Cancel {
+		if err := key.ValidateValue(msg.Value); err != nil {
+			return cosmos.ErrUnknownRequest(err.Error())
+		}
+		if msg.ActivationHeight > 0 && msg.ActivationHeight <= ctx.BlockHeight() {
+			return cosmos.ErrUnknownRequest(fmt.Sprintf("activation height %d is not in the future", msg.ActivationHeight))
+		}
+		if msg.ActivationHeight > ctx.BlockHeight()+maxMimirActivationBlocks {
+			return cosmos.ErrUnknownRequest(fmt.Sprintf("activation height %d is more than %d blocks away", msg.ActivationHeight, maxMimirActivationBlocks))
+		}
+	}
+	isAdmin := h.isAdmin(msg.Signer)
+	if (key.Setter == constants.MimirSetterAdmin && !isAdmin) || (key.Setter == constants.MimirSetterNode && isAdmin) {
+		return cosmos.ErrUnauthorized(fmt.Sprintf("%s can't be set by %s", msg.Key, msg.Signer))
+	}
+	return nil
+}
+
 func (h MimirHandler) handle(ctx cosmos.Context, msg MsgMimir) error {
 	ctx.Logger().Info("handleMsgMimir request", "node", msg.Signer, "key", msg.Key, "value", msg.Value)
 	version := h.mgr.GetVersion()
 	switch {
+	case version.GTE(semver.MustParse("1.106.0")):
+		return h.handleV106(ctx, msg)
 	case version.GTE(semver.MustParse("1.92.0")):
 		return h.handleV92(ctx, msg)
 	default:
@@ -90,6 +124,134 @@ func (h MimirHandler) handle(ctx cosmos.Context, msg MsgMimir) error {
 	}
 }
 
+func (h MimirHandler) handleV106(ctx cosmos.Context, msg MsgMimir) error {
+	switch {
+	case msg.Cancel:
+		return h.cancelPendingMimir(ctx, msg)
+	case msg.ActivationHeight > 0:
+		return h.schedulePendingMimir(ctx, msg)
+	default:
+		return h.handleV92(ctx, msg)
+	}
+}
+
+// schedulePendingMimir stores the mimir until its activation height, it
+// replaces the one the signer scheduled for the same key and height. Nodes pay
+// the mimir fee when they schedule, it isn't refunded on cancel.
+func (h MimirHandler) schedulePendingMimir(ctx cosmos.Context, msg MsgMimir) error {
+	pending, err := h.mgr.Keeper().GetPendingMimirs(ctx, msg.ActivationHeight)
+	if err != nil {
+		return fmt.Errorf("fail to get pending mimirs: %w", err)
+	}
+	if !h.isAdmin(msg.Signer) {
+		nodeAccount, cost, err := h.payNodeMimirFee(ctx, msg.Signer)
+		if err != nil {
+			return err
+		}
+		if err := h.emitNodeMimirBondEvent(ctx, nodeAccount, cost); err != nil {
+			return err
+		}
+	}
+	mimir := PendingMimir{
+		Key:              strings.ToUpper(msg.Key),
+		Value:            msg.Value,
+		Signer:           msg.Signer,
+		ActivationHeight: msg.ActivationHeight,
+	}
+	pending.Set(mimir)
+	h.mgr.Keeper().SetPendingMimirs(ctx, msg.ActivationHeight, pending)
+	if err := h.mgr.EventMgr().EmitEvent(ctx, NewEventPendingMimir(PendingMimirActionSchedule, mimir)); err != nil {
+		ctx.Logger().Error("fail to emit pending mimir event", "error", err)
+	}
+	return nil
+}
+
+// cancelPendingMimir removes the pending mimirs of the key at the activation
+// height, nodes can only cancel their own while admins can cancel any
+func (h MimirHandler) cancelPendingMimir(ctx cosmos.Context, msg MsgMimir) error {
+	pending, err := h.mgr.Keeper().GetPendingMimirs(ctx, msg.ActivationHeight)
+	if err != nil {
+		return fmt.Errorf("fail to get pending mimirs: %w", err)
+	}
+	isAdmin := h.isAdmin(msg.Signer)
+	remaining := PendingMimirs{}
+	var cancelled []PendingMimir
+	for _, mimir := range pending.Mimirs {
+		if strings.EqualFold(mimir.Key, msg.Key) && (isAdmin || mimir.Signer.Equals(msg.Signer)) {
+			cancelled = append(cancelled, mimir)
+			continue
+		}
+		remaining.Mimirs = append(remaining.Mimirs, mimir)
+	}
+	if len(cancelled) == 0 {
+		return cosmos.ErrUnknownRequest(fmt.Sprintf("no pending mimir %s at height %d", msg.Key, msg.ActivationHeight))
+	}
+	h.mgr.Keeper().SetPendingMimirs(ctx, msg.ActivationHeight, remaining)
+	for _, mimir := range cancelled {
+		if err := h.mgr.EventMgr().EmitEvent(ctx, NewEventPendingMimir(PendingMimirActionCancel, mimir)); err != nil {
+			ctx.Logger().Error("fail to emit pending mimir event", "error", err)
+		}
+	}
+	return nil
+}
+
+// ApplyPendingMimirs applies the mimirs scheduled for the current block. Each
+// of them is applied on its own, one that fails doesn't hold back the others.
+func (h MimirHandler) ApplyPendingMimirs(ctx cosmos.Context) error {
+	height := ctx.BlockHeight()
+	pending, err := h.mgr.Keeper().GetPendingMimirs(ctx, height)
+	if err != nil {
+		return fmt.Errorf("fail to get pending mimirs: %w", err)
+	}
+	if len(pending.Mimirs) == 0 {
+		return nil
+	}
+	// the pending mimirs of the block are dropped, whether they apply or not
+	h.mgr.Keeper().SetPendingMimirs(ctx, height, PendingMimirs{})
+
+	var failed []string
+	for _, mimir := range pending.Mimirs {
+		cacheCtx, commit := ctx.CacheContext()
+		if err := h.applyPendingMimir(cacheCtx, mimir); err != nil {
+			ctx.Logger().Error("fail to apply pending mimir", "key", mimir.Key, "signer", mimir.Signer.String(), "error", err)
+			failed = append(failed, mimir.Key)
+			continue
+		}
+		commit()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("fail to apply pending mimirs: %s", strings.Join(failed, ","))
+	}
+	return nil
+}
+
+// applyPendingMimir sets the mimir, or the node vote, the fee of node votes
+// was paid when they were scheduled
+func (h MimirHandler) applyPendingMimir(ctx cosmos.Context, mimir PendingMimir) error {
+	msg := NewMsgMimir(mimir.Key, mimir.Value, mimir.Signer)
+	if err := h.validate(ctx, *msg); err != nil {
+		return fmt.Errorf("fail to validate pending mimir: %w", err)
+	}
+	if h.isAdmin(msg.Signer) {
+		if err := h.handle(ctx, *msg); err != nil {
+			return err
+		}
+	} else {
+		currentMimirValue, _ := h.mgr.Keeper().GetMimir(ctx, msg.Key)
+		if err := h.setNodeMimir(ctx, *msg); err != nil {
+			return err
+		}
+		if err := h.updateMimirFromNodeVotes(ctx, *msg, currentMimirValue); err != nil {
+			return err
+		}
+	}
+	if err := h.mgr.EventMgr().EmitEvent(ctx, NewEventPendingMimir(PendingMimirActionApply, mimir)); err != nil {
+		ctx.Logger().Error("fail to emit pending mimir event", "error", err)
+	}
+	return nil
+}
+
 func (h MimirHandler) handleV92(ctx cosmos.Context, msg MsgMimir) error {
 	// Get the current Mimir key value if it exists.
 	currentMimirValue, _ := h.mgr.Keeper().GetMimir(ctx, msg.Key)
@@ -135,83 +297,113 @@ func (h MimirHandler) handleV92(ctx cosmos.Context, msg MsgMimir) error {
 	} else {
 		// Cost and emitting of SetNodeMimir, even if a duplicate
 		// (for instance if needed to confirm a new supermajority after a node number decrease).
-		nodeAccount, err := h.mgr.Keeper().GetNodeAccount(ctx, msg.Signer)
+		nodeAccount, cost, err := h.payNodeMimirFee(ctx, msg.Signer)
 		if err != nil {
-			ctx.Logger().Error("fail to get node account", "error", err, "address", msg.Signer.String())
-			return cosmos.ErrUnauthorized(fmt.Sprintf("%s is not authorized", msg.Signer))
-		}
-
-		c, err := h.mgr.Keeper().GetMimir(ctx, constants.NativeTransactionFee.String())
-		if err != nil {
-			ctx.Logger().Error("fail to get mimir", "error", err)
-		}
-		if c < 0 {
-			c = h.mgr.GetConstants().GetInt64Value(constants.NativeTransactionFee)
-		}
-		cost := cosmos.NewUint(uint64(c))
-		coin := common.NewCoin(common.BaseNative, cost)
-		if !cost.IsZero() {
-			if err := h.mgr.Keeper().SendFromAccountToModule(ctx, nodeAccount.NodeAddress, ReserveName, common.NewCoins(coin)); err != nil {
-				ctx.Logger().Error("fail to transfer funds from bond to reserve", "error", err)
-				return err
-			}
-		}
-
-		if err := h.mgr.Keeper().SetNodeMimir(ctx, msg.Key, msg.Value, msg.Signer); err != nil {
-			ctx.Logger().Error("fail to save node mimir", "error", err)
 			return err
 		}
-		nodeMimirEvent := NewEventSetNodeMimir(strings.ToUpper(msg.Key), strconv.FormatInt(msg.Value, 10), msg.Signer.String())
-		if err := h.mgr.EventMgr().EmitEvent(ctx, nodeMimirEvent); err != nil {
-			ctx.Logger().Error("fail to emit set_node_mimir event", "error", err)
+		if err := h.setNodeMimir(ctx, msg); err != nil {
 			return err
 		}
-
-		tx := common.Tx{}
-		tx.ID = common.BlankTxID
-		tx.ToAddress = common.Address(nodeAccount.String())
-		if err := h.mgr.EventMgr().EmitBondEvent(ctx, h.mgr, common.BaseNative, cost, BondCost, tx); err != nil {
-			ctx.Logger().Error("fail to emit bond event", "error", err)
+		if err := h.emitNodeMimirBondEvent(ctx, nodeAccount, cost); err != nil {
 			return err
 		}
-		// If the Mimir key is already the submitted value, don't do anything further.
-		if msg.Value == currentMimirValue {
-			return nil
-		}
+		return h.updateMimirFromNodeVotes(ctx, msg, currentMimirValue)
+	}
 
-		// Get the current Active Node supermajority Mimir key value if it exists.
-		// This code needs to be duplicated, since run either for Admin or only after SetNodeMimir.
-		nodeMimirs, err := h.mgr.Keeper().GetNodeMimirs(ctx, msg.Key)
-		if err != nil {
-			ctx.Logger().Error("fail to get node mimirs", "error", err)
-			return err
-		}
-		activeNodes, err := h.mgr.Keeper().ListActiveValidators(ctx)
-		if err != nil {
-			ctx.Logger().Error("fail to list active validators", "error", err)
-			return err
-		}
-		currentSuperMajorityValue, currentlyHasSuperMajority := nodeMimirs.HasSuperMajority(msg.Key, activeNodes.GetNodeAddresses())
-		// if the given key doesn't have super majority , then it shall return
-		if !currentlyHasSuperMajority {
-			return nil
-		}
-		// Given that there is an active Node super majority,
-		// a Node must only change the Mimir key value when changing it to the super majority value.
-		if currentlyHasSuperMajority && (currentMimirValue == currentSuperMajorityValue) {
-			return nil
-		}
-		// after this point , means node mimir reach consensus for the first time
-		// set admin mimir to lock in the value
-		// setting Mimir key value, and emitting a SetMimir event.
-		// if admin override node mimir voted value , and node vote again , it will then reset the admin mimir
-		h.mgr.Keeper().SetMimir(ctx, msg.Key, currentSuperMajorityValue)
-		mimirEvent := NewEventSetMimir(strings.ToUpper(msg.Key), strconv.FormatInt(msg.Value, 10))
-		if err := h.mgr.EventMgr().EmitEvent(ctx, mimirEvent); err != nil {
-			ctx.Logger().Error("fail to emit set_mimir event", "error", err)
+	return nil
+}
+
+// payNodeMimirFee moves the native transaction fee from the node to the reserve
+func (h MimirHandler) payNodeMimirFee(ctx cosmos.Context, signer cosmos.AccAddress) (NodeAccount, cosmos.Uint, error) {
+	nodeAccount, err := h.mgr.Keeper().GetNodeAccount(ctx, signer)
+	if err != nil {
+		ctx.Logger().Error("fail to get node account", "error", err, "address", signer.String())
+		return NodeAccount{}, cosmos.ZeroUint(), cosmos.ErrUnauthorized(fmt.Sprintf("%s is not authorized", signer))
+	}
+
+	c, err := h.mgr.Keeper().GetMimir(ctx, constants.NativeTransactionFee.String())
+	if err != nil {
+		ctx.Logger().Error("fail to get mimir", "error", err)
+	}
+	if c < 0 {
+		c = h.mgr.GetConstants().GetInt64Value(constants.NativeTransactionFee)
+	}
+	cost := cosmos.NewUint(uint64(c))
+	coin := common.NewCoin(common.BaseNative, cost)
+	if !cost.IsZero() {
+		if err := h.mgr.Keeper().SendFromAccountToModule(ctx, nodeAccount.NodeAddress, ReserveName, common.NewCoins(coin)); err != nil {
+			ctx.Logger().Error("fail to transfer funds from bond to reserve", "error", err)
+			return NodeAccount{}, cosmos.ZeroUint(), err
 		}
 	}
+	return nodeAccount, cost, nil
+}
 
+// emitNodeMimirBondEvent records the mimir fee paid by the node as a bond cost
+func (h MimirHandler) emitNodeMimirBondEvent(ctx cosmos.Context, nodeAccount NodeAccount, cost cosmos.Uint) error {
+	tx := common.Tx{}
+	tx.ID = common.BlankTxID
+	tx.ToAddress = common.Address(nodeAccount.String())
+	if err := h.mgr.EventMgr().EmitBondEvent(ctx, h.mgr, common.BaseNative, cost, BondCost, tx); err != nil {
+		ctx.Logger().Error("fail to emit bond event", "error", err)
+		return err
+	}
+	return nil
+}
+
+// setNodeMimir saves the vote of the node
+func (h MimirHandler) setNodeMimir(ctx cosmos.Context, msg MsgMimir) error {
+	if err := h.mgr.Keeper().SetNodeMimir(ctx, msg.Key, msg.Value, msg.Signer); err != nil {
+		ctx.Logger().Error("fail to save node mimir", "error", err)
+		return err
+	}
+	nodeMimirEvent := NewEventSetNodeMimir(strings.ToUpper(msg.Key), strconv.FormatInt(msg.Value, 10), msg.Signer.String())
+	if err := h.mgr.EventMgr().EmitEvent(ctx, nodeMimirEvent); err != nil {
+		ctx.Logger().Error("fail to emit set_node_mimir event", "error", err)
+		return err
+	}
+	return nil
+}
+
+// updateMimirFromNodeVotes sets the mimir to the value of the node votes once
+// they reach a super majority
+func (h MimirHandler) updateMimirFromNodeVotes(ctx cosmos.Context, msg MsgMimir, currentMimirValue int64) error {
+	// If the Mimir key is already the submitted value, don't do anything further.
+	if msg.Value == currentMimirValue {
+		return nil
+	}
+
+	// Get the current Active Node supermajority Mimir key value if it exists.
+	// This code needs to be duplicated, since run either for Admin or only after SetNodeMimir.
+	nodeMimirs, err := h.mgr.Keeper().GetNodeMimirs(ctx, msg.Key)
+	if err != nil {
+		ctx.Logger().Error("fail to get node mimirs", "error", err)
+		return err
+	}
+	activeNodes, err := h.mgr.Keeper().ListActiveValidators(ctx)
+	if err != nil {
+		ctx.Logger().Error("fail to list active validators", "error", err)
+		return err
+	}
+	currentSuperMajorityValue, currentlyHasSuperMajority := nodeMimirs.HasSuperMajority(msg.Key, activeNodes.GetNodeAddresses())
+	// if the given key doesn't have super majority , then it shall return
+	if !currentlyHasSuperMajority {
+		return nil
+	}
+	// Given that there is an active Node super majority,
+	// a Node must only change the Mimir key value when changing it to the super majority value.
+	if currentlyHasSuperMajority && (currentMimirValue == currentSuperMajorityValue) {
+		return nil
+	}
+	// after this point , means node mimir reach consensus for the first time
+	// set admin mimir to lock in the value
+	// setting Mimir key value, and emitting a SetMimir event.
+	// if admin override node mimir voted value , and node vote again , it will then reset the admin mimir
+	h.mgr.Keeper().SetMimir(ctx, msg.Key, currentSuperMajorityValue)
+	mimirEvent := NewEventSetMimir(strings.ToUpper(msg.Key), strconv.FormatInt(msg.Value, 10))
+	if err := h.mgr.EventMgr().EmitEvent(ctx, mimirEvent); err != nil {
+		ctx.Logger().Error("fail to emit set_mimir event", "error", err)
+	}
 	return nil
 }
 
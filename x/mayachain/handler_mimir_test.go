@@ -7,6 +7,7 @@ import (
 
 	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/constants"
 )
 
 type HandlerMimirSuite struct{}
@@ -23,7 +24,7 @@ func (s *HandlerMimirSuite) TestValidate(c *C) {
 	addr, _ := cosmos.AccAddressFromBech32(ADMINS[0])
 	handler := NewMimirHandler(NewDummyMgrWithKeeper(keeper))
 	// happy path
-	msg := NewMsgMimir("foo", 44, addr)
+	msg := NewMsgMimir("HaltTrading", 44, addr)
 	err := handler.validate(ctx, *msg)
 	c.Assert(err, IsNil)
 
@@ -31,6 +32,32 @@ func (s *HandlerMimirSuite) TestValidate(c *C) {
 	msg = &MsgMimir{}
 	err = handler.validate(ctx, *msg)
 	c.Assert(err, NotNil)
+
+	// unknown key
+	msg = NewMsgMimir("HaltTradin", 1, addr)
+	c.Assert(handler.validate(ctx, *msg), NotNil)
+
+	// patterned key
+	msg = NewMsgMimir("HaltBTCTrading", 1, addr)
+	c.Assert(handler.validate(ctx, *msg), IsNil)
+
+	// out of bounds, unsetting is always allowed
+	msg = NewMsgMimir("PermittedSolvencyGap", 10_001, addr)
+	c.Assert(handler.validate(ctx, *msg), NotNil)
+	msg = NewMsgMimir("PermittedSolvencyGap", -1, addr)
+	c.Assert(handler.validate(ctx, *msg), IsNil)
+	msg = NewMsgMimir("ChurnInterval", 0, addr)
+	c.Assert(handler.validate(ctx, *msg), NotNil)
+
+	// admin only key
+	msg = NewMsgMimir("MimirUpgradeContractETH", 1, addr)
+	c.Assert(handler.validate(ctx, *msg), IsNil)
+	na := GetRandomValidatorNode(NodeActive)
+	c.Assert(keeper.SetNodeAccount(ctx, na), IsNil)
+	msg = NewMsgMimir("MimirUpgradeContractETH", 1, na.NodeAddress)
+	c.Assert(handler.validate(ctx, *msg), NotNil)
+	msg = NewMsgMimir("HaltETHTrading", 1, na.NodeAddress)
+	c.Assert(handler.validate(ctx, *msg), IsNil)
 }
 
 func (s *HandlerMimirSuite) TestMimirHandle(c *C) {
@@ -38,10 +65,10 @@ func (s *HandlerMimirSuite) TestMimirHandle(c *C) {
 	handler := NewMimirHandler(NewDummyMgrWithKeeper(keeper))
 	addr, err := cosmos.AccAddressFromBech32(ADMINS[0])
 	c.Assert(err, IsNil)
-	msg := NewMsgMimir("foo", 55, addr)
+	msg := NewMsgMimir("HaltTrading", 55, addr)
 	sdkErr := handler.handle(ctx, *msg)
 	c.Assert(sdkErr, IsNil)
-	val, err := keeper.GetMimir(ctx, "foo")
+	val, err := keeper.GetMimir(ctx, "HaltTrading")
 	c.Assert(err, IsNil)
 	c.Check(val, Equals, int64(55))
 
@@ -55,21 +82,21 @@ func (s *HandlerMimirSuite) TestMimirHandle(c *C) {
 	c.Assert(err, NotNil)
 	c.Assert(result, IsNil)
 
-	msg1 := NewMsgMimir("hello", 1, addr)
+	msg1 := NewMsgMimir("MaxSwapsPerBlock", 1, addr)
 	result, err = handler.Run(ctx, msg1)
 	c.Check(err, IsNil)
 	c.Check(result, NotNil)
 
-	val, err = keeper.GetMimir(ctx, "hello")
+	val, err = keeper.GetMimir(ctx, "MaxSwapsPerBlock")
 	c.Assert(err, IsNil)
 	c.Assert(val, Equals, int64(1))
 
 	// delete mimir
-	msg1 = NewMsgMimir("hello", -3, addr)
+	msg1 = NewMsgMimir("MaxSwapsPerBlock", -3, addr)
 	result, err = handler.Run(ctx, msg1)
 	c.Check(err, IsNil)
 	c.Check(result, NotNil)
-	val, err = keeper.GetMimir(ctx, "hello")
+	val, err = keeper.GetMimir(ctx, "MaxSwapsPerBlock")
 	c.Assert(err, IsNil)
 	c.Assert(val, Equals, int64(-1))
 
@@ -131,50 +158,140 @@ func (s *HandlerMimirSuite) TestMimirHandle(c *C) {
 	FundAccount(c, ctx, keeper, na3.NodeAddress, 5*common.One)
 
 	// first node set mimir , no consensus
-	result, err = handler.Run(ctx, NewMsgMimir("node-mimir", 1, na1.NodeAddress))
+	result, err = handler.Run(ctx, NewMsgMimir("HaltChurning", 1, na1.NodeAddress))
 	c.Assert(err, IsNil)
 	c.Assert(result, NotNil)
-	mvalue, err := keeper.GetMimir(ctx, "node-mimir")
+	mvalue, err := keeper.GetMimir(ctx, "HaltChurning")
 	c.Assert(err, IsNil)
 	c.Assert(mvalue, Equals, int64(-1))
 
 	// second node set mimir, reach consensus
-	result, err = handler.Run(ctx, NewMsgMimir("node-mimir", 1, na2.NodeAddress))
+	result, err = handler.Run(ctx, NewMsgMimir("HaltChurning", 1, na2.NodeAddress))
 	c.Assert(err, IsNil)
 	c.Assert(result, NotNil)
 
-	mvalue, err = keeper.GetMimir(ctx, "node-mimir")
+	mvalue, err = keeper.GetMimir(ctx, "HaltChurning")
 	c.Assert(err, IsNil)
 	c.Assert(mvalue, Equals, int64(1))
 
 	// third node set mimir, reach consensus
-	result, err = handler.Run(ctx, NewMsgMimir("node-mimir", 1, na3.NodeAddress))
+	result, err = handler.Run(ctx, NewMsgMimir("HaltChurning", 1, na3.NodeAddress))
 	c.Assert(err, IsNil)
 	c.Assert(result, NotNil)
 
-	mvalue, err = keeper.GetMimir(ctx, "node-mimir")
+	mvalue, err = keeper.GetMimir(ctx, "HaltChurning")
 	c.Assert(err, IsNil)
 	c.Assert(mvalue, Equals, int64(1))
 
 	// third node vote mimir to a different value, it should not change the admin mimir value
-	result, err = handler.Run(ctx, NewMsgMimir("node-mimir", 0, na3.NodeAddress))
+	result, err = handler.Run(ctx, NewMsgMimir("HaltChurning", 0, na3.NodeAddress))
 	c.Assert(err, IsNil)
 	c.Assert(result, NotNil)
 
-	mvalue, err = keeper.GetMimir(ctx, "node-mimir")
+	mvalue, err = keeper.GetMimir(ctx, "HaltChurning")
 	c.Assert(err, IsNil)
 	c.Assert(mvalue, Equals, int64(1))
 
 	// second node vote mimir to a different value , it should update admin mimir
-	result, err = handler.Run(ctx, NewMsgMimir("node-mimir", 0, na2.NodeAddress))
+	result, err = handler.Run(ctx, NewMsgMimir("HaltChurning", 0, na2.NodeAddress))
 	c.Assert(err, IsNil)
 	c.Assert(result, NotNil)
 
-	mvalue, err = keeper.GetMimir(ctx, "node-mimir")
+	mvalue, err = keeper.GetMimir(ctx, "HaltChurning")
 	c.Assert(err, IsNil)
 	c.Assert(mvalue, Equals, int64(0))
 
-	result, err = handler.Run(ctx, NewMsgMimir("node-mimir-1", 0, na2.NodeAddress))
+	result, err = handler.Run(ctx, NewMsgMimir("PauseLP", 0, na2.NodeAddress))
 	c.Assert(err, IsNil)
 	c.Assert(result, NotNil)
 }
+
+func (s *HandlerMimirSuite) TestPendingMimir(c *C) {
+	ctx, keeper := setupKeeperForTest(c)
+	handler := NewMimirHandler(NewDummyMgrWithKeeper(keeper))
+	admin, err := cosmos.AccAddressFromBech32(ADMINS[0])
+	c.Assert(err, IsNil)
+	height := ctx.BlockHeight() + 10
+
+	// activation height must be in the future, and not too far
+	_, err = handler.Run(ctx, NewMsgScheduleMimir("HaltTrading", 1, ctx.BlockHeight(), admin))
+	c.Assert(err, NotNil)
+	_, err = handler.Run(ctx, NewMsgScheduleMimir("HaltTrading", 1, ctx.BlockHeight()+maxMimirActivationBlocks+1, admin))
+	c.Assert(err, NotNil)
+
+	// schedule, nothing changes until the activation height
+	_, err = handler.Run(ctx, NewMsgScheduleMimir("HaltTrading", 1, height, admin))
+	c.Assert(err, IsNil)
+	_, err = handler.Run(ctx, NewMsgScheduleMimir("MaxSwapsPerBlock", 50, height, admin))
+	c.Assert(err, IsNil)
+	val, err := keeper.GetMimir(ctx, "HaltTrading")
+	c.Assert(err, IsNil)
+	c.Check(val, Equals, int64(-1))
+	pending, err := keeper.GetPendingMimirs(ctx, height)
+	c.Assert(err, IsNil)
+	c.Check(pending.Mimirs, HasLen, 2)
+
+	// node schedules and cancels its own vote, the fee is paid when scheduling
+	FundModule(c, ctx, keeper, BondName, 100*common.One)
+	na := GetRandomValidatorNode(NodeActive)
+	c.Assert(keeper.SetNodeAccount(ctx, na), IsNil)
+	FundAccount(c, ctx, keeper, na.NodeAddress, 5*common.One)
+	balance := keeper.GetBalance(ctx, na.NodeAddress).AmountOf(common.BaseNative.Native())
+	_, err = handler.Run(ctx, NewMsgScheduleMimir("HaltChurning", 1, height, na.NodeAddress))
+	c.Assert(err, IsNil)
+	fee := constants.GetConstantValues(GetCurrentVersion()).GetInt64Value(constants.NativeTransactionFee)
+	c.Check(keeper.GetBalance(ctx, na.NodeAddress).AmountOf(common.BaseNative.Native()).Int64(), Equals, balance.Int64()-fee)
+	_, err = handler.Run(ctx, NewMsgCancelMimir("HaltTrading", height, na.NodeAddress))
+	c.Assert(err, NotNil)
+	_, err = handler.Run(ctx, NewMsgCancelMimir("HaltChurning", height, na.NodeAddress))
+	c.Assert(err, IsNil)
+	_, err = handler.Run(ctx, NewMsgCancelMimir("HaltChurning", height, na.NodeAddress))
+	c.Assert(err, NotNil)
+
+	// apply at the activation height
+	c.Assert(handler.ApplyPendingMimirs(ctx), IsNil)
+	ctx = ctx.WithBlockHeight(height)
+	c.Assert(handler.ApplyPendingMimirs(ctx), IsNil)
+	val, err = keeper.GetMimir(ctx, "HaltTrading")
+	c.Assert(err, IsNil)
+	c.Check(val, Equals, int64(1))
+	val, err = keeper.GetMimir(ctx, "MaxSwapsPerBlock")
+	c.Assert(err, IsNil)
+	c.Check(val, Equals, int64(50))
+	pending, err = keeper.GetPendingMimirs(ctx, height)
+	c.Assert(err, IsNil)
+	c.Check(pending.Mimirs, HasLen, 0)
+
+	// the node vote isn't charged again when applied
+	height = ctx.BlockHeight() + 10
+	_, err = handler.Run(ctx, NewMsgScheduleMimir("HaltChurning", 1, height, na.NodeAddress))
+	c.Assert(err, IsNil)
+	balance = keeper.GetBalance(ctx, na.NodeAddress).AmountOf(common.BaseNative.Native())
+	ctx = ctx.WithBlockHeight(height)
+	c.Assert(handler.ApplyPendingMimirs(ctx), IsNil)
+	c.Check(keeper.GetBalance(ctx, na.NodeAddress).AmountOf(common.BaseNative.Native()).Int64(), Equals, balance.Int64())
+	nodeMimirs, err := keeper.GetNodeMimirs(ctx, "HaltChurning")
+	c.Assert(err, IsNil)
+	c.Check(nodeMimirs.Mimirs, HasLen, 1)
+
+	// the changes of a block apply one by one, one that fails doesn't hold back the others
+	height = ctx.BlockHeight() + 10
+	_, err = handler.Run(ctx, NewMsgScheduleMimir("HaltTrading", 0, height, admin))
+	c.Assert(err, IsNil)
+	_, err = handler.Run(ctx, NewMsgScheduleMimir("HaltChurning", 0, height, na.NodeAddress))
+	c.Assert(err, IsNil)
+	na.Status = NodeStandby
+	c.Assert(keeper.SetNodeAccount(ctx, na), IsNil)
+	ctx = ctx.WithBlockHeight(height)
+	c.Assert(handler.ApplyPendingMimirs(ctx), NotNil)
+	val, err = keeper.GetMimir(ctx, "HaltTrading")
+	c.Assert(err, IsNil)
+	c.Check(val, Equals, int64(0))
+	nodeMimirs, err = keeper.GetNodeMimirs(ctx, "HaltChurning")
+	c.Assert(err, IsNil)
+	c.Assert(nodeMimirs.Mimirs, HasLen, 1)
+	c.Check(nodeMimirs.Mimirs[0].Value, Equals, int64(1))
+	pending, err = keeper.GetPendingMimirs(ctx, height)
+	c.Assert(err, IsNil)
+	c.Check(pending.Mimirs, HasLen, 0)
+}
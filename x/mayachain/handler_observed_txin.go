@@ -68,6 +68,7 @@ func (h ObservedTxInHandler) validateV1(ctx cosmos.Context, msg MsgObservedTxIn)
 
 func (h ObservedTxInHandler) handle(ctx cosmos.Context, msg MsgObservedTxIn) (*cosmos.Result, error) {
 	version := h.mgr.GetVersion()
+	msg.Txs = settleInclusionProofs(ctx, version, msg.Txs)
 	switch {
 	case version.GTE(semver.MustParse("1.89.0")):
 		return h.handleV89(ctx, msg)
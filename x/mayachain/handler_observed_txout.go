@@ -65,6 +65,7 @@ func (h ObservedTxOutHandler) validateV1(ctx cosmos.Context, msg MsgObservedTxOu
 
 func (h ObservedTxOutHandler) handle(ctx cosmos.Context, msg MsgObservedTxOut) (*cosmos.Result, error) {
 	version := h.mgr.GetVersion()
+	msg.Txs = settleInclusionProofs(ctx, version, msg.Txs)
 	switch {
 	case version.GTE(semver.MustParse("1.104.0")):
 		return h.handleV104(ctx, msg)
@@ -104,7 +105,14 @@ func (h ObservedTxOutHandler) preflightV89(ctx cosmos.Context, voter ObservedTxV
 	}
 	parts := strings.Split(tx.Tx.Memo, ":")
 	if len(parts) > 1 {
-		inhash, err := common.NewTxID(parts[len(parts)-1])
+		var inhash common.TxID
+		var err error
+		// sub indexed inbound hashes are only linked from 1.106.0
+		if h.mgr.GetVersion().GTE(semver.MustParse("1.106.0")) {
+			inhash, err = common.NewTxIDV106(parts[len(parts)-1])
+		} else {
+			inhash, err = common.NewTxID(parts[len(parts)-1])
+		}
 		if err == nil {
 			h.mgr.Keeper().SetObservedLink(ctx, inhash, tx.Tx.ID)
 		}
@@ -214,13 +214,21 @@ func (k *TestObservedTxOutHandleKeeper) GetModuleAddress(module string) (common.
 }
 
 func (s *HandlerObservedTxOutSuite) TestHandle(c *C) {
+	subTxID, err := common.NewSubTxID(GetRandomTxHash().String(), 1)
+	c.Assert(err, IsNil)
+	// the outbound of an inbound split from a multi msg tx is matched like any other
+	for _, txInHash := range []common.TxID{GetRandomTxHash(), subTxID} {
+		s.testHandle(c, txInHash)
+	}
+}
+
+func (s *HandlerObservedTxOutSuite) testHandle(c *C, txInHash common.TxID) {
 	var err error
 	ctx, mgr := setupManagerForTest(c)
 
 	tx := GetRandomTx()
 	pk := GetRandomPubKey()
 	tx.FromAddress, err = pk.GetAddress(tx.Coins[0].Asset.Chain)
-	txInHash := GetRandomTxHash()
 	tx.Memo = fmt.Sprintf("OUT:%s", txInHash)
 	obTx := NewObservedTx(tx, 12, pk, 12)
 	txs := ObservedTxs{obTx}
@@ -0,0 +1,96 @@
+package mayachain
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+// SetEVMTokensHandler process MsgSetEVMTokens
+// MsgSetEVMTokens is used by admins to list, update and delist the tokens
+// whitelisted on the EVM chains, without having to release a new binary
+type SetEVMTokensHandler struct {
+	mgr Manager
+}
+
+// NewSetEVMTokensHandler create a new instance of SetEVMTokensHandler
+func NewSetEVMTokensHandler(mgr Manager) SetEVMTokensHandler {
+	return SetEVMTokensHandler{
+		mgr: mgr,
+	}
+}
+
+// Run is the main entry point to process MsgSetEVMTokens
+func (h SetEVMTokensHandler) Run(ctx cosmos.Context, m cosmos.Msg) (*cosmos.Result, error) {
+	msg, ok := m.(*MsgSetEVMTokens)
+	if !ok {
+		return nil, errInvalidMessage
+	}
+	if err := h.validate(ctx, *msg); err != nil {
+		ctx.Logger().Error("MsgSetEVMTokens failed validation", "error", err)
+		return nil, err
+	}
+	if err := h.handle(ctx, *msg); err != nil {
+		ctx.Logger().Error("fail to process MsgSetEVMTokens", "error", err)
+		return nil, err
+	}
+	return &cosmos.Result{}, nil
+}
+
+func (h SetEVMTokensHandler) validate(ctx cosmos.Context, msg MsgSetEVMTokens) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("1.106.0")):
+		return h.validateV106(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+func (h SetEVMTokensHandler) isAdmin(acc cosmos.AccAddress) bool {
+	for _, admin := range ADMINS {
+		addr, err := cosmos.AccAddressFromBech32(admin)
+		if acc.Equals(addr) && err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (h SetEVMTokensHandler) validateV106(ctx cosmos.Context, msg MsgSetEVMTokens) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	if !h.isAdmin(msg.Signer) {
+		return cosmos.ErrUnauthorized(fmt.Sprintf("%s is not authorized", msg.Signer))
+	}
+	return nil
+}
+
+func (h SetEVMTokensHandler) handle(ctx cosmos.Context, msg MsgSetEVMTokens) error {
+	ctx.Logger().Info("handleMsgSetEVMTokens request", "signer", msg.Signer, "tokens", len(msg.Tokens))
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("1.106.0")):
+		return h.handleV106(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+func (h SetEVMTokensHandler) handleV106(ctx cosmos.Context, msg MsgSetEVMTokens) error {
+	for _, token := range msg.Tokens {
+		// the delisted entry is kept, as it has to shadow the compiled token list
+		if err := h.mgr.Keeper().SetEVMToken(ctx, token); err != nil {
+			return fmt.Errorf("fail to set evm token %s: %w", token.Key(), err)
+		}
+
+		evt := NewEventEVMToken(token, msg.Signer)
+		if err := h.mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+			ctx.Logger().Error("fail to emit evm token event", "error", err)
+		}
+	}
+	return nil
+}
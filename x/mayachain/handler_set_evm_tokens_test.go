@@ -0,0 +1,94 @@
+package mayachain
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/x/mayachain/types"
+)
+
+type HandlerSetEVMTokensSuite struct{}
+
+var _ = Suite(&HandlerSetEVMTokensSuite{})
+
+func (s *HandlerSetEVMTokensSuite) SetUpSuite(c *C) {
+	SetupConfigForTest()
+}
+
+func (s *HandlerSetEVMTokensSuite) TestValidate(c *C) {
+	ctx, keeper := setupKeeperForTest(c)
+	handler := NewSetEVMTokensHandler(NewDummyMgrWithKeeper(keeper))
+
+	admin, err := cosmos.AccAddressFromBech32(ADMINS[0])
+	c.Assert(err, IsNil)
+	tokens := EVMTokens{
+		NewEVMToken(common.ETHChain, "0xdAC17F958D2ee523a2206206994597C13D831ec7", "USDT", 6),
+	}
+
+	// happy path
+	msg := NewMsgSetEVMTokens(tokens, admin)
+	c.Assert(handler.validate(ctx, *msg), IsNil)
+
+	// invalid msg
+	msg = &MsgSetEVMTokens{}
+	c.Assert(handler.validate(ctx, *msg), NotNil)
+
+	// not an admin
+	msg = NewMsgSetEVMTokens(tokens, GetRandomBech32Addr())
+	c.Assert(handler.validate(ctx, *msg), NotNil)
+}
+
+func (s *HandlerSetEVMTokensSuite) TestHandle(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	handler := NewSetEVMTokensHandler(mgr)
+
+	admin, err := cosmos.AccAddressFromBech32(ADMINS[0])
+	c.Assert(err, IsNil)
+	compiled := getEVMTokenWhitelist(ctx, mgr.Keeper(), common.ETHChain)
+	c.Assert(len(compiled) > 0, Equals, true)
+	avax := getEVMTokenWhitelist(ctx, mgr.Keeper(), common.AVAXChain)
+
+	listed := NewEVMToken(common.ETHChain, "0x1111111111111111111111111111111111111abc", "FOO", 18)
+	delisted := EVMToken{Chain: common.ETHChain, Address: compiled[0].Address, Delisted: true}
+	msg := NewMsgSetEVMTokens(EVMTokens{listed, delisted}, admin)
+	result, err := handler.Run(ctx, msg)
+	c.Assert(err, IsNil)
+	c.Assert(result, NotNil)
+
+	token, err := mgr.Keeper().GetEVMToken(ctx, common.ETHChain, listed.Address)
+	c.Assert(err, IsNil)
+	c.Check(token.Symbol, Equals, "FOO")
+
+	whitelist := getEVMTokenWhitelist(ctx, mgr.Keeper(), common.ETHChain)
+	c.Check(whitelist, HasLen, len(compiled))
+	found := false
+	for _, item := range whitelist {
+		c.Check(item.Key() == delisted.Key(), Equals, false)
+		if item.Key() == listed.Key() {
+			found = true
+		}
+	}
+	c.Check(found, Equals, true)
+	// the whitelist of another chain is untouched
+	c.Check(getEVMTokenWhitelist(ctx, mgr.Keeper(), common.AVAXChain), DeepEquals, avax)
+
+	events := 0
+	for _, evt := range ctx.EventManager().Events() {
+		if evt.Type == types.EVMTokenEventType {
+			events++
+		}
+	}
+	c.Check(events, Equals, 2)
+
+	// unauthorized signer
+	msg.Signer = GetRandomBech32Addr()
+	result, err = handler.Run(ctx, msg)
+	c.Assert(err, NotNil)
+	c.Assert(result, IsNil)
+
+	// wrong msg type
+	result, err = handler.Run(ctx, NewMsgMimir("foo", 1, admin))
+	c.Assert(err, NotNil)
+	c.Assert(result, IsNil)
+}
@@ -0,0 +1,115 @@
+package mayachain
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/constants"
+)
+
+// SetLiquidityAuctionTiersHandler process MsgSetLiquidityAuctionTiers
+// MsgSetLiquidityAuctionTiers is used by admins to assign and revoke the
+// liquidity auction tiers of liquidity providers in bulk
+type SetLiquidityAuctionTiersHandler struct {
+	mgr Manager
+}
+
+// NewSetLiquidityAuctionTiersHandler create a new instance of SetLiquidityAuctionTiersHandler
+func NewSetLiquidityAuctionTiersHandler(mgr Manager) SetLiquidityAuctionTiersHandler {
+	return SetLiquidityAuctionTiersHandler{
+		mgr: mgr,
+	}
+}
+
+// Run is the main entry point to process MsgSetLiquidityAuctionTiers
+func (h SetLiquidityAuctionTiersHandler) Run(ctx cosmos.Context, m cosmos.Msg) (*cosmos.Result, error) {
+	msg, ok := m.(*MsgSetLiquidityAuctionTiers)
+	if !ok {
+		return nil, errInvalidMessage
+	}
+	if err := h.validate(ctx, *msg); err != nil {
+		ctx.Logger().Error("MsgSetLiquidityAuctionTiers failed validation", "error", err)
+		return nil, err
+	}
+	if err := h.handle(ctx, *msg); err != nil {
+		ctx.Logger().Error("fail to process MsgSetLiquidityAuctionTiers", "error", err)
+		return nil, err
+	}
+	return &cosmos.Result{}, nil
+}
+
+func (h SetLiquidityAuctionTiersHandler) validate(ctx cosmos.Context, msg MsgSetLiquidityAuctionTiers) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("1.106.0")):
+		return h.validateV106(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+func (h SetLiquidityAuctionTiersHandler) isAdmin(acc cosmos.AccAddress) bool {
+	for _, admin := range ADMINS {
+		addr, err := cosmos.AccAddressFromBech32(admin)
+		if acc.Equals(addr) && err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (h SetLiquidityAuctionTiersHandler) validateV106(ctx cosmos.Context, msg MsgSetLiquidityAuctionTiers) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	if !h.isAdmin(msg.Signer) {
+		return cosmos.ErrUnauthorized(fmt.Sprintf("%s is not authorized", msg.Signer))
+	}
+
+	// zero revokes the tier, anything else has to be one of the withdraw tiers
+	validTiers := map[int64]bool{
+		0: true,
+		h.mgr.GetConstants().GetInt64Value(constants.WithdrawTier1): true,
+		h.mgr.GetConstants().GetInt64Value(constants.WithdrawTier2): true,
+		h.mgr.GetConstants().GetInt64Value(constants.WithdrawTier3): true,
+	}
+	for _, tier := range msg.Tiers {
+		if !validTiers[tier.Tier] {
+			return cosmos.ErrUnknownRequest(fmt.Sprintf("invalid tier(%d) for %s", tier.Tier, tier.Address))
+		}
+	}
+	return nil
+}
+
+func (h SetLiquidityAuctionTiersHandler) handle(ctx cosmos.Context, msg MsgSetLiquidityAuctionTiers) error {
+	ctx.Logger().Info("handleMsgSetLiquidityAuctionTiers request", "signer", msg.Signer, "tiers", len(msg.Tiers))
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("1.106.0")):
+		return h.handleV106(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+func (h SetLiquidityAuctionTiersHandler) handleV106(ctx cosmos.Context, msg MsgSetLiquidityAuctionTiers) error {
+	for _, tier := range msg.Tiers {
+		previous, err := h.mgr.Keeper().GetLiquidityAuctionTier(ctx, tier.Address)
+		if err != nil {
+			return fmt.Errorf("fail to get liquidity auction tier of %s: %w", tier.Address, err)
+		}
+		if tier.Tier == 0 {
+			h.mgr.Keeper().RemoveLiquidityAuctionTier(ctx, tier.Address)
+		} else if err := h.mgr.Keeper().SetLiquidityAuctionTier(ctx, tier.Address, tier.Tier); err != nil {
+			return fmt.Errorf("fail to set liquidity auction tier of %s: %w", tier.Address, err)
+		}
+
+		evt := NewEventLiquidityAuctionTier(tier.Address, tier.Tier, previous, msg.Signer)
+		if err := h.mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+			ctx.Logger().Error("fail to emit liquidity auction tier event", "error", err)
+		}
+	}
+	return nil
+}
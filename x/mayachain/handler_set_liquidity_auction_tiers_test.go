@@ -0,0 +1,91 @@
+package mayachain
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/x/mayachain/types"
+)
+
+type HandlerSetLiquidityAuctionTiersSuite struct{}
+
+var _ = Suite(&HandlerSetLiquidityAuctionTiersSuite{})
+
+func (s *HandlerSetLiquidityAuctionTiersSuite) SetUpSuite(c *C) {
+	SetupConfigForTest()
+}
+
+func (s *HandlerSetLiquidityAuctionTiersSuite) TestValidate(c *C) {
+	ctx, keeper := setupKeeperForTest(c)
+	handler := NewSetLiquidityAuctionTiersHandler(NewDummyMgrWithKeeper(keeper))
+
+	admin, err := cosmos.AccAddressFromBech32(ADMINS[0])
+	c.Assert(err, IsNil)
+	tiers := LiquidityAuctionTiers{
+		{Address: GetRandomBaseAddress(), Tier: 1},
+		{Address: GetRandomBaseAddress(), Tier: 0},
+	}
+
+	// happy path
+	msg := NewMsgSetLiquidityAuctionTiers(tiers, admin)
+	c.Assert(handler.validate(ctx, *msg), IsNil)
+
+	// invalid msg
+	msg = &MsgSetLiquidityAuctionTiers{}
+	c.Assert(handler.validate(ctx, *msg), NotNil)
+
+	// not an admin
+	msg = NewMsgSetLiquidityAuctionTiers(tiers, GetRandomBech32Addr())
+	c.Assert(handler.validate(ctx, *msg), NotNil)
+
+	// unknown tier
+	msg = NewMsgSetLiquidityAuctionTiers(LiquidityAuctionTiers{
+		{Address: GetRandomBaseAddress(), Tier: 4},
+	}, admin)
+	c.Assert(handler.validate(ctx, *msg), NotNil)
+}
+
+func (s *HandlerSetLiquidityAuctionTiersSuite) TestHandle(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	handler := NewSetLiquidityAuctionTiersHandler(mgr)
+
+	admin, err := cosmos.AccAddressFromBech32(ADMINS[0])
+	c.Assert(err, IsNil)
+	addr1 := GetRandomBaseAddress()
+	addr2 := GetRandomBaseAddress()
+	c.Assert(mgr.Keeper().SetLiquidityAuctionTier(ctx, addr2, 3), IsNil)
+
+	msg := NewMsgSetLiquidityAuctionTiers(LiquidityAuctionTiers{
+		{Address: addr1, Tier: 2},
+		{Address: addr2, Tier: 0},
+	}, admin)
+	result, err := handler.Run(ctx, msg)
+	c.Assert(err, IsNil)
+	c.Assert(result, NotNil)
+
+	tier, err := mgr.Keeper().GetLiquidityAuctionTier(ctx, addr1)
+	c.Assert(err, IsNil)
+	c.Check(tier, Equals, int64(2))
+	tier, err = mgr.Keeper().GetLiquidityAuctionTier(ctx, addr2)
+	c.Assert(err, IsNil)
+	c.Check(tier, Equals, int64(0))
+
+	found := 0
+	for _, evt := range ctx.EventManager().Events() {
+		if evt.Type == types.LiquidityAuctionTierEventType {
+			found++
+		}
+	}
+	c.Check(found, Equals, 2)
+
+	// unauthorized signer
+	msg.Signer = GetRandomBech32Addr()
+	result, err = handler.Run(ctx, msg)
+	c.Assert(err, NotNil)
+	c.Assert(result, IsNil)
+
+	// wrong msg type
+	result, err = handler.Run(ctx, NewMsgMimir("foo", 1, admin))
+	c.Assert(err, NotNil)
+	c.Assert(result, IsNil)
+}
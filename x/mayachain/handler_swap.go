@@ -44,6 +44,8 @@ func (h SwapHandler) Run(ctx cosmos.Context, m cosmos.Msg) (*cosmos.Result, erro
 func (h SwapHandler) validate(ctx cosmos.Context, msg MsgSwap) error {
 	version := h.mgr.GetVersion()
 	switch {
+	case version.GTE(semver.MustParse("1.106.0")):
+		return h.validateV106(ctx, msg)
 	case version.GTE(semver.MustParse("1.101.0")):
 		return h.validateV101(ctx, msg)
 	case version.GTE(semver.MustParse("1.95.0")):
@@ -53,8 +55,8 @@ func (h SwapHandler) validate(ctx cosmos.Context, msg MsgSwap) error {
 	}
 }
 
-func (h SwapHandler) validateV101(ctx cosmos.Context, msg MsgSwap) error {
-	if err := msg.ValidateBasicV63(); err != nil {
+func (h SwapHandler) validateV106(ctx cosmos.Context, msg MsgSwap) error {
+	if err := msg.ValidateBasicV106(); err != nil {
 		return err
 	}
 
@@ -67,6 +69,10 @@ func (h SwapHandler) validateV101(ctx cosmos.Context, msg MsgSwap) error {
 		return errors.New("liquidity auction is in progress, can't process swap")
 	}
 
+	if msg.IBCChannel != "" && !h.mgr.Keeper().GetIBCTransferParams(ctx).SendEnabled {
+		return errors.New("ibc send is disabled, can't process swap")
+	}
+
 	if target.IsSyntheticAsset() {
 		// the following  only applicable for chaosnet
 		totalLiquidityRUNE, err := h.getTotalLiquidityRUNE(ctx)
@@ -158,6 +164,8 @@ func (h SwapHandler) handle(ctx cosmos.Context, msg MsgSwap) (*cosmos.Result, er
 	ctx.Logger().Info("receive MsgSwap", "request tx hash", msg.Tx.ID, "source asset", msg.Tx.Coins[0].Asset, "target asset", msg.TargetAsset, "signer", msg.Signer.String())
 	version := h.mgr.GetVersion()
 	switch {
+	case version.GTE(semver.MustParse("1.106.0")):
+		return h.handleV106(ctx, msg)
 	case version.GTE(semver.MustParse("1.95.0")):
 		return h.handleV95(ctx, msg)
 	default:
@@ -165,12 +173,34 @@ func (h SwapHandler) handle(ctx cosmos.Context, msg MsgSwap) (*cosmos.Result, er
 	}
 }
 
-func (h SwapHandler) handleV95(ctx cosmos.Context, msg MsgSwap) (*cosmos.Result, error) {
-	// test that the network we are running matches the destination network
-	if !common.GetCurrentChainNetwork().SoftEquals(msg.Destination.GetNetwork(h.mgr.GetVersion(), msg.Destination.GetChain())) {
+func (h SwapHandler) handleV106(ctx cosmos.Context, msg MsgSwap) (*cosmos.Result, error) {
+	// IBC transfers are sent out from the MAYAChain bank to an address on the
+	// counterparty chain, so the swap itself doesn't have a destination and
+	// the outbound is scheduled once the swap is done
+	destination := msg.Destination
+	outboundChain := msg.Destination.GetChainV106()
+	if msg.IBCChannel != "" {
+		destination = common.NoopAddress
+		outboundChain = common.BASEChain
+	} else if !common.GetCurrentChainNetwork().SoftEquals(msg.Destination.GetNetwork(h.mgr.GetVersion(), msg.Destination.GetChainV106())) {
+		// test that the network we are running matches the destination network
 		return nil, fmt.Errorf("address(%s) is not same network", msg.Destination)
 	}
-	transactionFee := h.mgr.GasMgr().GetFee(ctx, msg.Destination.GetChain(), common.BaseAsset())
+
+	// when a fee asset is chosen, the outbound fee is taken from the inbound
+	// before the swap, and the outbound is scheduled once the swap is done
+	feePaid := false
+	if !msg.FeeAsset.IsEmpty() && !msg.FeeAsset.Equals(msg.TargetAsset) {
+		tx, err := h.payOutboundFee(ctx, msg, outboundChain)
+		if err != nil {
+			return nil, err
+		}
+		msg.Tx = tx
+		destination = common.NoopAddress
+		feePaid = true
+	}
+
+	transactionFee := h.mgr.GasMgr().GetFee(ctx, outboundChain, common.BaseAsset())
 	synthVirtualDepthMult, err := h.mgr.Keeper().GetMimir(ctx, constants.VirtualMultSynthsBasisPoints.String())
 	if synthVirtualDepthMult < 1 || err != nil {
 		synthVirtualDepthMult = h.mgr.GetConstants().GetInt64Value(constants.VirtualMultSynthsBasisPoints)
@@ -200,7 +230,7 @@ func (h SwapHandler) handleV95(ctx cosmos.Context, msg MsgSwap) (*cosmos.Result,
 		h.mgr.Keeper(),
 		msg.Tx,
 		msg.TargetAsset,
-		msg.Destination,
+		destination,
 		msg.TradeTarget,
 		dexAgg,
 		dexAggTargetAsset,
@@ -212,6 +242,34 @@ func (h SwapHandler) handleV95(ctx cosmos.Context, msg MsgSwap) (*cosmos.Result,
 		return nil, swapErr
 	}
 
+	if msg.IBCChannel != "" || feePaid {
+		toi := TxOutItem{
+			Chain:                 msg.TargetAsset.GetChain(),
+			InHash:                msg.Tx.ID,
+			ToAddress:             msg.Destination,
+			Coin:                  common.NewCoin(msg.TargetAsset, emit),
+			Aggregator:            dexAgg,
+			AggregatorTargetAsset: dexAggTargetAsset,
+			AggregatorTargetLimit: msg.AggregatorTargetLimit,
+			FeePaid:               feePaid,
+		}
+		if msg.IBCChannel != "" {
+			toi.Chain = common.BASEChain
+			toi.IBCChannel = msg.IBCChannel
+		}
+		// let the txout manager mint our outbound asset if it is a synthetic asset
+		if toi.Chain.IsBASEChain() && toi.Coin.Asset.IsSyntheticAsset() {
+			toi.ModuleName = ModuleName
+		}
+		ok, err := h.mgr.TxOutStore().TryAddTxOutItem(ctx, h.mgr, toi, msg.TradeTarget)
+		if err != nil {
+			return nil, ErrInternal(err, "fail to add outbound tx")
+		}
+		if !ok {
+			return nil, errFailAddOutboundTx
+		}
+	}
+
 	mem, err := ParseMemoWithMAYANames(ctx, h.mgr.Keeper(), msg.Tx.Memo)
 	if err != nil {
 		ctx.Logger().Error("swap handler failed to parse memo", "memo", msg.Tx.Memo, "error", err)
@@ -240,6 +298,71 @@ func (h SwapHandler) handleV95(ctx cosmos.Context, msg MsgSwap) (*cosmos.Result,
 	return &cosmos.Result{}, nil
 }
 
+// payOutboundFee takes the outbound fee of the given chain from the inbound
+// coin, in the coin itself or in its CACAO value, and sends it to the reserve.
+// It returns the inbound tx with the fee deducted.
+func (h SwapHandler) payOutboundFee(ctx cosmos.Context, msg MsgSwap, chain common.Chain) (common.Tx, error) {
+	tx := msg.Tx
+	coin := tx.Coins[0]
+	fee := h.mgr.GasMgr().GetFee(ctx, chain, coin.Asset)
+	if msg.FeeAsset.IsNativeBase() && !coin.Asset.IsBase() {
+		pool, err := h.mgr.Keeper().GetPool(ctx, coin.Asset.GetLayer1Asset())
+		if err != nil {
+			return tx, ErrInternal(err, "fail to get pool")
+		}
+		fee = pool.RuneValueInAsset(h.mgr.GasMgr().GetFee(ctx, chain, common.BaseAsset()))
+	}
+	if fee.IsZero() {
+		return tx, fmt.Errorf("fail to get outbound fee for chain(%s) in %s", chain, coin.Asset)
+	}
+	if coin.Amount.LTE(fee) {
+		return tx, fmt.Errorf("%s is not enough to pay the outbound fee (%s)", coin, fee)
+	}
+
+	feeCacao := fee
+	if !coin.Asset.IsBase() {
+		pool, err := h.mgr.Keeper().GetPool(ctx, coin.Asset.GetLayer1Asset())
+		if err != nil {
+			return tx, ErrInternal(err, "fail to get pool")
+		}
+		feeCacao = pool.RuneDisbursementForAssetAdd(fee)
+		if feeCacao.GT(pool.BalanceCacao) {
+			feeCacao = pool.BalanceCacao
+		}
+		if coin.Asset.IsSyntheticAsset() {
+			// synths paid as fee are burned, their value is taken from the pool
+			feeCoins := common.NewCoins(common.NewCoin(coin.Asset, fee))
+			if err := h.mgr.Keeper().SendFromModuleToModule(ctx, AsgardName, ModuleName, feeCoins); err != nil {
+				return tx, ErrInternal(err, "fail to move synth fee from asgard")
+			}
+			if err := h.mgr.Keeper().BurnFromModule(ctx, ModuleName, feeCoins[0]); err != nil {
+				return tx, ErrInternal(err, "fail to burn synth fee")
+			}
+		} else {
+			pool.BalanceAsset = pool.BalanceAsset.Add(fee)
+		}
+		pool.BalanceCacao = common.SafeSub(pool.BalanceCacao, feeCacao)
+		if err := h.mgr.Keeper().SetPool(ctx, pool); err != nil {
+			return tx, ErrInternal(err, "fail to save pool")
+		}
+	}
+	if err := h.mgr.Keeper().AddPoolFeeToReserve(ctx, feeCacao); err != nil {
+		return tx, ErrInternal(err, "fail to add outbound fee to reserve")
+	}
+	if !chain.IsBASEChain() {
+		if err := h.mgr.Keeper().AddToOutboundFeeWithheldCacao(ctx, chain, feeCacao); err != nil {
+			ctx.Logger().Error("fail to add outbound fee withheld", "chain", chain, "error", err)
+		}
+	}
+	feeEvt := NewEventFee(tx.ID, common.NewFee(common.Coins{common.NewCoin(coin.Asset, fee)}, feeCacao), cosmos.ZeroUint())
+	if err := h.mgr.EventMgr().EmitFeeEvent(ctx, feeEvt); err != nil {
+		ctx.Logger().Error("fail to emit fee event", "error", err)
+	}
+
+	tx.Coins = common.Coins{common.NewCoin(coin.Asset, common.SafeSub(coin.Amount, fee))}
+	return tx, nil
+}
+
 // get the total bond of the bottom 2/3rds active validators
 func (h SwapHandler) getEffectiveSecurityBond(ctx cosmos.Context, mgr Manager) (cosmos.Uint, error) {
 	nodeAccounts, err := h.mgr.Keeper().ListActiveValidators(ctx)
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	se "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctransfertypes "github.com/cosmos/ibc-go/v2/modules/apps/transfer/types"
 
 	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
@@ -518,3 +519,174 @@ func (s *HandlerSwapSuite) TestSwapOutDexIntegration(c *C) {
 	c.Assert(items[0].AggregatorTargetAsset, Equals, swapM.DexTargetAddress)
 	c.Assert(items[0].AggregatorTargetLimit, IsNil)
 }
+
+// nativeTxOutStoreTest keeps the outbounds on BASEChain, which the dummy
+// txout store drops
+type nativeTxOutStoreTest struct {
+	*TxOutStoreDummy
+	items []TxOutItem
+}
+
+func (tos *nativeTxOutStoreTest) TryAddTxOutItem(ctx cosmos.Context, mgr Manager, toi TxOutItem, minOut cosmos.Uint) (bool, error) {
+	tos.items = append(tos.items, toi)
+	return true, nil
+}
+
+func (s *HandlerSwapSuite) TestSwapIBCChannel(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	txOutStore := &nativeTxOutStoreTest{TxOutStoreDummy: NewTxStoreDummy()}
+	mgr.txOutStore = txOutStore
+	mgr.Keeper().SetIBCTransferParams(ctx, ibctransfertypes.Params{SendEnabled: true})
+	handler := NewSwapHandler(mgr)
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceAsset = cosmos.NewUint(10000 * common.One)
+	pool.BalanceCacao = cosmos.NewUint(10000 * common.One)
+	pool.Status = PoolAvailable
+	c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+
+	memo := "=:MAYA.CACAO:cosmos1xv9tklw7d82sezh9haa573wufgy59vmwe6xxe5:::::::channel-3"
+	m, err := ParseMemoWithMAYANames(ctx, mgr.Keeper(), memo)
+	c.Assert(err, IsNil)
+	// ibc transfers are only sent for swaps from MAYAChain
+	coin := common.NewCoin(common.BNBAsset.GetSyntheticAsset(), cosmos.NewUint(100*common.One))
+	c.Assert(mgr.Keeper().MintToModule(ctx, ModuleName, coin), IsNil)
+	c.Assert(mgr.Keeper().SendFromModuleToModule(ctx, ModuleName, AsgardName, common.NewCoins(coin)), IsNil)
+	txIn := NewObservedTx(
+		common.NewTx(GetRandomTxHash(), GetRandomBaseAddress(), GetRandomBaseAddress(),
+			common.Coins{coin},
+			common.Gas{common.NewCoin(common.BaseAsset(), cosmos.NewUint(2000000000))},
+			memo,
+		),
+		1,
+		GetRandomPubKey(), 1,
+	)
+	observerAddr, err := GetRandomBaseAddress().AccAddress()
+	c.Assert(err, IsNil)
+	msg, err := getMsgSwapFromMemo(m.(SwapMemo), txIn, observerAddr)
+	c.Assert(err, IsNil)
+	c.Assert(msg.(*MsgSwap).IBCChannel, Equals, "channel-3")
+
+	res, err := handler.Run(ctx, msg)
+	c.Assert(err, IsNil)
+	c.Assert(res, NotNil)
+
+	var ibcItems []TxOutItem
+	for _, item := range txOutStore.items {
+		if item.IBCChannel != "" {
+			ibcItems = append(ibcItems, item)
+		}
+	}
+	c.Assert(ibcItems, HasLen, 1)
+	c.Check(ibcItems[0].IBCChannel, Equals, "channel-3")
+	c.Check(ibcItems[0].Chain.Equals(common.BASEChain), Equals, true)
+	c.Check(ibcItems[0].ToAddress.String(), Equals, "cosmos1xv9tklw7d82sezh9haa573wufgy59vmwe6xxe5")
+	c.Check(ibcItems[0].Coin.Asset.Equals(common.BaseAsset()), Equals, true)
+	c.Check(ibcItems[0].InHash.Equals(txIn.Tx.ID), Equals, true)
+}
+
+func (s *HandlerSwapSuite) TestSwapFeeAsset(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	mgr.txOutStore = NewTxStoreDummy()
+	handler := NewSwapHandler(mgr)
+	FundModule(c, ctx, mgr.Keeper(), AsgardName, 20000)
+
+	for _, asset := range []common.Asset{common.BNBAsset, common.BTCAsset} {
+		pool := NewPool()
+		pool.Asset = asset
+		pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+		pool.BalanceCacao = cosmos.NewUint(10000 * common.One)
+		pool.Status = PoolAvailable
+		c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+	}
+	c.Assert(mgr.Keeper().SaveNetworkFee(ctx, common.BTCChain, NetworkFee{
+		Chain:              common.BTCChain,
+		TransactionSize:    250,
+		TransactionFeeRate: 100,
+	}), IsNil)
+
+	observerAddr, err := GetRandomBaseAddress().AccAddress()
+	c.Assert(err, IsNil)
+	run := handler.Run
+	swap := func(amount uint64, memo string) error {
+		m, err := ParseMemoWithMAYANames(ctx, mgr.Keeper(), memo)
+		c.Assert(err, IsNil)
+		txIn := NewObservedTx(
+			common.NewTx(GetRandomTxHash(), GetRandomBNBAddress(), GetRandomBNBAddress(),
+				common.Coins{common.NewCoin(common.BNBAsset, cosmos.NewUint(amount))},
+				BNBGasFeeSingleton,
+				memo,
+			),
+			1,
+			GetRandomPubKey(), 1,
+		)
+		msg, err := getMsgSwapFromMemo(m.(SwapMemo), txIn, observerAddr)
+		c.Assert(err, IsNil)
+		_, err = run(ctx, msg)
+		return err
+	}
+	fee := mgr.GasMgr().GetFee(ctx, common.BTCChain, common.BNBAsset)
+	c.Assert(fee.IsZero(), Equals, false)
+
+	// the inbound must be enough to pay the outbound fee
+	memo := "=:BTC.BTC:" + GetRandomBTCAddress().String() + "::::::::BNB.BNB"
+	c.Assert(swap(fee.Uint64(), memo), NotNil)
+
+	reserveBefore := mgr.Keeper().GetRuneBalanceOfModule(ctx, ReserveName)
+	bnbPool, err := mgr.Keeper().GetPool(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Assert(swap(common.One, memo), IsNil)
+
+	// the fee is added to the pool and its cacao value sent to the reserve
+	feeCacao := bnbPool.RuneDisbursementForAssetAdd(fee)
+	reserveAfter := mgr.Keeper().GetRuneBalanceOfModule(ctx, ReserveName)
+	c.Check(reserveAfter.Sub(reserveBefore).String(), Equals, feeCacao.String())
+	withheld, err := mgr.Keeper().GetOutboundFeeWithheldCacao(ctx, common.BTCChain)
+	c.Assert(err, IsNil)
+	c.Check(withheld.String(), Equals, feeCacao.String())
+
+	// the outbound is marked as paid, so the txout manager doesn't charge it again
+	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].FeePaid, Equals, true)
+	c.Check(items[0].Coin.Asset.Equals(common.BTCAsset), Equals, true)
+
+	// the fee can be paid in the cacao value of the inbound as well, valued
+	// through the pool
+	bnbPool, err = mgr.Keeper().GetPool(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	feeInBNB := bnbPool.RuneValueInAsset(mgr.GasMgr().GetFee(ctx, common.BTCChain, common.BaseAsset()))
+	c.Assert(feeInBNB.IsZero(), Equals, false)
+	reserveBefore = mgr.Keeper().GetRuneBalanceOfModule(ctx, ReserveName)
+	memo = "=:BTC.BTC:" + GetRandomBTCAddress().String() + "::::::::MAYA.CACAO"
+	c.Assert(swap(common.One, memo), IsNil)
+	items, err = mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 2)
+	c.Check(items[1].FeePaid, Equals, true)
+	reserveAfter = mgr.Keeper().GetRuneBalanceOfModule(ctx, ReserveName)
+	c.Check(reserveAfter.Sub(reserveBefore).String(), Equals, bnbPool.RuneDisbursementForAssetAdd(feeInBNB).String())
+
+	// a swap that fails rolls back the fee it paid
+	run = NewInternalHandler(mgr)
+	reserveBefore = mgr.Keeper().GetRuneBalanceOfModule(ctx, ReserveName)
+	bnbPool, err = mgr.Keeper().GetPool(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	memo = "=:BTC.BTC:" + GetRandomBTCAddress().String() + ":" + cosmos.NewUint(1000*common.One).String() + ":::::::MAYA.CACAO"
+	c.Assert(swap(common.One, memo), NotNil)
+	c.Check(mgr.Keeper().GetRuneBalanceOfModule(ctx, ReserveName).String(), Equals, reserveBefore.String())
+	bnbPoolAfter, err := mgr.Keeper().GetPool(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(bnbPoolAfter.BalanceAsset.String(), Equals, bnbPool.BalanceAsset.String())
+	c.Check(bnbPoolAfter.BalanceCacao.String(), Equals, bnbPool.BalanceCacao.String())
+	items, err = mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 2)
+	run = handler.Run
+
+	// a fee asset other than the inbound or CACAO is rejected
+	memo = "=:BTC.BTC:" + GetRandomBTCAddress().String() + "::::::::ETH.ETH"
+	c.Assert(swap(common.One, memo), NotNil)
+}
@@ -810,11 +810,21 @@ func (s *HandlerSuite) TestMemoFetchAddress(c *C) {
 }
 
 func (s *HandlerSuite) TestExternalAssetMatch(c *C) {
-	v := GetCurrentVersion()
+	ctx, k := setupKeeperForTest(c)
 
-	c.Check(externalAssetMatch(v, common.ETHChain, "7a0"), Equals, "0xd601c6A3a36721320573885A8d8420746dA3d7A0")
-	c.Check(externalAssetMatch(v, common.ETHChain, "foobar"), Equals, "foobar")
-	c.Check(externalAssetMatch(v, common.ETHChain, "3"), Equals, "3")
-	c.Check(externalAssetMatch(v, common.ETHChain, ""), Equals, "")
-	c.Check(externalAssetMatch(v, common.BTCChain, "foo"), Equals, "foo")
+	c.Check(externalAssetMatch(ctx, k, common.ETHChain, "7a0"), Equals, "0xd601c6A3a36721320573885A8d8420746dA3d7A0")
+	c.Check(externalAssetMatch(ctx, k, common.ETHChain, "foobar"), Equals, "foobar")
+	c.Check(externalAssetMatch(ctx, k, common.ETHChain, "3"), Equals, "3")
+	c.Check(externalAssetMatch(ctx, k, common.ETHChain, ""), Equals, "")
+	c.Check(externalAssetMatch(ctx, k, common.BTCChain, "foo"), Equals, "foo")
+
+	// tokens listed on chain are matched, delisted ones are not
+	listed := NewEVMToken(common.ETHChain, "0x1111111111111111111111111111111111111abc", "FOO", 18)
+	c.Assert(k.SetEVMToken(ctx, listed), IsNil)
+	c.Check(externalAssetMatch(ctx, k, common.ETHChain, "1abc"), Equals, listed.Address.String())
+	compiled := getEVMTokenWhitelist(ctx, k, common.ETHChain)[0].Address.String()
+	hint := compiled[len(compiled)-8:]
+	c.Check(externalAssetMatch(ctx, k, common.ETHChain, hint), Equals, compiled)
+	c.Assert(k.SetEVMToken(ctx, EVMToken{Chain: common.ETHChain, Address: common.Address(compiled), Delisted: true}), IsNil)
+	c.Check(externalAssetMatch(ctx, k, common.ETHChain, hint), Equals, hint)
 }
@@ -125,6 +125,8 @@ func (h TssHandler) handle(ctx cosmos.Context, msg MsgTssPool) (*cosmos.Result,
 	ctx.Logger().Info("handleMsgTssPool request", "ID:", msg.ID)
 	version := h.mgr.GetVersion()
 	switch {
+	case version.GTE(semver.MustParse("1.106.0")):
+		return h.handleV106(ctx, msg)
 	case version.GTE(semver.MustParse("1.93.0")):
 		return h.handleV93(ctx, msg)
 	default:
@@ -132,7 +134,7 @@ func (h TssHandler) handle(ctx cosmos.Context, msg MsgTssPool) (*cosmos.Result,
 	}
 }
 
-func (h TssHandler) handleV93(ctx cosmos.Context, msg MsgTssPool) (*cosmos.Result, error) {
+func (h TssHandler) handleV106(ctx cosmos.Context, msg MsgTssPool) (*cosmos.Result, error) {
 	ctx.Logger().Info("handler tss", "current version", h.mgr.GetVersion())
 	if !msg.Blame.IsEmpty() {
 		ctx.Logger().Error(msg.Blame.String())
@@ -246,6 +248,7 @@ func (h TssHandler) handleV93(ctx cosmos.Context, msg MsgTssPool) (*cosmos.Resul
 			// from churning then it will be slashed accordingly
 			slashPoints := h.mgr.GetConstants().GetInt64Value(constants.FailKeygenSlashPoints)
 			totalSlash := cosmos.ZeroUint()
+			var blamed []BlameRecord
 			for _, node := range msg.Blame.BlameNodes {
 				nodePubKey, err := common.NewPubKey(node.Pubkey)
 				if err != nil {
@@ -319,9 +322,11 @@ func (h TssHandler) handleV93(ctx cosmos.Context, msg MsgTssPool) (*cosmos.Resul
 				if err := h.mgr.EventMgr().EmitBondEvent(ctx, h.mgr, common.BaseNative, totalSlash, BondCost, tx); err != nil {
 					return nil, fmt.Errorf("fail to emit bond event: %w", err)
 				}
-
+				blamed = append(blamed, NewBlameRecord(ctx.BlockHeight(), BlameTypeKeygen, msg.ID, na.NodeAddress, nodePubKey, msg.PoolPubKey, common.EmptyChain, msg.Blame))
 			}
 
+			recordBlameHistory(ctx, h.mgr, blamed)
+
 		}
 		return &cosmos.Result{}, nil
 	}
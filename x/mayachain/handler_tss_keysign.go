@@ -117,6 +117,8 @@ func (h TssKeysignHandler) handle(ctx cosmos.Context, msg MsgTssKeysignFail) (*c
 	ctx.Logger().Info("handle MsgTssKeysignFail request", "ID", msg.ID, "signer", msg.Signer, "pubkey", msg.PubKey, "blame", msg.Blame.String())
 	version := h.mgr.GetVersion()
 	switch {
+	case version.GTE(semver.MustParse("1.106.0")):
+		return h.handleV106(ctx, msg)
 	case version.GTE(semver.MustParse("1.104.0")):
 		return h.handleV104(ctx, msg)
 	case version.GTE(semver.MustParse("0.1.0")):
@@ -125,7 +127,7 @@ func (h TssKeysignHandler) handle(ctx cosmos.Context, msg MsgTssKeysignFail) (*c
 	return nil, errBadVersion
 }
 
-func (h TssKeysignHandler) handleV104(ctx cosmos.Context, msg MsgTssKeysignFail) (*cosmos.Result, error) {
+func (h TssKeysignHandler) handleV106(ctx cosmos.Context, msg MsgTssKeysignFail) (*cosmos.Result, error) {
 	voter, err := h.mgr.Keeper().GetTssKeysignFailVoter(ctx, msg.ID)
 	if err != nil {
 		return nil, err
@@ -202,6 +204,11 @@ func (h TssKeysignHandler) handleV104(ctx cosmos.Context, msg MsgTssKeysignFail)
 	slashPoints := h.mgr.GetConstants().GetInt64Value(constants.FailKeysignSlashPoints)
 	// fail to generate a new tss key let's slash the node account
 
+	chain := common.EmptyChain
+	if len(msg.Coins) > 0 {
+		chain = msg.Coins[0].Asset.GetChain()
+	}
+	var blamed []BlameRecord
 	for _, node := range msg.Blame.BlameNodes {
 		nodePubKey, err := common.NewPubKey(node.Pubkey)
 		if err != nil {
@@ -226,8 +233,11 @@ func (h TssKeysignHandler) handleV104(ctx cosmos.Context, msg MsgTssKeysignFail)
 		if err := h.mgr.Keeper().SetNodeAccountJail(ctx, na.NodeAddress, releaseHeight, reason); err != nil {
 			ctx.Logger().Error("fail to set node account jail", "node address", na.NodeAddress, "reason", reason, "error", err)
 		}
+		blamed = append(blamed, NewBlameRecord(ctx.BlockHeight(), BlameTypeKeysign, msg.ID, na.NodeAddress, nodePubKey, msg.PubKey, chain, msg.Blame))
 	}
 
+	recordBlameHistory(ctx, h.mgr, blamed)
+
 	if msg.Blame.Round == tssMessages.KEYSIGN7 {
 		// handle round7 failure, assume attack
 		vault, err := h.mgr.Keeper().GetVault(ctx, msg.PubKey)
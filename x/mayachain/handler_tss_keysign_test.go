@@ -335,6 +335,41 @@ func (h HandlerTssKeysignSuite) TestTssKeysignFailHandler(c *C) {
 	}
 }
 
+func (h HandlerTssKeysignSuite) TestTssKeysignFailHandler_blame_history(c *C) {
+	helper := newTssKeysignHandlerTestHelper(c)
+	handler := NewTssKeysignHandler(NewDummyMgrWithKeeper(helper.keeper))
+	coins := common.Coins{common.NewCoin(common.BNBAsset, cosmos.NewUint(100))}
+
+	// the vault members reach consensus on the keysign failure
+	for _, member := range helper.retiringVault.Membership[:6] {
+		na, err := helper.keeper.GetNodeAccountByPubKey(helper.ctx, common.PubKey(member))
+		c.Assert(err, IsNil)
+		msg, err := NewMsgTssKeysignFail(helper.ctx.BlockHeight(), helper.blame, "hello", coins, na.NodeAddress, helper.retiringVault.PubKey)
+		c.Assert(err, IsNil)
+		_, err = handler.Run(helper.ctx, msg)
+		c.Assert(err, IsNil)
+	}
+
+	// every blamed node has a record in the blame history
+	blamed := make(map[string]bool)
+	iter := helper.keeper.GetBlameRecordIterator(helper.ctx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record BlameRecord
+		c.Assert(helper.keeper.Cdc().Unmarshal(iter.Value(), &record), IsNil)
+		c.Check(record.Type, Equals, BlameTypeKeysign)
+		c.Check(record.Height, Equals, helper.ctx.BlockHeight())
+		c.Check(record.VaultPubKey.Equals(helper.retiringVault.PubKey), Equals, true)
+		c.Check(record.Chain.Equals(common.BNBChain), Equals, true)
+		c.Check(record.FailReason, Equals, helper.blame.FailReason)
+		blamed[record.NodePubKey.String()] = true
+	}
+	c.Assert(blamed, HasLen, len(helper.blame.BlameNodes))
+	for _, node := range helper.blame.BlameNodes {
+		c.Check(blamed[node.Pubkey], Equals, true)
+	}
+}
+
 func (h HandlerTssKeysignSuite) TestTssKeysignFailHandler_accept_standby_node_messages(c *C) {
 	helper := newTssKeysignHandlerTestHelper(c)
 	handler := NewTssKeysignHandler(NewDummyMgrWithKeeper(helper.keeper))
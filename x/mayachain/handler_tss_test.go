@@ -133,6 +133,14 @@ func newTssHandlerTestHelper(c *C) tssHandlerTestHelper {
 	SetupLiquidityBondForTest(c, ctx, k, common.BNBAsset, nodeAccount.BondAddress, nodeReady, nodeReadyBond)
 	c.Assert(k.SetBondProviders(ctx, bp), IsNil)
 	c.Assert(keeperHelper.SetNodeAccount(ctx, nodeReady), IsNil)
+	// the other keygen members are nodes as well, so they can be blamed
+	for _, pk := range members[1:] {
+		na := GetRandomValidatorNode(NodeReady)
+		na.NodeAddress, err = pk.GetThorAddress()
+		c.Assert(err, IsNil)
+		na.PubKeySet.Secp256k1 = pk
+		c.Assert(keeperHelper.SetNodeAccount(ctx, na), IsNil)
+	}
 	keygenBlock := NewKeygenBlock(ctx.BlockHeight())
 	keygenBlock.Keygens = []Keygen{
 		{
@@ -462,6 +470,17 @@ func (s *HandlerTssSuite) TestTssHandler(c *C) {
 				slashPts, err := helper.keeper.GetNodeAccountSlashPoints(helper.ctx, na.NodeAddress)
 				c.Assert(err, IsNil)
 				c.Assert(slashPts > 0, Equals, true)
+				// and the keygen failure is kept in its blame history
+				iter := helper.keeper.GetBlameRecordIterator(helper.ctx)
+				defer iter.Close()
+				c.Assert(iter.Valid(), Equals, true)
+				var record BlameRecord
+				c.Assert(helper.keeper.Cdc().Unmarshal(iter.Value(), &record), IsNil)
+				c.Check(record.Type, Equals, BlameTypeKeygen)
+				c.Check(record.NodeAddress.Equals(na.NodeAddress), Equals, true)
+				c.Check(record.FailReason, Equals, "who knows")
+				iter.Next()
+				c.Check(iter.Valid(), Equals, false)
 			},
 			expectedResult: nil,
 		},
@@ -134,6 +134,8 @@ func (h UnBondHandler) validateV105(ctx cosmos.Context, msg MsgUnBond) error {
 func (h UnBondHandler) handle(ctx cosmos.Context, msg MsgUnBond) error {
 	version := h.mgr.GetVersion()
 	switch {
+	case version.GTE(semver.MustParse("1.106.0")):
+		return h.handleV106(ctx, msg)
 	case version.GTE(semver.MustParse("1.105.0")):
 		return h.handleV105(ctx, msg)
 	case version.GTE(semver.MustParse("1.92.0")):
@@ -143,7 +145,7 @@ func (h UnBondHandler) handle(ctx cosmos.Context, msg MsgUnBond) error {
 	}
 }
 
-func (h UnBondHandler) handleV105(ctx cosmos.Context, msg MsgUnBond) error {
+func (h UnBondHandler) handleV106(ctx cosmos.Context, msg MsgUnBond) error {
 	na, err := h.mgr.Keeper().GetNodeAccount(ctx, msg.NodeAddress)
 	if err != nil {
 		return ErrInternal(err, fmt.Sprintf("fail to get node account(%s)", msg.NodeAddress))
@@ -282,11 +284,12 @@ func (h UnBondHandler) handleV105(ctx cosmos.Context, msg MsgUnBond) error {
 		}
 	}
 
+	// the node doesn't accrue rewards anymore, the CACAO sent along to the bond
+	// module is shared between its bond providers straight away
 	coin := msg.TxIn.Coins.GetCoin(common.BaseAsset())
 	if !coin.IsEmpty() {
-		na.Reward = na.Reward.Add(coin.Amount)
-		if err := h.mgr.Keeper().SetNodeAccount(ctx, na); err != nil {
-			return ErrInternal(err, "fail to save node account to key value store")
+		if err := payBondProviderShares(ctx, h.mgr.Keeper(), h.mgr.EventMgr(), na, coin.Amount); err != nil {
+			return ErrInternal(err, "fail to pay bond providers")
 		}
 	}
 
@@ -231,3 +231,153 @@ func (h UnBondHandler) handleV92(ctx cosmos.Context, msg MsgUnBond) error {
 
 	return nil
 }
+
+func (h UnBondHandler) handleV105(ctx cosmos.Context, msg MsgUnBond) error {
+	na, err := h.mgr.Keeper().GetNodeAccount(ctx, msg.NodeAddress)
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get node account(%s)", msg.NodeAddress))
+	}
+
+	var ygg Vault
+	if h.mgr.Keeper().VaultExists(ctx, na.PubKeySet.Secp256k1) {
+		var err error
+		ygg, err = h.mgr.Keeper().GetVault(ctx, na.PubKeySet.Secp256k1)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ygg.HasFunds() {
+		canUnbond := true
+		totalRuneValue := cosmos.ZeroUint()
+		for _, c := range ygg.Coins {
+			if c.Amount.IsZero() {
+				continue
+			}
+			if !c.Asset.IsGasAsset() {
+				// None gas asset has not been sent back to asgard in full
+				canUnbond = false
+				break
+			}
+			chain := c.Asset.GetChain()
+			maxGas, err := h.mgr.GasMgr().GetMaxGas(ctx, chain)
+			if err != nil {
+				ctx.Logger().Error("fail to get max gas", "chain", chain, "error", err)
+				canUnbond = false
+				break
+			}
+			// 10x the maxGas , if the amount of gas asset left in the yggdrasil vault is larger than 10x of the MaxGas , then we don't allow node to unbond
+			if c.Amount.GT(maxGas.Amount.MulUint64(10)) {
+				canUnbond = false
+			}
+			pool, err := h.mgr.Keeper().GetPool(ctx, c.Asset)
+			if err != nil {
+				ctx.Logger().Error("fail to get pool", "asset", c.Asset, "error", err)
+				canUnbond = false
+				break
+			}
+			totalRuneValue = totalRuneValue.Add(pool.AssetValueInRune(c.Amount))
+		}
+		if !canUnbond {
+			ctx.Logger().Error("cannot unbond while yggdrasil vault still has funds")
+			if err := h.mgr.ValidatorMgr().RequestYggReturn(ctx, na, h.mgr); err != nil {
+				return ErrInternal(err, "fail to request yggdrasil return fund")
+			}
+			return nil
+		}
+
+		penaltyPts := fetchConfigInt64(ctx, h.mgr, constants.SlashPenalty)
+		totalRuneValue = common.GetUncappedShare(cosmos.NewUint(uint64(penaltyPts)), cosmos.NewUint(10_000), totalRuneValue)
+		_, _, err := h.mgr.Slasher().SlashNodeAccountLP(ctx, na, totalRuneValue)
+		if err != nil {
+			return ErrInternal(err, "fail to slash node account")
+		}
+	}
+
+	bondLockPeriod, err := h.mgr.Keeper().GetMimir(ctx, constants.BondLockupPeriod.String())
+	if err != nil || bondLockPeriod < 0 {
+		bondLockPeriod = h.mgr.GetConstants().GetInt64Value(constants.BondLockupPeriod)
+	}
+	if ctx.BlockHeight()-na.StatusSince < bondLockPeriod {
+		return fmt.Errorf("node can not unbond before %d", na.StatusSince+bondLockPeriod)
+	}
+	vaults, err := h.mgr.Keeper().GetAsgardVaultsByStatus(ctx, RetiringVault)
+	if err != nil {
+		return ErrInternal(err, "fail to get retiring vault")
+	}
+	isMemberOfRetiringVault := false
+	for _, v := range vaults {
+		if v.GetMembership().Contains(na.PubKeySet.Secp256k1) {
+			isMemberOfRetiringVault = true
+			ctx.Logger().Info("node account is still part of the retiring vault,can't return bond yet")
+			break
+		}
+	}
+	if isMemberOfRetiringVault {
+		return ErrInternal(err, "fail to unbond, still part of the retiring vault")
+	}
+
+	from, err := cosmos.AccAddressFromBech32(msg.BondAddress.String())
+	if err != nil {
+		return ErrInternal(err, "fail to parse from address")
+	}
+
+	// remove/unbonding bond provider
+	// check that 1) requester is node operator, 2) references
+	if msg.BondAddress.Equals(na.BondAddress) && !msg.BondProviderAddress.Empty() {
+		// remove bond provider (if bond is now zero)
+		bondAddr, err := na.BondAddress.AccAddress()
+		if err != nil {
+			return ErrInternal(err, "fail to refund bond")
+		}
+
+		if bondAddr.Equals(msg.BondProviderAddress) {
+			bp, err := h.mgr.Keeper().GetBondProviders(ctx, from)
+			if err != nil {
+				return ErrInternal(err, fmt.Sprintf("fail to get bond providers(%s)", msg.NodeAddress))
+			}
+
+			for _, provider := range bp.Providers {
+				if err := refundBond(ctx, msg.TxIn, provider.BondAddress, common.EmptyAsset, cosmos.ZeroUint(), &na, h.mgr); err != nil {
+					return ErrInternal(err, "fail to unbond")
+				}
+			}
+		} else {
+			if err := refundBond(ctx, msg.TxIn, msg.BondProviderAddress, common.EmptyAsset, cosmos.ZeroUint(), &na, h.mgr); err != nil {
+				return ErrInternal(err, "fail to unbond")
+			}
+
+			// remove bond provider (if bond is now zero)
+			bp, err := h.mgr.Keeper().GetBondProviders(ctx, na.NodeAddress)
+			if err != nil {
+				return ErrInternal(err, fmt.Sprintf("fail to get bond providers(%s)", na.NodeAddress))
+			}
+			provider := bp.Get(msg.BondProviderAddress)
+			providerBond, err := h.mgr.Keeper().CalcLPLiquidityBond(ctx, common.Address(provider.BondAddress.String()), na.NodeAddress)
+			if err != nil {
+				return ErrInternal(err, "fail to get bond provider liquidity")
+			}
+			if !provider.IsEmpty() && providerBond.IsZero() {
+				if ok := bp.Remove(msg.BondProviderAddress); ok {
+					if err := h.mgr.Keeper().SetBondProviders(ctx, bp); err != nil {
+						return ErrInternal(err, fmt.Sprintf("fail to save bond providers(%s)", bp.NodeAddress.String()))
+					}
+				}
+			}
+		}
+	} else {
+		if err := refundBond(ctx, msg.TxIn, from, msg.Asset, msg.Units, &na, h.mgr); err != nil {
+			return ErrInternal(err, "fail to unbond")
+		}
+	}
+
+	coin := msg.TxIn.Coins.GetCoin(common.BaseAsset())
+	if !coin.IsEmpty() {
+		na.Reward = na.Reward.Add(coin.Amount)
+		if err := h.mgr.Keeper().SetNodeAccount(ctx, na); err != nil {
+			return ErrInternal(err, "fail to save node account to key value store")
+		}
+	}
+
+	return nil
+}
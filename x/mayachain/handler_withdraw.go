@@ -286,7 +286,11 @@ func (h WithdrawLiquidityHandler) swapV93(ctx cosmos.Context, msg MsgWithdrawLiq
 		return fmt.Errorf("txn hash conflict")
 	}
 
-	target := addr.GetChain().GetGasAsset()
+	chain := addr.GetChain()
+	if h.mgr.GetVersion().GTE(semver.MustParse("1.106.0")) {
+		chain = addr.GetChainV106()
+	}
+	target := chain.GetGasAsset()
 	memo := fmt.Sprintf("=:%s:%s", target, addr)
 	msg.Tx.Memo = memo
 	msg.Tx.Coins = common.NewCoins(coin)
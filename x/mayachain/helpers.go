@@ -13,7 +13,9 @@ import (
 
 	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/common/tokenlist"
 	"gitlab.com/mayachain/mayanode/constants"
+	"gitlab.com/mayachain/mayanode/x/mayachain/inclusion"
 	"gitlab.com/mayachain/mayanode/x/mayachain/keeper"
 	"gitlab.com/mayachain/mayanode/x/mayachain/types"
 )
@@ -608,6 +610,17 @@ func fetchConfigInt64(ctx cosmos.Context, mgr Manager, key constants.ConstantNam
 	return val
 }
 
+// recordBlameHistory saves the blame records of the blamed nodes, and drops the
+// records that fell out of the blame history window
+func recordBlameHistory(ctx cosmos.Context, mgr Manager, records []BlameRecord) {
+	for _, record := range records {
+		if err := mgr.Keeper().SetBlameRecord(ctx, record); err != nil {
+			ctx.Logger().Error("fail to save blame record", "node", record.NodeAddress, "error", err)
+		}
+	}
+	mgr.Keeper().PruneBlameRecords(ctx, ctx.BlockHeight()-fetchConfigInt64(ctx, mgr, constants.BlameHistoryBlocks))
+}
+
 // polPoolValue - calculates how much the POL is worth in rune
 func polPoolValue(ctx cosmos.Context, mgr Manager) (cosmos.Uint, error) {
 	total := cosmos.ZeroUint()
@@ -641,6 +654,203 @@ func polPoolValue(ctx cosmos.Context, mgr Manager) (cosmos.Uint, error) {
 	return total, nil
 }
 
+// polCycleAction - what a single iteration of the POL cycle does
+type polCycleAction struct {
+	Pool              Pool
+	Action            string // POLActionDeposit, POLActionWithdraw or empty when POL isn't moved
+	CacaoAmount       cosmos.Uint
+	BasisPoints       cosmos.Uint // basis points of the POL position to withdraw
+	SynthUtilization  cosmos.Uint
+	TargetUtilization cosmos.Uint
+	Reason            string
+}
+
+// getPOLPools - generated a filtered list of pools that the POL is active with
+func getPOLPools(ctx cosmos.Context, mgr Manager) Pools {
+	var pools Pools
+	iterator := mgr.Keeper().GetPoolIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var pool Pool
+		err := mgr.Keeper().Cdc().Unmarshal(iterator.Value(), &pool)
+		if err != nil {
+			ctx.Logger().Error("fail to unmarshal pool", "pool", pool.Asset.String(), "error", err)
+			continue
+		}
+
+		if pool.Asset.IsSyntheticAsset() {
+			continue
+		}
+
+		if pool.BalanceCacao.IsZero() {
+			continue
+		}
+
+		if pool.Status == PoolSuspended {
+			continue
+		}
+
+		if isChainTradingHalted(ctx, mgr, pool.Asset.GetChain()) || isGlobalTradingHalted(ctx, mgr) {
+			continue
+		}
+
+		// The POL key for the ETH.ETH pool would be POL-ETH-ETH .
+		key := "POL-" + pool.Asset.MimirString()
+		val, err := mgr.Keeper().GetMimir(ctx, key)
+		if err != nil {
+			ctx.Logger().Error("fail to manage POL in pool", "pool", pool.Asset.String(), "error", err)
+			continue
+		}
+
+		// -1 is unset default behaviour; 0 is off (paused); 1 is on; 2 (elsewhere) is forced withdraw.
+		if val <= 0 {
+			continue
+		}
+
+		pools = append(pools, pool)
+	}
+
+	return pools
+}
+
+// getPOLCycleAction - works out what the POL cycle does at the current block
+// height, without moving any liquidity. The pool is picked round robin by
+// block height from the pools POL is enabled on, the per pool mimir
+// POLMaxPoolDeposit-<POOL> caps the cacao POL deposits into a single pool
+func getPOLCycleAction(ctx cosmos.Context, mgr Manager) (polCycleAction, error) {
+	maxDeposit := fetchConfigInt64(ctx, mgr, constants.POLMaxNetworkDeposit)
+	movement := fetchConfigInt64(ctx, mgr, constants.POLMaxPoolMovement)
+	target := fetchConfigInt64(ctx, mgr, constants.POLSynthUtilization)
+	buf := fetchConfigInt64(ctx, mgr, constants.POLBuffer)
+	targetUtil := cosmos.NewUint(uint64(target))
+	maxMovement := cosmos.NewUint(uint64(movement))
+	buffer := cosmos.NewUint(uint64(buf))
+
+	action := polCycleAction{
+		CacaoAmount:       cosmos.ZeroUint(),
+		BasisPoints:       cosmos.ZeroUint(),
+		SynthUtilization:  cosmos.ZeroUint(),
+		TargetUtilization: targetUtil,
+	}
+
+	// if target synth utilization is zero, disable POL
+	if target == 0 {
+		action.Reason = "POL is disabled"
+		return action, nil
+	}
+
+	pools := getPOLPools(ctx, mgr)
+	if len(pools) == 0 {
+		return action, fmt.Errorf("no POL pools")
+	}
+
+	pool := pools[int(ctx.BlockHeight()%int64(len(pools)))]
+	action.Pool = pool
+
+	// The POL key for the ETH.ETH pool would be POL-ETH-ETH .
+	val, err := mgr.Keeper().GetMimir(ctx, "POL-"+pool.Asset.MimirString())
+	if err != nil {
+		return action, fmt.Errorf("fail to get POL mimir of pool(%s): %w", pool.Asset, err)
+	}
+
+	// if pool isn't available or mimir has it configured, force withdraw from the pool
+	if val == 2 || pool.Status != PoolAvailable {
+		targetUtil = cosmos.NewUint(10_000)
+		action.TargetUtilization = targetUtil
+	}
+
+	synthSupply := mgr.Keeper().GetTotalSupply(ctx, pool.Asset.GetSyntheticAsset())
+	pool.CalcUnits(mgr.GetVersion(), synthSupply)
+	utilization := common.GetUncappedShare(pool.SynthUnits, pool.GetPoolUnits(), cosmos.NewUint(10_000))
+	action.SynthUtilization = utilization
+
+	// detect if we need to deposit cacao
+	if common.SafeSub(utilization, buffer).GT(targetUtil) {
+		pol, err := mgr.Keeper().GetPOL(ctx)
+		if err != nil {
+			return action, err
+		}
+		if maxDeposit <= pol.CurrentDeposit().Int64() {
+			action.Reason = "maximum cacao deployed from POL"
+			return action, nil
+		}
+
+		move := common.SafeSub(utilization, targetUtil)
+		if move.GT(maxMovement) {
+			move = maxMovement
+		}
+		cacaoAmt := common.GetSafeShare(move, cosmos.NewUint(10_000), pool.BalanceCacao)
+
+		maxPoolDeposit, err := mgr.Keeper().GetMimir(ctx, "POLMaxPoolDeposit-"+pool.Asset.MimirString())
+		if err != nil {
+			return action, fmt.Errorf("fail to get POL max deposit of pool(%s): %w", pool.Asset, err)
+		}
+		if maxPoolDeposit > 0 {
+			polPool, err := mgr.Keeper().GetPOLPool(ctx, pool.Asset)
+			if err != nil {
+				return action, err
+			}
+			room := cosmos.NewInt(maxPoolDeposit).Sub(polPool.CurrentDeposit())
+			if !room.IsPositive() {
+				action.Reason = "maximum cacao deployed from POL into pool"
+				return action, nil
+			}
+			if cacaoAmt.GT(cosmos.NewUintFromBigInt(room.BigInt())) {
+				cacaoAmt = cosmos.NewUintFromBigInt(room.BigInt())
+			}
+		}
+
+		if cacaoAmt.IsZero() {
+			action.Reason = "nothing to deposit"
+			return action, nil
+		}
+		if cacaoAmt.GT(mgr.Keeper().GetRuneBalanceOfModule(ctx, ReserveName)) {
+			action.Reason = "insufficient cacao in reserve"
+			return action, nil
+		}
+		action.Action = POLActionDeposit
+		action.CacaoAmount = cacaoAmt
+		return action, nil
+	}
+
+	// detect if we need to withdraw cacao
+	if utilization.Add(buffer).LT(targetUtil) {
+		polAddress, err := mgr.Keeper().GetModuleAddress(ReserveName)
+		if err != nil {
+			return action, err
+		}
+		lp, err := mgr.Keeper().GetLiquidityProvider(ctx, pool.Asset, polAddress)
+		if err != nil {
+			return action, err
+		}
+		if lp.Units.IsZero() {
+			action.Reason = "no POL position to withdraw"
+			return action, nil
+		}
+
+		move := targetUtil.Sub(utilization)
+		if move.GT(maxMovement) {
+			move = maxMovement
+		}
+		cacaoAmt := common.GetSafeShare(move, cosmos.NewUint(10_000), pool.BalanceCacao)
+		if cacaoAmt.IsZero() {
+			action.Reason = "nothing to withdraw"
+			return action, nil
+		}
+		lpCacao := common.GetSafeShare(lp.Units, pool.GetPoolUnits(), pool.BalanceCacao).MulUint64(2)
+		if cacaoAmt.GT(lpCacao) {
+			cacaoAmt = lpCacao
+		}
+		action.Action = POLActionWithdraw
+		action.CacaoAmount = cacaoAmt
+		action.BasisPoints = common.GetSafeShare(cacaoAmt, lpCacao, cosmos.NewUint(10_000))
+		return action, nil
+	}
+
+	action.Reason = "synth utilization within target"
+	return action, nil
+}
+
 func wrapError(ctx cosmos.Context, err error, wrap string) error {
 	err = fmt.Errorf("%s: %w", wrap, err)
 	ctx.Logger().Error(err.Error())
@@ -1349,3 +1559,164 @@ func calcLiquidityInCacao(ctx cosmos.Context, mgr Manager, asset common.Asset, u
 	liquidity = liquidity.Add(pool.AssetValueInRune(common.GetSafeShare(units, pool.LPUnits, pool.BalanceAsset)))
 	return liquidity, nil
 }
+
+// bondProviderShare is the part of a node bond reward which goes to one of its
+// bond providers
+type bondProviderShare struct {
+	BondAddress cosmos.AccAddress
+	Bond        cosmos.Uint
+	Reward      cosmos.Uint
+	OperatorFee cosmos.Uint
+}
+
+// Total return the reward and operator fee of the share together
+func (s bondProviderShare) Total() cosmos.Uint {
+	return s.Reward.Add(s.OperatorFee)
+}
+
+// getBondProviderShares split the given node reward between the bond providers
+// of the node. The node operator takes NodeOperatorFee (in basis points) of the
+// reward, what is left is split pro-rata to the CACAO value each provider has
+// bonded to the node. The operator share always comes first.
+func getBondProviderShares(ctx cosmos.Context, k keeper.Keeper, na NodeAccount, reward cosmos.Uint) ([]bondProviderShare, error) {
+	bp, err := k.GetBondProviders(ctx, na.NodeAddress)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get bond providers: %w", err)
+	}
+	operator, err := na.BondAddress.AccAddress()
+	if err != nil {
+		return nil, fmt.Errorf("fail to parse node operator bond address: %w", err)
+	}
+
+	shares := []bondProviderShare{{
+		BondAddress: operator,
+		Bond:        cosmos.ZeroUint(),
+		Reward:      cosmos.ZeroUint(),
+		OperatorFee: cosmos.ZeroUint(),
+	}}
+	totalBond := cosmos.ZeroUint()
+	for _, p := range bp.Providers {
+		if !p.HasBonded() {
+			continue
+		}
+		bond, err := k.CalcLPLiquidityBond(ctx, common.Address(p.BondAddress.String()), na.NodeAddress)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get bond of provider(%s): %w", p.BondAddress, err)
+		}
+		totalBond = totalBond.Add(bond)
+		if p.BondAddress.Equals(operator) {
+			shares[0].Bond = bond
+			continue
+		}
+		shares = append(shares, bondProviderShare{
+			BondAddress: p.BondAddress,
+			Bond:        bond,
+			Reward:      cosmos.ZeroUint(),
+			OperatorFee: cosmos.ZeroUint(),
+		})
+	}
+
+	fee := common.GetSafeShare(bp.NodeOperatorFee, cosmos.NewUint(10_000), reward)
+	remaining := common.SafeSub(reward, fee)
+	distributed := cosmos.ZeroUint()
+	if !totalBond.IsZero() {
+		for i := range shares {
+			shares[i].Reward = common.GetSafeShare(shares[i].Bond, totalBond, remaining)
+			distributed = distributed.Add(shares[i].Reward)
+		}
+	}
+	// the operator takes the fee along with anything left over by rounding
+	shares[0].OperatorFee = common.SafeSub(reward, distributed)
+	return shares, nil
+}
+
+// payBondProviderShares split the reward of a node between its operator and
+// bond providers, and send each of them their share from the bond module
+func payBondProviderShares(ctx cosmos.Context, k keeper.Keeper, eventMgr EventManager, na NodeAccount, reward cosmos.Uint) error {
+	if reward.IsZero() {
+		return nil
+	}
+	shares, err := getBondProviderShares(ctx, k, na, reward)
+	if err != nil {
+		return err
+	}
+	for _, share := range shares {
+		if share.Total().IsZero() {
+			continue
+		}
+		coin := common.NewCoin(common.BaseNative, share.Total())
+		if err := k.SendFromModuleToAccount(ctx, BondName, share.BondAddress, common.NewCoins(coin)); err != nil {
+			return fmt.Errorf("fail to send bond reward to %s: %w", share.BondAddress, err)
+		}
+		if !share.Reward.IsZero() {
+			evt := NewEventBondShare(na.NodeAddress, share.BondAddress, BondShareReward, common.BaseNative, share.Reward)
+			if err := eventMgr.EmitEvent(ctx, evt); err != nil {
+				ctx.Logger().Error("fail to emit bond share event", "error", err)
+			}
+		}
+		if !share.OperatorFee.IsZero() {
+			evt := NewEventBondShare(na.NodeAddress, share.BondAddress, BondShareOperatorFee, common.BaseNative, share.OperatorFee)
+			if err := eventMgr.EmitEvent(ctx, evt); err != nil {
+				ctx.Logger().Error("fail to emit bond share event", "error", err)
+			}
+		}
+	}
+	return nil
+}
+
+// getEVMTokenWhitelist returns the tokens whitelisted on the given EVM chain.
+// The token list compiled into the binary is the default, the entries managed
+// on chain through MsgSetEVMTokens list new tokens, update the existing ones or
+// delist them.
+func getEVMTokenWhitelist(ctx cosmos.Context, k keeper.Keeper, chain common.Chain) EVMTokens {
+	tokens := make(map[string]EVMToken)
+	for _, item := range tokenlist.GetEVMTokenList(chain, k.GetVersion()).Tokens {
+		token := NewEVMToken(chain, common.Address(item.Address), item.Symbol, int64(item.Decimals))
+		tokens[token.Key()] = token
+	}
+
+	iter := k.GetEVMTokenIterator(ctx, chain)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var token EVMToken
+		if err := k.Cdc().Unmarshal(iter.Value(), &token); err != nil {
+			ctx.Logger().Error("fail to unmarshal evm token", "error", err)
+			continue
+		}
+		if token.Delisted {
+			delete(tokens, token.Key())
+			continue
+		}
+		tokens[token.Key()] = token
+	}
+
+	result := make(EVMTokens, 0, len(tokens))
+	for _, token := range tokens {
+		result = append(result, token)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Key() < result[j].Key()
+	})
+	return result
+}
+
+// settleInclusionProofs verifies the inclusion proof of each observed tx at
+// vote time, only the hash of a proof that verifies is kept, the raw proof and
+// block hash are dropped before the tx is persisted. Observations made before
+// 1.106.0 carry none of them.
+func settleInclusionProofs(ctx cosmos.Context, version semver.Version, txs ObservedTxs) ObservedTxs {
+	settled := make(ObservedTxs, len(txs))
+	for i, tx := range txs {
+		proof, blockHash := tx.Proof, tx.BlockHash
+		tx.Proof, tx.BlockHash, tx.ProofHash = nil, "", ""
+		if proof != nil && version.GTE(semver.MustParse("1.106.0")) {
+			if err := inclusion.Verify(tx.Tx.ID.String(), proof); err != nil {
+				ctx.Logger().Error("invalid inclusion proof", "tx", tx.Tx.ID, "error", err)
+			} else {
+				tx.ProofHash = proof.Hash(blockHash)
+			}
+		}
+		settled[i] = tx
+	}
+	return settled
+}
@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/blang/semver"
 	. "gopkg.in/check.v1"
 
 	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
 	"gitlab.com/mayachain/mayanode/constants"
+	"gitlab.com/mayachain/mayanode/x/mayachain/inclusion"
 	"gitlab.com/mayachain/mayanode/x/mayachain/keeper"
 	"gitlab.com/mayachain/mayanode/x/mayachain/types"
 )
@@ -1416,3 +1418,237 @@ func (s *HelperSuite) TestIsLiquidityAuction(c *C) {
 	ctx = ctx.WithBlockHeight(51)
 	c.Assert(isLiquidityAuction(ctx, mgr.Keeper()), Equals, false)
 }
+
+// setupBondProvidersForTest create an active node, bonded by its operator and
+// one more bond provider with the same amount of liquidity
+func setupBondProvidersForTest(c *C, ctx cosmos.Context, k keeper.Keeper, operatorFee uint64) (NodeAccount, cosmos.AccAddress) {
+	na := GetRandomValidatorNode(NodeActive)
+	operator, err := na.BondAddress.AccAddress()
+	c.Assert(err, IsNil)
+	SetupLiquidityBondForTest(c, ctx, k, common.BTCAsset, na.BondAddress, na, cosmos.NewUint(1000*common.One))
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+
+	provider := GetRandomBaseAddress()
+	providerAcc, err := provider.AccAddress()
+	c.Assert(err, IsNil)
+	pool, err := k.GetPool(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	pool.BalanceCacao = pool.BalanceCacao.Add(cosmos.NewUint(1000 * common.One))
+	pool.BalanceAsset = pool.BalanceAsset.Add(cosmos.NewUint(1000 * common.One))
+	pool.LPUnits = pool.LPUnits.Add(cosmos.NewUint(1000 * common.One))
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+	lp := LiquidityProvider{
+		Asset:        common.BTCAsset,
+		CacaoAddress: provider,
+		Units:        cosmos.NewUint(1000 * common.One),
+	}
+	lp.Bond(na.NodeAddress, lp.Units)
+	k.SetLiquidityProvider(ctx, lp)
+
+	bp := NewBondProviders(na.NodeAddress)
+	bp.NodeOperatorFee = cosmos.NewUint(operatorFee)
+	bp.Providers = append(bp.Providers, NewBondProvider(operator), NewBondProvider(providerAcc))
+	bp.BondLiquidity(operator)
+	bp.BondLiquidity(providerAcc)
+	c.Assert(k.SetBondProviders(ctx, bp), IsNil)
+	return na, providerAcc
+}
+
+func (s *HelperSuite) TestGetBondProviderShares(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	na, provider := setupBondProvidersForTest(c, ctx, k, 1000)
+
+	shares, err := getBondProviderShares(ctx, k, na, cosmos.NewUint(100*common.One))
+	c.Assert(err, IsNil)
+	c.Assert(shares, HasLen, 2)
+	// operator takes 10% fee, the rest is split evenly
+	c.Check(shares[0].BondAddress.String(), Equals, na.BondAddress.String())
+	c.Check(shares[0].Bond.Uint64(), Equals, uint64(2000*common.One))
+	c.Check(shares[0].Reward.Uint64(), Equals, uint64(45*common.One))
+	c.Check(shares[0].OperatorFee.Uint64(), Equals, uint64(10*common.One))
+	c.Check(shares[1].BondAddress.Equals(provider), Equals, true)
+	c.Check(shares[1].Reward.Uint64(), Equals, uint64(45*common.One))
+	c.Check(shares[1].OperatorFee.IsZero(), Equals, true)
+
+	// rounding dust goes to the operator
+	shares, err = getBondProviderShares(ctx, k, na, cosmos.NewUint(13))
+	c.Assert(err, IsNil)
+	c.Check(shares[0].Total().Add(shares[1].Total()).Uint64(), Equals, uint64(13))
+	c.Check(shares[1].Reward.Uint64(), Equals, uint64(6))
+
+	// without any bond, all the reward goes to the operator
+	bp, err := k.GetBondProviders(ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	bp.Unbond(provider)
+	operator, err := na.BondAddress.AccAddress()
+	c.Assert(err, IsNil)
+	bp.Unbond(operator)
+	c.Assert(k.SetBondProviders(ctx, bp), IsNil)
+	shares, err = getBondProviderShares(ctx, k, na, cosmos.NewUint(100))
+	c.Assert(err, IsNil)
+	c.Assert(shares, HasLen, 1)
+	c.Check(shares[0].OperatorFee.Uint64(), Equals, uint64(100))
+}
+
+// setupPOLPoolForTest create a BTC pool with a synth utilization of 2500 basis
+// points and a reserve able to fund POL deposits
+func setupPOLPoolForTest(c *C, ctx cosmos.Context, mgr Manager) Pool {
+	na := GetRandomValidatorNode(NodeActive)
+	c.Assert(mgr.Keeper().SetNodeAccount(ctx, na), IsNil)
+	c.Assert(mgr.Keeper().SetVault(ctx, GetRandomVault()), IsNil)
+	FundModule(c, ctx, mgr.Keeper(), ReserveName, 10_000)
+
+	coin := common.NewCoin(common.BTCAsset.GetSyntheticAsset(), cosmos.NewUint(50*common.One))
+	c.Assert(mgr.Keeper().MintToModule(ctx, ModuleName, coin), IsNil)
+
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.Status = PoolAvailable
+	pool.BalanceCacao = cosmos.NewUint(100 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	pool.LPUnits = cosmos.NewUint(100 * common.One)
+	c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+
+	mgr.Keeper().SetMimir(ctx, constants.POLSynthUtilization.String(), 1000)
+	mgr.Keeper().SetMimir(ctx, constants.POLMaxNetworkDeposit.String(), 1000*common.One)
+	mgr.Keeper().SetMimir(ctx, constants.POLMaxPoolMovement.String(), 100)
+	return pool
+}
+
+func (s *HelperSuite) TestGetPOLCycleAction(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	pool := setupPOLPoolForTest(c, ctx, mgr)
+
+	// POL disabled
+	mgr.Keeper().SetMimir(ctx, constants.POLSynthUtilization.String(), 0)
+	action, err := getPOLCycleAction(ctx, mgr)
+	c.Assert(err, IsNil)
+	c.Check(action.Action, Equals, "")
+	c.Check(action.Reason, Equals, "POL is disabled")
+	mgr.Keeper().SetMimir(ctx, constants.POLSynthUtilization.String(), 1000)
+
+	// POL not enabled on any pool
+	_, err = getPOLCycleAction(ctx, mgr)
+	c.Assert(err, NotNil)
+
+	// synth utilization above target, deposit 1% of the pool cacao depth
+	mgr.Keeper().SetMimir(ctx, "POL-BTC-BTC", 1)
+	action, err = getPOLCycleAction(ctx, mgr)
+	c.Assert(err, IsNil)
+	c.Check(action.Pool.Asset.Equals(pool.Asset), Equals, true)
+	c.Check(action.Action, Equals, POLActionDeposit)
+	c.Check(action.SynthUtilization.Uint64(), Equals, uint64(2500), Commentf("%d", action.SynthUtilization.Uint64()))
+	c.Check(action.CacaoAmount.Uint64(), Equals, uint64(common.One))
+
+	// per pool cap limits the deposit
+	mgr.Keeper().SetMimir(ctx, "POLMaxPoolDeposit-BTC-BTC", common.One/2)
+	action, err = getPOLCycleAction(ctx, mgr)
+	c.Assert(err, IsNil)
+	c.Check(action.Action, Equals, POLActionDeposit)
+	c.Check(action.CacaoAmount.Uint64(), Equals, uint64(common.One/2))
+
+	polPool := NewPOLPool(pool.Asset)
+	polPool.CacaoDeposited = cosmos.NewUint(common.One / 2)
+	c.Assert(mgr.Keeper().SetPOLPool(ctx, polPool), IsNil)
+	action, err = getPOLCycleAction(ctx, mgr)
+	c.Assert(err, IsNil)
+	c.Check(action.Action, Equals, "")
+	c.Check(action.Reason, Equals, "maximum cacao deployed from POL into pool")
+
+	// forced withdraw, without a POL position there is nothing to withdraw
+	mgr.Keeper().SetMimir(ctx, "POL-BTC-BTC", 2)
+	action, err = getPOLCycleAction(ctx, mgr)
+	c.Assert(err, IsNil)
+	c.Check(action.Action, Equals, "")
+	c.Check(action.TargetUtilization.Uint64(), Equals, uint64(10_000))
+	c.Check(action.Reason, Equals, "no POL position to withdraw")
+
+	polAddress, err := mgr.Keeper().GetModuleAddress(ReserveName)
+	c.Assert(err, IsNil)
+	mgr.Keeper().SetLiquidityProvider(ctx, LiquidityProvider{
+		Asset:             pool.Asset,
+		CacaoAddress:      polAddress,
+		Units:             cosmos.NewUint(50 * common.One),
+		PendingCacao:      cosmos.ZeroUint(),
+		PendingAsset:      cosmos.ZeroUint(),
+		AssetDepositValue: cosmos.ZeroUint(),
+		CacaoDepositValue: cosmos.ZeroUint(),
+	})
+	action, err = getPOLCycleAction(ctx, mgr)
+	c.Assert(err, IsNil)
+	c.Check(action.Action, Equals, POLActionWithdraw)
+	c.Check(action.CacaoAmount.Uint64(), Equals, uint64(common.One))
+	c.Check(action.BasisPoints.Uint64(), Equals, uint64(133), Commentf("%d", action.BasisPoints.Uint64()))
+}
+
+func (s *HelperSuite) TestPayBondProviderShares(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	na, provider := setupBondProvidersForTest(c, ctx, mgr.Keeper(), 1000)
+	operator, err := na.BondAddress.AccAddress()
+	c.Assert(err, IsNil)
+
+	// nothing to pay
+	c.Assert(payBondProviderShares(ctx, mgr.Keeper(), mgr.EventMgr(), na, cosmos.ZeroUint()), IsNil)
+
+	// bond module doesn't have the reward
+	c.Assert(payBondProviderShares(ctx, mgr.Keeper(), mgr.EventMgr(), na, cosmos.NewUint(100*common.One)), NotNil)
+
+	FundModule(c, ctx, mgr.Keeper(), BondName, 100)
+	c.Assert(payBondProviderShares(ctx, mgr.Keeper(), mgr.EventMgr(), na, cosmos.NewUint(100*common.One)), IsNil)
+	balance := mgr.Keeper().GetBalance(ctx, operator).AmountOf(common.BaseNative.Native())
+	c.Check(balance.Int64(), Equals, int64(55*common.One))
+	balance = mgr.Keeper().GetBalance(ctx, provider).AmountOf(common.BaseNative.Native())
+	c.Check(balance.Int64(), Equals, int64(45*common.One))
+
+	count := 0
+	for _, evt := range ctx.EventManager().Events() {
+		if evt.Type == types.BondShareEventType {
+			count++
+		}
+	}
+	c.Check(count, Equals, 3)
+}
+
+func (s *HelperSuite) TestSettleInclusionProofs(c *C) {
+	ctx, _ := setupManagerForTest(c)
+
+	// txids of bitcoin block 100000
+	txIDs := []string{
+		"8C14F0DB3DF150123E6F3DBBF30F8B955A8249B62AC1D1FF16284AEFA3D06D87",
+		"FFF2525B8931402DD09222C50775608F75787BD2B87E56995A7BDD30F79702C4",
+		"6359F0868171B1D194CBEE1AF2F16EA598AE8FAD666D9B012C8ED2B79A236EC4",
+	}
+	proof, err := inclusion.UTXOMerkleProof(txIDs, txIDs[1])
+	c.Assert(err, IsNil)
+
+	tx := GetRandomObservedTx()
+	tx.Tx.ID = common.TxID(txIDs[1])
+	tx.BlockHash = "blockhash"
+	tx.Proof = proof
+	bogus := GetRandomObservedTx()
+	bogus.BlockHash = "blockhash"
+	bogus.Proof = proof
+	bogus.ProofHash = proof.Hash("blockhash")
+	noProof := GetRandomObservedTx()
+	noProof.ProofHash = proof.Hash("blockhash")
+	txs := ObservedTxs{tx, bogus, noProof}
+
+	// only the hash of a verified proof is kept
+	settled := settleInclusionProofs(ctx, semver.MustParse("1.106.0"), txs)
+	c.Assert(settled, HasLen, 3)
+	c.Check(settled[0].Proof, IsNil)
+	c.Check(settled[0].BlockHash, Equals, "")
+	c.Check(settled[0].ProofHash, Equals, proof.Hash("blockhash"))
+	c.Check(settled[0].Equals(tx), Equals, true)
+	c.Check(settled[1].Proof, IsNil)
+	c.Check(settled[1].ProofHash, Equals, "")
+	c.Check(settled[2].ProofHash, Equals, "")
+	// the observations of the message are left untouched
+	c.Check(txs[0].Proof, NotNil)
+
+	// observations made before 1.106.0 carry no proof
+	settled = settleInclusionProofs(ctx, semver.MustParse("1.105.0"), txs)
+	c.Check(settled[0].Proof, IsNil)
+	c.Check(settled[0].BlockHash, Equals, "")
+	c.Check(settled[0].ProofHash, Equals, "")
+}
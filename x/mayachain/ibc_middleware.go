@@ -0,0 +1,132 @@
+package mayachain
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+	ibctransfertypes "github.com/cosmos/ibc-go/v2/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v2/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v2/modules/core/05-port/types"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/x/mayachain/keeper"
+	mem "gitlab.com/mayachain/mayanode/x/mayachain/memo"
+)
+
+// IBCMiddleware wraps the IBC transfer module, so outbound IBC transfers sent
+// by BASEChain can be settled once the counterparty chain acknowledged them,
+// or refunded to the original sender when they fail or time out
+type IBCMiddleware struct {
+	porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// NewIBCMiddleware create a new instance of IBCMiddleware
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		IBCModule: app,
+		keeper:    k,
+	}
+}
+
+// OnAcknowledgementPacket settle the IBC transfer the packet belongs to
+func (im IBCMiddleware) OnAcknowledgementPacket(ctx cosmos.Context, packet channeltypes.Packet, acknowledgement []byte, relayer cosmos.AccAddress) error {
+	if err := im.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+	transfer, ok := im.getIBCTransfer(ctx, packet)
+	if !ok {
+		return nil
+	}
+	var ack channeltypes.Acknowledgement
+	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return fmt.Errorf("fail to unmarshal ibc packet acknowledgement: %w", err)
+	}
+	if !ack.Success() {
+		return im.refundIBCTransfer(ctx, transfer, IBCTransferStatusFailed, ack.GetError())
+	}
+
+	im.keeper.RemoveIBCTransfer(ctx, transfer.Channel, transfer.Sequence)
+	evt := NewEventIBCTransfer(transfer.InHash, transfer.Channel, transfer.Sequence, transfer.Receiver, transfer.Coin, IBCTransferStatusAcked)
+	return im.emitEvent(ctx, evt)
+}
+
+// OnTimeoutPacket refund the IBC transfer the packet belongs to
+func (im IBCMiddleware) OnTimeoutPacket(ctx cosmos.Context, packet channeltypes.Packet, relayer cosmos.AccAddress) error {
+	if err := im.IBCModule.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+	transfer, ok := im.getIBCTransfer(ctx, packet)
+	if !ok {
+		return nil
+	}
+	return im.refundIBCTransfer(ctx, transfer, IBCTransferStatusTimedOut, "ibc transfer timed out")
+}
+
+// getIBCTransfer return the IBC transfer sent by BASEChain the given packet
+// belongs to, packets sent by anyone else are left to the transfer module
+func (im IBCMiddleware) getIBCTransfer(ctx cosmos.Context, packet channeltypes.Packet) (IBCTransfer, bool) {
+	if im.keeper.GetLowestActiveVersion(ctx).LT(semver.MustParse("1.106.0")) {
+		return IBCTransfer{}, false
+	}
+	transfer, err := im.keeper.GetIBCTransfer(ctx, packet.SourceChannel, packet.Sequence)
+	if err != nil {
+		return IBCTransfer{}, false
+	}
+	return transfer, true
+}
+
+// refundIBCTransfer the transfer module already returned the coin to the
+// module that sent it, pass it on to the sender of the inbound tx
+func (im IBCMiddleware) refundIBCTransfer(ctx cosmos.Context, transfer IBCTransfer, status, reason string) error {
+	sender, err := transfer.Sender.AccAddress()
+	if err != nil {
+		return fmt.Errorf("fail to parse sender of ibc transfer: %w", err)
+	}
+	coins := common.NewCoins(transfer.Coin)
+	if err := im.keeper.SendFromModuleToAccount(ctx, transfer.ModuleName, sender, coins); err != nil {
+		return fmt.Errorf("fail to refund ibc transfer: %w", err)
+	}
+	im.keeper.RemoveIBCTransfer(ctx, transfer.Channel, transfer.Sequence)
+
+	inTx := common.Tx{
+		ID:          transfer.InHash,
+		Chain:       common.BASEChain,
+		FromAddress: transfer.Sender,
+		ToAddress:   transfer.Receiver,
+		Coins:       coins,
+	}
+	voter, err := im.keeper.GetObservedTxInVoter(ctx, transfer.InHash)
+	if err == nil && !voter.Tx.IsEmpty() {
+		inTx = voter.Tx.Tx
+	}
+	moduleAddr, err := im.keeper.GetModuleAddress(transfer.ModuleName)
+	if err != nil {
+		return fmt.Errorf("fail to get module address: %w", err)
+	}
+	outTx := common.NewTx(common.BlankTxID, moduleAddr, transfer.Sender, coins, common.Gas{}, mem.NewRefundMemo(transfer.InHash).String())
+
+	events := []EmitEventItem{
+		NewEventRefund(CodeTxFail, reason, inTx, common.NewFee(common.Coins{}, cosmos.ZeroUint())),
+		NewEventOutbound(transfer.InHash, outTx),
+		NewEventIBCTransfer(transfer.InHash, transfer.Channel, transfer.Sequence, transfer.Receiver, transfer.Coin, status),
+	}
+	for _, evt := range events {
+		if err := im.emitEvent(ctx, evt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (im IBCMiddleware) emitEvent(ctx cosmos.Context, evt EmitEventItem) error {
+	events, err := evt.Events()
+	if err != nil {
+		return fmt.Errorf("fail to get events: %w", err)
+	}
+	ctx.EventManager().EmitEvents(events)
+	return nil
+}
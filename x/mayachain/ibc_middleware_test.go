@@ -0,0 +1,90 @@
+package mayachain
+
+import (
+	channeltypes "github.com/cosmos/ibc-go/v2/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v2/modules/core/05-port/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+type IBCMiddlewareSuite struct{}
+
+var _ = Suite(&IBCMiddlewareSuite{})
+
+func (s *IBCMiddlewareSuite) SetUpSuite(*C) {
+	SetupConfigForTest()
+}
+
+type TestIBCModule struct {
+	porttypes.IBCModule
+}
+
+func (TestIBCModule) OnAcknowledgementPacket(_ cosmos.Context, _ channeltypes.Packet, _ []byte, _ cosmos.AccAddress) error {
+	return nil
+}
+
+func (TestIBCModule) OnTimeoutPacket(_ cosmos.Context, _ channeltypes.Packet, _ cosmos.AccAddress) error {
+	return nil
+}
+
+func (s *IBCMiddlewareSuite) newTransfer(c *C, ctx cosmos.Context, k interface {
+	SetIBCTransfer(cosmos.Context, IBCTransfer)
+}, sequence uint64,
+) IBCTransfer {
+	transfer := NewIBCTransfer("channel-3", sequence, GetRandomTxHash(), AsgardName, GetRandomBaseAddress(), GetRandomBaseAddress(), common.NewCoin(common.BaseNative, cosmos.NewUint(10*common.One)), ctx.BlockHeight())
+	c.Assert(transfer.Valid(), IsNil)
+	k.SetIBCTransfer(ctx, transfer)
+	return transfer
+}
+
+func (s *IBCMiddlewareSuite) TestOnAcknowledgementPacket(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	na := GetRandomValidatorNode(NodeActive)
+	na.Version = GetCurrentVersion().String()
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+	im := NewIBCMiddleware(TestIBCModule{}, k)
+
+	// success ack settle the transfer
+	transfer := s.newTransfer(c, ctx, k, 1)
+	packet := channeltypes.Packet{SourceChannel: transfer.Channel, Sequence: transfer.Sequence}
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+	c.Assert(im.OnAcknowledgementPacket(ctx, packet, ack, nil), IsNil)
+	_, err := k.GetIBCTransfer(ctx, transfer.Channel, transfer.Sequence)
+	c.Assert(err, NotNil)
+
+	// error ack refund the sender
+	FundModule(c, ctx, k, AsgardName, 10)
+	transfer = s.newTransfer(c, ctx, k, 2)
+	packet = channeltypes.Packet{SourceChannel: transfer.Channel, Sequence: transfer.Sequence}
+	ack = channeltypes.NewErrorAcknowledgement("invalid receiver").Acknowledgement()
+	c.Assert(im.OnAcknowledgementPacket(ctx, packet, ack, nil), IsNil)
+	_, err = k.GetIBCTransfer(ctx, transfer.Channel, transfer.Sequence)
+	c.Assert(err, NotNil)
+	sender, err := transfer.Sender.AccAddress()
+	c.Assert(err, IsNil)
+	c.Assert(k.GetBalance(ctx, sender).AmountOf(common.BaseNative.Native()).Int64(), Equals, int64(10*common.One))
+
+	// packets which do not belong to BASEChain are ignored
+	packet = channeltypes.Packet{SourceChannel: "channel-3", Sequence: 100}
+	c.Assert(im.OnAcknowledgementPacket(ctx, packet, ack, nil), IsNil)
+}
+
+func (s *IBCMiddlewareSuite) TestOnTimeoutPacket(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	na := GetRandomValidatorNode(NodeActive)
+	na.Version = GetCurrentVersion().String()
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+	im := NewIBCMiddleware(TestIBCModule{}, k)
+
+	transfer := s.newTransfer(c, ctx, k, 1)
+	packet := channeltypes.Packet{SourceChannel: transfer.Channel, Sequence: transfer.Sequence}
+	FundModule(c, ctx, k, AsgardName, 10)
+	c.Assert(im.OnTimeoutPacket(ctx, packet, nil), IsNil)
+	_, err := k.GetIBCTransfer(ctx, transfer.Channel, transfer.Sequence)
+	c.Assert(err, NotNil)
+	sender, err := transfer.Sender.AccAddress()
+	c.Assert(err, IsNil)
+	c.Assert(k.GetBalance(ctx, sender).AmountOf(common.BaseNative.Native()).Int64(), Equals, int64(10*common.One))
+}
@@ -0,0 +1,64 @@
+package inclusion
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	stypes "gitlab.com/mayachain/mayanode/x/mayachain/types"
+)
+
+// CosmosTxProof returns the merkle branch of the tx at index in a tendermint
+// block, txs are all the raw txs of the block in block order
+func CosmosTxProof(txs [][]byte, index int) (*stypes.InclusionProof, error) {
+	if index < 0 || index >= len(txs) {
+		return nil, fmt.Errorf("tx %d out of range of %d txs", index, len(txs))
+	}
+	blockTxs := make(tmtypes.Txs, len(txs))
+	for i, tx := range txs {
+		blockTxs[i] = tx
+	}
+	txProof := blockTxs.Proof(index)
+	proof := &stypes.InclusionProof{
+		Type:  stypes.InclusionProofCosmosTx,
+		Root:  txProof.RootHash.String(),
+		Index: txProof.Proof.Index,
+		Total: txProof.Proof.Total,
+	}
+	for _, aunt := range txProof.Proof.Aunts {
+		proof.Nodes = append(proof.Nodes, hex.EncodeToString(aunt))
+	}
+	return proof, nil
+}
+
+// VerifyCosmosTxProof checks the merkle branch of the tx hash resolves to the
+// data hash of the proof
+func VerifyCosmosTxProof(txID string, proof *stypes.InclusionProof) error {
+	leaf, err := hex.DecodeString(txID)
+	if err != nil {
+		return fmt.Errorf("fail to parse tx hash %s: %w", txID, err)
+	}
+	root, err := hex.DecodeString(proof.Root)
+	if err != nil {
+		return fmt.Errorf("fail to parse data hash %s: %w", proof.Root, err)
+	}
+	merkleProof := merkle.Proof{
+		Total:    proof.Total,
+		Index:    proof.Index,
+		LeafHash: tmhash.Sum(append([]byte{0}, leaf...)),
+	}
+	for _, node := range proof.Nodes {
+		aunt, err := hex.DecodeString(node)
+		if err != nil {
+			return fmt.Errorf("fail to parse merkle node %s: %w", node, err)
+		}
+		merkleProof.Aunts = append(merkleProof.Aunts, aunt)
+	}
+	if err := merkleProof.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid tx proof: %w", err)
+	}
+	return merkleProof.Verify(root, leaf)
+}
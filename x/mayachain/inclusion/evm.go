@@ -0,0 +1,148 @@
+package inclusion
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	ecommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	etypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+
+	stypes "gitlab.com/mayachain/mayanode/x/mayachain/types"
+)
+
+// EVMReceiptProof returns the path of the receipt at index in the receipts
+// trie of an EVM block, along with the path of its tx at the same index in the
+// transactions trie, which binds the receipt to the tx hash. txs and receipts
+// are all the txs and receipts of the block in block order.
+func EVMReceiptProof(txs etypes.Transactions, receipts etypes.Receipts, index int) (*stypes.InclusionProof, error) {
+	if len(txs) != len(receipts) {
+		return nil, fmt.Errorf("block has %d txs and %d receipts", len(txs), len(receipts))
+	}
+	if index < 0 || index >= len(txs) {
+		return nil, fmt.Errorf("tx %d out of range of %d txs", index, len(txs))
+	}
+	receiptRoot, receiptNodes, err := provePath(receipts, index)
+	if err != nil {
+		return nil, fmt.Errorf("fail to prove receipt %d: %w", index, err)
+	}
+	txRoot, txNodes, err := provePath(txs, index)
+	if err != nil {
+		return nil, fmt.Errorf("fail to prove tx %d: %w", index, err)
+	}
+	return &stypes.InclusionProof{
+		Type:    stypes.InclusionProofEVMReceipt,
+		Root:    receiptRoot,
+		Index:   int64(index),
+		Total:   int64(len(receipts)),
+		Nodes:   receiptNodes,
+		TxRoot:  txRoot,
+		TxNodes: txNodes,
+	}, nil
+}
+
+// VerifyEVMReceiptProof checks the receipt path resolves to the receipts root of
+// the proof, and that the tx at the same index of the transactions trie is the
+// one with the given hash
+func VerifyEVMReceiptProof(txID string, proof *stypes.InclusionProof) error {
+	receipt, err := verifyPath(proof.Root, proof.Nodes, proof.Index)
+	if err != nil {
+		return fmt.Errorf("invalid receipt proof: %w", err)
+	}
+	var r etypes.Receipt
+	if err := r.UnmarshalBinary(receipt); err != nil {
+		return fmt.Errorf("fail to decode receipt at index %d: %w", proof.Index, err)
+	}
+	encoded, err := verifyPath(proof.TxRoot, proof.TxNodes, proof.Index)
+	if err != nil {
+		return fmt.Errorf("invalid tx proof: %w", err)
+	}
+	// the trie holds the consensus encoding of the tx, which is what its hash
+	// is taken over
+	hash := crypto.Keccak256Hash(encoded)
+	if !strings.EqualFold(trimHexPrefix(hash.Hex()), trimHexPrefix(txID)) {
+		return fmt.Errorf("tx at index %d is %s, not %s", proof.Index, hash.Hex(), txID)
+	}
+	return nil
+}
+
+// provePath builds the trie of the given list, keyed by the rlp encoded index
+// as EVM blocks do, and returns its root along with the path to index
+func provePath(list etypes.DerivableList, index int) (string, []string, error) {
+	t := trie.NewEmpty(trie.NewDatabase(rawdb.NewMemoryDatabase()))
+	var buf bytes.Buffer
+	for i := 0; i < list.Len(); i++ {
+		key, err := rlp.EncodeToBytes(uint(i))
+		if err != nil {
+			return "", nil, fmt.Errorf("fail to encode key: %w", err)
+		}
+		buf.Reset()
+		list.EncodeIndex(i, &buf)
+		t.Update(key, ecommon.CopyBytes(buf.Bytes()))
+	}
+	key, err := rlp.EncodeToBytes(uint(index))
+	if err != nil {
+		return "", nil, fmt.Errorf("fail to encode key: %w", err)
+	}
+	var nodes proofNodes
+	if err := t.Prove(key, 0, &nodes); err != nil {
+		return "", nil, err
+	}
+	return t.Hash().Hex(), nodes, nil
+}
+
+// verifyPath checks the trie path resolves to the given root and returns the
+// value at index
+func verifyPath(rootHex string, nodes []string, index int64) ([]byte, error) {
+	root, err := hex.DecodeString(trimHexPrefix(rootHex))
+	if err != nil || len(root) != ecommon.HashLength {
+		return nil, fmt.Errorf("invalid root %s", rootHex)
+	}
+	db := memorydb.New()
+	for _, node := range nodes {
+		buf, err := hex.DecodeString(trimHexPrefix(node))
+		if err != nil {
+			return nil, fmt.Errorf("fail to parse trie node: %w", err)
+		}
+		if err := db.Put(crypto.Keccak256(buf), buf); err != nil {
+			return nil, fmt.Errorf("fail to load trie node: %w", err)
+		}
+	}
+	key, err := rlp.EncodeToBytes(uint(index))
+	if err != nil {
+		return nil, fmt.Errorf("fail to encode key: %w", err)
+	}
+	value, err := trie.VerifyProof(ecommon.BytesToHash(root), key, db)
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return nil, fmt.Errorf("nothing at index %d", index)
+	}
+	return value, nil
+}
+
+// proofNodes collects the trie nodes of a proof in root to leaf order
+type proofNodes []string
+
+func (p *proofNodes) Put(_, value []byte) error {
+	*p = append(*p, hex.EncodeToString(value))
+	return nil
+}
+
+func (p *proofNodes) Delete([]byte) error {
+	return fmt.Errorf("proof nodes can't be deleted")
+}
+
+func trimHexPrefix(value string) string {
+	if len(value) >= 2 && value[0] == '0' && (value[1] == 'x' || value[1] == 'X') {
+		return value[2:]
+	}
+	return value
+}
@@ -0,0 +1,33 @@
+// Package inclusion builds and verifies the proofs that an observed
+// transaction is part of the source chain block it was observed in.
+package inclusion
+
+import (
+	"fmt"
+
+	"gitlab.com/mayachain/mayanode/common"
+	stypes "gitlab.com/mayachain/mayanode/x/mayachain/types"
+)
+
+// Verify checks the proof of the given observed tx id resolves to the root of
+// the proof. The root itself still has to be checked against the block header.
+func Verify(txID string, proof *stypes.InclusionProof) error {
+	if proof == nil {
+		return fmt.Errorf("no inclusion proof")
+	}
+	if err := proof.Valid(); err != nil {
+		return err
+	}
+	// sub indexed observations are proven by the tx they were split from
+	txID = common.TxID(txID).BaseID().String()
+	switch proof.Type {
+	case stypes.InclusionProofUTXOMerkle:
+		return VerifyUTXOMerkleProof(txID, proof)
+	case stypes.InclusionProofEVMReceipt:
+		return VerifyEVMReceiptProof(txID, proof)
+	case stypes.InclusionProofCosmosTx:
+		return VerifyCosmosTxProof(txID, proof)
+	default:
+		return fmt.Errorf("unknown inclusion proof type %q", proof.Type)
+	}
+}
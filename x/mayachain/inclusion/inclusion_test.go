@@ -0,0 +1,176 @@
+package inclusion
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+
+	ecommon "github.com/ethereum/go-ethereum/common"
+	etypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+	tmtypes "github.com/tendermint/tendermint/types"
+	. "gopkg.in/check.v1"
+
+	stypes "gitlab.com/mayachain/mayanode/x/mayachain/types"
+)
+
+func TestPackage(t *testing.T) { TestingT(t) }
+
+type InclusionSuite struct{}
+
+var _ = Suite(&InclusionSuite{})
+
+// txids and merkle root of bitcoin block 100000
+var (
+	btcBlockTxIDs = []string{
+		"8c14f0db3df150123e6f3dbbf30f8b955a8249b62ac1d1ff16284aefa3d06d87",
+		"fff2525b8931402dd09222c50775608f75787bd2b87e56995a7bdd30f79702c4",
+		"6359f0868171b1d194cbee1af2f16ea598ae8fad666d9b012c8ed2b79a236ec4",
+		"e9a66845e05d5abc0ad04ec80f774a7e585c6e8db975962d069a522137b80c1d",
+	}
+	btcBlockMerkleRoot = "f3e94742aca4b5ef85488dc37c06c3282295ffec960994b2c0d5ac2a25a95766"
+)
+
+func (s *InclusionSuite) TestUTXOMerkleProof(c *C) {
+	for i, txID := range btcBlockTxIDs {
+		proof, err := UTXOMerkleProof(btcBlockTxIDs, txID)
+		c.Assert(err, IsNil)
+		c.Check(proof.Type, Equals, stypes.InclusionProofUTXOMerkle)
+		c.Check(proof.Root, Equals, btcBlockMerkleRoot)
+		c.Check(proof.Index, Equals, int64(i))
+		c.Check(proof.Total, Equals, int64(4))
+		c.Check(proof.Nodes, HasLen, 2)
+		c.Check(Verify(txID, proof), IsNil)
+	}
+
+	// odd number of txs duplicates the last hash of a level
+	txIDs := btcBlockTxIDs[:3]
+	for _, txID := range txIDs {
+		proof, err := UTXOMerkleProof(txIDs, txID)
+		c.Assert(err, IsNil)
+		c.Check(Verify(txID, proof), IsNil)
+	}
+
+	// a single tx is its own root
+	proof, err := UTXOMerkleProof(btcBlockTxIDs[:1], btcBlockTxIDs[0])
+	c.Assert(err, IsNil)
+	c.Check(proof.Root, Equals, btcBlockTxIDs[0])
+	c.Check(proof.Nodes, HasLen, 0)
+	c.Check(Verify(btcBlockTxIDs[0], proof), IsNil)
+
+	proof, err = UTXOMerkleProof(btcBlockTxIDs, btcBlockTxIDs[1])
+	c.Assert(err, IsNil)
+	c.Check(Verify(btcBlockTxIDs[2], proof), NotNil)
+	proof.Index = 0
+	c.Check(Verify(btcBlockTxIDs[1], proof), NotNil)
+
+	_, err = UTXOMerkleProof(btcBlockTxIDs, btcBlockMerkleRoot)
+	c.Check(err, NotNil)
+	_, err = UTXOMerkleProof([]string{"bogus"}, "bogus")
+	c.Check(err, NotNil)
+}
+
+func (s *InclusionSuite) TestEVMReceiptProof(c *C) {
+	var txs etypes.Transactions
+	var receipts etypes.Receipts
+	for i := 0; i < 130; i++ {
+		txs = append(txs, etypes.NewTx(&etypes.DynamicFeeTx{
+			Nonce:     uint64(i),
+			GasTipCap: ecommon.Big1,
+			GasFeeCap: ecommon.Big1,
+			Gas:       21000,
+			Value:     ecommon.Big1,
+		}))
+		receipts = append(receipts, &etypes.Receipt{
+			Type:              etypes.DynamicFeeTxType,
+			Status:            etypes.ReceiptStatusSuccessful,
+			CumulativeGasUsed: uint64(21000 * (i + 1)),
+			Logs:              []*etypes.Log{},
+		})
+	}
+	// a legacy tx and its receipt are encoded without their type in the trie
+	txs[7] = etypes.NewTransaction(7, ecommon.Address{}, ecommon.Big1, 21000, ecommon.Big1, nil)
+	receipts[7].Type = etypes.LegacyTxType
+	txsRoot := etypes.DeriveSha(txs, trie.NewStackTrie(nil))
+	receiptsRoot := etypes.DeriveSha(receipts, trie.NewStackTrie(nil))
+
+	for _, index := range []int{0, 7, 127, 128, 129} {
+		proof, err := EVMReceiptProof(txs, receipts, index)
+		c.Assert(err, IsNil)
+		c.Check(proof.Type, Equals, stypes.InclusionProofEVMReceipt)
+		c.Check(proof.Root, Equals, receiptsRoot.Hex())
+		c.Check(proof.TxRoot, Equals, txsRoot.Hex())
+		c.Check(proof.Total, Equals, int64(130))
+		c.Check(Verify(txs[index].Hash().Hex(), proof), IsNil)
+		// tx ids are kept upper cased by the chain
+		c.Check(Verify(strings.ToUpper(txs[index].Hash().Hex()), proof), IsNil)
+	}
+
+	// a proof for one tx doesn't prove another
+	proof, err := EVMReceiptProof(txs, receipts, 7)
+	c.Assert(err, IsNil)
+	c.Check(Verify(txs[8].Hash().Hex(), proof), NotNil)
+	proof.Index = 8
+	c.Check(Verify(txs[7].Hash().Hex(), proof), NotNil)
+
+	// the tx path alone doesn't prove the receipt
+	proof, err = EVMReceiptProof(txs, receipts, 7)
+	c.Assert(err, IsNil)
+	proof.Root = proof.TxRoot
+	proof.Nodes = proof.TxNodes
+	c.Check(Verify(txs[7].Hash().Hex(), proof), NotNil)
+
+	_, err = EVMReceiptProof(txs, receipts, 130)
+	c.Check(err, NotNil)
+	_, err = EVMReceiptProof(txs, receipts[:129], 0)
+	c.Check(err, NotNil)
+}
+
+func (s *InclusionSuite) TestCosmosTxProof(c *C) {
+	txs := [][]byte{[]byte("tx1"), []byte("tx2"), []byte("tx3"), []byte("tx4"), []byte("tx5")}
+	blockTxs := tmtypes.Txs{}
+	for _, tx := range txs {
+		blockTxs = append(blockTxs, tx)
+	}
+	dataHash := fmt.Sprintf("%X", blockTxs.Hash())
+
+	for i, tx := range blockTxs {
+		proof, err := CosmosTxProof(txs, i)
+		c.Assert(err, IsNil)
+		c.Check(proof.Type, Equals, stypes.InclusionProofCosmosTx)
+		c.Check(proof.Root, Equals, dataHash)
+		c.Check(proof.Index, Equals, int64(i))
+		c.Check(proof.Total, Equals, int64(5))
+		txID := hex.EncodeToString(tx.Hash())
+		c.Check(Verify(txID, proof), IsNil)
+	}
+
+	proof, err := CosmosTxProof(txs, 1)
+	c.Assert(err, IsNil)
+	c.Check(Verify(hex.EncodeToString(blockTxs[2].Hash()), proof), NotNil)
+
+	_, err = CosmosTxProof(txs, 5)
+	c.Check(err, NotNil)
+}
+
+func (s *InclusionSuite) TestVerify(c *C) {
+	c.Check(Verify(btcBlockTxIDs[0], nil), NotNil)
+	c.Check(Verify(btcBlockTxIDs[0], &stypes.InclusionProof{
+		Type:  "unknown",
+		Root:  btcBlockMerkleRoot,
+		Index: 0,
+		Total: 1,
+	}), NotNil)
+	c.Check(Verify(btcBlockTxIDs[0], &stypes.InclusionProof{
+		Type:  stypes.InclusionProofUTXOMerkle,
+		Root:  btcBlockMerkleRoot,
+		Index: 4,
+		Total: 4,
+	}), NotNil)
+	c.Check(Verify(btcBlockTxIDs[0], &stypes.InclusionProof{
+		Type:  stypes.InclusionProofUTXOMerkle,
+		Index: 0,
+		Total: 4,
+	}), NotNil)
+}
@@ -0,0 +1,89 @@
+package inclusion
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+
+	stypes "gitlab.com/mayachain/mayanode/x/mayachain/types"
+)
+
+// UTXOMerkleProof returns the merkle branch of txID in a block of a bitcoin
+// like chain, txIDs are all the txids of the block in block order
+func UTXOMerkleProof(txIDs []string, txID string) (*stypes.InclusionProof, error) {
+	index := -1
+	level := make([]chainhash.Hash, len(txIDs))
+	for i, id := range txIDs {
+		hash, err := chainhash.NewHashFromStr(id)
+		if err != nil {
+			return nil, fmt.Errorf("fail to parse txid %s: %w", id, err)
+		}
+		level[i] = *hash
+		if strings.EqualFold(id, txID) {
+			index = i
+		}
+	}
+	if index < 0 {
+		return nil, fmt.Errorf("tx %s is not in the block", txID)
+	}
+
+	proof := &stypes.InclusionProof{
+		Type:  stypes.InclusionProofUTXOMerkle,
+		Index: int64(index),
+		Total: int64(len(txIDs)),
+	}
+	for pos := index; len(level) > 1; pos /= 2 {
+		// an odd level pairs its last hash with itself
+		if len(level)%2 == 1 {
+			level = append(level, level[len(level)-1])
+		}
+		proof.Nodes = append(proof.Nodes, level[pos^1].String())
+		next := make([]chainhash.Hash, len(level)/2)
+		for i := range next {
+			next[i] = hashUTXOPair(level[2*i], level[2*i+1])
+		}
+		level = next
+	}
+	proof.Root = level[0].String()
+	return proof, nil
+}
+
+// VerifyUTXOMerkleProof checks the merkle branch of txID resolves to the merkle
+// root of the proof
+func VerifyUTXOMerkleProof(txID string, proof *stypes.InclusionProof) error {
+	hash, err := chainhash.NewHashFromStr(txID)
+	if err != nil {
+		return fmt.Errorf("fail to parse txid %s: %w", txID, err)
+	}
+	root, err := chainhash.NewHashFromStr(proof.Root)
+	if err != nil {
+		return fmt.Errorf("fail to parse merkle root %s: %w", proof.Root, err)
+	}
+	current := *hash
+	pos := proof.Index
+	for _, node := range proof.Nodes {
+		sibling, err := chainhash.NewHashFromStr(node)
+		if err != nil {
+			return fmt.Errorf("fail to parse merkle node %s: %w", node, err)
+		}
+		if pos%2 == 0 {
+			current = hashUTXOPair(current, *sibling)
+		} else {
+			current = hashUTXOPair(*sibling, current)
+		}
+		pos /= 2
+	}
+	if !current.IsEqual(root) {
+		return fmt.Errorf("merkle branch of %s resolves to %s, not %s", txID, current, root)
+	}
+	return nil
+}
+
+func hashUTXOPair(left, right chainhash.Hash) chainhash.Hash {
+	var buf bytes.Buffer
+	buf.Write(left[:])
+	buf.Write(right[:])
+	return chainhash.DoubleHashH(buf.Bytes())
+}
@@ -38,6 +38,8 @@ type (
 	PoolStatus               = types.PoolStatus
 	Pool                     = types.Pool
 	Pools                    = types.Pools
+	PoolSnapshot             = types.PoolSnapshot
+	OutboundFeeRecord        = types.OutboundFeeRecord
 	LiquidityProvider        = types.LiquidityProvider
 	LiquidityProviders       = types.LiquidityProviders
 	ObservedTxVoter          = types.ObservedTxVoter
@@ -46,6 +48,8 @@ type (
 	ErrataTxVoter            = types.ErrataTxVoter
 	TssVoter                 = types.TssVoter
 	TssKeysignFailVoter      = types.TssKeysignFailVoter
+	BlameRecord              = types.BlameRecord
+	EVMToken                 = types.EVMToken
 	TssKeygenMetric          = types.TssKeygenMetric
 	TssKeysignMetric         = types.TssKeysignMetric
 	TxOutItem                = types.TxOutItem
@@ -60,9 +64,11 @@ type (
 	NodeAccount              = types.NodeAccount
 	NodeAccounts             = types.NodeAccounts
 	NodeMimirs               = types.NodeMimirs
+	PendingMimirs            = types.PendingMimirs
 	NodeStatus               = types.NodeStatus
 	Network                  = types.Network
 	ProtocolOwnedLiquidity   = types.ProtocolOwnedLiquidity
+	POLPool                  = types.POLPool
 	VaultStatus              = types.VaultStatus
 	NetworkFee               = types.NetworkFee
 	ObservedNetworkFeeVoter  = types.ObservedNetworkFeeVoter
@@ -70,5 +76,6 @@ type (
 	ChainContract            = types.ChainContract
 	SolvencyVoter            = types.SolvencyVoter
 	MAYAName                 = types.MAYAName
+	IBCTransfer              = types.IBCTransfer
 	LiquidityAuctionTier     = types.LiquidityAuctionTier
 )
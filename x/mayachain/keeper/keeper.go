@@ -58,6 +58,8 @@ type Keeper interface {
 	KeeperNetwork
 	KeeperTss
 	KeeperTssKeysignFail
+	KeeperBlameRecord
+	KeeperEVMToken
 	KeeperKeygen
 	KeeperRagnarok
 	KeeperErrataTx
@@ -71,10 +73,12 @@ type Keeper interface {
 	KeeperSolvencyVoter
 	KeeperMAYAName
 	KeeperForgiveSlashVoter
+	KeeperIBCTransfer
 }
 
 type KeeperPool interface {
 	GetPoolIterator(ctx cosmos.Context) cosmos.Iterator
+	GetPoolIteratorFrom(ctx cosmos.Context, start []byte) cosmos.Iterator
 	GetPool(ctx cosmos.Context, asset common.Asset) (Pool, error)
 	GetPools(ctx cosmos.Context) (Pools, error)
 	SetPool(ctx cosmos.Context, pool Pool) error
@@ -82,6 +86,16 @@ type KeeperPool interface {
 	RemovePool(ctx cosmos.Context, asset common.Asset)
 	SetPoolLUVI(ctx cosmos.Context, asset common.Asset, luvi cosmos.Uint)
 	GetPoolLUVI(ctx cosmos.Context, asset common.Asset) (cosmos.Uint, error)
+	SetPoolSnapshot(ctx cosmos.Context, snapshot PoolSnapshot)
+	RemovePoolSnapshot(ctx cosmos.Context, asset common.Asset, height int64)
+	GetPoolSnapshots(ctx cosmos.Context, asset common.Asset) ([]PoolSnapshot, error)
+	AddToPoolSnapshotSwaps(ctx cosmos.Context, asset common.Asset, fee, volume cosmos.Uint) error
+	GetPoolSnapshotSwaps(ctx cosmos.Context, asset common.Asset) (cosmos.Uint, cosmos.Uint, error)
+	ResetPoolSnapshotSwaps(ctx cosmos.Context, asset common.Asset)
+	AddSaversYield(ctx cosmos.Context, asset common.Asset, amt cosmos.Uint) error
+	GetSaversYield(ctx cosmos.Context, asset common.Asset) (cosmos.Uint, error)
+	AddSaversCount(ctx cosmos.Context, asset common.Asset, delta int64) error
+	GetSaversCount(ctx cosmos.Context, asset common.Asset) (int64, error)
 }
 
 type KeeperLastHeight interface {
@@ -96,12 +110,15 @@ type KeeperLastHeight interface {
 
 type KeeperLiquidityProvider interface {
 	GetLiquidityProviderIterator(ctx cosmos.Context, _ common.Asset) cosmos.Iterator
+	GetLiquidityProviderIteratorFrom(ctx cosmos.Context, asset common.Asset, addressPrefix string, start []byte) cosmos.Iterator
 	GetLiquidityProvider(ctx cosmos.Context, asset common.Asset, addr common.Address) (LiquidityProvider, error)
 	GetLiquidityProviderByAssets(ctx cosmos.Context, assets common.Assets, addr common.Address) (LiquidityProviders, error)
 	GetLiquidityAuctionTier(ctx cosmos.Context, addr common.Address) (int64, error)
 	SetLiquidityProvider(ctx cosmos.Context, lp LiquidityProvider)
 	SetLiquidityProviders(ctx cosmos.Context, lps LiquidityProviders)
 	SetLiquidityAuctionTier(ctx cosmos.Context, addr common.Address, tier int64) error
+	RemoveLiquidityAuctionTier(ctx cosmos.Context, addr common.Address)
+	GetLiquidityAuctionTierIterator(ctx cosmos.Context) cosmos.Iterator
 	RemoveLiquidityProvider(ctx cosmos.Context, lp LiquidityProvider)
 	GetTotalSupply(ctx cosmos.Context, asset common.Asset) cosmos.Uint
 	CalcLPLiquidityBond(ctx cosmos.Context, bondAddr common.Address, nodeAddr cosmos.AccAddress) (cosmos.Uint, error)
@@ -121,6 +138,7 @@ type KeeperNodeAccount interface {
 	EnsureNodeKeysUnique(ctx cosmos.Context, consensusPubKey string, pubKeys common.PubKeySet) error
 	EnsureAztecAddressUnique(ctx cosmos.Context, aztecAddress common.Address) error
 	GetNodeAccountIterator(ctx cosmos.Context) cosmos.Iterator
+	GetNodeAccountIteratorFrom(ctx cosmos.Context, start []byte) cosmos.Iterator
 	GetNodeAccountSlashPoints(_ cosmos.Context, _ cosmos.AccAddress) (int64, error)
 	SetNodeAccountSlashPoints(_ cosmos.Context, _ cosmos.AccAddress, _ int64)
 	IncNodeAccountSlashPoints(_ cosmos.Context, _ cosmos.AccAddress, _ int64) error
@@ -194,6 +212,9 @@ type KeeperNetwork interface {
 	SetNetwork(ctx cosmos.Context, data Network) error
 	GetPOL(ctx cosmos.Context) (ProtocolOwnedLiquidity, error)
 	SetPOL(ctx cosmos.Context, data ProtocolOwnedLiquidity) error
+	GetPOLPoolIterator(ctx cosmos.Context) cosmos.Iterator
+	GetPOLPool(ctx cosmos.Context, asset common.Asset) (POLPool, error)
+	SetPOLPool(ctx cosmos.Context, data POLPool) error
 }
 
 type KeeperTss interface {
@@ -213,6 +234,19 @@ type KeeperTssKeysignFail interface {
 	GetTssKeysignFailVoter(_ cosmos.Context, _ string) (TssKeysignFailVoter, error)
 }
 
+type KeeperBlameRecord interface {
+	SetBlameRecord(ctx cosmos.Context, record BlameRecord) error
+	GetBlameRecordIterator(ctx cosmos.Context) cosmos.Iterator
+	PruneBlameRecords(ctx cosmos.Context, height int64)
+}
+
+type KeeperEVMToken interface {
+	SetEVMToken(ctx cosmos.Context, token EVMToken) error
+	GetEVMToken(ctx cosmos.Context, chain common.Chain, address common.Address) (EVMToken, error)
+	RemoveEVMToken(ctx cosmos.Context, chain common.Chain, address common.Address)
+	GetEVMTokenIterator(ctx cosmos.Context, chain common.Chain) cosmos.Iterator
+}
+
 type KeeperKeygen interface {
 	SetKeygenBlock(ctx cosmos.Context, keygenBlock KeygenBlock)
 	GetKeygenBlockIterator(ctx cosmos.Context) cosmos.Iterator
@@ -280,7 +314,12 @@ type KeeperMimir interface {
 	SetNodeMimir(_ cosmos.Context, key string, value int64, acc cosmos.AccAddress) error
 	GetMimirIterator(ctx cosmos.Context) cosmos.Iterator
 	GetNodeMimirIterator(ctx cosmos.Context) cosmos.Iterator
+	GetMimirIteratorFrom(ctx cosmos.Context, start []byte) cosmos.Iterator
+	GetNodeMimirIteratorFrom(ctx cosmos.Context, start []byte) cosmos.Iterator
 	DeleteMimir(_ cosmos.Context, key string) error
+	GetPendingMimirs(ctx cosmos.Context, height int64) (PendingMimirs, error)
+	SetPendingMimirs(ctx cosmos.Context, height int64, mimirs PendingMimirs)
+	GetPendingMimirIterator(ctx cosmos.Context) cosmos.Iterator
 	GetNodePauseChain(ctx cosmos.Context, acc cosmos.AccAddress) int64
 	SetNodePauseChain(ctx cosmos.Context, acc cosmos.AccAddress)
 }
@@ -289,6 +328,13 @@ type KeeperNetworkFee interface {
 	GetNetworkFee(ctx cosmos.Context, chain common.Chain) (NetworkFee, error)
 	SaveNetworkFee(ctx cosmos.Context, chain common.Chain, networkFee NetworkFee) error
 	GetNetworkFeeIterator(ctx cosmos.Context) cosmos.Iterator
+	AddToOutboundFeeWithheldCacao(ctx cosmos.Context, chain common.Chain, amt cosmos.Uint) error
+	GetOutboundFeeWithheldCacao(ctx cosmos.Context, chain common.Chain) (cosmos.Uint, error)
+	AddToOutboundFeeSpentCacao(ctx cosmos.Context, chain common.Chain, amt cosmos.Uint) error
+	GetOutboundFeeSpentCacao(ctx cosmos.Context, chain common.Chain) (cosmos.Uint, error)
+	SetOutboundFeeRecord(ctx cosmos.Context, record OutboundFeeRecord)
+	RemoveOutboundFeeRecord(ctx cosmos.Context, chain common.Chain, height int64)
+	GetOutboundFeeRecords(ctx cosmos.Context, chain common.Chain) ([]OutboundFeeRecord, error)
 	DistributeMayaFund(ctx cosmos.Context, constAccessor constants.ConstantValues)
 	DynamicInflation(ctx cosmos.Context, constAccessor constants.ConstantValues) error
 }
@@ -320,6 +366,14 @@ type KeeperMAYAName interface {
 	DeleteMAYAName(ctx cosmos.Context, _ string) error
 }
 
+type KeeperIBCTransfer interface {
+	SendIBCTransfer(ctx cosmos.Context, moduleName, channel string, coin common.Coin, receiver common.Address, timeoutTimestamp uint64) (uint64, error)
+	GetIBCTransferIterator(ctx cosmos.Context) cosmos.Iterator
+	SetIBCTransfer(ctx cosmos.Context, transfer IBCTransfer)
+	GetIBCTransfer(ctx cosmos.Context, channel string, sequence uint64) (IBCTransfer, error)
+	RemoveIBCTransfer(ctx cosmos.Context, channel string, sequence uint64)
+}
+
 // NewKVStore creates new instances of the thorchain Keeper
 func NewKeeper(cdc codec.BinaryCodec, coinKeeper bankkeeper.Keeper, accountKeeper authkeeper.AccountKeeper, ibcTransferkeeper ibctransferkeeper.Keeper, storeKey cosmos.StoreKey) Keeper {
 	version := semver.MustParse("0.0.0")
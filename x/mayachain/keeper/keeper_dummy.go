@@ -153,6 +153,9 @@ func (k KVStoreDummy) GetPoolBalances(_ cosmos.Context, _, _ common.Asset) (cosm
 func (k KVStoreDummy) GetPoolIterator(_ cosmos.Context) cosmos.Iterator {
 	return NewDummyIterator()
 }
+func (k KVStoreDummy) GetPoolIteratorFrom(_ cosmos.Context, _ []byte) cosmos.Iterator {
+	return NewDummyIterator()
+}
 func (k KVStoreDummy) SetPoolData(_ cosmos.Context, _ common.Asset, _ PoolStatus) {}
 func (k KVStoreDummy) GetPoolDataIterator(_ cosmos.Context) cosmos.Iterator {
 	return NewDummyIterator()
@@ -171,11 +174,39 @@ func (k KVStoreDummy) SetPoolLUVI(ctx cosmos.Context, asset common.Asset, luvi c
 func (k KVStoreDummy) GetPoolLUVI(ctx cosmos.Context, asset common.Asset) (cosmos.Uint, error) {
 	return cosmos.ZeroUint(), kaboom
 }
+func (k KVStoreDummy) SetPoolSnapshot(_ cosmos.Context, _ PoolSnapshot)             {}
+func (k KVStoreDummy) RemovePoolSnapshot(_ cosmos.Context, _ common.Asset, _ int64) {}
+func (k KVStoreDummy) GetPoolSnapshots(_ cosmos.Context, _ common.Asset) ([]PoolSnapshot, error) {
+	return nil, kaboom
+}
+func (k KVStoreDummy) AddToPoolSnapshotSwaps(_ cosmos.Context, _ common.Asset, _, _ cosmos.Uint) error {
+	return kaboom
+}
+func (k KVStoreDummy) GetPoolSnapshotSwaps(_ cosmos.Context, _ common.Asset) (cosmos.Uint, cosmos.Uint, error) {
+	return cosmos.ZeroUint(), cosmos.ZeroUint(), kaboom
+}
+func (k KVStoreDummy) ResetPoolSnapshotSwaps(_ cosmos.Context, _ common.Asset) {}
+func (k KVStoreDummy) AddSaversYield(_ cosmos.Context, _ common.Asset, _ cosmos.Uint) error {
+	return kaboom
+}
+func (k KVStoreDummy) GetSaversYield(_ cosmos.Context, _ common.Asset) (cosmos.Uint, error) {
+	return cosmos.ZeroUint(), kaboom
+}
+func (k KVStoreDummy) AddSaversCount(_ cosmos.Context, _ common.Asset, _ int64) error {
+	return kaboom
+}
+func (k KVStoreDummy) GetSaversCount(_ cosmos.Context, _ common.Asset) (int64, error) {
+	return 0, kaboom
+}
 
 func (k KVStoreDummy) GetLiquidityProviderIterator(_ cosmos.Context, _ common.Asset) cosmos.Iterator {
 	return nil
 }
 
+func (k KVStoreDummy) GetLiquidityProviderIteratorFrom(_ cosmos.Context, _ common.Asset, _ string, _ []byte) cosmos.Iterator {
+	return nil
+}
+
 func (k KVStoreDummy) GetLiquidityProvider(_ cosmos.Context, _ common.Asset, _ common.Address) (LiquidityProvider, error) {
 	return LiquidityProvider{}, kaboom
 }
@@ -194,6 +225,8 @@ func (k KVStoreDummy) SetLiquidityProvider(_ cosmos.Context, _ LiquidityProvider
 func (k KVStoreDummy) SetLiquidityAuctionTier(_ cosmos.Context, _ common.Address, _ int64) error {
 	return nil
 }
+func (k KVStoreDummy) RemoveLiquidityAuctionTier(_ cosmos.Context, _ common.Address)    {}
+func (k KVStoreDummy) GetLiquidityAuctionTierIterator(_ cosmos.Context) cosmos.Iterator { return nil }
 
 func (k KVStoreDummy) SetLiquidityProviders(_ cosmos.Context, _ LiquidityProviders)  {}
 func (k KVStoreDummy) RemoveLiquidityProvider(_ cosmos.Context, _ LiquidityProvider) {}
@@ -240,6 +273,9 @@ func (k KVStoreDummy) EnsureAztecAddressUnique(_ cosmos.Context, _ common.Addres
 	return kaboom
 }
 func (k KVStoreDummy) GetNodeAccountIterator(_ cosmos.Context) cosmos.Iterator { return nil }
+func (k KVStoreDummy) GetNodeAccountIteratorFrom(_ cosmos.Context, _ []byte) cosmos.Iterator {
+	return nil
+}
 
 func (k KVStoreDummy) GetNodeAccountSlashPoints(_ cosmos.Context, _ cosmos.AccAddress) (int64, error) {
 	return 0, kaboom
@@ -380,6 +416,15 @@ func (k KVStoreDummy) SetPOL(_ cosmos.Context, _ ProtocolOwnedLiquidity) error {
 	return kaboom
 }
 
+func (k KVStoreDummy) GetPOLPoolIterator(_ cosmos.Context) cosmos.Iterator { return nil }
+func (k KVStoreDummy) GetPOLPool(_ cosmos.Context, _ common.Asset) (POLPool, error) {
+	return POLPool{}, kaboom
+}
+
+func (k KVStoreDummy) SetPOLPool(_ cosmos.Context, _ POLPool) error {
+	return kaboom
+}
+
 func (k KVStoreDummy) SetTssKeysignFailVoter(_ cosmos.Context, tss TssKeysignFailVoter) {
 }
 
@@ -391,6 +436,19 @@ func (k KVStoreDummy) GetTssKeysignFailVoter(_ cosmos.Context, _ string) (TssKey
 	return TssKeysignFailVoter{}, kaboom
 }
 
+func (k KVStoreDummy) SetBlameRecord(_ cosmos.Context, _ BlameRecord) error    { return kaboom }
+func (k KVStoreDummy) GetBlameRecordIterator(_ cosmos.Context) cosmos.Iterator { return nil }
+func (k KVStoreDummy) PruneBlameRecords(_ cosmos.Context, _ int64)             {}
+
+func (k KVStoreDummy) SetEVMToken(_ cosmos.Context, _ EVMToken) error { return kaboom }
+func (k KVStoreDummy) GetEVMToken(_ cosmos.Context, _ common.Chain, _ common.Address) (EVMToken, error) {
+	return EVMToken{}, kaboom
+}
+func (k KVStoreDummy) RemoveEVMToken(_ cosmos.Context, _ common.Chain, _ common.Address) {}
+func (k KVStoreDummy) GetEVMTokenIterator(_ cosmos.Context, _ common.Chain) cosmos.Iterator {
+	return nil
+}
+
 func (k KVStoreDummy) GetGas(_ cosmos.Context, _ common.Asset) ([]cosmos.Uint, error) {
 	return nil, kaboom
 }
@@ -474,9 +532,20 @@ func (k KVStoreDummy) GetNodeMimirs(ctx cosmos.Context, key string) (NodeMimirs,
 func (k KVStoreDummy) SetNodeMimir(_ cosmos.Context, key string, value int64, acc cosmos.AccAddress) error {
 	return kaboom
 }
-func (k KVStoreDummy) DeleteMimir(_ cosmos.Context, key string) error          { return kaboom }
-func (k KVStoreDummy) GetMimirIterator(ctx cosmos.Context) cosmos.Iterator     { return nil }
-func (k KVStoreDummy) GetNodeMimirIterator(ctx cosmos.Context) cosmos.Iterator { return nil }
+func (k KVStoreDummy) DeleteMimir(_ cosmos.Context, key string) error      { return kaboom }
+func (k KVStoreDummy) GetMimirIterator(ctx cosmos.Context) cosmos.Iterator { return nil }
+func (k KVStoreDummy) GetPendingMimirs(_ cosmos.Context, _ int64) (PendingMimirs, error) {
+	return PendingMimirs{}, kaboom
+}
+func (k KVStoreDummy) SetPendingMimirs(_ cosmos.Context, _ int64, _ PendingMimirs) {}
+func (k KVStoreDummy) GetPendingMimirIterator(_ cosmos.Context) cosmos.Iterator    { return nil }
+func (k KVStoreDummy) GetNodeMimirIterator(ctx cosmos.Context) cosmos.Iterator     { return nil }
+func (k KVStoreDummy) GetMimirIteratorFrom(_ cosmos.Context, _ []byte) cosmos.Iterator {
+	return nil
+}
+func (k KVStoreDummy) GetNodeMimirIteratorFrom(_ cosmos.Context, _ []byte) cosmos.Iterator {
+	return nil
+}
 func (k KVStoreDummy) GetNodePauseChain(ctx cosmos.Context, acc cosmos.AccAddress) int64 {
 	return int64(-1)
 }
@@ -494,6 +563,28 @@ func (k KVStoreDummy) GetNetworkFeeIterator(ctx cosmos.Context) cosmos.Iterator
 	return nil
 }
 
+func (k KVStoreDummy) AddToOutboundFeeWithheldCacao(_ cosmos.Context, _ common.Chain, _ cosmos.Uint) error {
+	return kaboom
+}
+
+func (k KVStoreDummy) GetOutboundFeeWithheldCacao(_ cosmos.Context, _ common.Chain) (cosmos.Uint, error) {
+	return cosmos.ZeroUint(), kaboom
+}
+
+func (k KVStoreDummy) AddToOutboundFeeSpentCacao(_ cosmos.Context, _ common.Chain, _ cosmos.Uint) error {
+	return kaboom
+}
+
+func (k KVStoreDummy) GetOutboundFeeSpentCacao(_ cosmos.Context, _ common.Chain) (cosmos.Uint, error) {
+	return cosmos.ZeroUint(), kaboom
+}
+
+func (k KVStoreDummy) SetOutboundFeeRecord(_ cosmos.Context, _ OutboundFeeRecord)        {}
+func (k KVStoreDummy) RemoveOutboundFeeRecord(_ cosmos.Context, _ common.Chain, _ int64) {}
+func (k KVStoreDummy) GetOutboundFeeRecords(_ cosmos.Context, _ common.Chain) ([]OutboundFeeRecord, error) {
+	return nil, kaboom
+}
+
 func (k KVStoreDummy) SetObservedNetworkFeeVoter(ctx cosmos.Context, networkFeeVoter ObservedNetworkFeeVoter) {
 }
 
@@ -622,3 +713,13 @@ func (iter *DummyIterator) Error() error {
 func (iter *DummyIterator) Domain() (start, end []byte) {
 	return nil, nil
 }
+
+func (k KVStoreDummy) SendIBCTransfer(ctx cosmos.Context, moduleName, channel string, coin common.Coin, receiver common.Address, timeoutTimestamp uint64) (uint64, error) {
+	return 0, kaboom
+}
+func (k KVStoreDummy) GetIBCTransferIterator(ctx cosmos.Context) cosmos.Iterator { return nil }
+func (k KVStoreDummy) SetIBCTransfer(ctx cosmos.Context, transfer IBCTransfer)   {}
+func (k KVStoreDummy) GetIBCTransfer(ctx cosmos.Context, channel string, sequence uint64) (IBCTransfer, error) {
+	return IBCTransfer{}, kaboom
+}
+func (k KVStoreDummy) RemoveIBCTransfer(ctx cosmos.Context, channel string, sequence uint64) {}
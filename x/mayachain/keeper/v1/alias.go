@@ -43,9 +43,12 @@ const (
 
 var (
 	NewPool                    = types.NewPool
+	NewPoolSnapshot            = types.NewPoolSnapshot
+	NewOutboundFeeRecord       = types.NewOutboundFeeRecord
 	NewJail                    = types.NewJail
 	NewNetwork                 = types.NewNetwork
 	NewProtocolOwnedLiquidity  = types.NewProtocolOwnedLiquidity
+	NewPOLPool                 = types.NewPOLPool
 	NewObservedTx              = types.NewObservedTx
 	NewTssVoter                = types.NewTssVoter
 	NewBanVoter                = types.NewBanVoter
@@ -63,6 +66,7 @@ var (
 	NewVault                   = types.NewVault
 	NewReserveContributor      = types.NewReserveContributor
 	NewMAYAName                = types.NewMAYAName
+	NewIBCTransfer             = types.NewIBCTransfer
 	GetRandomTx                = types.GetRandomTx
 	GetRandomValidatorNode     = types.GetRandomValidatorNode
 	GetRandomVaultNode         = types.GetRandomVaultNode
@@ -78,6 +82,7 @@ var (
 	NewObservedNetworkFeeVoter = types.NewObservedNetworkFeeVoter
 	NewNetworkFee              = types.NewNetworkFee
 	NewTssKeysignFailVoter     = types.NewTssKeysignFailVoter
+	NewBlameRecord             = types.NewBlameRecord
 	SetupConfigForTest         = types.SetupConfigForTest
 	NewChainContract           = types.NewChainContract
 	GetLiquidityPools          = types.GetLiquidityPools
@@ -87,6 +92,8 @@ type (
 	MsgSwap                  = types.MsgSwap
 	Pool                     = types.Pool
 	Pools                    = types.Pools
+	PoolSnapshot             = types.PoolSnapshot
+	OutboundFeeRecord        = types.OutboundFeeRecord
 	LiquidityProvider        = types.LiquidityProvider
 	LiquidityProviders       = types.LiquidityProviders
 	ObservedTxs              = types.ObservedTxs
@@ -96,6 +103,8 @@ type (
 	ErrataTxVoter            = types.ErrataTxVoter
 	TssVoter                 = types.TssVoter
 	TssKeysignFailVoter      = types.TssKeysignFailVoter
+	BlameRecord              = types.BlameRecord
+	EVMToken                 = types.EVMToken
 	TxOutItem                = types.TxOutItem
 	TxOut                    = types.TxOut
 	KeygenBlock              = types.KeygenBlock
@@ -118,12 +127,16 @@ type (
 	TssKeysignMetric         = types.TssKeysignMetric
 	ChainContract            = types.ChainContract
 	MAYAName                 = types.MAYAName
+	IBCTransfer              = types.IBCTransfer
 	MAYANameAlias            = types.MAYANameAlias
 	SolvencyVoter            = types.SolvencyVoter
 	NodeMimir                = types.NodeMimir
+	PendingMimir             = types.PendingMimir
 	NodeMimirs               = types.NodeMimirs
+	PendingMimirs            = types.PendingMimirs
 	LiquidityAuctionTier     = types.LiquidityAuctionTier
 	ProtocolOwnedLiquidity   = types.ProtocolOwnedLiquidity
+	POLPool                  = types.POLPool
 
 	ProtoInt64        = types.ProtoInt64
 	ProtoUint64       = types.ProtoUint64
@@ -1,6 +1,7 @@
 package keeperv1
 
 import (
+	"bytes"
 	"fmt"
 	"strings"
 
@@ -45,9 +46,12 @@ const (
 	prefixVaultAsgardIndex        kvTypes.DbPrefix = "vault_asgard_index/"
 	prefixNetwork                 kvTypes.DbPrefix = "network/"
 	prefixPOL                     kvTypes.DbPrefix = "pol/"
+	prefixPOLPool                 kvTypes.DbPrefix = "pol_pool/"
 	prefixObservingAddresses      kvTypes.DbPrefix = "observing_addresses/"
 	prefixTss                     kvTypes.DbPrefix = "tss/"
 	prefixTssKeysignFailure       kvTypes.DbPrefix = "tssKeysignFailure/"
+	prefixBlameRecord             kvTypes.DbPrefix = "blame_record/"
+	prefixEVMToken                kvTypes.DbPrefix = "evm_token/"
 	prefixKeygen                  kvTypes.DbPrefix = "keygen/"
 	prefixRagnarokHeight          kvTypes.DbPrefix = "ragnarokHeight/"
 	prefixRagnarokNth             kvTypes.DbPrefix = "ragnarokNth/"
@@ -66,6 +70,7 @@ const (
 	prefixOrderBookProcessor      kvTypes.DbPrefix = "oproc/"
 	prefixMimir                   kvTypes.DbPrefix = "mimir/"
 	prefixNodeMimir               kvTypes.DbPrefix = "nodemimir/"
+	prefixPendingMimir            kvTypes.DbPrefix = "pendingmimir/"
 	prefixNodePauseChain          kvTypes.DbPrefix = "node_pause_chain/"
 	prefixNetworkFee              kvTypes.DbPrefix = "network_fee/"
 	prefixNetworkFeeVoter         kvTypes.DbPrefix = "network_fee_voter/"
@@ -77,7 +82,16 @@ const (
 	prefixMAYAName                kvTypes.DbPrefix = "mayaname/"
 	prefixRollingPoolLiquidityFee kvTypes.DbPrefix = "rolling_pool_liquidity_fee/"
 	prefixLiquidityAuctionTier    kvTypes.DbPrefix = "la_tier/"
+	prefixIBCTransfer             kvTypes.DbPrefix = "ibc_transfer/"
 	prefixVersion                 kvTypes.DbPrefix = "version/"
+	prefixPoolSnapshot            kvTypes.DbPrefix = "pool_snapshot/"
+	prefixPoolSnapshotFee         kvTypes.DbPrefix = "pool_snapshot_fee/"
+	prefixPoolSnapshotVolume      kvTypes.DbPrefix = "pool_snapshot_volume/"
+	prefixSaversYield             kvTypes.DbPrefix = "savers_yield/"
+	prefixSaversCount             kvTypes.DbPrefix = "savers_count/"
+	prefixOutboundFeeWithheld     kvTypes.DbPrefix = "outbound_fee_withheld/"
+	prefixOutboundFeeSpent        kvTypes.DbPrefix = "outbound_fee_spent/"
+	prefixOutboundFeeRecord       kvTypes.DbPrefix = "outbound_fee_record/"
 )
 
 func dbError(ctx cosmos.Context, wrapper string, err error) error {
@@ -157,6 +171,16 @@ func (k KVStore) getIterator(ctx cosmos.Context, prefix types.DbPrefix) cosmos.I
 	return cosmos.KVStorePrefixIterator(store, []byte(prefix))
 }
 
+// getIteratorFrom - get an iterator for given prefix, starting at the given key,
+// the iterator starts at the beginning of the prefix when the key is outside of it
+func (k KVStore) getIteratorFrom(ctx cosmos.Context, prefix types.DbPrefix, start []byte) cosmos.Iterator {
+	if !bytes.HasPrefix(start, []byte(prefix)) {
+		return k.getIterator(ctx, prefix)
+	}
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(start, cosmos.PrefixEndBytes([]byte(prefix)))
+}
+
 // del - delete data from the kvstore
 func (k KVStore) del(ctx cosmos.Context, key string) {
 	store := ctx.KVStore(k.storeKey)
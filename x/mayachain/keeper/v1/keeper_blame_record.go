@@ -0,0 +1,52 @@
+package keeperv1
+
+import (
+	"fmt"
+
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+// blameRecordKey records are keyed by height first, so the history can be
+// pruned by iterating from the oldest record
+func (k KVStore) blameRecordKey(ctx cosmos.Context, record BlameRecord) string {
+	return k.GetKey(ctx, prefixBlameRecord, fmt.Sprintf("%020d/%s/%s/%s", record.Height, record.NodePubKey, record.Type, record.ID))
+}
+
+// SetBlameRecord save a record of a node blamed for a failed keysign or keygen
+func (k KVStore) SetBlameRecord(ctx cosmos.Context, record BlameRecord) error {
+	if err := record.Valid(); err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(k.blameRecordKey(ctx, record)), k.cdc.MustMarshal(&record))
+	return nil
+}
+
+// GetBlameRecordIterator iterate the blame history, oldest record first
+func (k KVStore) GetBlameRecordIterator(ctx cosmos.Context) cosmos.Iterator {
+	return k.getIterator(ctx, prefixBlameRecord)
+}
+
+// PruneBlameRecords remove all the blame records older than the given height
+func (k KVStore) PruneBlameRecords(ctx cosmos.Context, height int64) {
+	var keys [][]byte
+	iter := k.GetBlameRecordIterator(ctx)
+	for ; iter.Valid(); iter.Next() {
+		var record BlameRecord
+		if err := k.cdc.Unmarshal(iter.Value(), &record); err != nil {
+			ctx.Logger().Error("fail to unmarshal blame record", "error", err)
+			keys = append(keys, iter.Key())
+			continue
+		}
+		if record.Height >= height {
+			break
+		}
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,47 @@
+package keeperv1
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/x/mayachain/types"
+)
+
+type KeeperBlameRecordSuite struct{}
+
+var _ = Suite(&KeeperBlameRecordSuite{})
+
+func (KeeperBlameRecordSuite) TestBlameRecord(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	na := GetRandomValidatorNode(NodeActive)
+	vault := GetRandomPubKey()
+	blame := types.Blame{FailReason: "fail to keysign", Round: "SignRound7Message"}
+
+	c.Check(k.SetBlameRecord(ctx, BlameRecord{}), NotNil)
+	for _, height := range []int64{30, 10, 20} {
+		record := NewBlameRecord(height, types.BlameTypeKeysign, GetRandomTxHash().String(), na.NodeAddress, na.PubKeySet.Secp256k1, vault, common.BTCChain, blame)
+		c.Assert(k.SetBlameRecord(ctx, record), IsNil)
+	}
+	c.Assert(k.SetBlameRecord(ctx, NewBlameRecord(20, types.BlameTypeKeygen, "keygen", na.NodeAddress, na.PubKeySet.Secp256k1, vault, common.EmptyChain, blame)), IsNil)
+
+	heights := func() []int64 {
+		var result []int64
+		iter := k.GetBlameRecordIterator(ctx)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			var record BlameRecord
+			c.Assert(k.cdc.Unmarshal(iter.Value(), &record), IsNil)
+			c.Check(record.NodeAddress.Equals(na.NodeAddress), Equals, true)
+			c.Check(record.Round, Equals, "SignRound7Message")
+			result = append(result, record.Height)
+		}
+		return result
+	}
+	// oldest first
+	c.Check(heights(), DeepEquals, []int64{10, 20, 20, 30})
+
+	k.PruneBlameRecords(ctx, 20)
+	c.Check(heights(), DeepEquals, []int64{20, 20, 30})
+	k.PruneBlameRecords(ctx, 31)
+	c.Check(heights(), HasLen, 0)
+}
@@ -0,0 +1,55 @@
+package keeperv1
+
+import (
+	"fmt"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+// evmTokenKey tokens are keyed by chain first, so the whitelist of a single
+// chain can be iterated
+func (k KVStore) evmTokenKey(ctx cosmos.Context, chain common.Chain, address common.Address) string {
+	return k.GetKey(ctx, prefixEVMToken, fmt.Sprintf("%s/%s", chain, address))
+}
+
+// SetEVMToken save the whitelist entry of an EVM token
+func (k KVStore) SetEVMToken(ctx cosmos.Context, token EVMToken) error {
+	if err := token.Valid(); err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(k.evmTokenKey(ctx, token.Chain, token.Address)), k.cdc.MustMarshal(&token))
+	return nil
+}
+
+// GetEVMToken get the whitelist entry of an EVM token, an empty token is
+// returned when there is none
+func (k KVStore) GetEVMToken(ctx cosmos.Context, chain common.Chain, address common.Address) (EVMToken, error) {
+	var record EVMToken
+	key := k.evmTokenKey(ctx, chain, address)
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has([]byte(key)) {
+		return record, nil
+	}
+	if err := k.cdc.Unmarshal(store.Get([]byte(key)), &record); err != nil {
+		return record, dbError(ctx, fmt.Sprintf("Unmarshal kvstore: (%T) %s", record, key), err)
+	}
+	return record, nil
+}
+
+// RemoveEVMToken remove the whitelist entry of an EVM token
+func (k KVStore) RemoveEVMToken(ctx cosmos.Context, chain common.Chain, address common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete([]byte(k.evmTokenKey(ctx, chain, address)))
+}
+
+// GetEVMTokenIterator iterate the whitelist entries of the given chain, or of
+// all chains when the chain is empty
+func (k KVStore) GetEVMTokenIterator(ctx cosmos.Context, chain common.Chain) cosmos.Iterator {
+	if chain.IsEmpty() {
+		return k.getIterator(ctx, prefixEVMToken)
+	}
+	store := ctx.KVStore(k.storeKey)
+	return cosmos.KVStorePrefixIterator(store, []byte(k.GetKey(ctx, prefixEVMToken, chain.String()+"/")))
+}
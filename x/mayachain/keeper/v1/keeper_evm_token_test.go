@@ -0,0 +1,54 @@
+package keeperv1
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/x/mayachain/types"
+)
+
+type KeeperEVMTokenSuite struct{}
+
+var _ = Suite(&KeeperEVMTokenSuite{})
+
+func (KeeperEVMTokenSuite) TestEVMToken(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	usdt := types.NewEVMToken(common.ETHChain, "0xdAC17F958D2ee523a2206206994597C13D831ec7", "USDT", 6)
+	usdc := types.NewEVMToken(common.ETHChain, "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48", "USDC", 6)
+	avax := types.NewEVMToken(common.AVAXChain, "0xB97EF9Ef8734C71904D8002F8b6Bc66Dd9c48a6E", "USDC", 6)
+
+	c.Check(k.SetEVMToken(ctx, EVMToken{}), NotNil)
+	for _, token := range []EVMToken{usdt, usdc, avax} {
+		c.Assert(k.SetEVMToken(ctx, token), IsNil)
+	}
+
+	// addresses are case insensitive
+	token, err := k.GetEVMToken(ctx, common.ETHChain, "0xdac17f958d2ee523a2206206994597c13d831ec7")
+	c.Assert(err, IsNil)
+	c.Check(token.Symbol, Equals, "USDT")
+	c.Check(token.Decimals, Equals, int64(6))
+	token, err = k.GetEVMToken(ctx, common.AVAXChain, usdt.Address)
+	c.Assert(err, IsNil)
+	c.Check(token.Address.IsEmpty(), Equals, true)
+
+	count := func(chain common.Chain) int {
+		total := 0
+		iter := k.GetEVMTokenIterator(ctx, chain)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			var t EVMToken
+			c.Assert(k.cdc.Unmarshal(iter.Value(), &t), IsNil)
+			if !chain.IsEmpty() {
+				c.Check(t.Chain.Equals(chain), Equals, true)
+			}
+			total++
+		}
+		return total
+	}
+	c.Check(count(common.ETHChain), Equals, 2)
+	c.Check(count(common.AVAXChain), Equals, 1)
+	c.Check(count(common.EmptyChain), Equals, 3)
+
+	k.RemoveEVMToken(ctx, common.ETHChain, usdt.Address)
+	c.Check(count(common.ETHChain), Equals, 1)
+}
@@ -0,0 +1,109 @@
+package keeperv1
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	clienttypes "github.com/cosmos/ibc-go/v2/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v2/modules/core/04-channel/types"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+const ibcTransferPort = "transfer"
+
+func (k KVStore) setIBCTransfer(ctx cosmos.Context, key string, record IBCTransfer) {
+	store := ctx.KVStore(k.storeKey)
+	buf := k.cdc.MustMarshal(&record)
+	if buf == nil {
+		store.Delete([]byte(key))
+	} else {
+		store.Set([]byte(key), buf)
+	}
+}
+
+func (k KVStore) getIBCTransfer(ctx cosmos.Context, key string, record *IBCTransfer) (bool, error) {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has([]byte(key)) {
+		return false, nil
+	}
+
+	bz := store.Get([]byte(key))
+	if err := k.cdc.Unmarshal(bz, record); err != nil {
+		return true, dbError(ctx, fmt.Sprintf("Unmarshal kvstore: (%T) %s", record, key), err)
+	}
+	return true, nil
+}
+
+// SendIBCTransfer send the given coin from a module account to the receiver
+// over the given IBC channel, return the sequence of the packet sent
+func (k KVStore) SendIBCTransfer(ctx cosmos.Context, moduleName, channel string, coin common.Coin, receiver common.Address, timeoutTimestamp uint64) (uint64, error) {
+	token, err := coin.Native()
+	if err != nil {
+		return 0, fmt.Errorf("fail to parse coin: %w", err)
+	}
+	sender := k.GetModuleAccAddress(moduleName)
+
+	// use a fresh event manager, so the sequence of the packet can be read
+	// back from the send_packet event emitted by the channel keeper
+	sendCtx := ctx.WithEventManager(cosmos.NewEventManager())
+	if err := k.ibcTransferkeeper.SendTransfer(sendCtx, ibcTransferPort, channel, token, sender, receiver.String(), clienttypes.ZeroHeight(), timeoutTimestamp); err != nil {
+		return 0, fmt.Errorf("fail to send ibc transfer: %w", err)
+	}
+	events := sendCtx.EventManager().Events()
+	ctx.EventManager().EmitEvents(events)
+
+	for _, evt := range events {
+		if evt.Type != channeltypes.EventTypeSendPacket {
+			continue
+		}
+		for _, attr := range evt.Attributes {
+			if string(attr.Key) != channeltypes.AttributeKeySequence {
+				continue
+			}
+			sequence, err := strconv.ParseUint(string(attr.Value), 10, 64)
+			if err != nil {
+				return 0, fmt.Errorf("fail to parse packet sequence: %w", err)
+			}
+			return sequence, nil
+		}
+	}
+	return 0, errors.New("fail to find sequence of ibc transfer packet")
+}
+
+// GetIBCTransferIterator iterate pending IBC transfers
+func (k KVStore) GetIBCTransferIterator(ctx cosmos.Context) cosmos.Iterator {
+	return k.getIterator(ctx, prefixIBCTransfer)
+}
+
+// SetIBCTransfer save the pending IBC transfer to key value store
+func (k KVStore) SetIBCTransfer(ctx cosmos.Context, transfer IBCTransfer) {
+	k.setIBCTransfer(ctx, k.GetKey(ctx, prefixIBCTransfer, transfer.Key()), transfer)
+}
+
+// GetIBCTransfer get the pending IBC transfer sent over the given channel with the given sequence
+func (k KVStore) GetIBCTransfer(ctx cosmos.Context, channel string, sequence uint64) (IBCTransfer, error) {
+	record := IBCTransfer{
+		Channel:  channel,
+		Sequence: sequence,
+	}
+	ok, err := k.getIBCTransfer(ctx, k.GetKey(ctx, prefixIBCTransfer, record.Key()), &record)
+	if err != nil {
+		return record, err
+	}
+	if !ok {
+		return record, fmt.Errorf("ibc transfer doesn't exist: %s", record.Key())
+	}
+	return record, nil
+}
+
+// RemoveIBCTransfer remove the pending IBC transfer from key value store
+func (k KVStore) RemoveIBCTransfer(ctx cosmos.Context, channel string, sequence uint64) {
+	record := IBCTransfer{
+		Channel:  channel,
+		Sequence: sequence,
+	}
+	k.del(ctx, k.GetKey(ctx, prefixIBCTransfer, record.Key()))
+}
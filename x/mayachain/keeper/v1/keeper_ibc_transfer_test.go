@@ -0,0 +1,42 @@
+package keeperv1
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+type KeeperIBCTransferSuite struct{}
+
+var _ = Suite(&KeeperIBCTransferSuite{})
+
+func (s *KeeperIBCTransferSuite) TestIBCTransfer(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	receiver := common.Address("cosmos1xv9tklw7d82sezh9haa573wufgy59vmwe6xxe5")
+	coin := common.NewCoin(common.BaseAsset(), cosmos.NewUint(100*common.One))
+	transfer := NewIBCTransfer("channel-0", 3, GetRandomTxHash(), AsgardName, GetRandomBaseAddress(), receiver, coin, ctx.BlockHeight())
+	k.SetIBCTransfer(ctx, transfer)
+
+	result, err := k.GetIBCTransfer(ctx, "channel-0", 3)
+	c.Assert(err, IsNil)
+	c.Check(result.InHash.Equals(transfer.InHash), Equals, true)
+	c.Check(result.Sender.Equals(transfer.Sender), Equals, true)
+	c.Check(result.Coin.Equals(coin), Equals, true)
+
+	_, err = k.GetIBCTransfer(ctx, "channel-0", 4)
+	c.Assert(err, NotNil)
+
+	iter := k.GetIBCTransferIterator(ctx)
+	count := 0
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	c.Assert(iter.Close(), IsNil)
+	c.Check(count, Equals, 1)
+
+	k.RemoveIBCTransfer(ctx, "channel-0", 3)
+	_, err = k.GetIBCTransfer(ctx, "channel-0", 3)
+	c.Assert(err, NotNil)
+}
@@ -64,6 +64,13 @@ func (k KVStore) GetLiquidityProviderIterator(ctx cosmos.Context, asset common.A
 	return k.getIterator(ctx, types.DbPrefix(key))
 }
 
+// GetLiquidityProviderIteratorFrom iterate the liquidity providers of the given
+// pool whose address starts with the given prefix, starting at the given key
+func (k KVStore) GetLiquidityProviderIteratorFrom(ctx cosmos.Context, asset common.Asset, addressPrefix string, start []byte) cosmos.Iterator {
+	key := k.GetKey(ctx, prefixLiquidityProvider, (&LiquidityProvider{Asset: asset}).Key()+addressPrefix)
+	return k.getIteratorFrom(ctx, types.DbPrefix(key), start)
+}
+
 func (k KVStore) GetTotalSupply(ctx cosmos.Context, asset common.Asset) cosmos.Uint {
 	if k.GetVersion().GTE(semver.MustParse("1.91.0")) {
 		// when pool ragnarok started , synth unit become zero
@@ -212,6 +219,17 @@ func (k KVStore) SetLiquidityAuctionTier(ctx cosmos.Context, addr common.Address
 	return nil
 }
 
+// RemoveLiquidityAuctionTier remove the liquidity auction tier of the given address from kv store
+func (k KVStore) RemoveLiquidityAuctionTier(ctx cosmos.Context, addr common.Address) {
+	record := LiquidityAuctionTier{Address: addr}
+	k.del(ctx, k.GetKey(ctx, prefixLiquidityAuctionTier, record.Key()))
+}
+
+// GetLiquidityAuctionTierIterator iterate liquidity auction tiers
+func (k KVStore) GetLiquidityAuctionTierIterator(ctx cosmos.Context) cosmos.Iterator {
+	return k.getIterator(ctx, prefixLiquidityAuctionTier)
+}
+
 // GetLiquidityAuctionTier retrieve liquidity auction tier from the data store
 // if liquidity auction tier doesn't exist, return const LATier_Dont_Exist
 func (k KVStore) GetLiquidityAuctionTier(ctx cosmos.Context, addr common.Address) (int64, error) {
@@ -71,6 +71,25 @@ func (s *KeeperLiquidityProviderSuite) TestLiquidityAuctionTier(c *C) {
 	laTierValue, err = k.GetLiquidityAuctionTier(ctx, newLATier.Address)
 	c.Assert(err, IsNil)
 	c.Assert(laTierValue, Equals, int64(1))
+
+	c.Assert(k.SetLiquidityAuctionTier(ctx, GetRandomBaseAddress(), 2), IsNil)
+	iter := k.GetLiquidityAuctionTierIterator(ctx)
+	count := 0
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	iter.Close()
+	c.Assert(count, Equals, 2)
+
+	k.RemoveLiquidityAuctionTier(ctx, newLATier.Address)
+	laTierValue, err = k.GetLiquidityAuctionTier(ctx, newLATier.Address)
+	c.Assert(err, IsNil)
+	c.Assert(laTierValue, Equals, int64(0))
+	iter = k.GetLiquidityAuctionTierIterator(ctx)
+	defer iter.Close()
+	c.Assert(iter.Valid(), Equals, true)
+	iter.Next()
+	c.Assert(iter.Valid(), Equals, false)
 }
 
 func (s *KeeperLiquidityProviderSuite) TestCalcLPLiquidityBond(c *C) {
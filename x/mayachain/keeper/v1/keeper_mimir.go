@@ -2,6 +2,7 @@ package keeperv1
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/blang/semver"
@@ -100,6 +101,47 @@ func (k KVStore) GetNodeMimirIterator(ctx cosmos.Context) cosmos.Iterator {
 	return k.getIterator(ctx, prefixNodeMimir)
 }
 
+// GetMimirIteratorFrom iterate mimirs, starting at the given key
+func (k KVStore) GetMimirIteratorFrom(ctx cosmos.Context, start []byte) cosmos.Iterator {
+	return k.getIteratorFrom(ctx, prefixMimir, start)
+}
+
+// GetNodeMimirIteratorFrom iterate node mimirs, starting at the given key
+func (k KVStore) GetNodeMimirIteratorFrom(ctx cosmos.Context, start []byte) cosmos.Iterator {
+	return k.getIteratorFrom(ctx, prefixNodeMimir, start)
+}
+
+// GetPendingMimirs get the mimirs scheduled to activate at the given height
+func (k KVStore) GetPendingMimirs(ctx cosmos.Context, height int64) (PendingMimirs, error) {
+	record := PendingMimirs{}
+	key := k.GetKey(ctx, prefixPendingMimir, strconv.FormatInt(height, 10))
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has([]byte(key)) {
+		return record, nil
+	}
+	if err := k.cdc.Unmarshal(store.Get([]byte(key)), &record); err != nil {
+		return PendingMimirs{}, dbError(ctx, fmt.Sprintf("Unmarshal kvstore: (%T) %s", record, key), err)
+	}
+	return record, nil
+}
+
+// SetPendingMimirs save the mimirs scheduled to activate at the given height,
+// the record is removed once it holds no mimirs
+func (k KVStore) SetPendingMimirs(ctx cosmos.Context, height int64, mimirs PendingMimirs) {
+	key := k.GetKey(ctx, prefixPendingMimir, strconv.FormatInt(height, 10))
+	store := ctx.KVStore(k.storeKey)
+	if len(mimirs.Mimirs) == 0 {
+		store.Delete([]byte(key))
+		return
+	}
+	store.Set([]byte(key), k.cdc.MustMarshal(&mimirs))
+}
+
+// GetPendingMimirIterator iterate the pending mimirs, by activation height
+func (k KVStore) GetPendingMimirIterator(ctx cosmos.Context) cosmos.Iterator {
+	return k.getIterator(ctx, prefixPendingMimir)
+}
+
 func (k KVStore) DeleteMimir(ctx cosmos.Context, key string) error {
 	k.del(ctx, k.GetKey(ctx, prefixMimir, key))
 	return nil
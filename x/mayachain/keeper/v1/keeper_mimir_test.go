@@ -40,3 +40,33 @@ func (s *KeeperMimirSuite) TestMimir(c *C) {
 	pause := k.GetNodePauseChain(ctx, addr)
 	c.Assert(pause, Equals, int64(18))
 }
+
+func (s *KeeperMimirSuite) TestPendingMimirs(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	mimirs, err := k.GetPendingMimirs(ctx, 100)
+	c.Assert(err, IsNil)
+	c.Check(mimirs.Mimirs, HasLen, 0)
+
+	addr := GetRandomBech32Addr()
+	mimirs.Set(PendingMimir{Key: "HALTTRADING", Value: 1, Signer: addr, ActivationHeight: 100})
+	mimirs.Set(PendingMimir{Key: "HALTTRADING", Value: 2, Signer: addr, ActivationHeight: 100})
+	c.Assert(mimirs.Mimirs, HasLen, 1)
+	k.SetPendingMimirs(ctx, 100, mimirs)
+
+	mimirs, err = k.GetPendingMimirs(ctx, 100)
+	c.Assert(err, IsNil)
+	m, ok := mimirs.Get("HaltTrading", addr)
+	c.Assert(ok, Equals, true)
+	c.Check(m.Value, Equals, int64(2))
+
+	iter := k.GetPendingMimirIterator(ctx)
+	c.Check(iter.Valid(), Equals, true)
+	iter.Close()
+
+	mimirs.Delete("HaltTrading", addr)
+	k.SetPendingMimirs(ctx, 100, mimirs)
+	iter = k.GetPendingMimirIterator(ctx)
+	c.Check(iter.Valid(), Equals, false)
+	iter.Close()
+}
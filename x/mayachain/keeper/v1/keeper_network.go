@@ -3,6 +3,7 @@ package keeperv1
 import (
 	"fmt"
 
+	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
 )
 
@@ -77,3 +78,47 @@ func (k KVStore) SetPOL(ctx cosmos.Context, data ProtocolOwnedLiquidity) error {
 	k.setPOL(ctx, k.GetKey(ctx, prefixPOL, ""), data)
 	return nil
 }
+
+func (k KVStore) setPOLPool(ctx cosmos.Context, key string, record POLPool) {
+	store := ctx.KVStore(k.storeKey)
+	buf := k.cdc.MustMarshal(&record)
+	if buf == nil {
+		store.Delete([]byte(key))
+	} else {
+		store.Set([]byte(key), buf)
+	}
+}
+
+func (k KVStore) getPOLPool(ctx cosmos.Context, key string, record *POLPool) (bool, error) {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has([]byte(key)) {
+		return false, nil
+	}
+
+	bz := store.Get([]byte(key))
+	if err := k.cdc.Unmarshal(bz, record); err != nil {
+		return true, dbError(ctx, fmt.Sprintf("Unmarshal kvstore: (%T) %s", record, key), err)
+	}
+	return true, nil
+}
+
+// GetPOLPoolIterator iterate the POL accounting of all pools
+func (k KVStore) GetPOLPoolIterator(ctx cosmos.Context) cosmos.Iterator {
+	return k.getIterator(ctx, prefixPOLPool)
+}
+
+// GetPOLPool retrieve the POL accounting of the given pool from key value store
+func (k KVStore) GetPOLPool(ctx cosmos.Context, asset common.Asset) (POLPool, error) {
+	record := NewPOLPool(asset)
+	_, err := k.getPOLPool(ctx, k.GetKey(ctx, prefixPOLPool, asset.String()), &record)
+	return record, err
+}
+
+// SetPOLPool save the POL accounting of a pool to key value store
+func (k KVStore) SetPOLPool(ctx cosmos.Context, data POLPool) error {
+	if err := data.Valid(); err != nil {
+		return err
+	}
+	k.setPOLPool(ctx, k.GetKey(ctx, prefixPOLPool, data.Asset.String()), data)
+	return nil
+}
@@ -41,3 +41,28 @@ func (KeeperNetworkSuite) TestPOL(c *C) {
 	c.Check(err2, IsNil)
 	c.Check(pol2.CacaoDeposited.Uint64(), Equals, uint64(100*common.One))
 }
+
+func (KeeperNetworkSuite) TestPOLPool(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	pol, err := k.GetPOLPool(ctx, common.BTCAsset)
+	c.Check(err, IsNil)
+	c.Check(pol.Asset.Equals(common.BTCAsset), Equals, true)
+	c.Check(pol.CacaoDeposited.IsZero(), Equals, true)
+
+	pol.CacaoDeposited = cosmos.NewUint(common.One * 100)
+	c.Assert(k.SetPOLPool(ctx, pol), IsNil)
+	c.Assert(k.SetPOLPool(ctx, NewPOLPool(common.ETHAsset)), IsNil)
+	c.Check(k.SetPOLPool(ctx, NewPOLPool(common.EmptyAsset)), NotNil)
+
+	pol2, err := k.GetPOLPool(ctx, common.BTCAsset)
+	c.Check(err, IsNil)
+	c.Check(pol2.CacaoDeposited.Uint64(), Equals, uint64(100*common.One))
+
+	iter := k.GetPOLPoolIterator(ctx)
+	defer iter.Close()
+	count := 0
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	c.Check(count, Equals, 2)
+}
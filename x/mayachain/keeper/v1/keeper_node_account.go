@@ -261,6 +261,11 @@ func (k KVStore) GetNodeAccountIterator(ctx cosmos.Context) cosmos.Iterator {
 	return k.getIterator(ctx, prefixNodeAccount)
 }
 
+// GetNodeAccountIteratorFrom iterate node accounts, starting at the given key
+func (k KVStore) GetNodeAccountIteratorFrom(ctx cosmos.Context, start []byte) cosmos.Iterator {
+	return k.getIteratorFrom(ctx, prefixNodeAccount, start)
+}
+
 // GetNodeAccountSlashPoints - get the slash points associated with the given
 // node address
 func (k KVStore) GetNodeAccountSlashPoints(ctx cosmos.Context, addr cosmos.AccAddress) (int64, error) {
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/blang/semver"
+
 	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
 	"gitlab.com/mayachain/mayanode/x/mayachain/types"
@@ -142,8 +144,16 @@ func (k KVStore) GetOrderBookIndex(ctx cosmos.Context, msg MsgSwap) (common.TxID
 		return nil, err
 	}
 	result := make(common.TxIDs, len(record))
+	version := k.GetVersion()
 	for i, rec := range record {
-		hash, err := common.NewTxID(rec)
+		var hash common.TxID
+		var err error
+		// sub indexed swaps are only queued from 1.106.0
+		if version.GTE(semver.MustParse("1.106.0")) {
+			hash, err = common.NewTxIDV106(rec)
+		} else {
+			hash, err = common.NewTxID(rec)
+		}
 		if err != nil {
 			_ = dbError(ctx, fmt.Sprintf("failed to parse tx hash: (%s)", rec), err)
 			continue
@@ -3,6 +3,8 @@ package keeperv1
 import (
 	"fmt"
 
+	"github.com/blang/semver"
+
 	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
 	"gitlab.com/mayachain/mayanode/x/mayachain/types"
@@ -73,6 +75,32 @@ func (s *KeeperOrderBookSuite) TestKeeperOrderBook(c *C) {
 	c.Check(ok, Equals, false)
 }
 
+func (s *KeeperOrderBookSuite) TestGetOrderBookIndexSubIndexedTxID(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	k.SetVersion(semver.MustParse("1.106.0"))
+
+	msg := MsgSwap{
+		Tx:          GetRandomTx(),
+		TradeTarget: cosmos.NewUint(10 * common.One),
+		OrderType:   types.OrderType_limit,
+	}
+	txID, err := common.NewSubTxID(msg.Tx.ID.String(), 1)
+	c.Assert(err, IsNil)
+	msg.Tx.ID = txID
+	c.Assert(k.SetOrderBookItem(ctx, msg), IsNil)
+
+	hashes, err := k.GetOrderBookIndex(ctx, msg)
+	c.Assert(err, IsNil)
+	c.Check(hashes, DeepEquals, common.TxIDs{txID})
+
+	// sub indexed tx ids aren't parsed before 1.106.0
+	k.SetVersion(semver.MustParse("1.105.0"))
+	hashes, err = k.GetOrderBookIndex(ctx, msg)
+	c.Assert(err, IsNil)
+	c.Assert(hashes, HasLen, 1)
+	c.Check(hashes[0].IsEmpty(), Equals, true)
+}
+
 func (s *KeeperOrderBookSuite) TestGetOrderBookIndexKey(c *C) {
 	ctx, k := setupKeeperForTest(c)
 	msg := MsgSwap{
@@ -0,0 +1,78 @@
+package keeperv1
+
+import (
+	"fmt"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/x/mayachain/keeper/types"
+)
+
+// AddToOutboundFeeWithheldCacao add the cacao value of an outbound fee withheld
+// to the total of the chain the outbound is sent on
+func (k KVStore) AddToOutboundFeeWithheldCacao(ctx cosmos.Context, chain common.Chain, amt cosmos.Uint) error {
+	total, err := k.GetOutboundFeeWithheldCacao(ctx, chain)
+	if err != nil {
+		return err
+	}
+	k.setUint(ctx, k.GetKey(ctx, prefixOutboundFeeWithheld, chain.String()), total.Add(amt))
+	return nil
+}
+
+// GetOutboundFeeWithheldCacao get the cacao value of the outbound fees withheld on the given chain
+func (k KVStore) GetOutboundFeeWithheldCacao(ctx cosmos.Context, chain common.Chain) (cosmos.Uint, error) {
+	record := cosmos.ZeroUint()
+	_, err := k.getUint(ctx, k.GetKey(ctx, prefixOutboundFeeWithheld, chain.String()), &record)
+	return record, err
+}
+
+// AddToOutboundFeeSpentCacao add the cacao value of the gas spent by outbounds
+// to the total of the given chain
+func (k KVStore) AddToOutboundFeeSpentCacao(ctx cosmos.Context, chain common.Chain, amt cosmos.Uint) error {
+	total, err := k.GetOutboundFeeSpentCacao(ctx, chain)
+	if err != nil {
+		return err
+	}
+	k.setUint(ctx, k.GetKey(ctx, prefixOutboundFeeSpent, chain.String()), total.Add(amt))
+	return nil
+}
+
+// GetOutboundFeeSpentCacao get the cacao value of the gas spent by outbounds on the given chain
+func (k KVStore) GetOutboundFeeSpentCacao(ctx cosmos.Context, chain common.Chain) (cosmos.Uint, error) {
+	record := cosmos.ZeroUint()
+	_, err := k.getUint(ctx, k.GetKey(ctx, prefixOutboundFeeSpent, chain.String()), &record)
+	return record, err
+}
+
+func (k KVStore) getOutboundFeeRecordKey(ctx cosmos.Context, chain common.Chain, height int64) string {
+	// heights are zero padded so the records of a chain iterate in order
+	return k.GetKey(ctx, prefixOutboundFeeRecord, fmt.Sprintf("%s/%020d", chain.String(), height))
+}
+
+// SetOutboundFeeRecord save the outbound fee record to the key value store
+func (k KVStore) SetOutboundFeeRecord(ctx cosmos.Context, record OutboundFeeRecord) {
+	store := ctx.KVStore(k.storeKey)
+	key := k.getOutboundFeeRecordKey(ctx, record.Chain, record.Height)
+	store.Set([]byte(key), k.cdc.MustMarshal(&record))
+}
+
+// RemoveOutboundFeeRecord remove the outbound fee record of the chain taken at the given height
+func (k KVStore) RemoveOutboundFeeRecord(ctx cosmos.Context, chain common.Chain, height int64) {
+	k.del(ctx, k.getOutboundFeeRecordKey(ctx, chain, height))
+}
+
+// GetOutboundFeeRecords get the outbound fee records of the given chain, oldest first
+func (k KVStore) GetOutboundFeeRecords(ctx cosmos.Context, chain common.Chain) ([]OutboundFeeRecord, error) {
+	records := make([]OutboundFeeRecord, 0)
+	key := k.GetKey(ctx, prefixOutboundFeeRecord, chain.String()+"/")
+	iterator := k.getIterator(ctx, types.DbPrefix(key))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record OutboundFeeRecord
+		if err := k.cdc.Unmarshal(iterator.Value(), &record); err != nil {
+			return nil, dbError(ctx, fmt.Sprintf("Unmarshal kvstore: (%T) %s", record, iterator.Key()), err)
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
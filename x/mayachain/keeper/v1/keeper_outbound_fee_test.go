@@ -0,0 +1,53 @@
+package keeperv1
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+type KeeperOutboundFeeSuite struct{}
+
+var _ = Suite(&KeeperOutboundFeeSuite{})
+
+func (s *KeeperOutboundFeeSuite) TestOutboundFeeTotals(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	c.Assert(k.AddToOutboundFeeWithheldCacao(ctx, common.BTCChain, cosmos.NewUint(300)), IsNil)
+	c.Assert(k.AddToOutboundFeeWithheldCacao(ctx, common.BTCChain, cosmos.NewUint(200)), IsNil)
+	c.Assert(k.AddToOutboundFeeSpentCacao(ctx, common.BTCChain, cosmos.NewUint(100)), IsNil)
+
+	withheld, err := k.GetOutboundFeeWithheldCacao(ctx, common.BTCChain)
+	c.Assert(err, IsNil)
+	c.Check(withheld.Uint64(), Equals, uint64(500))
+	spent, err := k.GetOutboundFeeSpentCacao(ctx, common.BTCChain)
+	c.Assert(err, IsNil)
+	c.Check(spent.Uint64(), Equals, uint64(100))
+
+	withheld, err = k.GetOutboundFeeWithheldCacao(ctx, common.ETHChain)
+	c.Assert(err, IsNil)
+	c.Check(withheld.IsZero(), Equals, true)
+}
+
+func (s *KeeperOutboundFeeSuite) TestOutboundFeeRecords(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	for _, height := range []int64{1000, 20, 300} {
+		k.SetOutboundFeeRecord(ctx, NewOutboundFeeRecord(common.BTCChain, height, cosmos.NewUint(uint64(height)), cosmos.ZeroUint()))
+	}
+	k.SetOutboundFeeRecord(ctx, NewOutboundFeeRecord(common.ETHChain, 30, cosmos.ZeroUint(), cosmos.ZeroUint()))
+
+	records, err := k.GetOutboundFeeRecords(ctx, common.BTCChain)
+	c.Assert(err, IsNil)
+	c.Assert(records, HasLen, 3)
+	c.Check(records[0].Height, Equals, int64(20))
+	c.Check(records[1].Height, Equals, int64(300))
+	c.Check(records[2].FeeWithheldCacao.Uint64(), Equals, uint64(1000))
+
+	k.RemoveOutboundFeeRecord(ctx, common.BTCChain, 20)
+	records, err = k.GetOutboundFeeRecords(ctx, common.BTCChain)
+	c.Assert(err, IsNil)
+	c.Assert(records, HasLen, 2)
+	c.Check(records[0].Height, Equals, int64(300))
+}
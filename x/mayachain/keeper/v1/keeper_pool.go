@@ -36,6 +36,11 @@ func (k KVStore) GetPoolIterator(ctx cosmos.Context) cosmos.Iterator {
 	return k.getIterator(ctx, prefixPool)
 }
 
+// GetPoolIteratorFrom iterate pools, starting at the given key
+func (k KVStore) GetPoolIteratorFrom(ctx cosmos.Context, start []byte) cosmos.Iterator {
+	return k.getIteratorFrom(ctx, prefixPool, start)
+}
+
 // GetPools return all pool in key value store regardless state
 func (k KVStore) GetPools(ctx cosmos.Context) (Pools, error) {
 	var pools Pools
@@ -89,3 +94,44 @@ func (k KVStore) GetPoolLUVI(ctx cosmos.Context, asset common.Asset) (cosmos.Uin
 	_, err := k.getUint(ctx, key, &record)
 	return record, err
 }
+
+// AddSaversYield add the synths paid as yield to the savers of the given bucket
+func (k KVStore) AddSaversYield(ctx cosmos.Context, asset common.Asset, amt cosmos.Uint) error {
+	total, err := k.GetSaversYield(ctx, asset)
+	if err != nil {
+		return err
+	}
+	k.setUint(ctx, k.GetKey(ctx, prefixSaversYield, asset.String()), total.Add(amt))
+	return nil
+}
+
+// GetSaversYield get the total synths paid as yield to the savers of the given bucket
+func (k KVStore) GetSaversYield(ctx cosmos.Context, asset common.Asset) (cosmos.Uint, error) {
+	key := k.GetKey(ctx, prefixSaversYield, asset.String())
+	record := cosmos.ZeroUint()
+	_, err := k.getUint(ctx, key, &record)
+	return record, err
+}
+
+// AddSaversCount add the given delta, negative when savers leave, to the number
+// of savers of the given bucket
+func (k KVStore) AddSaversCount(ctx cosmos.Context, asset common.Asset, delta int64) error {
+	count, err := k.GetSaversCount(ctx, asset)
+	if err != nil {
+		return err
+	}
+	count += delta
+	if count < 0 {
+		count = 0
+	}
+	k.setInt64(ctx, k.GetKey(ctx, prefixSaversCount, asset.String()), count)
+	return nil
+}
+
+// GetSaversCount get the number of savers with units in the given bucket
+func (k KVStore) GetSaversCount(ctx cosmos.Context, asset common.Asset) (int64, error) {
+	key := k.GetKey(ctx, prefixSaversCount, asset.String())
+	var record int64
+	_, err := k.getInt64(ctx, key, &record)
+	return record, err
+}
@@ -0,0 +1,74 @@
+package keeperv1
+
+import (
+	"fmt"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+	"gitlab.com/mayachain/mayanode/x/mayachain/keeper/types"
+)
+
+func (k KVStore) getPoolSnapshotKey(ctx cosmos.Context, asset common.Asset, height int64) string {
+	// heights are zero padded so the snapshots of a pool iterate in order
+	return k.GetKey(ctx, prefixPoolSnapshot, fmt.Sprintf("%s/%020d", asset.String(), height))
+}
+
+// SetPoolSnapshot save the pool snapshot to the key value store
+func (k KVStore) SetPoolSnapshot(ctx cosmos.Context, snapshot PoolSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	key := k.getPoolSnapshotKey(ctx, snapshot.Asset, snapshot.Height)
+	store.Set([]byte(key), k.cdc.MustMarshal(&snapshot))
+}
+
+// RemovePoolSnapshot remove the snapshot of the pool taken at the given height
+func (k KVStore) RemovePoolSnapshot(ctx cosmos.Context, asset common.Asset, height int64) {
+	k.del(ctx, k.getPoolSnapshotKey(ctx, asset, height))
+}
+
+// GetPoolSnapshots get the snapshots of the given pool, oldest first
+func (k KVStore) GetPoolSnapshots(ctx cosmos.Context, asset common.Asset) ([]PoolSnapshot, error) {
+	snapshots := make([]PoolSnapshot, 0)
+	key := k.GetKey(ctx, prefixPoolSnapshot, asset.String()+"/")
+	iterator := k.getIterator(ctx, types.DbPrefix(key))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot PoolSnapshot
+		if err := k.cdc.Unmarshal(iterator.Value(), &snapshot); err != nil {
+			return nil, dbError(ctx, fmt.Sprintf("Unmarshal kvstore: (%T) %s", snapshot, iterator.Key()), err)
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, nil
+}
+
+// AddToPoolSnapshotSwaps add the liquidity fee and swap volume, both in cacao,
+// of a swap to the totals of the pool since its last snapshot
+func (k KVStore) AddToPoolSnapshotSwaps(ctx cosmos.Context, asset common.Asset, fee, volume cosmos.Uint) error {
+	fees, volumes, err := k.GetPoolSnapshotSwaps(ctx, asset)
+	if err != nil {
+		return err
+	}
+	k.setUint(ctx, k.GetKey(ctx, prefixPoolSnapshotFee, asset.String()), fees.Add(fee))
+	k.setUint(ctx, k.GetKey(ctx, prefixPoolSnapshotVolume, asset.String()), volumes.Add(volume))
+	return nil
+}
+
+// GetPoolSnapshotSwaps get the liquidity fees and swap volume of the pool
+// since its last snapshot
+func (k KVStore) GetPoolSnapshotSwaps(ctx cosmos.Context, asset common.Asset) (cosmos.Uint, cosmos.Uint, error) {
+	fees := cosmos.ZeroUint()
+	if _, err := k.getUint(ctx, k.GetKey(ctx, prefixPoolSnapshotFee, asset.String()), &fees); err != nil {
+		return cosmos.ZeroUint(), cosmos.ZeroUint(), err
+	}
+	volume := cosmos.ZeroUint()
+	if _, err := k.getUint(ctx, k.GetKey(ctx, prefixPoolSnapshotVolume, asset.String()), &volume); err != nil {
+		return cosmos.ZeroUint(), cosmos.ZeroUint(), err
+	}
+	return fees, volume, nil
+}
+
+// ResetPoolSnapshotSwaps clear the liquidity fees and swap volume of the pool
+func (k KVStore) ResetPoolSnapshotSwaps(ctx cosmos.Context, asset common.Asset) {
+	k.del(ctx, k.GetKey(ctx, prefixPoolSnapshotFee, asset.String()))
+	k.del(ctx, k.GetKey(ctx, prefixPoolSnapshotVolume, asset.String()))
+}
@@ -0,0 +1,72 @@
+package keeperv1
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+type KeeperPoolSnapshotSuite struct{}
+
+var _ = Suite(&KeeperPoolSnapshotSuite{})
+
+func (s *KeeperPoolSnapshotSuite) TestPoolSnapshot(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.BalanceCacao = cosmos.NewUint(100 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(10 * common.One)
+	for _, height := range []int64{1000, 20, 300} {
+		k.SetPoolSnapshot(ctx, NewPoolSnapshot(height, pool, cosmos.NewUint(uint64(height)), cosmos.ZeroUint()))
+	}
+	// snapshots of a pool whose asset shares the prefix are not returned
+	pool.Asset = common.Asset{Chain: common.BTCChain, Symbol: "BTCX", Ticker: "BTCX"}
+	k.SetPoolSnapshot(ctx, NewPoolSnapshot(30, pool, cosmos.ZeroUint(), cosmos.ZeroUint()))
+
+	snapshots, err := k.GetPoolSnapshots(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Assert(snapshots, HasLen, 3)
+	c.Check(snapshots[0].Height, Equals, int64(20))
+	c.Check(snapshots[1].Height, Equals, int64(300))
+	c.Check(snapshots[2].Height, Equals, int64(1000))
+	c.Check(snapshots[2].LiquidityFees.Uint64(), Equals, uint64(1000))
+	c.Check(snapshots[2].BalanceCacao.Equal(cosmos.NewUint(100*common.One)), Equals, true)
+
+	k.RemovePoolSnapshot(ctx, common.BTCAsset, 300)
+	snapshots, err = k.GetPoolSnapshots(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Assert(snapshots, HasLen, 2)
+	c.Check(snapshots[1].Height, Equals, int64(1000))
+
+	snapshots, err = k.GetPoolSnapshots(ctx, common.ETHAsset)
+	c.Assert(err, IsNil)
+	c.Check(snapshots, HasLen, 0)
+}
+
+func (s *KeeperPoolSnapshotSuite) TestPoolSnapshotSwaps(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	fees, volume, err := k.GetPoolSnapshotSwaps(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Check(fees.IsZero(), Equals, true)
+	c.Check(volume.IsZero(), Equals, true)
+
+	c.Assert(k.AddToPoolSnapshotSwaps(ctx, common.BTCAsset, cosmos.NewUint(10), cosmos.NewUint(1000)), IsNil)
+	c.Assert(k.AddToPoolSnapshotSwaps(ctx, common.BTCAsset, cosmos.NewUint(5), cosmos.NewUint(500)), IsNil)
+	c.Assert(k.AddToPoolSnapshotSwaps(ctx, common.ETHAsset, cosmos.NewUint(1), cosmos.NewUint(100)), IsNil)
+	fees, volume, err = k.GetPoolSnapshotSwaps(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Check(fees.Uint64(), Equals, uint64(15))
+	c.Check(volume.Uint64(), Equals, uint64(1500))
+
+	k.ResetPoolSnapshotSwaps(ctx, common.BTCAsset)
+	fees, volume, err = k.GetPoolSnapshotSwaps(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Check(fees.IsZero(), Equals, true)
+	c.Check(volume.IsZero(), Equals, true)
+	fees, _, err = k.GetPoolSnapshotSwaps(ctx, common.ETHAsset)
+	c.Assert(err, IsNil)
+	c.Check(fees.Uint64(), Equals, uint64(1))
+}
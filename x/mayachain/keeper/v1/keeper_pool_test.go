@@ -45,3 +45,46 @@ func (s *KeeperPoolSuite) TestPoolLUVI(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(luvi.Uint64(), Equals, luvi2.Uint64())
 }
+
+func (s *KeeperPoolSuite) TestSaversYield(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	asset := common.BTCAsset.GetSyntheticAsset()
+	total, err := k.GetSaversYield(ctx, asset)
+	c.Assert(err, IsNil)
+	c.Assert(total.IsZero(), Equals, true)
+
+	c.Assert(k.AddSaversYield(ctx, asset, cosmos.NewUint(100)), IsNil)
+	c.Assert(k.AddSaversYield(ctx, asset, cosmos.NewUint(250)), IsNil)
+	total, err = k.GetSaversYield(ctx, asset)
+	c.Assert(err, IsNil)
+	c.Assert(total.Uint64(), Equals, uint64(350))
+
+	total, err = k.GetSaversYield(ctx, common.ETHAsset.GetSyntheticAsset())
+	c.Assert(err, IsNil)
+	c.Assert(total.IsZero(), Equals, true)
+}
+
+func (s *KeeperPoolSuite) TestSaversCount(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	asset := common.BTCAsset.GetSyntheticAsset()
+	count, err := k.GetSaversCount(ctx, asset)
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, int64(0))
+
+	c.Assert(k.AddSaversCount(ctx, asset, 1), IsNil)
+	c.Assert(k.AddSaversCount(ctx, asset, 1), IsNil)
+	c.Assert(k.AddSaversCount(ctx, asset, -1), IsNil)
+	count, err = k.GetSaversCount(ctx, asset)
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, int64(1))
+
+	// the count never goes below zero
+	c.Assert(k.AddSaversCount(ctx, asset, -2), IsNil)
+	count, err = k.GetSaversCount(ctx, asset)
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, int64(0))
+
+	count, err = k.GetSaversCount(ctx, common.ETHAsset.GetSyntheticAsset())
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, int64(0))
+}
@@ -3,6 +3,8 @@ package mayachain
 import (
 	"fmt"
 
+	"github.com/blang/semver"
+
 	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
 	"gitlab.com/mayachain/mayanode/constants"
@@ -125,10 +127,15 @@ func (gm *GasMgrV104) GetFee(ctx cosmos.Context, chain common.Chain, asset commo
 		// THORNode is going to charge 3 times the fee it takes to send out the tx
 		// 1.5 * fee will goes to vault
 		// 1.5 * fee will become the max gas used to send out the tx
-		fee = cosmos.RoundToDecimal(
-			cosmos.NewUint(networkFee.TransactionSize*networkFee.TransactionFeeRate*3),
-			pool.Decimals,
-		)
+		fee = cosmos.NewUint(networkFee.TransactionSize * networkFee.TransactionFeeRate * 3)
+		if gm.keeper.GetVersion().GTE(semver.MustParse("1.106.0")) {
+			// the multiplier comes down from 3 as the fees withheld on the chain
+			// build up a surplus over the gas actually spent
+			fee = cosmos.NewUint(networkFee.TransactionSize * networkFee.TransactionFeeRate).
+				Mul(gm.getOutboundFeeMultiplier(ctx, chain)).
+				QuoUint64(MaxWithdrawBasisPoints)
+		}
+		fee = cosmos.RoundToDecimal(fee, pool.Decimals)
 
 		// Ensure fee is always more than minAsset
 		if fee.LT(minAsset) {
@@ -164,6 +171,53 @@ func (gm *GasMgrV104) GetFee(ctx cosmos.Context, chain common.Chain, asset commo
 	return pool.RuneValueInAsset(fee)
 }
 
+// getOutboundFeeMultiplier returns the multiplier, in basis points, of the
+// network fee charged on the outbounds of the given chain
+func (gm *GasMgrV104) getOutboundFeeMultiplier(ctx cosmos.Context, chain common.Chain) cosmos.Uint {
+	minMultiplier := gm.getConfigInt64(ctx, constants.MinOutboundFeeMultiplier)
+	maxMultiplier := gm.getConfigInt64(ctx, constants.MaxOutboundFeeMultiplier)
+	targetSurplus := gm.getConfigInt64(ctx, constants.TargetOutboundFeeSurplusCacao)
+	withheld, err := gm.keeper.GetOutboundFeeWithheldCacao(ctx, chain)
+	if err != nil {
+		ctx.Logger().Error("fail to get outbound fee withheld", "chain", chain, "error", err)
+		return cosmos.NewUint(uint64(maxMultiplier))
+	}
+	spent, err := gm.keeper.GetOutboundFeeSpentCacao(ctx, chain)
+	if err != nil {
+		ctx.Logger().Error("fail to get outbound fee spent", "chain", chain, "error", err)
+		return cosmos.NewUint(uint64(maxMultiplier))
+	}
+	return calcOutboundFeeMultiplier(minMultiplier, maxMultiplier, targetSurplus, withheld, spent)
+}
+
+// calcOutboundFeeMultiplier returns the outbound fee multiplier in basis points.
+// It is maxMultiplier while the fees withheld on a chain don't cover the gas
+// spent, and comes down linearly to minMultiplier as the surplus reaches the
+// target.
+func calcOutboundFeeMultiplier(minMultiplier, maxMultiplier, targetSurplus int64, withheld, spent cosmos.Uint) cosmos.Uint {
+	if minMultiplier > maxMultiplier {
+		minMultiplier = maxMultiplier
+	}
+	if targetSurplus <= 0 || withheld.LTE(spent) {
+		return cosmos.NewUint(uint64(maxMultiplier))
+	}
+	surplus := withheld.Sub(spent)
+	target := cosmos.NewUint(uint64(targetSurplus))
+	if surplus.GTE(target) {
+		return cosmos.NewUint(uint64(minMultiplier))
+	}
+	reduction := common.GetSafeShare(surplus, target, cosmos.NewUint(uint64(maxMultiplier-minMultiplier)))
+	return cosmos.NewUint(uint64(maxMultiplier)).Sub(reduction)
+}
+
+func (gm *GasMgrV104) getConfigInt64(ctx cosmos.Context, key constants.ConstantName) int64 {
+	val, err := gm.keeper.GetMimir(ctx, key.String())
+	if val < 0 || err != nil {
+		val = gm.constantsAccessor.GetInt64Value(key)
+	}
+	return val
+}
+
 // getRuneInAssetValue convert the transaction fee to asset value , when the given asset is synthetic , it will need to get
 // the layer1 asset first , and then use the pool to convert
 func (gm *GasMgrV104) getRuneInAssetValue(ctx cosmos.Context, transactionFee cosmos.Uint, asset common.Asset) cosmos.Uint {
@@ -248,6 +302,9 @@ func (gm *GasMgrV104) EndBlock(ctx cosmos.Context, keeper keeper.Keeper, eventMa
 	blocksPerDay := gm.constantsAccessor.GetInt64Value(constants.BlocksPerDay)
 	if IsPeriodLastBlock(ctx, uint64(blocksPerDay)) {
 		keeper.DistributeMayaFund(ctx, gm.constantsAccessor)
+		if keeper.GetVersion().GTE(semver.MustParse("1.106.0")) {
+			gm.recordOutboundFees(ctx, keeper)
+		}
 	}
 
 	if len(gm.gasEvent.Pools) == 0 {
@@ -259,6 +316,45 @@ func (gm *GasMgrV104) EndBlock(ctx cosmos.Context, keeper keeper.Keeper, eventMa
 	gm.reset() // do not remove, will cause consensus failures
 }
 
+// recordOutboundFees keep a record of the outbound fees withheld and the gas
+// spent so far on every chain with a network fee, to follow their surplus
+func (gm *GasMgrV104) recordOutboundFees(ctx cosmos.Context, keeper keeper.Keeper) {
+	retention := gm.getConfigInt64(ctx, constants.OutboundFeeHistoryRetention)
+	if retention < 1 {
+		retention = 1
+	}
+	iterator := keeper.GetNetworkFeeIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var networkFee NetworkFee
+		if err := keeper.Cdc().Unmarshal(iterator.Value(), &networkFee); err != nil {
+			ctx.Logger().Error("fail to unmarshal network fee", "error", err)
+			continue
+		}
+		chain := networkFee.Chain
+		withheld, err := keeper.GetOutboundFeeWithheldCacao(ctx, chain)
+		if err != nil {
+			ctx.Logger().Error("fail to get outbound fee withheld", "chain", chain, "error", err)
+			continue
+		}
+		spent, err := keeper.GetOutboundFeeSpentCacao(ctx, chain)
+		if err != nil {
+			ctx.Logger().Error("fail to get outbound fee spent", "chain", chain, "error", err)
+			continue
+		}
+		keeper.SetOutboundFeeRecord(ctx, NewOutboundFeeRecord(chain, ctx.BlockHeight(), withheld, spent))
+
+		records, err := keeper.GetOutboundFeeRecords(ctx, chain)
+		if err != nil {
+			ctx.Logger().Error("fail to get outbound fee records", "chain", chain, "error", err)
+			continue
+		}
+		for i := 0; i < len(records)-int(retention); i++ {
+			keeper.RemoveOutboundFeeRecord(ctx, chain, records[i].Height)
+		}
+	}
+}
+
 // ProcessGas to subsidise the pool with RUNE for the gas they have spent
 func (gm *GasMgrV104) ProcessGas(ctx cosmos.Context, keeper keeper.Keeper) {
 	if keeper.RagnarokInProgress(ctx) {
@@ -289,6 +385,11 @@ func (gm *GasMgrV104) ProcessGas(ctx cosmos.Context, keeper keeper.Keeper) {
 		if runeGas.IsZero() {
 			continue
 		}
+		if keeper.GetVersion().GTE(semver.MustParse("1.106.0")) {
+			if err := keeper.AddToOutboundFeeSpentCacao(ctx, gas.Asset.GetChain(), runeGas); err != nil {
+				ctx.Logger().Error("fail to add outbound fee spent", "chain", gas.Asset.GetChain(), "error", err)
+			}
+		}
 		// If Rune owed now exceeds the Total Reserve, return it all
 		if runeGas.LT(keeper.GetRuneBalanceOfModule(ctx, ReserveName)) {
 			coin := common.NewCoin(common.BaseNative, runeGas)
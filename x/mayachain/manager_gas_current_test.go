@@ -113,3 +113,79 @@ func (GasManagerTestSuiteV104) TestGetFeeV104(c *C) {
 	fee = gasMgr.GetFee(ctx, common.BTCChain, common.BTCAsset)
 	c.Assert(fee.Uint64(), Equals, uint64(150000000))
 }
+
+func (GasManagerTestSuiteV104) TestCalcOutboundFeeMultiplier(c *C) {
+	target := int64(100 * common.One)
+	// no surplus, the maximum multiplier is charged
+	mult := calcOutboundFeeMultiplier(15_000, 30_000, target, cosmos.ZeroUint(), cosmos.ZeroUint())
+	c.Check(mult.Uint64(), Equals, uint64(30_000))
+	mult = calcOutboundFeeMultiplier(15_000, 30_000, target, cosmos.NewUint(common.One), cosmos.NewUint(2*common.One))
+	c.Check(mult.Uint64(), Equals, uint64(30_000))
+
+	// the multiplier comes down linearly with the surplus
+	mult = calcOutboundFeeMultiplier(15_000, 30_000, target, cosmos.NewUint(150*common.One), cosmos.NewUint(100*common.One))
+	c.Check(mult.Uint64(), Equals, uint64(22_500))
+
+	// and stays at the minimum once the surplus reaches the target
+	mult = calcOutboundFeeMultiplier(15_000, 30_000, target, cosmos.NewUint(300*common.One), cosmos.NewUint(100*common.One))
+	c.Check(mult.Uint64(), Equals, uint64(15_000))
+
+	// no target, the maximum multiplier is charged
+	mult = calcOutboundFeeMultiplier(15_000, 30_000, 0, cosmos.NewUint(300*common.One), cosmos.NewUint(100*common.One))
+	c.Check(mult.Uint64(), Equals, uint64(30_000))
+
+	// a minimum above the maximum is capped
+	mult = calcOutboundFeeMultiplier(40_000, 30_000, target, cosmos.NewUint(300*common.One), cosmos.NewUint(100*common.One))
+	c.Check(mult.Uint64(), Equals, uint64(30_000))
+}
+
+func (GasManagerTestSuiteV104) TestOutboundFeeMultiplier(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	k := mgr.Keeper()
+	constAccessor := constants.GetConstantValues(GetCurrentVersion())
+	gasMgr := newGasMgrV104(constAccessor, k)
+	gasMgr.BeginBlock(mgr)
+
+	c.Assert(k.SetPool(ctx, Pool{
+		BalanceCacao: cosmos.NewUint(100 * common.One),
+		BalanceAsset: cosmos.NewUint(100 * common.One),
+		Asset:        common.BTCAsset,
+		Status:       PoolAvailable,
+	}), IsNil)
+	c.Assert(k.SaveNetworkFee(ctx, common.BTCChain, NewNetworkFee(common.BTCChain, 70, 50)), IsNil)
+	fee := gasMgr.GetFee(ctx, common.BTCChain, common.BaseAsset())
+	c.Assert(fee.Uint64(), Equals, uint64(70*50*3))
+
+	// once the fees withheld reach the target surplus, the minimum multiplier is charged
+	target := constAccessor.GetInt64Value(constants.TargetOutboundFeeSurplusCacao)
+	c.Assert(k.AddToOutboundFeeWithheldCacao(ctx, common.BTCChain, cosmos.NewUint(uint64(target))), IsNil)
+	fee = gasMgr.GetFee(ctx, common.BTCChain, common.BaseAsset())
+	c.Assert(fee.Uint64(), Equals, uint64(70*50*3/2))
+
+	// the multiplier is back up as the gas spent reduces the surplus
+	c.Assert(k.AddToOutboundFeeSpentCacao(ctx, common.BTCChain, cosmos.NewUint(uint64(target))), IsNil)
+	fee = gasMgr.GetFee(ctx, common.BTCChain, common.BaseAsset())
+	c.Assert(fee.Uint64(), Equals, uint64(70*50*3))
+
+	// the fees of the chain are recorded every day
+	blocksPerDay := constAccessor.GetInt64Value(constants.BlocksPerDay)
+	ctx = ctx.WithBlockHeight(blocksPerDay)
+	gasMgr.recordOutboundFees(ctx, k)
+	records, err := k.GetOutboundFeeRecords(ctx, common.BTCChain)
+	c.Assert(err, IsNil)
+	c.Assert(records, HasLen, 1)
+	c.Check(records[0].Height, Equals, blocksPerDay)
+	c.Check(records[0].FeeWithheldCacao.Uint64(), Equals, uint64(target))
+	c.Check(records[0].FeeSpentCacao.Uint64(), Equals, uint64(target))
+
+	// and only the most recent ones are kept
+	k.SetMimir(ctx, constants.OutboundFeeHistoryRetention.String(), 2)
+	for i := int64(2); i <= 4; i++ {
+		gasMgr.recordOutboundFees(ctx.WithBlockHeight(blocksPerDay*i), k)
+	}
+	records, err = k.GetOutboundFeeRecords(ctx, common.BTCChain)
+	c.Assert(err, IsNil)
+	c.Assert(records, HasLen, 2)
+	c.Check(records[0].Height, Equals, blocksPerDay*3)
+	c.Check(records[1].Height, Equals, blocksPerDay*4)
+}
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/blang/semver"
+
 	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
 	"gitlab.com/mayachain/mayanode/constants"
@@ -16,23 +18,23 @@ const (
 	EventTypeInactiveVault = "InactiveVault"
 )
 
-// NetworkMgrV102 is going to manage the vaults
-type NetworkMgrV102 struct {
+// NetworkMgrV106 is going to manage the vaults
+type NetworkMgrV106 struct {
 	k          keeper.Keeper
 	txOutStore TxOutStore
 	eventMgr   EventManager
 }
 
-// newNetworkMgrV102 create a new vault manager
-func newNetworkMgrV102(k keeper.Keeper, txOutStore TxOutStore, eventMgr EventManager) *NetworkMgrV102 {
-	return &NetworkMgrV102{
+// newNetworkMgrV106 create a new vault manager
+func newNetworkMgrV106(k keeper.Keeper, txOutStore TxOutStore, eventMgr EventManager) *NetworkMgrV106 {
+	return &NetworkMgrV106{
 		k:          k,
 		txOutStore: txOutStore,
 		eventMgr:   eventMgr,
 	}
 }
 
-func (vm *NetworkMgrV102) processGenesisSetup(ctx cosmos.Context) error {
+func (vm *NetworkMgrV106) processGenesisSetup(ctx cosmos.Context) error {
 	if ctx.BlockHeight() != genesisBlockHeight {
 		return nil
 	}
@@ -68,7 +70,7 @@ func (vm *NetworkMgrV102) processGenesisSetup(ctx cosmos.Context) error {
 	return nil
 }
 
-func (vm *NetworkMgrV102) synthYieldCycle(ctx cosmos.Context, mgr Manager, yieldPts int64) error {
+func (vm *NetworkMgrV106) synthYieldCycle(ctx cosmos.Context, mgr Manager, yieldPts int64) error {
 	iterator := vm.k.GetPoolIterator(ctx)
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
@@ -117,12 +119,21 @@ func (vm *NetworkMgrV102) synthYieldCycle(ctx cosmos.Context, mgr Manager, yield
 		if err := mgr.EventMgr().EmitEvent(ctx, donateEvt); err != nil {
 			return cosmos.Wrapf(errFailSaveEvent, "fail to save donate events: %w", err)
 		}
+		if mgr.GetVersion().GTE(semver.MustParse("1.106.0")) {
+			if err := mgr.Keeper().AddSaversYield(ctx, bucket.Asset, earnings); err != nil {
+				ctx.Logger().Error("fail to add savers yield", "bucket", bucket.Asset, "error", err)
+			}
+			yieldEvt := NewEventSaversYield(bucket, earnings)
+			if err := mgr.EventMgr().EmitEvent(ctx, yieldEvt); err != nil {
+				return cosmos.Wrapf(errFailSaveEvent, "fail to save savers yield events: %w", err)
+			}
+		}
 		ctx.Logger().Info("Synth Earnings", "bucket", bucket.Asset.String(), "amount", earnings.String())
 	}
 	return nil
 }
 
-func (vm *NetworkMgrV102) calcSynthYield(ctx cosmos.Context, mgr Manager, yieldPts int64, bucket Pool) cosmos.Uint {
+func (vm *NetworkMgrV106) calcSynthYield(ctx cosmos.Context, mgr Manager, yieldPts int64, bucket Pool) cosmos.Uint {
 	// skip any layer1 pools
 	if !bucket.Asset.IsSyntheticAsset() {
 		return cosmos.ZeroUint()
@@ -185,7 +196,7 @@ func (vm *NetworkMgrV102) calcSynthYield(ctx cosmos.Context, mgr Manager, yieldP
 }
 
 // EndBlock move funds from retiring asgard vaults
-func (vm *NetworkMgrV102) EndBlock(ctx cosmos.Context, mgr Manager) error {
+func (vm *NetworkMgrV106) EndBlock(ctx cosmos.Context, mgr Manager) error {
 	if ctx.BlockHeight() == genesisBlockHeight {
 		return vm.processGenesisSetup(ctx)
 	}
@@ -391,24 +402,17 @@ func (vm *NetworkMgrV102) EndBlock(ctx cosmos.Context, mgr Manager) error {
 	return nil
 }
 
-func (vm *NetworkMgrV102) POLCycle(ctx cosmos.Context, mgr Manager) error {
-	maxDeposit := fetchConfigInt64(ctx, mgr, constants.POLMaxNetworkDeposit)
-	movement := fetchConfigInt64(ctx, mgr, constants.POLMaxPoolMovement)
-	target := fetchConfigInt64(ctx, mgr, constants.POLSynthUtilization)
-	buf := fetchConfigInt64(ctx, mgr, constants.POLBuffer)
-	targetUtil := cosmos.NewUint(uint64(target))
-	maxMovement := cosmos.NewUint(uint64(movement))
-	buffer := cosmos.NewUint(uint64(buf))
-
-	// if target synth utilization is zero, disable POL
-	if target == 0 {
-		return nil
-	}
-
-	pol, err := mgr.Keeper().GetPOL(ctx)
+func (vm *NetworkMgrV106) POLCycle(ctx cosmos.Context, mgr Manager) error {
+	action, err := getPOLCycleAction(ctx, mgr)
 	if err != nil {
 		return err
 	}
+	if action.Action == "" {
+		if action.Reason != "" && !action.Pool.IsEmpty() {
+			ctx.Logger().Debug("POL not moved", "pool", action.Pool.Asset.String(), "reason", action.Reason)
+		}
+		return nil
+	}
 
 	nodeAccounts, err := mgr.Keeper().ListActiveValidators(ctx)
 	if err != nil {
@@ -428,140 +432,71 @@ func (vm *NetworkMgrV102) POLCycle(ctx cosmos.Context, mgr Manager) error {
 		return err
 	}
 
-	pools := vm.fetchPOLPools(ctx, mgr)
-
-	if len(pools) == 0 {
-		return fmt.Errorf("no POL pools")
+	before, err := mgr.Keeper().GetPOL(ctx)
+	if err != nil {
+		return err
 	}
 
-	pool := pools[int(ctx.BlockHeight()%int64(len(pools)))]
-
-	// The POL key for the ETH.ETH pool would be POL-ETH-ETH .
-	key := "POL-" + pool.Asset.MimirString()
-	val, err := mgr.Keeper().GetMimir(ctx, key)
+	switch action.Action {
+	case POLActionDeposit:
+		err = vm.addPOLLiquidity(ctx, action.Pool, polAddress, asgardAddress, signer, action.CacaoAmount, mgr)
+	case POLActionWithdraw:
+		err = vm.removePOLLiquidity(ctx, action.Pool, polAddress, asgardAddress, signer, action.BasisPoints, mgr)
+	}
 	if err != nil {
-		ctx.Logger().Error("fail to manage POL in pool", "pool", pool.Asset.String(), "error", err)
+		ctx.Logger().Error("fail to manage POL in pool", "pool", action.Pool.Asset.String(), "error", err)
 		return nil
 	}
 
-	// if pool isn't available or mimir has it configured, force withdraw from the pool
-	if val == 2 || pool.Status != PoolAvailable {
-		targetUtil = cosmos.NewUint(10_000)
+	// the add liquidity / withdraw handlers keep the network wide POL
+	// accounting, attribute whatever they recorded to the pool
+	after, err := mgr.Keeper().GetPOL(ctx)
+	if err != nil {
+		return err
 	}
-
-	synthSupply := mgr.Keeper().GetTotalSupply(ctx, pool.Asset.GetSyntheticAsset())
-	pool.CalcUnits(mgr.GetVersion(), synthSupply)
-	utilization := common.GetUncappedShare(pool.SynthUnits, pool.GetPoolUnits(), cosmos.NewUint(10_000))
-
-	// detect if we need to deposit rune
-	if common.SafeSub(utilization, buffer).GT(targetUtil) {
-		if maxDeposit <= pol.CurrentDeposit().Int64() {
-			ctx.Logger().Info("maximum rune deployed from POL")
-			return nil
-		}
-		if err := vm.addPOLLiquidity(ctx, pool, polAddress, asgardAddress, signer, maxMovement, utilization, targetUtil, mgr); err != nil {
-			ctx.Logger().Error("fail to manage POL in pool", "pool", pool.Asset.String(), "error", err)
-		}
-		return nil
+	polPool, err := mgr.Keeper().GetPOLPool(ctx, action.Pool.Asset)
+	if err != nil {
+		return err
 	}
-
-	// detect if we need to withdraw rune
-	if utilization.Add(buffer).LT(targetUtil) {
-		if err := vm.removePOLLiquidity(ctx, pool, polAddress, asgardAddress, signer, maxMovement, utilization, targetUtil, mgr); err != nil {
-			ctx.Logger().Error("fail to manage POL in pool", "pool", pool.Asset.String(), "error", err)
-		}
+	deposited := common.SafeSub(after.CacaoDeposited, before.CacaoDeposited)
+	withdrawn := common.SafeSub(after.CacaoWithdrawn, before.CacaoWithdrawn)
+	polPool.CacaoDeposited = polPool.CacaoDeposited.Add(deposited)
+	polPool.CacaoWithdrawn = polPool.CacaoWithdrawn.Add(withdrawn)
+	if err := mgr.Keeper().SetPOLPool(ctx, polPool); err != nil {
+		return fmt.Errorf("fail to save POL of pool(%s): %w", action.Pool.Asset, err)
 	}
 
-	return nil
-}
-
-// generated a filtered list of pools that the POL is active with
-func (mv *NetworkMgrV102) fetchPOLPools(ctx cosmos.Context, mgr Manager) Pools {
-	var pools Pools
-	iterator := mgr.Keeper().GetPoolIterator(ctx)
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var pool Pool
-		err := mgr.Keeper().Cdc().Unmarshal(iterator.Value(), &pool)
-		if err != nil {
-			ctx.Logger().Error("fail to unmarshal pool", "pool", pool.Asset.String(), "error", err)
-			continue
-		}
-
-		if pool.Asset.IsSyntheticAsset() {
-			continue
-		}
-
-		if pool.BalanceCacao.IsZero() {
-			continue
-		}
-
-		if pool.Status == PoolSuspended {
-			continue
-		}
-
-		if isChainTradingHalted(ctx, mgr, pool.Asset.GetChain()) || isGlobalTradingHalted(ctx, mgr) {
-			continue
-		}
-
-		// The POL key for the ETH.ETH pool would be POL-ETH-ETH .
-		key := "POL-" + pool.Asset.MimirString()
-		val, err := mgr.Keeper().GetMimir(ctx, key)
-		if err != nil {
-			ctx.Logger().Error("fail to manage POL in pool", "pool", pool.Asset.String(), "error", err)
-			continue
-		}
-
-		// -1 is unset default behaviour; 0 is off (paused); 1 is on; 2 (elsewhere) is forced withdraw.
-		switch val {
-		case -1:
-			continue // unset default behaviour:  pause POL movements
-		case 0:
-			continue // off behaviour:  pause POL movements
-		case 1:
-			// on behaviour:  POL is enabled
-		}
-
-		pools = append(pools, pool)
+	cacaoAmt := deposited
+	if action.Action == POLActionWithdraw {
+		cacaoAmt = withdrawn
 	}
-
-	return pools
+	evt := NewEventPOL(action.Pool.Asset, action.Action, cacaoAmt, action.BasisPoints, action.SynthUtilization)
+	if err := mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+		ctx.Logger().Error("fail to emit POL event", "error", err)
+	}
+	return nil
 }
 
-func (vm *NetworkMgrV102) addPOLLiquidity(
+func (vm *NetworkMgrV106) addPOLLiquidity(
 	ctx cosmos.Context,
 	pool Pool,
 	polAddress, asgardAddress common.Address,
 	signer cosmos.AccAddress,
-	maxMovement, utilization, targetUtil cosmos.Uint,
+	cacaoAmt cosmos.Uint,
 	mgr Manager,
 ) error {
 	handler := NewInternalHandler(mgr)
 
-	move := utilization.Sub(targetUtil)
-	if move.GT(maxMovement) {
-		move = maxMovement
-	}
-	runeAmt := common.GetSafeShare(move, cosmos.NewUint(10_000), pool.BalanceCacao)
-	if runeAmt.IsZero() {
-		return nil
-	}
-	coins := common.NewCoins(common.NewCoin(common.BaseAsset(), runeAmt))
-
-	// check balance
-	bal := mgr.Keeper().GetRuneBalanceOfModule(ctx, ReserveName)
-	if runeAmt.GT(bal) {
-		return nil
-	}
+	coins := common.NewCoins(common.NewCoin(common.BaseAsset(), cacaoAmt))
 	if err := mgr.Keeper().SendFromModuleToModule(ctx, ReserveName, AsgardName, coins); err != nil {
 		return err
 	}
 
 	tx := common.NewTx(common.BlankTxID, polAddress, asgardAddress, coins, nil, "MAYA-ADD-POL")
-	msg := NewMsgAddLiquidity(tx, pool.Asset, runeAmt, cosmos.ZeroUint(), polAddress, common.NoAddress, common.NoAddress, cosmos.ZeroUint(), signer, 1)
+	msg := NewMsgAddLiquidity(tx, pool.Asset, cacaoAmt, cosmos.ZeroUint(), polAddress, common.NoAddress, common.NoAddress, cosmos.ZeroUint(), signer, 1)
 	_, err := handler(ctx, msg)
 	if err != nil {
-		// revert the rune back to the reserve
+		// revert the cacao back to the reserve
 		if err := mgr.Keeper().SendFromModuleToModule(ctx, AsgardName, ReserveName, coins); err != nil {
 			return err
 		}
@@ -569,36 +504,16 @@ func (vm *NetworkMgrV102) addPOLLiquidity(
 	return err
 }
 
-func (vm *NetworkMgrV102) removePOLLiquidity(
+func (vm *NetworkMgrV106) removePOLLiquidity(
 	ctx cosmos.Context,
 	pool Pool,
 	polAddress, asgardAddress common.Address,
 	signer cosmos.AccAddress,
-	maxMovement, utilization, targetUtil cosmos.Uint,
+	basisPts cosmos.Uint,
 	mgr Manager,
 ) error {
 	handler := NewInternalHandler(mgr)
 
-	lp, err := mgr.Keeper().GetLiquidityProvider(ctx, pool.Asset, polAddress)
-	if err != nil {
-		return err
-	}
-	if lp.Units.IsZero() {
-		// no LP position to withdraw
-		return nil
-	}
-
-	move := targetUtil.Sub(utilization)
-	if move.GT(maxMovement) {
-		move = maxMovement
-	}
-	runeAmt := common.GetSafeShare(move, cosmos.NewUint(10_000), pool.BalanceCacao)
-	if runeAmt.IsZero() {
-		return nil
-	}
-	lpRune := common.GetSafeShare(lp.Units, pool.GetPoolUnits(), pool.BalanceCacao).MulUint64(2)
-	basisPts := common.GetSafeShare(runeAmt, lpRune, cosmos.NewUint(10_000))
-
 	coins := common.NewCoins(common.NewCoin(common.BaseAsset(), cosmos.OneUint()))
 	tx := common.NewTx(common.BlankTxID, polAddress, asgardAddress, coins, nil, "MAYA-POL-REMOVE")
 	msg := NewMsgWithdrawLiquidity(
@@ -610,12 +525,12 @@ func (vm *NetworkMgrV102) removePOLLiquidity(
 		signer,
 	)
 
-	_, err = handler(ctx, msg)
+	_, err := handler(ctx, msg)
 	return err
 }
 
 // TriggerKeygen generate a record to instruct signer kick off keygen process
-func (vm *NetworkMgrV102) TriggerKeygen(ctx cosmos.Context, nas NodeAccounts) error {
+func (vm *NetworkMgrV106) TriggerKeygen(ctx cosmos.Context, nas NodeAccounts) error {
 	halt, err := vm.k.GetMimir(ctx, "HaltChurning")
 	if halt > 0 && halt <= ctx.BlockHeight() && err == nil {
 		ctx.Logger().Info("churn event skipped due to mimir has halted churning")
@@ -652,6 +567,10 @@ func (vm *NetworkMgrV102) TriggerKeygen(ctx cosmos.Context, nas NodeAccounts) er
 	}
 
 	vm.k.SetKeygenBlock(ctx, keygenBlock)
+	// let the signers know there is a keygen to join
+	if err := vm.eventMgr.EmitEvent(ctx, NewEventKeygen(keygenBlock.Height, keygen)); err != nil {
+		ctx.Logger().Error("fail to emit keygen event", "error", err)
+	}
 	// clear the init vault
 	initVaults, err := vm.k.GetAsgardVaultsByStatus(ctx, InitVault)
 	if err != nil {
@@ -671,7 +590,7 @@ func (vm *NetworkMgrV102) TriggerKeygen(ctx cosmos.Context, nas NodeAccounts) er
 }
 
 // RotateVault update vault to Retiring and new vault to active
-func (vm *NetworkMgrV102) RotateVault(ctx cosmos.Context, vault Vault) error {
+func (vm *NetworkMgrV106) RotateVault(ctx cosmos.Context, vault Vault) error {
 	active, err := vm.k.GetAsgardVaultsByStatus(ctx, ActiveVault)
 	if err != nil {
 		return err
@@ -720,7 +639,7 @@ func (vm *NetworkMgrV102) RotateVault(ctx cosmos.Context, vault Vault) error {
 	return nil
 }
 
-func (vm *NetworkMgrV102) cleanupAsgardIndex(ctx cosmos.Context) error {
+func (vm *NetworkMgrV106) cleanupAsgardIndex(ctx cosmos.Context) error {
 	asgards, err := vm.k.GetAsgardVaults(ctx)
 	if err != nil {
 		return fmt.Errorf("fail to get all asgards,err: %w", err)
@@ -743,7 +662,7 @@ func (vm *NetworkMgrV102) cleanupAsgardIndex(ctx cosmos.Context) error {
 
 // manageChains - checks to see if we have any chains that we are ragnaroking,
 // and ragnaroks them
-func (vm *NetworkMgrV102) manageChains(ctx cosmos.Context, mgr Manager) error {
+func (vm *NetworkMgrV106) manageChains(ctx cosmos.Context, mgr Manager) error {
 	chains, err := vm.findChainsToRetire(ctx)
 	if err != nil {
 		return err
@@ -789,7 +708,7 @@ func (vm *NetworkMgrV102) manageChains(ctx cosmos.Context, mgr Manager) error {
 // findChainsToRetire - evaluates the chains associated with active asgard
 // vaults vs retiring asgard vaults to detemine if any chains need to be
 // ragnarok'ed
-func (vm *NetworkMgrV102) findChainsToRetire(ctx cosmos.Context) (common.Chains, error) {
+func (vm *NetworkMgrV106) findChainsToRetire(ctx cosmos.Context) (common.Chains, error) {
 	chains := make(common.Chains, 0)
 
 	active, err := vm.k.GetAsgardVaultsByStatus(ctx, ActiveVault)
@@ -827,7 +746,7 @@ func (vm *NetworkMgrV102) findChainsToRetire(ctx cosmos.Context) (common.Chains,
 
 // RecallChainFunds - sends a message to bifrost nodes to send back all funds
 // associated with given chain
-func (vm *NetworkMgrV102) RecallChainFunds(ctx cosmos.Context, chain common.Chain, mgr Manager, excludeNodes common.PubKeys) error {
+func (vm *NetworkMgrV106) RecallChainFunds(ctx cosmos.Context, chain common.Chain, mgr Manager, excludeNodes common.PubKeys) error {
 	allNodes, err := vm.k.ListValidatorsWithBond(ctx)
 	if err != nil {
 		return fmt.Errorf("fail to list all node accounts: %w", err)
@@ -894,7 +813,7 @@ func (vm *NetworkMgrV102) RecallChainFunds(ctx cosmos.Context, chain common.Chai
 
 // ragnarokChain - ends a chain by withdrawing all liquidity providers of any pool that's
 // asset is on the given chain
-func (vm *NetworkMgrV102) ragnarokChain(ctx cosmos.Context, chain common.Chain, nth int64, mgr Manager) error {
+func (vm *NetworkMgrV106) ragnarokChain(ctx cosmos.Context, chain common.Chain, nth int64, mgr Manager) error {
 	nas, err := vm.k.ListActiveValidators(ctx)
 	if err != nil {
 		ctx.Logger().Error("can't get active nodes", "error", err)
@@ -928,7 +847,7 @@ func (vm *NetworkMgrV102) ragnarokChain(ctx cosmos.Context, chain common.Chain,
 
 // withdrawLiquidity will process a batch of LP per iteration, the batch size is defined by constants.RagnarokProcessNumOfLPPerIteration
 // once the all LP get processed, none-gas pool will be removed , gas pool will be set to Suspended
-func (vm *NetworkMgrV102) withdrawLiquidity(ctx cosmos.Context, pool Pool, na NodeAccount, mgr Manager) error {
+func (vm *NetworkMgrV106) withdrawLiquidity(ctx cosmos.Context, pool Pool, na NodeAccount, mgr Manager) error {
 	if pool.Status == PoolSuspended {
 		ctx.Logger().Info("cannot further withdraw liquidity from a suspended pool", "pool", pool.Asset)
 		return nil
@@ -1006,7 +925,7 @@ func (vm *NetworkMgrV102) withdrawLiquidity(ctx cosmos.Context, pool Pool, na No
 }
 
 // UpdateNetwork Update the network data to reflect changing in this block
-func (vm *NetworkMgrV102) UpdateNetwork(ctx cosmos.Context, constAccessor constants.ConstantValues, gasManager GasManager, eventMgr EventManager) error {
+func (vm *NetworkMgrV106) UpdateNetwork(ctx cosmos.Context, constAccessor constants.ConstantValues, gasManager GasManager, eventMgr EventManager) error {
 	network, err := vm.k.GetNetwork(ctx)
 	if err != nil {
 		return fmt.Errorf("fail to get existing network data: %w", err)
@@ -1091,7 +1010,7 @@ func (vm *NetworkMgrV102) UpdateNetwork(ctx cosmos.Context, constAccessor consta
 	return vm.k.SetNetwork(ctx, network)
 }
 
-func (vm *NetworkMgrV102) getTotalProvidedLiquidityRune(ctx cosmos.Context) (Pools, cosmos.Uint, error) {
+func (vm *NetworkMgrV106) getTotalProvidedLiquidityRune(ctx cosmos.Context) (Pools, cosmos.Uint, error) {
 	// First get active pools and total provided liquidity Rune
 	totalProvidedLiquidity := cosmos.ZeroUint()
 	var pools Pools
@@ -1113,7 +1032,7 @@ func (vm *NetworkMgrV102) getTotalProvidedLiquidityRune(ctx cosmos.Context) (Poo
 	return pools, totalProvidedLiquidity, nil
 }
 
-func (vm *NetworkMgrV102) getTotalActiveBond(ctx cosmos.Context) (cosmos.Uint, error) {
+func (vm *NetworkMgrV106) getTotalActiveBond(ctx cosmos.Context) (cosmos.Uint, error) {
 	totalBonded := cosmos.ZeroUint()
 	nodes, err := vm.k.ListActiveValidators(ctx)
 	if err != nil {
@@ -1130,7 +1049,7 @@ func (vm *NetworkMgrV102) getTotalActiveBond(ctx cosmos.Context) (cosmos.Uint, e
 }
 
 // Pays out Rewards
-func (vm *NetworkMgrV102) payPoolRewards(ctx cosmos.Context, poolRewards []cosmos.Uint, pools Pools) error {
+func (vm *NetworkMgrV106) payPoolRewards(ctx cosmos.Context, poolRewards []cosmos.Uint, pools Pools) error {
 	for i, reward := range poolRewards {
 		if reward.IsZero() {
 			continue
@@ -1150,12 +1069,12 @@ func (vm *NetworkMgrV102) payPoolRewards(ctx cosmos.Context, poolRewards []cosmo
 }
 
 // Calculate pool deficit based on the pool's accrued fees compared with total fees.
-func (vm *NetworkMgrV102) calcPoolDeficit(lpDeficit, totalFees, poolFees cosmos.Uint) cosmos.Uint {
+func (vm *NetworkMgrV106) calcPoolDeficit(lpDeficit, totalFees, poolFees cosmos.Uint) cosmos.Uint {
 	return common.GetSafeShare(poolFees, totalFees, lpDeficit)
 }
 
 // Calculate the block rewards that bonders and liquidity providers should receive
-func (vm *NetworkMgrV102) calcBlockRewards(ctx cosmos.Context, totalProvidedLiquidity, totalBonded, totalLiquidityFees cosmos.Uint) (cosmos.Uint, cosmos.Uint) {
+func (vm *NetworkMgrV106) calcBlockRewards(ctx cosmos.Context, totalProvidedLiquidity, totalBonded, totalLiquidityFees cosmos.Uint) (cosmos.Uint, cosmos.Uint) {
 	// Check if we have a Mimir value for the incentive curve control
 	incentiveCurveControl, err := vm.k.GetMimir(ctx, constants.IncentiveCurveControl.String())
 	if err == nil && incentiveCurveControl >= 0 && incentiveCurveControl <= 10_000 {
@@ -1215,7 +1134,7 @@ func getTotalActiveNodeWithBond(ctx cosmos.Context, k keeper.Keeper) (int64, err
 // pool is in proportion to the amount of fees it accrued:
 //
 // deduction = (poolFees / totalLiquidityFees) * lpDeficit
-func (vm *NetworkMgrV102) deductPoolRewardDeficit(ctx cosmos.Context, pools Pools, totalLiquidityFees, lpDeficit cosmos.Uint) ([]PoolAmt, error) {
+func (vm *NetworkMgrV106) deductPoolRewardDeficit(ctx cosmos.Context, pools Pools, totalLiquidityFees, lpDeficit cosmos.Uint) ([]PoolAmt, error) {
 	poolAmts := make([]PoolAmt, 0)
 	for _, pool := range pools {
 		if !pool.IsAvailable() {
@@ -1257,7 +1176,7 @@ func (vm *NetworkMgrV102) deductPoolRewardDeficit(ctx cosmos.Context, pools Pool
 
 // checkPoolRagnarok iterate through all the pools to see whether there are pools need to be ragnarok
 // this function will only run in an interval , defined by constants.FundMigrationInterval
-func (vm *NetworkMgrV102) checkPoolRagnarok(ctx cosmos.Context, mgr Manager) error {
+func (vm *NetworkMgrV106) checkPoolRagnarok(ctx cosmos.Context, mgr Manager) error {
 	// check whether pool need to be ragnarok per constants.FundMigrationInterval
 	if ctx.BlockHeight()%mgr.GetConstants().GetInt64Value(constants.FundMigrationInterval) > 0 {
 		return nil
@@ -1291,7 +1210,7 @@ func (vm *NetworkMgrV102) checkPoolRagnarok(ctx cosmos.Context, mgr Manager) err
 
 // canRagnarokGasPool check whether a gas pool can be ragnarok
 // On blockchain that support multiple assets, make sure gas pool doesn't get ragnarok before none-gas asset pool
-func (vm *NetworkMgrV102) canRagnarokGasPool(ctx cosmos.Context, c common.Chain, allPools Pools) bool {
+func (vm *NetworkMgrV106) canRagnarokGasPool(ctx cosmos.Context, c common.Chain, allPools Pools) bool {
 	for _, pool := range allPools {
 		if pool.Status == PoolSuspended {
 			continue
@@ -1306,7 +1225,7 @@ func (vm *NetworkMgrV102) canRagnarokGasPool(ctx cosmos.Context, c common.Chain,
 	return true
 }
 
-func (vm *NetworkMgrV102) redeemSynthAssetToReserve(ctx cosmos.Context, p Pool) error {
+func (vm *NetworkMgrV106) redeemSynthAssetToReserve(ctx cosmos.Context, p Pool) error {
 	totalSupply := vm.k.GetTotalSupply(ctx, p.Asset.GetSyntheticAsset())
 	if totalSupply.IsZero() {
 		return nil
@@ -1329,7 +1248,7 @@ func (vm *NetworkMgrV102) redeemSynthAssetToReserve(ctx cosmos.Context, p Pool)
 	return nil
 }
 
-func (vm *NetworkMgrV102) ragnarokPool(ctx cosmos.Context, mgr Manager, p Pool) error {
+func (vm *NetworkMgrV106) ragnarokPool(ctx cosmos.Context, mgr Manager, p Pool) error {
 	if p.Status == PoolSuspended {
 		ctx.Logger().Info("cannot further ragnarok a suspended pool", "pool", p.Asset)
 		return nil
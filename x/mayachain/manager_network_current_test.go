@@ -10,13 +10,14 @@ import (
 	"gitlab.com/mayachain/mayanode/common/cosmos"
 	"gitlab.com/mayachain/mayanode/constants"
 	"gitlab.com/mayachain/mayanode/x/mayachain/keeper"
+	"gitlab.com/mayachain/mayanode/x/mayachain/types"
 )
 
-type NetworkManagerV102TestSuite struct{}
+type NetworkManagerV106TestSuite struct{}
 
-var _ = Suite(&NetworkManagerV102TestSuite{})
+var _ = Suite(&NetworkManagerV106TestSuite{})
 
-func (s *NetworkManagerV102TestSuite) SetUpSuite(c *C) {
+func (s *NetworkManagerV106TestSuite) SetUpSuite(c *C) {
 	SetupConfigForTest()
 }
 
@@ -168,7 +169,7 @@ func (k *TestRagnarokChainKeeper) IsActiveObserver(_ cosmos.Context, _ cosmos.Ac
 	return true
 }
 
-func (s *NetworkManagerV102TestSuite) TestRagnarokChain(c *C) {
+func (s *NetworkManagerV106TestSuite) TestRagnarokChain(c *C) {
 	ctx, _ := setupKeeperForTest(c)
 	ctx = ctx.WithBlockHeight(100000)
 
@@ -237,7 +238,7 @@ func (s *NetworkManagerV102TestSuite) TestRagnarokChain(c *C) {
 
 	mgr := NewDummyMgrWithKeeper(keeper)
 
-	networkMgr := newNetworkMgrV102(keeper, mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newNetworkMgrV106(keeper, mgr.TxOutStore(), mgr.EventMgr())
 
 	// the first round should just recall yggdrasil fund
 	err := networkMgr.manageChains(ctx, mgr)
@@ -273,7 +274,7 @@ func (s *NetworkManagerV102TestSuite) TestRagnarokChain(c *C) {
 	ctx, mgr1 := setupManagerForTest(c)
 	helper := NewVaultGenesisSetupTestHelper(mgr1.Keeper())
 	mgr.K = helper
-	networkMgr1 := newNetworkMgrV102(helper, mgr1.TxOutStore(), mgr1.EventMgr())
+	networkMgr1 := newNetworkMgrV106(helper, mgr1.TxOutStore(), mgr1.EventMgr())
 	// fail to get active nodes should error out
 	helper.failToListActiveAccounts = true
 	c.Assert(networkMgr1.ragnarokChain(ctx, common.BNBChain, 1, mgr), NotNil)
@@ -290,13 +291,13 @@ func (s *NetworkManagerV102TestSuite) TestRagnarokChain(c *C) {
 	helper.failGetPools = false
 }
 
-func (s *NetworkManagerV102TestSuite) TestUpdateNetwork(c *C) {
+func (s *NetworkManagerV106TestSuite) TestUpdateNetwork(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 	ver := GetCurrentVersion()
 	constAccessor := constants.GetConstantValues(ver)
 	helper := NewVaultGenesisSetupTestHelper(mgr.Keeper())
 	mgr.K = helper
-	networkMgr := newNetworkMgrV102(helper, mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newNetworkMgrV106(helper, mgr.TxOutStore(), mgr.EventMgr())
 
 	// fail to get Network should return error
 	helper.failGetNetwork = true
@@ -358,10 +359,10 @@ func (s *NetworkManagerV102TestSuite) TestUpdateNetwork(c *C) {
 	c.Assert(networkMgr.UpdateNetwork(ctx, constAccessor, mgr.GasMgr(), mgr.EventMgr()), NotNil)
 }
 
-func (s *NetworkManagerV102TestSuite) TestCalcBlockRewards(c *C) {
+func (s *NetworkManagerV106TestSuite) TestCalcBlockRewards(c *C) {
 	ctx, k := setupKeeperForTest(c)
 	mgr := NewDummyMgrWithKeeper(k)
-	networkMgr := newNetworkMgrV102(k, mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newNetworkMgrV106(k, mgr.TxOutStore(), mgr.EventMgr())
 
 	bondR, bondShare := networkMgr.calcBlockRewards(ctx, cosmos.NewUint(1000*common.One), cosmos.NewUint(751*common.One), cosmos.NewUint(100*common.One))
 	c.Check(bondR.Uint64(), Equals, uint64(99_60000000), Commentf("%d", bondR.Uint64()))
@@ -400,13 +401,13 @@ func (s *NetworkManagerV102TestSuite) TestCalcBlockRewards(c *C) {
 	c.Check(bondShare.Uint64(), Equals, uint64(4000), Commentf("%d", bondShare.Uint64()))
 }
 
-func (s *NetworkManagerV102TestSuite) TestCalcPoolDeficit(c *C) {
+func (s *NetworkManagerV106TestSuite) TestCalcPoolDeficit(c *C) {
 	pool1Fees := cosmos.NewUint(1000)
 	pool2Fees := cosmos.NewUint(3000)
 	totalFees := cosmos.NewUint(4000)
 
 	mgr := NewDummyMgr()
-	networkMgr := newNetworkMgrV102(keeper.KVStoreDummy{}, mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newNetworkMgrV106(keeper.KVStoreDummy{}, mgr.TxOutStore(), mgr.EventMgr())
 
 	lpDeficit := cosmos.NewUint(1120)
 	amt1 := networkMgr.calcPoolDeficit(lpDeficit, totalFees, pool1Fees)
@@ -494,12 +495,12 @@ func (h *VaultManagerTestHelpKeeper) GetPools(ctx cosmos.Context) (Pools, error)
 	return h.Keeper.GetPools(ctx)
 }
 
-func (*NetworkManagerV102TestSuite) TestProcessGenesisSetup(c *C) {
+func (*NetworkManagerV106TestSuite) TestProcessGenesisSetup(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 	helper := NewVaultGenesisSetupTestHelper(mgr.Keeper())
 	ctx = ctx.WithBlockHeight(1)
 	mgr.K = helper
-	networkMgr := newNetworkMgrV102(helper, mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newNetworkMgrV106(helper, mgr.TxOutStore(), mgr.EventMgr())
 	// no active account
 	c.Assert(networkMgr.EndBlock(ctx, mgr), NotNil)
 
@@ -523,7 +524,7 @@ func (*NetworkManagerV102TestSuite) TestProcessGenesisSetup(c *C) {
 	helper = NewVaultGenesisSetupTestHelper(mgr.Keeper())
 	ctx = ctx.WithBlockHeight(1)
 	mgr.K = helper
-	networkMgr = newNetworkMgrV102(helper, mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr = newNetworkMgrV106(helper, mgr.TxOutStore(), mgr.EventMgr())
 	helper.failToListActiveAccounts = true
 	c.Assert(networkMgr.EndBlock(ctx, mgr), NotNil)
 	helper.failToListActiveAccounts = false
@@ -542,11 +543,11 @@ func (*NetworkManagerV102TestSuite) TestProcessGenesisSetup(c *C) {
 	helper.failGetActiveAsgardVault = false
 }
 
-func (*NetworkManagerV102TestSuite) TestGetTotalActiveBond(c *C) {
+func (*NetworkManagerV106TestSuite) TestGetTotalActiveBond(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 	helper := NewVaultGenesisSetupTestHelper(mgr.Keeper())
 	mgr.K = helper
-	networkMgr := newNetworkMgrV102(helper, mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newNetworkMgrV106(helper, mgr.TxOutStore(), mgr.EventMgr())
 	helper.failToListActiveAccounts = true
 	bond, err := networkMgr.getTotalActiveBond(ctx)
 	c.Assert(err, NotNil)
@@ -566,11 +567,11 @@ func (*NetworkManagerV102TestSuite) TestGetTotalActiveBond(c *C) {
 	c.Assert(bond.Uint64() > 0, Equals, true)
 }
 
-func (*NetworkManagerV102TestSuite) TestGetTotalLiquidityRune(c *C) {
+func (*NetworkManagerV106TestSuite) TestGetTotalLiquidityRune(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 	helper := NewVaultGenesisSetupTestHelper(mgr.Keeper())
 	mgr.K = helper
-	networkMgr := newNetworkMgrV102(helper, mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newNetworkMgrV106(helper, mgr.TxOutStore(), mgr.EventMgr())
 	p := NewPool()
 	p.Asset = common.BNBAsset
 	p.BalanceCacao = cosmos.NewUint(common.One * 100)
@@ -583,11 +584,11 @@ func (*NetworkManagerV102TestSuite) TestGetTotalLiquidityRune(c *C) {
 	c.Assert(totalLiquidity.Equal(p.BalanceCacao), Equals, true)
 }
 
-func (*NetworkManagerV102TestSuite) TestPayPoolRewards(c *C) {
+func (*NetworkManagerV106TestSuite) TestPayPoolRewards(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 	helper := NewVaultGenesisSetupTestHelper(mgr.Keeper())
 	mgr.K = helper
-	networkMgr := newNetworkMgrV102(helper, mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newNetworkMgrV106(helper, mgr.TxOutStore(), mgr.EventMgr())
 	p := NewPool()
 	p.Asset = common.BNBAsset
 	p.BalanceCacao = cosmos.NewUint(common.One * 100)
@@ -599,11 +600,11 @@ func (*NetworkManagerV102TestSuite) TestPayPoolRewards(c *C) {
 	c.Assert(networkMgr.payPoolRewards(ctx, []cosmos.Uint{cosmos.NewUint(100 * common.One)}, Pools{p}), NotNil)
 }
 
-func (*NetworkManagerV102TestSuite) TestFindChainsToRetire(c *C) {
+func (*NetworkManagerV106TestSuite) TestFindChainsToRetire(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 	helper := NewVaultGenesisSetupTestHelper(mgr.Keeper())
 	mgr.K = helper
-	networkMgr := newNetworkMgrV102(helper, mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newNetworkMgrV106(helper, mgr.TxOutStore(), mgr.EventMgr())
 	// fail to get active asgard vault
 	helper.failGetActiveAsgardVault = true
 	chains, err := networkMgr.findChainsToRetire(ctx)
@@ -619,11 +620,11 @@ func (*NetworkManagerV102TestSuite) TestFindChainsToRetire(c *C) {
 	helper.failGetRetiringAsgardVault = false
 }
 
-func (*NetworkManagerV102TestSuite) TestRecallChainFunds(c *C) {
+func (*NetworkManagerV106TestSuite) TestRecallChainFunds(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 	helper := NewVaultGenesisSetupTestHelper(mgr.Keeper())
 	mgr.K = helper
-	networkMgr := newNetworkMgrV102(helper, mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newNetworkMgrV106(helper, mgr.TxOutStore(), mgr.EventMgr())
 	helper.failToListActiveAccounts = true
 	c.Assert(networkMgr.RecallChainFunds(ctx, common.BNBChain, mgr, common.PubKeys{}), NotNil)
 	helper.failToListActiveAccounts = false
@@ -633,11 +634,11 @@ func (*NetworkManagerV102TestSuite) TestRecallChainFunds(c *C) {
 	helper.failGetActiveAsgardVault = false
 }
 
-func (s *NetworkManagerV102TestSuite) TestRecoverPoolDeficit(c *C) {
+func (s *NetworkManagerV106TestSuite) TestRecoverPoolDeficit(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 	helper := NewVaultGenesisSetupTestHelper(mgr.Keeper())
 	mgr.K = helper
-	networkMgr := newNetworkMgrV102(helper, mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newNetworkMgrV106(helper, mgr.TxOutStore(), mgr.EventMgr())
 
 	pools := Pools{
 		Pool{
@@ -679,10 +680,10 @@ func (s *NetworkManagerV102TestSuite) TestRecoverPoolDeficit(c *C) {
 	c.Assert(pool.BalanceCacao.String(), Equals, pools[0].BalanceCacao.Sub(lpDeficit).String())
 }
 
-func (s *NetworkManagerV102TestSuite) TestSynthCycle(c *C) {
+func (s *NetworkManagerV106TestSuite) TestSynthCycle(c *C) {
 	var err error
 	ctx, mgr := setupManagerForTest(c)
-	net := newNetworkMgrV102(mgr.Keeper(), mgr.TxOutStore(), mgr.EventMgr())
+	net := newNetworkMgrV106(mgr.Keeper(), mgr.TxOutStore(), mgr.EventMgr())
 
 	// mint synths
 	coin := common.NewCoin(common.BTCAsset.GetSyntheticAsset(), cosmos.NewUint(10*common.One))
@@ -726,11 +727,23 @@ func (s *NetworkManagerV102TestSuite) TestSynthCycle(c *C) {
 	luvi, err = mgr.Keeper().GetPoolLUVI(ctx, pool.Asset)
 	c.Assert(err, IsNil)
 	c.Assert(luvi.String(), Equals, "196078431372549019607", Commentf("%s", luvi.String()))
+
+	// yield paid to the savers is aggregated per bucket
+	yield, err := mgr.Keeper().GetSaversYield(ctx, spool.Asset)
+	c.Assert(err, IsNil)
+	c.Assert(yield.Uint64(), Equals, uint64(257142857))
+	found := false
+	for _, evt := range ctx.EventManager().Events() {
+		if evt.Type == types.SaversYieldEventType {
+			found = true
+		}
+	}
+	c.Assert(found, Equals, true)
 }
 
-func (s *NetworkManagerV102TestSuite) TestCalcSynthYield(c *C) {
+func (s *NetworkManagerV106TestSuite) TestCalcSynthYield(c *C) {
 	ctx, mgr := setupManagerForTest(c)
-	net := newNetworkMgrV102(mgr.Keeper(), mgr.TxOutStore(), mgr.EventMgr())
+	net := newNetworkMgrV106(mgr.Keeper(), mgr.TxOutStore(), mgr.EventMgr())
 
 	// mint synths
 	coin := common.NewCoin(common.BTCAsset.GetSyntheticAsset(), cosmos.NewUint(10*common.One))
@@ -762,7 +775,7 @@ func (s *NetworkManagerV102TestSuite) TestCalcSynthYield(c *C) {
 	c.Assert(earnings.Uint64(), Equals, uint64(257142857), Commentf("%d", earnings.Uint64()))
 }
 
-func (s *NetworkManagerV102TestSuite) TestRagnarokPool(c *C) {
+func (s *NetworkManagerV106TestSuite) TestRagnarokPool(c *C) {
 	ctx, k := setupKeeperForTest(c)
 	ctx = ctx.WithBlockHeight(100000)
 	na := GetRandomValidatorNode(NodeActive)
@@ -834,7 +847,7 @@ func (s *NetworkManagerV102TestSuite) TestRagnarokPool(c *C) {
 	k.SetLiquidityProvider(ctx, lps[0])
 	k.SetLiquidityProvider(ctx, lps[1])
 	mgr := NewDummyMgrWithKeeper(k)
-	networkMgr := newNetworkMgrV102(k, mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newNetworkMgrV106(k, mgr.TxOutStore(), mgr.EventMgr())
 
 	ctx = ctx.WithBlockHeight(1)
 	// block height not correct , doesn't take any actions
@@ -894,7 +907,7 @@ func (s *NetworkManagerV102TestSuite) TestRagnarokPool(c *C) {
 	c.Assert(tempPool.Status, Equals, PoolAvailable)
 }
 
-func (s *NetworkManagerV102TestSuite) TestCleanupAsgardIndex(c *C) {
+func (s *NetworkManagerV106TestSuite) TestCleanupAsgardIndex(c *C) {
 	ctx, k := setupKeeperForTest(c)
 	vault1 := NewVault(1024, ActiveVault, AsgardVault, GetRandomPubKey(), common.Chains{common.BNBChain}.Strings(), []ChainContract{})
 	c.Assert(k.SetVault(ctx, vault1), IsNil)
@@ -905,7 +918,7 @@ func (s *NetworkManagerV102TestSuite) TestCleanupAsgardIndex(c *C) {
 	vault4 := NewVault(1024, InactiveVault, AsgardVault, GetRandomPubKey(), common.Chains{common.BNBChain}.Strings(), []ChainContract{})
 	c.Assert(k.SetVault(ctx, vault4), IsNil)
 	mgr := NewDummyMgrWithKeeper(k)
-	networkMgr := newNetworkMgrV102(k, mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newNetworkMgrV106(k, mgr.TxOutStore(), mgr.EventMgr())
 	c.Assert(networkMgr.cleanupAsgardIndex(ctx), IsNil)
 	containsVault := func(vaults Vaults, pubKey common.PubKey) bool {
 		for _, item := range vaults {
@@ -923,11 +936,10 @@ func (s *NetworkManagerV102TestSuite) TestCleanupAsgardIndex(c *C) {
 	c.Assert(containsVault(asgards, vault4.PubKey), Equals, false)
 }
 
-func (*NetworkManagerV102TestSuite) TestPOLLiquidityAdd(c *C) {
+func (*NetworkManagerV106TestSuite) TestPOLLiquidityAdd(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 
-	net := newNetworkMgrV102(mgr.Keeper(), NewTxStoreDummy(), NewDummyEventMgr())
-	max := cosmos.NewUint(100)
+	net := newNetworkMgrV106(mgr.Keeper(), NewTxStoreDummy(), NewDummyEventMgr())
 
 	polAddress, err := mgr.Keeper().GetModuleAddress(ReserveName)
 	c.Assert(err, IsNil)
@@ -944,43 +956,29 @@ func (*NetworkManagerV102TestSuite) TestPOLLiquidityAdd(c *C) {
 	btcPool.LPUnits = cosmos.NewUint(1600)
 	c.Assert(mgr.Keeper().SetPool(ctx, btcPool), IsNil)
 
-	// hit max
-	util := cosmos.NewUint(1500)
-	target := cosmos.NewUint(1000)
-	c.Assert(net.addPOLLiquidity(ctx, btcPool, polAddress, asgardAddress, signer, max, util, target, mgr), IsNil)
+	// 1% of the pool cacao depth
+	c.Assert(net.addPOLLiquidity(ctx, btcPool, polAddress, asgardAddress, signer, cosmos.NewUint(20*common.One), mgr), IsNil)
 	lp, err := mgr.Keeper().GetLiquidityProvider(ctx, btcPool.Asset, polAddress)
 	c.Assert(err, IsNil)
 	c.Check(lp.Units.Uint64(), Equals, uint64(7), Commentf("%d", lp.Units.Uint64()))
 
-	// doesn't hit max
-	util = cosmos.NewUint(1050)
-	c.Assert(net.addPOLLiquidity(ctx, btcPool, polAddress, asgardAddress, signer, max, util, target, mgr), IsNil)
-	lp, err = mgr.Keeper().GetLiquidityProvider(ctx, btcPool.Asset, polAddress)
-	c.Assert(err, IsNil)
-	c.Check(lp.Units.Uint64(), Equals, uint64(10), Commentf("%d", lp.Units.Uint64()))
-
-	// no change needed
-	util = cosmos.NewUint(1000)
-	c.Assert(net.addPOLLiquidity(ctx, btcPool, polAddress, asgardAddress, signer, max, util, target, mgr), IsNil)
+	// 0.5% of the pool cacao depth
+	c.Assert(net.addPOLLiquidity(ctx, btcPool, polAddress, asgardAddress, signer, cosmos.NewUint(10*common.One), mgr), IsNil)
 	lp, err = mgr.Keeper().GetLiquidityProvider(ctx, btcPool.Asset, polAddress)
 	c.Assert(err, IsNil)
 	c.Check(lp.Units.Uint64(), Equals, uint64(10), Commentf("%d", lp.Units.Uint64()))
 
 	// not enough balance in the reserve module
-	max = cosmos.NewUint(10000)
-	util = cosmos.NewUint(50_000)
-	btcPool.BalanceCacao = cosmos.NewUint(90000000000 * common.One)
-	c.Assert(net.addPOLLiquidity(ctx, btcPool, polAddress, asgardAddress, signer, max, util, target, mgr), IsNil)
+	c.Assert(net.addPOLLiquidity(ctx, btcPool, polAddress, asgardAddress, signer, cosmos.NewUint(90000000000*common.One), mgr), NotNil)
 	lp, err = mgr.Keeper().GetLiquidityProvider(ctx, btcPool.Asset, polAddress)
 	c.Assert(err, IsNil)
 	c.Check(lp.Units.Uint64(), Equals, uint64(10), Commentf("%d", lp.Units.Uint64()))
 }
 
-func (*NetworkManagerV102TestSuite) TestPOLLiquidityWithdraw(c *C) {
+func (*NetworkManagerV106TestSuite) TestPOLLiquidityWithdraw(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 
-	net := newNetworkMgrV102(mgr.Keeper(), NewTxStoreDummy(), NewDummyEventMgr())
-	max := cosmos.NewUint(100)
+	net := newNetworkMgrV106(mgr.Keeper(), NewTxStoreDummy(), NewDummyEventMgr())
 
 	polAddress, err := mgr.Keeper().GetModuleAddress(ReserveName)
 	c.Assert(err, IsNil)
@@ -1028,29 +1026,57 @@ func (*NetworkManagerV102TestSuite) TestPOLLiquidityWithdraw(c *C) {
 		mgr.Keeper().SetLiquidityProvider(ctx, lp)
 	}
 
-	// hit max
-	util := cosmos.NewUint(500)
-	target := cosmos.NewUint(1000)
-	c.Assert(net.removePOLLiquidity(ctx, btcPool, polAddress, asgardAddress, signer, max, util, target, mgr), IsNil)
+	// To withdraw 1% of the pool CACAO depth, asymmetrically withdraw as CACAO 0.5% of all pool units.
+	// 1% of the 800 POL units is 8; 800 minus 8 is 792.
+	c.Assert(net.removePOLLiquidity(ctx, btcPool, polAddress, asgardAddress, signer, cosmos.NewUint(100), mgr), IsNil)
 	lp, err := mgr.Keeper().GetLiquidityProvider(ctx, btcPool.Asset, polAddress)
 	c.Assert(err, IsNil)
 	c.Check(lp.Units.Uint64(), Equals, uint64(792), Commentf("%d", lp.Units.Uint64()))
-	// To withdraw max 1% (100 basis points) of the pool RUNE depth, asymmetrically withdraw as RUNE 0.5% of all pool units.
-	// 0.5% of 1600 is 8; 800 minus 8 is 792.
 
-	// doesn't hit max
-	util = cosmos.NewUint(950)
-	c.Assert(net.removePOLLiquidity(ctx, btcPool, polAddress, asgardAddress, signer, max, util, target, mgr), IsNil)
+	// 0.5% of 792 is 3.96 which rounds to 4; 792 minus 4 is 788.
+	c.Assert(net.removePOLLiquidity(ctx, btcPool, polAddress, asgardAddress, signer, cosmos.NewUint(50), mgr), IsNil)
 	lp, err = mgr.Keeper().GetLiquidityProvider(ctx, btcPool.Asset, polAddress)
 	c.Assert(err, IsNil)
 	c.Check(lp.Units.Uint64(), Equals, uint64(788), Commentf("%d", lp.Units.Uint64()))
-	// To withdraw 0.5% of the pool RUNE depth, asymmetrically withdraw as RUNE 0.25% of all pool units.
-	// 0.25% of 1592 is 3.98 which rounds to 4; 792 minus 4 is 788.
+}
 
-	// no change needed
-	util = cosmos.NewUint(1000)
-	c.Assert(net.removePOLLiquidity(ctx, btcPool, polAddress, asgardAddress, signer, max, util, target, mgr), IsNil)
-	lp, err = mgr.Keeper().GetLiquidityProvider(ctx, btcPool.Asset, polAddress)
+func (*NetworkManagerV106TestSuite) TestPOLCycle(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	net := newNetworkMgrV106(mgr.Keeper(), mgr.TxOutStore(), mgr.EventMgr())
+	pool := setupPOLPoolForTest(c, ctx, mgr)
+	mgr.Keeper().SetMimir(ctx, "POL-BTC-BTC", 1)
+
+	// deposit
+	c.Assert(net.POLCycle(ctx, mgr), IsNil)
+	pol, err := mgr.Keeper().GetPOL(ctx)
 	c.Assert(err, IsNil)
-	c.Check(lp.Units.Uint64(), Equals, uint64(788), Commentf("%d", lp.Units.Uint64()))
+	c.Check(pol.CacaoDeposited.Uint64(), Equals, uint64(common.One))
+	polPool, err := mgr.Keeper().GetPOLPool(ctx, pool.Asset)
+	c.Assert(err, IsNil)
+	c.Check(polPool.CacaoDeposited.Uint64(), Equals, uint64(common.One))
+	c.Check(polPool.CacaoWithdrawn.IsZero(), Equals, true)
+
+	// forced withdraw
+	mgr.Keeper().SetMimir(ctx, "POL-BTC-BTC", 2)
+	c.Assert(net.POLCycle(ctx, mgr), IsNil)
+	pol, err = mgr.Keeper().GetPOL(ctx)
+	c.Assert(err, IsNil)
+	c.Check(pol.CacaoWithdrawn.IsZero(), Equals, false)
+	polPool, err = mgr.Keeper().GetPOLPool(ctx, pool.Asset)
+	c.Assert(err, IsNil)
+	c.Check(polPool.CacaoDeposited.Uint64(), Equals, uint64(common.One))
+	c.Check(polPool.CacaoWithdrawn.Uint64(), Equals, pol.CacaoWithdrawn.Uint64())
+
+	var actions []string
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type != types.POLEventType {
+			continue
+		}
+		for _, attr := range e.Attributes {
+			if string(attr.Key) == "action" {
+				actions = append(actions, string(attr.Value))
+			}
+		}
+	}
+	c.Check(actions, DeepEquals, []string{POLActionDeposit, POLActionWithdraw})
 }
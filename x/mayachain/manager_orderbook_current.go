@@ -11,6 +11,7 @@ import (
 	"gitlab.com/mayachain/mayanode/constants"
 	"gitlab.com/mayachain/mayanode/x/mayachain/keeper"
 
+	"github.com/blang/semver"
 	"github.com/jinzhu/copier"
 )
 
@@ -259,7 +260,14 @@ func (ob *OrderBookV1) discoverLimitOrders(ctx cosmos.Context, pair tradePair, p
 		}
 
 		for i, rec := range value.Value {
-			hash, err := common.NewTxID(rec)
+			var hash common.TxID
+			var err error
+			// sub indexed swaps are only queued from 1.106.0
+			if ob.k.GetVersion().GTE(semver.MustParse("1.106.0")) {
+				hash, err = common.NewTxIDV106(rec)
+			} else {
+				hash, err = common.NewTxID(rec)
+			}
 			if err != nil {
 				ctx.Logger().Error("fail to parse tx hash", "error", err)
 				continue
@@ -3,6 +3,8 @@ package mayachain
 import (
 	"fmt"
 
+	"github.com/blang/semver"
+
 	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
 	"gitlab.com/mayachain/mayanode/constants"
@@ -43,9 +45,54 @@ func (pm *PoolMgrV95) EndBlock(ctx cosmos.Context, mgr Manager) error {
 			ctx.Logger().Error("Unable to enable a pool", "error", err)
 		}
 	}
+	if mgr.GetVersion().GTE(semver.MustParse("1.106.0")) {
+		pm.snapshotPools(ctx, mgr)
+	}
 	return nil
 }
 
+// snapshotPools saves a snapshot of every pool once every PoolSnapshotInterval
+// blocks, along with the liquidity fees and swap volume since the previous one,
+// and drops the snapshots beyond PoolSnapshotRetention
+func (pm *PoolMgrV95) snapshotPools(ctx cosmos.Context, mgr Manager) {
+	interval := fetchConfigInt64(ctx, mgr, constants.PoolSnapshotInterval)
+	if interval <= 0 || ctx.BlockHeight()%interval != 0 {
+		return
+	}
+	retention := fetchConfigInt64(ctx, mgr, constants.PoolSnapshotRetention)
+	if retention < 1 {
+		retention = 1
+	}
+	pools, err := mgr.Keeper().GetPools(ctx)
+	if err != nil {
+		ctx.Logger().Error("fail to get pools", "error", err)
+		return
+	}
+	for _, pool := range pools {
+		if pool.IsEmpty() || pool.LPUnits.IsZero() {
+			continue
+		}
+		synthSupply := mgr.Keeper().GetTotalSupply(ctx, pool.Asset.GetSyntheticAsset())
+		pool.CalcUnits(mgr.GetVersion(), synthSupply)
+		fees, volume, err := mgr.Keeper().GetPoolSnapshotSwaps(ctx, pool.Asset)
+		if err != nil {
+			ctx.Logger().Error("fail to get pool swaps since last snapshot", "pool", pool.Asset, "error", err)
+			continue
+		}
+		mgr.Keeper().SetPoolSnapshot(ctx, NewPoolSnapshot(ctx.BlockHeight(), pool, fees, volume))
+		mgr.Keeper().ResetPoolSnapshotSwaps(ctx, pool.Asset)
+
+		snapshots, err := mgr.Keeper().GetPoolSnapshots(ctx, pool.Asset)
+		if err != nil {
+			ctx.Logger().Error("fail to get pool snapshots", "pool", pool.Asset, "error", err)
+			continue
+		}
+		for i := 0; i < len(snapshots)-int(retention); i++ {
+			mgr.Keeper().RemovePoolSnapshot(ctx, pool.Asset, snapshots[i].Height)
+		}
+	}
+}
+
 // cyclePools update the set of Available and Staged pools
 // Available non-gas pools not meeting the fee quota since last cycle, or not
 // meeting availability requirements, are demoted to Staged.
@@ -330,3 +330,57 @@ func (s *PoolMgrV95Suite) TestRemoveLiquidityProviders(c *C) {
 	c.Assert(countLiquidityProviders(ctx, k, asset), Equals, 0,
 		Commentf("should have 0 lps after removing"))
 }
+
+func (s *PoolMgrV95Suite) TestSnapshotPools(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	mgr := NewDummyMgrWithKeeper(k)
+	pm := newPoolMgrV95(k)
+	k.SetMimir(ctx, constants.PoolSnapshotInterval.String(), 10)
+	k.SetMimir(ctx, constants.PoolSnapshotRetention.String(), 2)
+
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.BalanceCacao = cosmos.NewUint(100 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	pool.LPUnits = cosmos.NewUint(100 * common.One)
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+	// pools without liquidity are not snapshot
+	empty := NewPool()
+	empty.Asset = common.ETHAsset
+	c.Assert(k.SetPool(ctx, empty), IsNil)
+
+	c.Assert(k.AddToPoolSnapshotSwaps(ctx, common.BTCAsset, cosmos.NewUint(10), cosmos.NewUint(1000)), IsNil)
+	pm.snapshotPools(ctx.WithBlockHeight(10), mgr)
+	snapshots, err := k.GetPoolSnapshots(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Assert(snapshots, HasLen, 1)
+	c.Check(snapshots[0].LiquidityFees.Uint64(), Equals, uint64(10))
+	c.Check(snapshots[0].SwapVolume.Uint64(), Equals, uint64(1000))
+
+	for _, height := range []int64{15, 20, 30} {
+		pm.snapshotPools(ctx.WithBlockHeight(height), mgr)
+	}
+	snapshots, err = k.GetPoolSnapshots(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Assert(snapshots, HasLen, 2)
+	c.Check(snapshots[0].Height, Equals, int64(20))
+	c.Check(snapshots[1].Height, Equals, int64(30))
+	c.Check(snapshots[1].BalanceCacao.Equal(pool.BalanceCacao), Equals, true)
+	c.Check(snapshots[1].LPUnits.Equal(pool.LPUnits), Equals, true)
+	c.Check(snapshots[0].LiquidityFees.IsZero(), Equals, true)
+	fees, volume, err := k.GetPoolSnapshotSwaps(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Check(fees.IsZero(), Equals, true)
+	c.Check(volume.IsZero(), Equals, true)
+
+	snapshots, err = k.GetPoolSnapshots(ctx, common.ETHAsset)
+	c.Assert(err, IsNil)
+	c.Check(snapshots, HasLen, 0)
+
+	// no snapshot once disabled
+	k.SetMimir(ctx, constants.PoolSnapshotInterval.String(), 0)
+	pm.snapshotPools(ctx.WithBlockHeight(40), mgr)
+	snapshots, err = k.GetPoolSnapshots(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Check(snapshots[1].Height, Equals, int64(30))
+}
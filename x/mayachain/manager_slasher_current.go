@@ -20,19 +20,19 @@ import (
 	"gitlab.com/mayachain/mayanode/x/mayachain/types"
 )
 
-// SlasherV105 is v88 implementation of slasher
-type SlasherV105 struct {
+// SlasherV106 is v88 implementation of slasher
+type SlasherV106 struct {
 	keeper   keeper.Keeper
 	eventMgr EventManager
 }
 
-// newSlasherV105 create a new instance of Slasher
-func newSlasherV105(keeper keeper.Keeper, eventMgr EventManager) *SlasherV105 {
-	return &SlasherV105{keeper: keeper, eventMgr: eventMgr}
+// newSlasherV106 create a new instance of Slasher
+func newSlasherV106(keeper keeper.Keeper, eventMgr EventManager) *SlasherV106 {
+	return &SlasherV106{keeper: keeper, eventMgr: eventMgr}
 }
 
 // BeginBlock called when a new block get proposed to detect whether there are duplicate vote
-func (s *SlasherV105) BeginBlock(ctx cosmos.Context, req abci.RequestBeginBlock, constAccessor constants.ConstantValues) {
+func (s *SlasherV106) BeginBlock(ctx cosmos.Context, req abci.RequestBeginBlock, constAccessor constants.ConstantValues) {
 	// Iterate through any newly discovered evidence of infraction
 	// Slash any validators (and since-unbonded liquidity within the unbonding period)
 	// who contributed to valid infractions
@@ -51,7 +51,7 @@ func (s *SlasherV105) BeginBlock(ctx cosmos.Context, req abci.RequestBeginBlock,
 // HandleDoubleSign - slashes a validator for signing two blocks at the same
 // block height
 // https://blog.cosmos.network/consensus-compare-casper-vs-tendermint-6df154ad56ae
-func (s *SlasherV105) HandleDoubleSign(ctx cosmos.Context, addr crypto.Address, infractionHeight int64, constAccessor constants.ConstantValues) error {
+func (s *SlasherV106) HandleDoubleSign(ctx cosmos.Context, addr crypto.Address, infractionHeight int64, constAccessor constants.ConstantValues) error {
 	// check if we're recent enough to slash for this behavior
 	maxAge := constAccessor.GetInt64Value(constants.DoubleSignMaxAge)
 	if (ctx.BlockHeight() - infractionHeight) > maxAge {
@@ -111,7 +111,7 @@ func (s *SlasherV105) HandleDoubleSign(ctx cosmos.Context, addr crypto.Address,
 }
 
 // LackObserving Slash node accounts that didn't observe a single inbound txn
-func (s *SlasherV105) LackObserving(ctx cosmos.Context, constAccessor constants.ConstantValues) error {
+func (s *SlasherV106) LackObserving(ctx cosmos.Context, constAccessor constants.ConstantValues) error {
 	signingTransPeriod := constAccessor.GetInt64Value(constants.SigningTransactionPeriod)
 	height := ctx.BlockHeight()
 	if height < signingTransPeriod {
@@ -141,7 +141,7 @@ func (s *SlasherV105) LackObserving(ctx cosmos.Context, constAccessor constants.
 	return nil
 }
 
-func (s *SlasherV105) slashNotObserving(ctx cosmos.Context, txHash common.TxID, constAccessor constants.ConstantValues) error {
+func (s *SlasherV106) slashNotObserving(ctx cosmos.Context, txHash common.TxID, constAccessor constants.ConstantValues) error {
 	voter, err := s.keeper.GetObservedTxInVoter(ctx, txHash)
 	if err != nil {
 		return fmt.Errorf("fail to get observe txin voter (%s): %w", txHash.String(), err)
@@ -173,7 +173,7 @@ func (s *SlasherV105) slashNotObserving(ctx cosmos.Context, txHash common.TxID,
 	return nil
 }
 
-func (s *SlasherV105) checkSignerAndSlash(ctx cosmos.Context, nodes NodeAccounts, blockHeight int64, signers []cosmos.AccAddress, constAccessor constants.ConstantValues) {
+func (s *SlasherV106) checkSignerAndSlash(ctx cosmos.Context, nodes NodeAccounts, blockHeight int64, signers []cosmos.AccAddress, constAccessor constants.ConstantValues) {
 	for _, na := range nodes {
 		// the node is active after the tx finalised
 		if na.ActiveBlockHeight > blockHeight {
@@ -200,7 +200,7 @@ func (s *SlasherV105) checkSignerAndSlash(ctx cosmos.Context, nodes NodeAccounts
 }
 
 // LackSigning slash account that fail to sign tx
-func (s *SlasherV105) LackSigning(ctx cosmos.Context, mgr Manager) error {
+func (s *SlasherV106) LackSigning(ctx cosmos.Context, mgr Manager) error {
 	var resultErr error
 	signingTransPeriod := mgr.GetConstants().GetInt64Value(constants.SigningTransactionPeriod)
 	if ctx.BlockHeight() < signingTransPeriod {
@@ -252,8 +252,13 @@ func (s *SlasherV105) LackSigning(ctx cosmos.Context, mgr Manager) error {
 				}
 			}
 
-			// slash if its a yggdrasil vault, and the chain isn't halted
-			if vault.IsYggdrasil() && !isChainHalted(ctx, mgr, tx.Chain) {
+			memo, _ := ParseMemoWithMAYANames(ctx, s.keeper, tx.Memo) // ignore err
+
+			// slash if its a yggdrasil vault, and the chain isn't halted. In
+			// asgard only mode yggdrasil vaults are retired and only sign the
+			// return of their funds, other outbounds are rescheduled on asgard
+			retired := isAsgardOnly(ctx, s.keeper) && !memo.IsType(TxYggdrasilReturn)
+			if vault.IsYggdrasil() && !isChainHalted(ctx, mgr, tx.Chain) && !retired {
 				na, err := s.keeper.GetNodeAccountByPubKey(ctx, tx.VaultPubKey)
 				if err != nil {
 					ctx.Logger().Error("Unable to get node account", "error", err, "vault pub key", tx.VaultPubKey.String())
@@ -277,7 +282,6 @@ func (s *SlasherV105) LackSigning(ctx cosmos.Context, mgr Manager) error {
 				}
 			}
 
-			memo, _ := ParseMemoWithMAYANames(ctx, s.keeper, tx.Memo) // ignore err
 			if memo.IsInternal() {
 				// there is a different mechanism for rescheduling outbound
 				// transactions for migration transactions
@@ -416,7 +420,7 @@ func (s *SlasherV105) LackSigning(ctx cosmos.Context, mgr Manager) error {
 }
 
 // IncSlashPoints will increase the given account's slash points
-func (s *SlasherV105) IncSlashPoints(ctx cosmos.Context, point int64, addresses ...cosmos.AccAddress) {
+func (s *SlasherV106) IncSlashPoints(ctx cosmos.Context, point int64, addresses ...cosmos.AccAddress) {
 	for _, addr := range addresses {
 		if err := s.keeper.IncNodeAccountSlashPoints(ctx, addr, point); err != nil {
 			ctx.Logger().Error("fail to increase node account slash point", "error", err, "address", addr.String())
@@ -425,7 +429,7 @@ func (s *SlasherV105) IncSlashPoints(ctx cosmos.Context, point int64, addresses
 }
 
 // DecSlashPoints will decrease the given account's slash points
-func (s *SlasherV105) DecSlashPoints(ctx cosmos.Context, point int64, addresses ...cosmos.AccAddress) {
+func (s *SlasherV106) DecSlashPoints(ctx cosmos.Context, point int64, addresses ...cosmos.AccAddress) {
 	for _, addr := range addresses {
 		if err := s.keeper.DecNodeAccountSlashPoints(ctx, addr, point); err != nil {
 			ctx.Logger().Error("fail to decrease node account slash point", "error", err, "address", addr.String())
@@ -434,7 +438,7 @@ func (s *SlasherV105) DecSlashPoints(ctx cosmos.Context, point int64, addresses
 }
 
 // SlashVaultToLP slashes a vault the membership's LPUnits that are bonded.
-func (s *SlasherV105) SlashVaultToLP(ctx cosmos.Context, vaultPK common.PubKey, coins common.Coins, mgr Manager, subsidize bool) error {
+func (s *SlasherV106) SlashVaultToLP(ctx cosmos.Context, vaultPK common.PubKey, coins common.Coins, mgr Manager, subsidize bool) error {
 	if coins.IsEmpty() {
 		return nil
 	}
@@ -614,7 +618,7 @@ func (s *SlasherV105) SlashVaultToLP(ctx cosmos.Context, vaultPK common.PubKey,
 // SlashNodeAccountLP slashes a node account based its LP units.
 // We take the percentage that the slash represents from the total bond
 // of the node and slash that percentage of the LP units to each of the
-func (s *SlasherV105) SlashNodeAccountLP(ctx cosmos.Context, na NodeAccount, slash cosmos.Uint) (cosmos.Uint, []types.PoolAmt, error) {
+func (s *SlasherV106) SlashNodeAccountLP(ctx cosmos.Context, na NodeAccount, slash cosmos.Uint) (cosmos.Uint, []types.PoolAmt, error) {
 	if slash.IsZero() {
 		return cosmos.ZeroUint(), nil, nil
 	}
@@ -706,6 +710,14 @@ func (s *SlasherV105) SlashNodeAccountLP(ctx cosmos.Context, na NodeAccount, sla
 					ctx.Logger().Error("fail to emit slash liquidity event", "error", err)
 				}
 
+				// value of the slashed units before they are moved to the POL
+				slashedValue := common.GetSafeShare(slashLPUnits, pool.LPUnits, pool.BalanceCacao)
+				slashedValue = slashedValue.Add(pool.AssetValueInRune(common.GetSafeShare(slashLPUnits, pool.LPUnits, pool.BalanceAsset)))
+				bondShareEvt := types.NewEventBondShare(na.NodeAddress, b.BondAddress, types.BondShareSlash, pool.Asset, slashedValue)
+				if err := s.eventMgr.EmitEvent(ctx, bondShareEvt); err != nil {
+					ctx.Logger().Error("fail to emit bond share event", "error", err)
+				}
+
 				s.keeper.SetLiquidityProviders(ctx, LiquidityProviders{lp, polLP})
 			}
 		}
@@ -714,7 +726,7 @@ func (s *SlasherV105) SlashNodeAccountLP(ctx cosmos.Context, na NodeAccount, sla
 	return totalSlashed, slashedAmountsPerPool, nil
 }
 
-func (s *SlasherV105) needsNewVault(ctx cosmos.Context, mgr Manager, nas int, signingTransPeriod, startHeight int64, inhash common.TxID, pk common.PubKey) bool {
+func (s *SlasherV106) needsNewVault(ctx cosmos.Context, mgr Manager, nas int, signingTransPeriod, startHeight int64, inhash common.TxID, pk common.PubKey) bool {
 	outhashes := mgr.Keeper().GetObservedLink(ctx, inhash)
 	if len(outhashes) == 0 {
 		return true
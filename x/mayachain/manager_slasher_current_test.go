@@ -14,9 +14,9 @@ import (
 	"gitlab.com/mayachain/mayanode/constants"
 )
 
-type SlashingV105Suite struct{}
+type SlashingV106Suite struct{}
 
-var _ = Suite(&SlashingV105Suite{})
+var _ = Suite(&SlashingV106Suite{})
 
 type TestSlashingLackKeeper struct {
 	keeper.KVStoreDummy
@@ -161,11 +161,11 @@ type TestDoubleSlashKeeper struct {
 	slashPoints map[string]int64
 }
 
-func (s *SlashingV105Suite) SetUpSuite(_ *C) {
+func (s *SlashingV106Suite) SetUpSuite(_ *C) {
 	SetupConfigForTest()
 }
 
-func (s *SlashingV105Suite) TestObservingSlashing(c *C) {
+func (s *SlashingV106Suite) TestObservingSlashing(c *C) {
 	var err error
 	ctx, k := setupKeeperForTest(c)
 	naActiveAfterTx := GetRandomValidatorNode(NodeActive)
@@ -206,7 +206,7 @@ func (s *SlashingV105Suite) TestObservingSlashing(c *C) {
 	ver := GetCurrentVersion()
 	constAccessor := constants.GetConstantValues(ver)
 
-	slasher := newSlasherV105(k, NewDummyEventMgr())
+	slasher := newSlasherV106(k, NewDummyEventMgr())
 	// should slash na2 only
 	lackOfObservationPenalty := constAccessor.GetInt64Value(constants.LackOfObservationPenalty)
 	err = slasher.LackObserving(ctx, constAccessor)
@@ -242,7 +242,7 @@ func (s *SlashingV105Suite) TestObservingSlashing(c *C) {
 	c.Assert(slashPoint, Equals, lackOfObservationPenalty)
 }
 
-func (s *SlashingV105Suite) TestLackObservingErrors(c *C) {
+func (s *SlashingV106Suite) TestLackObservingErrors(c *C) {
 	ctx, _ := setupKeeperForTest(c)
 
 	nas := NodeAccounts{
@@ -256,12 +256,12 @@ func (s *SlashingV105Suite) TestLackObservingErrors(c *C) {
 	}
 	ver := GetCurrentVersion()
 	constAccessor := constants.GetConstantValues(ver)
-	slasher := newSlasherV105(keeper, NewDummyEventMgr())
+	slasher := newSlasherV106(keeper, NewDummyEventMgr())
 	err := slasher.LackObserving(ctx, constAccessor)
 	c.Assert(err, IsNil)
 }
 
-func (s *SlashingV105Suite) TestNodeSignSlashErrors(c *C) {
+func (s *SlashingV106Suite) TestNodeSignSlashErrors(c *C) {
 	testCases := []struct {
 		name        string
 		condition   func(keeper *TestSlashingLackKeeper)
@@ -354,7 +354,7 @@ func (s *SlashingV105Suite) TestNodeSignSlashErrors(c *C) {
 		}
 		signingTransactionPeriod := constAccessor.GetInt64Value(constants.SigningTransactionPeriod)
 		ctx = ctx.WithBlockHeight(3 + signingTransactionPeriod)
-		slasher := newSlasherV105(keeper, NewDummyEventMgr())
+		slasher := newSlasherV106(keeper, NewDummyEventMgr())
 		item.condition(keeper)
 		if item.shouldError {
 			c.Assert(slasher.LackSigning(ctx, NewDummyMgr()), NotNil)
@@ -364,7 +364,7 @@ func (s *SlashingV105Suite) TestNodeSignSlashErrors(c *C) {
 	}
 }
 
-func (s *SlashingV105Suite) TestNotSigningSlash(c *C) {
+func (s *SlashingV106Suite) TestNotSigningSlash(c *C) {
 	ctx, _ := setupKeeperForTest(c)
 	ctx = ctx.WithBlockHeight(201) // set blockheight
 	txOutStore := NewTxStoreDummy()
@@ -413,7 +413,7 @@ func (s *SlashingV105Suite) TestNotSigningSlash(c *C) {
 	ctx = ctx.WithBlockHeight(3 + signingTransactionPeriod)
 	mgr := NewDummyMgr()
 	mgr.txOutStore = txOutStore
-	slasher := newSlasherV105(keeper, NewDummyEventMgr())
+	slasher := newSlasherV106(keeper, NewDummyEventMgr())
 	c.Assert(slasher.LackSigning(ctx, mgr), IsNil)
 
 	c.Check(keeper.slashPts[na.NodeAddress.String()], Equals, int64(600), Commentf("%+v\n", na))
@@ -429,7 +429,7 @@ func (s *SlashingV105Suite) TestNotSigningSlash(c *C) {
 	c.Assert(keeper.txOut.TxArray[0].OutHash.IsEmpty(), Equals, false)
 }
 
-func (s *SlashingV105Suite) TestNewSlasher(c *C) {
+func (s *SlashingV106Suite) TestNewSlasher(c *C) {
 	nas := NodeAccounts{
 		GetRandomValidatorNode(NodeActive),
 		GetRandomValidatorNode(NodeActive),
@@ -439,11 +439,11 @@ func (s *SlashingV105Suite) TestNewSlasher(c *C) {
 		addrs:    []cosmos.AccAddress{nas[0].NodeAddress},
 		slashPts: make(map[string]int64),
 	}
-	slasher := newSlasherV105(keeper, NewDummyEventMgr())
+	slasher := newSlasherV106(keeper, NewDummyEventMgr())
 	c.Assert(slasher, NotNil)
 }
 
-func (s *SlashingV105Suite) TestDoubleSign(c *C) {
+func (s *SlashingV106Suite) TestDoubleSign(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 	constAccessor := constants.GetConstantValues(GetCurrentVersion())
 
@@ -463,7 +463,7 @@ func (s *SlashingV105Suite) TestDoubleSign(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(prevNodeBond.Equal(naBond.MulUint64(2)), Equals, true, Commentf("%d", prevNodeBond))
 
-	slasher := newSlasherV105(mgr.Keeper(), mgr.EventMgr())
+	slasher := newSlasherV106(mgr.Keeper(), mgr.EventMgr())
 
 	pk, err := cosmos.GetPubKeyFromBech32(cosmos.Bech32PubKeyTypeConsPub, na.ValidatorConsPubKey)
 	c.Assert(err, IsNil)
@@ -477,7 +477,7 @@ func (s *SlashingV105Suite) TestDoubleSign(c *C) {
 	c.Assert(calcNodeBond.LT(prevNodeBond), Equals, true, Commentf("%d", calcNodeBond))
 }
 
-func (s *SlashingV105Suite) TestIncreaseDecreaseSlashPoints(c *C) {
+func (s *SlashingV106Suite) TestIncreaseDecreaseSlashPoints(c *C) {
 	ctx, _ := setupKeeperForTest(c)
 
 	na := GetRandomValidatorNode(NodeActive)
@@ -501,16 +501,16 @@ func (s *SlashingV105Suite) TestIncreaseDecreaseSlashPoints(c *C) {
 		network:     NewNetwork(),
 		slashPoints: make(map[string]int64),
 	}
-	slasher := newSlasherV105(keeper, NewDummyEventMgr())
+	slasher := newSlasherV106(keeper, NewDummyEventMgr())
 	addr := GetRandomBech32Addr()
 	slasher.IncSlashPoints(ctx, 1, addr)
 	slasher.DecSlashPoints(ctx, 1, addr)
 	c.Assert(keeper.slashPoints[addr.String()], Equals, int64(0))
 }
 
-func (s *SlashingV105Suite) TestSlashVault(c *C) {
+func (s *SlashingV106Suite) TestSlashVault(c *C) {
 	ctx, mgr := setupManagerForTest(c)
-	slasher := newSlasherV105(mgr.Keeper(), mgr.EventMgr())
+	slasher := newSlasherV106(mgr.Keeper(), mgr.EventMgr())
 	// when coins are empty , it should return nil
 	c.Assert(slasher.SlashVaultToLP(ctx, GetRandomPubKey(), common.NewCoins(), mgr, true), IsNil)
 
@@ -690,13 +690,13 @@ func (k *TestSlashNodeAccountLPKeeper) GetLiquidityProvider(ctx cosmos.Context,
 	return k.Keeper.GetLiquidityProvider(ctx, asset, lpAddr)
 }
 
-func (s *SlashingV105Suite) TestSlashNodeAccountLP(c *C) {
+func (s *SlashingV106Suite) TestSlashNodeAccountLP(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 	keeper := &TestSlashNodeAccountLPKeeper{
 		Keeper: mgr.Keeper(),
 	}
 
-	slasher := newSlasherV105(keeper, mgr.EventMgr())
+	slasher := newSlasherV106(keeper, mgr.EventMgr())
 	// when slash is zero
 	amt, poolAmts, err := slasher.SlashNodeAccountLP(ctx, GetRandomValidatorNode(NodeActive), cosmos.ZeroUint())
 	c.Assert(err, IsNil)
@@ -766,6 +766,20 @@ func (s *SlashingV105Suite) TestSlashNodeAccountLP(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(amt.Uint64(), Equals, uint64(20*common.One))
 	c.Assert(poolAmts, HasLen, 1)
+	// the slashed provider share is reported in CACAO
+	found := false
+	for _, evt := range ctx.EventManager().Events() {
+		if evt.Type != types2.BondShareEventType {
+			continue
+		}
+		for _, attr := range evt.Attributes {
+			if string(attr.Key) == "amount" {
+				c.Check(string(attr.Value), Equals, cosmos.NewUint(20*common.One).String())
+				found = true
+			}
+		}
+	}
+	c.Assert(found, Equals, true)
 	nodeBond, err = keeper.CalcNodeLiquidityBond(ctx, na)
 	c.Log("node bond", nodeBond.Uint64())
 	c.Assert(err, IsNil)
@@ -822,9 +836,9 @@ func (s *SlashingV105Suite) TestSlashNodeAccountLP(c *C) {
 	c.Assert(lp.Units.Uint64(), Equals, uint64(8_50000000), Commentf("expected %d, got %d", 7*common.One, lp.Units.Uint64()))
 }
 
-func (s *SlashingV105Suite) TestNetworkShouldNotSlashMorethanVaultAmount(c *C) {
+func (s *SlashingV106Suite) TestNetworkShouldNotSlashMorethanVaultAmount(c *C) {
 	ctx, mgr := setupManagerForTest(c)
-	slasher := newSlasherV105(mgr.Keeper(), mgr.EventMgr())
+	slasher := newSlasherV106(mgr.Keeper(), mgr.EventMgr())
 
 	// create a node
 	node := GetRandomValidatorNode(NodeActive)
@@ -951,7 +965,7 @@ func (s *SlashingV105Suite) TestNetworkShouldNotSlashMorethanVaultAmount(c *C) {
 	c.Assert(err, IsNil)
 }
 
-func (s *SlashingV105Suite) TestNeedsNewVault(c *C) {
+func (s *SlashingV106Suite) TestNeedsNewVault(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 
 	inhash := GetRandomTxHash()
@@ -970,7 +984,7 @@ func (s *SlashingV105Suite) TestNeedsNewVault(c *C) {
 	mgr.Keeper().SetObservedTxOutVoter(ctx, voter)
 
 	mgr.Keeper().SetObservedLink(ctx, inhash, outhash)
-	slasher := newSlasherV105(mgr.Keeper(), mgr.EventMgr())
+	slasher := newSlasherV106(mgr.Keeper(), mgr.EventMgr())
 
 	c.Check(slasher.needsNewVault(ctx, mgr, 10, 300, 1, inhash, pk), Equals, false)
 	ctx = ctx.WithBlockHeight(600)
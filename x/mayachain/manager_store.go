@@ -61,6 +61,8 @@ func (smgr *StoreMgr) migrate(ctx cosmos.Context, i uint64) error {
 		migrateStoreV104(ctx, smgr.mgr)
 	case 105:
 		migrateStoreV105(ctx, smgr.mgr)
+	case 106:
+		migrateStoreV106(ctx, smgr.mgr)
 	}
 
 	smgr.mgr.Keeper().SetStoreVersion(ctx, int64(i))
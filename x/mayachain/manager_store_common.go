@@ -95,3 +95,78 @@ func refundTransactions(ctx cosmos.Context, mgr *Mgrs, pubKey string, adhocRefun
 		}
 	}
 }
+
+// migrateStoreV106 settles the rewards the node accounts accrued before bond
+// rewards were paid out at every churn, by paying them to their bond providers,
+// and records the number of savers of every bucket
+func migrateStoreV106(ctx cosmos.Context, mgr *Mgrs) {
+	defer func() {
+		if err := recover(); err != nil {
+			ctx.Logger().Error("fail to migrate store to v106", "error", err)
+		}
+	}()
+
+	var nodeAccounts NodeAccounts
+	iter := mgr.Keeper().GetNodeAccountIterator(ctx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var na NodeAccount
+		if err := mgr.Keeper().Cdc().Unmarshal(iter.Value(), &na); err != nil {
+			ctx.Logger().Error("fail to unmarshal node account", "error", err)
+			continue
+		}
+		if !na.Reward.IsZero() {
+			nodeAccounts = append(nodeAccounts, na)
+		}
+	}
+
+	for _, na := range nodeAccounts {
+		// settle every node on its own, a node that fails keeps its reward
+		// rather than ending up with some of its bond providers paid
+		cacheCtx, commit := ctx.CacheContext()
+		if err := payBondProviderShares(cacheCtx, mgr.Keeper(), mgr.EventMgr(), na, na.Reward); err != nil {
+			ctx.Logger().Error("fail to settle node rewards", "node", na.NodeAddress, "error", err)
+			continue
+		}
+		na.Reward = cosmos.ZeroUint()
+		if err := mgr.Keeper().SetNodeAccount(cacheCtx, na); err != nil {
+			ctx.Logger().Error("fail to save node account", "node", na.NodeAddress, "error", err)
+			continue
+		}
+		commit()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+
+	countSaversV106(ctx, mgr)
+}
+
+// countSaversV106 records the number of savers with units in every bucket, the
+// count is kept up to date on add and withdraw from then on
+func countSaversV106(ctx cosmos.Context, mgr *Mgrs) {
+	pools, err := mgr.Keeper().GetPools(ctx)
+	if err != nil {
+		ctx.Logger().Error("fail to get pools", "error", err)
+		return
+	}
+	for _, pool := range pools {
+		if !pool.Asset.IsVaultAsset() {
+			continue
+		}
+		savers := int64(0)
+		iter := mgr.Keeper().GetLiquidityProviderIterator(ctx, pool.Asset)
+		for ; iter.Valid(); iter.Next() {
+			var lp LiquidityProvider
+			if err := mgr.Keeper().Cdc().Unmarshal(iter.Value(), &lp); err != nil {
+				ctx.Logger().Error("fail to unmarshal saver", "error", err)
+				continue
+			}
+			if !lp.Units.IsZero() {
+				savers++
+			}
+		}
+		iter.Close()
+		if err := mgr.Keeper().AddSaversCount(ctx, pool.Asset, savers); err != nil {
+			ctx.Logger().Error("fail to set savers count", "bucket", pool.Asset, "error", err)
+		}
+	}
+}
@@ -2,8 +2,76 @@ package mayachain
 
 import (
 	. "gopkg.in/check.v1"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
 )
 
 type StoreManagerTestSuite struct{}
 
 var _ = Suite(&StoreManagerTestSuite{})
+
+func (s *StoreManagerTestSuite) TestMigrateStoreV106(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	na, provider := setupBondProvidersForTest(c, ctx, mgr.Keeper(), 1000)
+	operator, err := na.BondAddress.AccAddress()
+	c.Assert(err, IsNil)
+	na.Reward = cosmos.NewUint(100 * common.One)
+	c.Assert(mgr.Keeper().SetNodeAccount(ctx, na), IsNil)
+	FundModule(c, ctx, mgr.Keeper(), BondName, 100)
+
+	migrateStoreV106(ctx, mgr)
+
+	na, err = mgr.Keeper().GetNodeAccount(ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(na.Reward.IsZero(), Equals, true)
+	balance := mgr.Keeper().GetBalance(ctx, operator).AmountOf(common.BaseNative.Native())
+	c.Check(balance.Int64(), Equals, int64(55*common.One))
+	balance = mgr.Keeper().GetBalance(ctx, provider).AmountOf(common.BaseNative.Native())
+	c.Check(balance.Int64(), Equals, int64(45*common.One))
+}
+
+func (s *StoreManagerTestSuite) TestMigrateStoreV106PartialPayment(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	na, provider := setupBondProvidersForTest(c, ctx, mgr.Keeper(), 1000)
+	operator, err := na.BondAddress.AccAddress()
+	c.Assert(err, IsNil)
+	na.Reward = cosmos.NewUint(100 * common.One)
+	c.Assert(mgr.Keeper().SetNodeAccount(ctx, na), IsNil)
+	// enough to pay the operator but not the bond provider
+	FundModule(c, ctx, mgr.Keeper(), BondName, 60)
+
+	migrateStoreV106(ctx, mgr)
+
+	// nothing is paid and the node keeps its reward
+	na, err = mgr.Keeper().GetNodeAccount(ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(na.Reward.Uint64(), Equals, uint64(100*common.One))
+	balance := mgr.Keeper().GetBalance(ctx, operator).AmountOf(common.BaseNative.Native())
+	c.Check(balance.Int64(), Equals, int64(0))
+	balance = mgr.Keeper().GetBalance(ctx, provider).AmountOf(common.BaseNative.Native())
+	c.Check(balance.Int64(), Equals, int64(0))
+}
+
+func (s *StoreManagerTestSuite) TestCountSaversV106(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	bucket := NewPool()
+	bucket.Asset = common.BTCAsset.GetSyntheticAsset()
+	bucket.BalanceAsset = cosmos.NewUint(10 * common.One)
+	bucket.LPUnits = cosmos.NewUint(10 * common.One)
+	bucket.Status = PoolAvailable
+	c.Assert(mgr.Keeper().SetPool(ctx, bucket), IsNil)
+	for _, units := range []uint64{5 * common.One, 5 * common.One, 0} {
+		mgr.Keeper().SetLiquidityProvider(ctx, LiquidityProvider{
+			Asset:        bucket.Asset,
+			AssetAddress: GetRandomBTCAddress(),
+			Units:        cosmos.NewUint(units),
+		})
+	}
+
+	countSaversV106(ctx, mgr)
+
+	savers, err := mgr.Keeper().GetSaversCount(ctx, bucket.Asset)
+	c.Assert(err, IsNil)
+	c.Check(savers, Equals, int64(2))
+}
@@ -3,6 +3,7 @@ package mayachain
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/armon/go-metrics"
 	"github.com/blang/semver"
@@ -262,7 +263,13 @@ func (tos *TxOutStorageV104) prepareTxOutItem(ctx cosmos.Context, toi TxOutItem)
 	if toi.ToAddress.IsEmpty() {
 		return outputs, fmt.Errorf("empty to address, can't send out")
 	}
-	if !toi.ToAddress.IsChain(toi.Chain) {
+	if toi.IBCChannel != "" {
+		// IBC transfers are sent from the MAYAChain bank to an address on the
+		// counterparty chain, which is not of the chain of the txout item
+		if !toi.Chain.IsBASEChain() {
+			return outputs, fmt.Errorf("ibc transfer is not supported on chain(%s)", toi.Chain)
+		}
+	} else if !toi.ToAddress.IsChain(toi.Chain) {
 		return outputs, fmt.Errorf("to address(%s), is not of chain(%s)", toi.ToAddress, toi.Chain)
 	}
 
@@ -315,7 +322,9 @@ func (tos *TxOutStorageV104) prepareTxOutItem(ctx cosmos.Context, toi TxOutItem)
 				ctx.Logger().Error("fail to get all active node accounts", "error", err)
 			}
 			yggs := make(Vaults, 0)
-			if len(activeNodeAccounts) > 0 {
+			// yggdrasil vaults are retired in asgard only mode, and are only
+			// expected to return their funds
+			if len(activeNodeAccounts) > 0 && !isAsgardOnly(ctx, tos.keeper) {
 				voter, err := tos.keeper.GetObservedTxInVoter(ctx, toi.InHash)
 				if err != nil {
 					return nil, fmt.Errorf("fail to get observed tx voter: %w", err)
@@ -415,12 +424,15 @@ func (tos *TxOutStorageV104) prepareTxOutItem(ctx cosmos.Context, toi TxOutItem)
 
 		// Deduct OutboundTransactionFee from TOI and add to Reserve
 		memo, err := ParseMemoWithMAYANames(ctx, tos.keeper, outputs[i].Memo)
-		if err == nil && !memo.IsType(TxYggdrasilFund) && !memo.IsType(TxYggdrasilReturn) && !memo.IsType(TxMigrate) && !memo.IsType(TxRagnarok) {
+		// the outbound fee of a prepaid item was already collected by its handler
+		feePaid := outputs[i].FeePaid && tos.keeper.GetVersion().GTE(semver.MustParse("1.106.0"))
+		if err == nil && !feePaid && !memo.IsType(TxYggdrasilFund) && !memo.IsType(TxYggdrasilReturn) && !memo.IsType(TxMigrate) && !memo.IsType(TxRagnarok) {
 			if outputs[i].Coin.Asset.IsBase() {
 				if outputs[i].Coin.Amount.LTE(transactionFeeRune) {
 					runeFee = outputs[i].Coin.Amount // Fee is the full amount
 				}
 				finalRuneFee = finalRuneFee.Add(runeFee)
+				tos.addOutboundFeeWithheld(ctx, outputs[i].Chain, runeFee)
 				outputs[i].Coin.Amount = common.SafeSub(outputs[i].Coin.Amount, runeFee)
 				fee := common.NewFee(common.Coins{common.NewCoin(outputs[i].Coin.Asset, runeFee)}, cosmos.ZeroUint())
 				feeEvents = append(feeEvents, NewEventFee(outputs[i].InHash, fee, cosmos.ZeroUint()))
@@ -464,6 +476,7 @@ func (tos *TxOutStorageV104) prepareTxOutItem(ctx cosmos.Context, toi TxOutItem)
 							poolDeduct = runeFee
 						}
 						finalRuneFee = finalRuneFee.Add(poolDeduct)
+						tos.addOutboundFeeWithheld(ctx, outputs[i].Chain, poolDeduct)
 						if !outputs[i].Coin.Asset.IsSyntheticAsset() {
 							pool.BalanceAsset = pool.BalanceAsset.Add(assetFee) // Add Asset fee to Pool
 						}
@@ -574,13 +587,27 @@ func (tos *TxOutStorageV104) addToBlockOut(ctx cosmos.Context, mgr Manager, item
 	}
 	telemetry.SetGaugeWithLabels([]string{"mayanode", "vault", "out_txn"}, float32(1), labels)
 
-	if err := tos.eventMgr.EmitEvent(ctx, NewEventScheduledOutbound(item)); err != nil {
+	// the height lets the signers find the delayed outbounds
+	evt := NewEventScheduledOutbound(item)
+	evt.Height = outboundHeight
+	if err := tos.eventMgr.EmitEvent(ctx, evt); err != nil {
 		ctx.Logger().Error("fail to emit scheduled outbound event", "error", err)
 	}
 
 	return tos.keeper.AppendTxOut(ctx, outboundHeight, item)
 }
 
+// addOutboundFeeWithheld keeps track of the outbound fees withheld on the given
+// chain, so the gas manager can compare them with the gas actually spent
+func (tos *TxOutStorageV104) addOutboundFeeWithheld(ctx cosmos.Context, chain common.Chain, fee cosmos.Uint) {
+	if chain.IsBASEChain() || fee.IsZero() || tos.keeper.GetVersion().LT(semver.MustParse("1.106.0")) {
+		return
+	}
+	if err := tos.keeper.AddToOutboundFeeWithheldCacao(ctx, chain, fee); err != nil {
+		ctx.Logger().Error("fail to add outbound fee withheld", "chain", chain, "error", err)
+	}
+}
+
 func (tos *TxOutStorageV104) CalcTxOutHeight(ctx cosmos.Context, version semver.Version, toi TxOutItem) (int64, error) {
 	// non-outbound transactions are skipped. This is so this code does not
 	// affect internal transactions (ie consolidation and migrate txs)
@@ -588,45 +615,68 @@ func (tos *TxOutStorageV104) CalcTxOutHeight(ctx cosmos.Context, version semver.
 	if !memo.IsType(TxRefund) && !memo.IsType(TxOutbound) {
 		return ctx.BlockHeight(), nil
 	}
+	schedule, err := calcTxOutSchedule(ctx, tos.keeper, tos.constAccessor, toi.Coin)
+	return schedule.Height, err
+}
+
+// txOutSchedule is the plan of an outbound in the scheduled outbound queue
+type txOutSchedule struct {
+	Height         int64       // height the outbound is scheduled at
+	Value          cosmos.Uint // value of the outbound in cacao
+	ScheduledValue cosmos.Uint // value of the outbounds scheduled, including this one
+	DelayRate      int64       // value of the outbound sent per block of delay
+	BlockValue     cosmos.Uint // value already scheduled at the height
+}
 
-	minTxOutVolumeThreshold, err := tos.keeper.GetMimir(ctx, constants.MinTxOutVolumeThreshold.String())
+// calcTxOutSchedule plans the height an outbound of the given coin is sent
+// at, delaying it relative to its value and the value already scheduled
+func calcTxOutSchedule(ctx cosmos.Context, k keeper.Keeper, constAccessor constants.ConstantValues, coin common.Coin) (txOutSchedule, error) {
+	schedule := txOutSchedule{
+		Height:         ctx.BlockHeight(),
+		Value:          cosmos.ZeroUint(),
+		ScheduledValue: cosmos.ZeroUint(),
+		BlockValue:     cosmos.ZeroUint(),
+	}
+	minTxOutVolumeThreshold, err := k.GetMimir(ctx, constants.MinTxOutVolumeThreshold.String())
 	if minTxOutVolumeThreshold <= 0 || err != nil {
-		minTxOutVolumeThreshold = tos.constAccessor.GetInt64Value(constants.MinTxOutVolumeThreshold)
+		minTxOutVolumeThreshold = constAccessor.GetInt64Value(constants.MinTxOutVolumeThreshold)
 	}
 	minVolumeThreshold := cosmos.NewUint(uint64(minTxOutVolumeThreshold))
-	txOutDelayRate, err := tos.keeper.GetMimir(ctx, constants.TxOutDelayRate.String())
+	txOutDelayRate, err := k.GetMimir(ctx, constants.TxOutDelayRate.String())
 	if txOutDelayRate <= 0 || err != nil {
-		txOutDelayRate = tos.constAccessor.GetInt64Value(constants.TxOutDelayRate)
+		txOutDelayRate = constAccessor.GetInt64Value(constants.TxOutDelayRate)
 	}
-	txOutDelayMax, err := tos.keeper.GetMimir(ctx, constants.TxOutDelayMax.String())
+	txOutDelayMax, err := k.GetMimir(ctx, constants.TxOutDelayMax.String())
 	if txOutDelayMax <= 0 || err != nil {
-		txOutDelayMax = tos.constAccessor.GetInt64Value(constants.TxOutDelayMax)
+		txOutDelayMax = constAccessor.GetInt64Value(constants.TxOutDelayMax)
 	}
-	maxTxOutOffset, err := tos.keeper.GetMimir(ctx, constants.MaxTxOutOffset.String())
+	maxTxOutOffset, err := k.GetMimir(ctx, constants.MaxTxOutOffset.String())
 	if maxTxOutOffset <= 0 || err != nil {
-		maxTxOutOffset = tos.constAccessor.GetInt64Value(constants.MaxTxOutOffset)
+		maxTxOutOffset = constAccessor.GetInt64Value(constants.MaxTxOutOffset)
 	}
 
 	// if volume threshold is zero
 	if minVolumeThreshold.IsZero() || txOutDelayRate == 0 {
-		return ctx.BlockHeight(), nil
+		return schedule, nil
 	}
 
 	// get txout item value in rune
-	runeValue := toi.Coin.Amount
-	if !toi.Coin.Asset.IsBase() {
-		pool, err := tos.keeper.GetPool(ctx, toi.Coin.Asset.GetLayer1Asset())
+	runeValue := coin.Amount
+	if !coin.Asset.IsBase() {
+		pool, err := k.GetPool(ctx, coin.Asset.GetLayer1Asset())
 		if err != nil {
 			ctx.Logger().Error("fail to get pool for appending txout item", "error", err)
-			return ctx.BlockHeight() + maxTxOutOffset, err
+			schedule.Height = ctx.BlockHeight() + maxTxOutOffset
+			return schedule, err
 		}
-		runeValue = pool.AssetValueInRune(toi.Coin.Amount)
+		runeValue = pool.AssetValueInRune(coin.Amount)
 	}
+	schedule.Value = runeValue
 
 	// sum value of scheduled txns (including this one)
 	sumValue := runeValue
 	for height := ctx.BlockHeight() + 1; height <= ctx.BlockHeight()+txOutDelayMax; height++ {
-		value, err := tos.keeper.GetTxOutValue(ctx, height)
+		value, err := k.GetTxOutValue(ctx, height)
 		if err != nil {
 			ctx.Logger().Error("fail to get tx out array from key value store", "error", err)
 			continue
@@ -638,6 +688,7 @@ func (tos *TxOutStorageV104) CalcTxOutHeight(ctx cosmos.Context, version semver.
 		}
 		sumValue = sumValue.Add(value)
 	}
+	schedule.ScheduledValue = sumValue
 	// reduce delay rate relative to the total scheduled value. In high volume
 	// scenarios, this causes the network to send outbound transactions slower,
 	// giving the community & NOs time to analyze and react. In an attack
@@ -648,6 +699,7 @@ func (tos *TxOutStorageV104) CalcTxOutHeight(ctx cosmos.Context, version semver.
 	if txOutDelayRate < 1 {
 		txOutDelayRate = 1
 	}
+	schedule.DelayRate = txOutDelayRate
 
 	// calculate the minimum number of blocks in the future the txn has to be
 	minBlocks := int64(runeValue.Uint64()) / txOutDelayRate
@@ -660,11 +712,12 @@ func (tos *TxOutStorageV104) CalcTxOutHeight(ctx cosmos.Context, version semver.
 	// find targetBlock that has space for new txout item.
 	count := int64(0)
 	for count < txOutDelayMax { // max set 1 day into the future
-		txOutValue, err := tos.keeper.GetTxOutValue(ctx, targetBlock)
+		txOutValue, err := k.GetTxOutValue(ctx, targetBlock)
 		if err != nil {
 			ctx.Logger().Error("fail to get txOutValue for block height", "error", err)
 			break
 		}
+		schedule.BlockValue = txOutValue
 		if txOutValue.IsZero() {
 			// the txout has no outbound txns, let's use this one
 			break
@@ -673,17 +726,23 @@ func (tos *TxOutStorageV104) CalcTxOutHeight(ctx cosmos.Context, version semver.
 			// the txout + this txout item has enough space to fit, lets use this one
 			break
 		}
+		schedule.BlockValue = cosmos.ZeroUint()
 		targetBlock++
 		count++
 	}
+	schedule.Height = targetBlock
 
-	return targetBlock, nil
+	return schedule, nil
 }
 
 func (tos *TxOutStorageV104) nativeTxOut(ctx cosmos.Context, mgr Manager, toi TxOutItem) error {
-	addr, err := cosmos.AccAddressFromBech32(toi.ToAddress.String())
-	if err != nil {
-		return err
+	var addr cosmos.AccAddress
+	var err error
+	if toi.IBCChannel == "" {
+		addr, err = cosmos.AccAddressFromBech32(toi.ToAddress.String())
+		if err != nil {
+			return err
+		}
 	}
 
 	if toi.ModuleName == "" {
@@ -704,12 +763,17 @@ func (tos *TxOutStorageV104) nativeTxOut(ctx cosmos.Context, mgr Manager, toi Tx
 	}
 
 	// send funds from module
-	if polAddress.Equals(toi.ToAddress) {
+	switch {
+	case toi.IBCChannel != "":
+		if err := tos.ibcTxOut(ctx, toi); err != nil {
+			return err
+		}
+	case polAddress.Equals(toi.ToAddress):
 		sdkErr := tos.keeper.SendFromModuleToModule(ctx, toi.ModuleName, ReserveName, common.NewCoins(toi.Coin))
 		if sdkErr != nil {
 			return errors.New(sdkErr.Error())
 		}
-	} else {
+	default:
 		sdkErr := tos.keeper.SendFromModuleToAccount(ctx, toi.ModuleName, addr, common.NewCoins(toi.Coin))
 		if sdkErr != nil {
 			return errors.New(sdkErr.Error())
@@ -768,6 +832,42 @@ func (tos *TxOutStorageV104) nativeTxOut(ctx cosmos.Context, mgr Manager, toi Tx
 	return nil
 }
 
+// ibcTxOut sends the txout item to the counterparty chain over its IBC
+// channel, and keeps track of the transfer until it is acknowledged or times
+// out, so the original sender can be refunded if the transfer fails
+func (tos *TxOutStorageV104) ibcTxOut(ctx cosmos.Context, toi TxOutItem) error {
+	voter, err := tos.keeper.GetObservedTxInVoter(ctx, toi.InHash)
+	if err != nil {
+		return fmt.Errorf("fail to get observed tx in voter: %w", err)
+	}
+	sender := voter.Tx.Tx.FromAddress
+	if !sender.IsChain(common.BASEChain) {
+		return fmt.Errorf("sender(%s) of an ibc transfer must be a %s address", sender, common.BASEChain)
+	}
+
+	timeout, err := tos.keeper.GetMimir(ctx, constants.IBCTransferTimeout.String())
+	if timeout <= 0 || err != nil {
+		timeout = tos.constAccessor.GetInt64Value(constants.IBCTransferTimeout)
+	}
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(time.Duration(timeout) * time.Second).UnixNano())
+
+	sequence, err := tos.keeper.SendIBCTransfer(ctx, toi.ModuleName, toi.IBCChannel, toi.Coin, toi.ToAddress, timeoutTimestamp)
+	if err != nil {
+		return err
+	}
+	transfer := NewIBCTransfer(toi.IBCChannel, sequence, toi.InHash, toi.ModuleName, sender, toi.ToAddress, toi.Coin, ctx.BlockHeight())
+	if err := transfer.Valid(); err != nil {
+		return fmt.Errorf("invalid ibc transfer: %w", err)
+	}
+	tos.keeper.SetIBCTransfer(ctx, transfer)
+
+	evt := NewEventIBCTransfer(toi.InHash, toi.IBCChannel, sequence, toi.ToAddress, toi.Coin, IBCTransferStatusSent)
+	if err := tos.eventMgr.EmitEvent(ctx, evt); err != nil {
+		ctx.Logger().Error("fail to emit ibc transfer event", "error", err)
+	}
+	return nil
+}
+
 // collectYggdrasilPools is to get all the yggdrasil vaults , that THORChain can used to send out fund
 func (tos *TxOutStorageV104) collectYggdrasilPools(ctx cosmos.Context, tx ObservedTx, gasAsset common.Asset) (Vaults, error) {
 	// collect yggdrasil pools
@@ -517,6 +517,53 @@ func (s TxOutStoreSuite) TestAddOutTxItemDeductMaxGasFromYggdrasil(c *C) {
 	c.Assert(msgs[1].VaultPubKey.Equals(acc1.PubKeySet.Secp256k1), Equals, true)
 }
 
+func (s TxOutStoreSuite) TestAddOutTxItemAsgardOnly(c *C) {
+	w := getHandlerTestWrapper(c, 1, true, true)
+	vault := GetRandomVault()
+	vault.Coins = common.Coins{
+		common.NewCoin(common.BaseAsset(), cosmos.NewUint(10000*common.One)),
+		common.NewCoin(common.BNBAsset, cosmos.NewUint(10000*common.One)),
+	}
+	c.Assert(w.keeper.SetVault(w.ctx, vault), IsNil)
+
+	acc1 := GetRandomValidatorNode(NodeActive)
+	c.Assert(w.keeper.SetNodeAccount(w.ctx, acc1), IsNil)
+	ygg := NewVault(w.ctx.BlockHeight(), ActiveVault, YggdrasilVault, acc1.PubKeySet.Secp256k1, common.Chains{common.BNBChain}.Strings(), []ChainContract{})
+	ygg.AddFunds(common.Coins{
+		common.NewCoin(common.BNBAsset, cosmos.NewUint(100*common.One)),
+	})
+	c.Assert(w.keeper.SetVault(w.ctx, ygg), IsNil)
+
+	inTxID := GetRandomTxHash()
+	voter := NewObservedTxVoter(inTxID, ObservedTxs{
+		ObservedTx{
+			Tx:             GetRandomTx(),
+			Status:         types.Status_incomplete,
+			BlockHeight:    1,
+			Signers:        []string{w.activeNodeAccount.NodeAddress.String(), acc1.NodeAddress.String()},
+			FinaliseHeight: 1,
+		},
+	})
+	w.keeper.SetObservedTxInVoter(w.ctx, voter)
+	w.keeper.SetMimir(w.ctx, "AsgardOnly", w.ctx.BlockHeight())
+
+	// the yggdrasil vault can afford the outbound, but is retired
+	item := TxOutItem{
+		Chain:     common.BNBChain,
+		ToAddress: GetRandomBNBAddress(),
+		InHash:    inTxID,
+		Coin:      common.NewCoin(common.BNBAsset, cosmos.NewUint(10*common.One)),
+	}
+	ok, err := w.mgr.TxOutStore().TryAddTxOutItem(w.ctx, w.mgr, item, cosmos.ZeroUint())
+	c.Assert(err, IsNil)
+	c.Assert(ok, Equals, true)
+	msgs, err := w.mgr.TxOutStore().GetOutboundItems(w.ctx)
+	c.Assert(err, IsNil)
+	c.Assert(msgs, HasLen, 1)
+	c.Assert(msgs[0].VaultPubKey.Equals(ygg.PubKey), Equals, false)
+	c.Assert(msgs[0].VaultPubKey.Equals(vault.PubKey), Equals, true)
+}
+
 type TestCalcKeeper struct {
 	keeper.KVStoreDummy
 	value map[int64]cosmos.Uint
@@ -746,6 +793,56 @@ func (s TxOutStoreSuite) TestAddOutTxItemInteractionWithPool(c *C) {
 	c.Assert(pool.BalanceCacao.Equal(cosmos.NewUint(9999775005)), Equals, true, Commentf("%d", pool.BalanceCacao.Uint64()))
 }
 
+func (s TxOutStoreSuite) TestAddOutTxItemFeePaid(c *C) {
+	w := getHandlerTestWrapper(c, 1, true, true)
+	vault := GetRandomVault()
+	vault.Coins = common.Coins{
+		common.NewCoin(common.BNBAsset, cosmos.NewUint(100*common.One)),
+	}
+	c.Assert(w.keeper.SetVault(w.ctx, vault), IsNil)
+	poolBefore, err := w.keeper.GetPool(w.ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+
+	txOutStore := newTxOutStorageV104(w.keeper, w.mgr.GetConstants(), w.mgr.EventMgr(), w.mgr.GasMgr())
+	item := TxOutItem{
+		Chain:     common.BNBChain,
+		ToAddress: GetRandomBNBAddress(),
+		InHash:    GetRandomTxHash(),
+		Coin:      common.NewCoin(common.BNBAsset, cosmos.NewUint(20*common.One)),
+		FeePaid:   true,
+	}
+	success, err := txOutStore.TryAddTxOutItem(w.ctx, w.mgr, item, cosmos.ZeroUint())
+	c.Assert(err, IsNil)
+	c.Assert(success, Equals, true)
+
+	// a prepaid outbound is sent out in full, and the pool is left untouched
+	msgs, err := txOutStore.GetOutboundItems(w.ctx)
+	c.Assert(err, IsNil)
+	c.Assert(msgs, HasLen, 1)
+	c.Check(msgs[0].Coin.Amount.Uint64(), Equals, uint64(20*common.One))
+	pool, err := w.keeper.GetPool(w.ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(pool.BalanceAsset.Equal(poolBefore.BalanceAsset), Equals, true)
+	c.Check(pool.BalanceCacao.Equal(poolBefore.BalanceCacao), Equals, true)
+	withheld, err := w.keeper.GetOutboundFeeWithheldCacao(w.ctx, common.BNBChain)
+	c.Assert(err, IsNil)
+	c.Check(withheld.IsZero(), Equals, true)
+
+	// otherwise the fee is deducted and recorded as withheld on the chain
+	item.InHash = GetRandomTxHash()
+	item.FeePaid = false
+	success, err = txOutStore.TryAddTxOutItem(w.ctx, w.mgr, item, cosmos.ZeroUint())
+	c.Assert(err, IsNil)
+	c.Assert(success, Equals, true)
+	msgs, err = txOutStore.GetOutboundItems(w.ctx)
+	c.Assert(err, IsNil)
+	c.Assert(msgs, HasLen, 2)
+	c.Check(msgs[1].Coin.Amount.LT(cosmos.NewUint(20*common.One)), Equals, true)
+	withheld, err = w.keeper.GetOutboundFeeWithheldCacao(w.ctx, common.BNBChain)
+	c.Assert(err, IsNil)
+	c.Check(withheld.IsZero(), Equals, false)
+}
+
 func (s TxOutStoreSuite) TestAddOutTxItemSendingFromRetiredVault(c *C) {
 	SetupConfigForTest()
 	w := getHandlerTestWrapper(c, 1, true, true)
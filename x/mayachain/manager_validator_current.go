@@ -15,8 +15,8 @@ import (
 	"gitlab.com/mayachain/mayanode/x/mayachain/keeper"
 )
 
-// validatorMgrV102 is to manage a list of validators , and rotate them
-type validatorMgrV102 struct {
+// validatorMgrV106 is to manage a list of validators , and rotate them
+type validatorMgrV106 struct {
 	k                  keeper.Keeper
 	networkMgr         NetworkManager
 	txOutStore         TxOutStore
@@ -24,9 +24,9 @@ type validatorMgrV102 struct {
 	existingValidators []string
 }
 
-// newValidatorMgrV102 create a new instance of validatorMgrV102
-func newValidatorMgrV102(k keeper.Keeper, networkMgr NetworkManager, txOutStore TxOutStore, eventMgr EventManager) *validatorMgrV102 {
-	return &validatorMgrV102{
+// newValidatorMgrV106 create a new instance of validatorMgrV106
+func newValidatorMgrV106(k keeper.Keeper, networkMgr NetworkManager, txOutStore TxOutStore, eventMgr EventManager) *validatorMgrV106 {
+	return &validatorMgrV106{
 		k:          k,
 		networkMgr: networkMgr,
 		txOutStore: txOutStore,
@@ -35,7 +35,7 @@ func newValidatorMgrV102(k keeper.Keeper, networkMgr NetworkManager, txOutStore
 }
 
 // BeginBlock when block begin
-func (vm *validatorMgrV102) BeginBlock(ctx cosmos.Context, constAccessor constants.ConstantValues, existingValidators []string) error {
+func (vm *validatorMgrV106) BeginBlock(ctx cosmos.Context, constAccessor constants.ConstantValues, existingValidators []string) error {
 	vm.existingValidators = existingValidators
 	height := ctx.BlockHeight()
 	if height == genesisBlockHeight {
@@ -156,7 +156,7 @@ func (vm *validatorMgrV102) BeginBlock(ctx cosmos.Context, constAccessor constan
 
 // splits given list of node accounts into separate list of nas, for separate
 // asgard vaults
-func (vm *validatorMgrV102) splitNext(ctx cosmos.Context, nas NodeAccounts, asgardSize int64) []NodeAccounts {
+func (vm *validatorMgrV106) splitNext(ctx cosmos.Context, nas NodeAccounts, asgardSize int64) []NodeAccounts {
 	// calculate the number of asgard vaults we'll need to support the given
 	// list of node accounts
 	groupNum := int64(len(nas)) / asgardSize
@@ -215,7 +215,7 @@ func (vm *validatorMgrV102) splitNext(ctx cosmos.Context, nas NodeAccounts, asga
 }
 
 // EndBlock when block commit
-func (vm *validatorMgrV102) EndBlock(ctx cosmos.Context, mgr Manager) []abci.ValidatorUpdate {
+func (vm *validatorMgrV106) EndBlock(ctx cosmos.Context, mgr Manager) []abci.ValidatorUpdate {
 	height := ctx.BlockHeight()
 	activeNodes, err := vm.k.ListActiveValidators(ctx)
 	if err != nil {
@@ -370,7 +370,7 @@ func (vm *validatorMgrV102) EndBlock(ctx cosmos.Context, mgr Manager) []abci.Val
 // checkContractUpgrade for those chains that support smart contract, it the contract get changed , then the network have to recall all
 // the yggdrasil fund for chain, take ETH for example , if the smart contract used to process transactions on ETH chain get updated for some reason
 // then the network has to recall all the fund on ETH(include both ETH and ERC20)
-func (vm *validatorMgrV102) checkContractUpgrade(ctx cosmos.Context, mgr Manager, removedNodeKeys common.PubKeys) error {
+func (vm *validatorMgrV106) checkContractUpgrade(ctx cosmos.Context, mgr Manager, removedNodeKeys common.PubKeys) error {
 	activeVaults, err := vm.k.GetAsgardVaultsByStatus(ctx, ActiveVault)
 	if err != nil {
 		return fmt.Errorf("fail to get active asgards: %w", err)
@@ -416,7 +416,7 @@ func (vm *validatorMgrV102) checkContractUpgrade(ctx cosmos.Context, mgr Manager
 
 // getChangedNodes to identify which node had been removed ,and which one had been added
 // newNodes , removed nodes,err
-func (vm *validatorMgrV102) getChangedNodes(ctx cosmos.Context, activeNodes NodeAccounts) (NodeAccounts, NodeAccounts, error) {
+func (vm *validatorMgrV106) getChangedNodes(ctx cosmos.Context, activeNodes NodeAccounts) (NodeAccounts, NodeAccounts, error) {
 	var newActive NodeAccounts    // store the list of new active users
 	var removedNodes NodeAccounts // nodes that had been removed
 
@@ -467,7 +467,7 @@ func (vm *validatorMgrV102) getChangedNodes(ctx cosmos.Context, activeNodes Node
 }
 
 // payNodeAccountBondAward pay
-func (vm *validatorMgrV102) payNodeAccountBondAward(ctx cosmos.Context, lastChurnHeight int64, na NodeAccount, totalBondReward, totalEffectiveBond, bondHardCap cosmos.Uint, mgr Manager, liquidityBond sdk.Uint) error {
+func (vm *validatorMgrV106) payNodeAccountBondAward(ctx cosmos.Context, lastChurnHeight int64, na NodeAccount, totalBondReward, totalEffectiveBond, bondHardCap cosmos.Uint, mgr Manager, liquidityBond sdk.Uint) error {
 	if na.ActiveBlockHeight == 0 && liquidityBond.IsZero() {
 		return nil
 	}
@@ -501,8 +501,10 @@ func (vm *validatorMgrV102) payNodeAccountBondAward(ctx cosmos.Context, lastChur
 	reward := totalBondReward.QuoUint64(uint64(len(activeNodes)))
 	reward = common.GetUncappedShare(cosmos.NewUint(uint64(earnedBlocks)), cosmos.NewUint(uint64(totalActiveBlocks)), reward)
 
-	// Add to their rewards the amount rewarded
-	na.Reward = na.Reward.Add(reward)
+	// Pay the reward straight to the bond providers of the node
+	if err := payBondProviderShares(ctx, vm.k, vm.eventMgr, na, reward); err != nil {
+		return fmt.Errorf("fail to pay bond providers: %w", err)
+	}
 
 	// Minus the number of rune THORNode have awarded them
 	network.BondRewardRune = common.SafeSub(network.BondRewardRune, reward)
@@ -531,7 +533,7 @@ func (vm *validatorMgrV102) payNodeAccountBondAward(ctx cosmos.Context, lastChur
 }
 
 // determines when/if to run each part of the ragnarok process
-func (vm *validatorMgrV102) processRagnarok(ctx cosmos.Context, mgr Manager) error {
+func (vm *validatorMgrV106) processRagnarok(ctx cosmos.Context, mgr Manager) error {
 	// execute Ragnarok protocol, no going back
 	// THORNode have to request the fund back now, because once it get to the rotate block height ,
 	// THORNode won't have validators anymore
@@ -612,7 +614,7 @@ func (vm *validatorMgrV102) processRagnarok(ctx cosmos.Context, mgr Manager) err
 	return nil
 }
 
-func (vm *validatorMgrV102) getPendingTxOut(ctx cosmos.Context, constAccessor constants.ConstantValues) (int64, error) {
+func (vm *validatorMgrV106) getPendingTxOut(ctx cosmos.Context, constAccessor constants.ConstantValues) (int64, error) {
 	signingTransactionPeriod := constAccessor.GetInt64Value(constants.SigningTransactionPeriod)
 	startHeight := ctx.BlockHeight() - signingTransactionPeriod
 	count := int64(0)
@@ -631,7 +633,7 @@ func (vm *validatorMgrV102) getPendingTxOut(ctx cosmos.Context, constAccessor co
 	return count, nil
 }
 
-func (vm *validatorMgrV102) ragnarokBondReward(ctx cosmos.Context, mgr Manager) error {
+func (vm *validatorMgrV106) ragnarokBondReward(ctx cosmos.Context, mgr Manager) error {
 	var resultErr error
 	active, err := vm.k.ListActiveValidators(ctx)
 	if err != nil {
@@ -687,7 +689,7 @@ func (vm *validatorMgrV102) ragnarokBondReward(ctx cosmos.Context, mgr Manager)
 	return resultErr
 }
 
-func (vm *validatorMgrV102) ragnarokPools(ctx cosmos.Context, nth int64, mgr Manager) error {
+func (vm *validatorMgrV106) ragnarokPools(ctx cosmos.Context, nth int64, mgr Manager) error {
 	nas, err := vm.k.ListActiveValidators(ctx)
 	if err != nil {
 		return fmt.Errorf("fail to get active nodes: %w", err)
@@ -831,7 +833,7 @@ Pool:
 }
 
 // RequestYggReturn request the node that had been removed (yggdrasil) to return their fund
-func (vm *validatorMgrV102) RequestYggReturn(ctx cosmos.Context, node NodeAccount, mgr Manager) error {
+func (vm *validatorMgrV106) RequestYggReturn(ctx cosmos.Context, node NodeAccount, mgr Manager) error {
 	if !vm.k.VaultExists(ctx, node.PubKeySet.Secp256k1) {
 		return nil
 	}
@@ -905,7 +907,7 @@ func (vm *validatorMgrV102) RequestYggReturn(ctx cosmos.Context, node NodeAccoun
 	return nil
 }
 
-func (vm *validatorMgrV102) recallYggFunds(ctx cosmos.Context, mgr Manager) error {
+func (vm *validatorMgrV106) recallYggFunds(ctx cosmos.Context, mgr Manager) error {
 	iter := vm.k.GetVaultIterator(ctx)
 	defer iter.Close()
 	vaults := Vaults{}
@@ -938,7 +940,7 @@ func (vm *validatorMgrV102) recallYggFunds(ctx cosmos.Context, mgr Manager) erro
 }
 
 // setupValidatorNodes it is one off it only get called when genesis
-func (vm *validatorMgrV102) setupValidatorNodes(ctx cosmos.Context, height int64, constAccessor constants.ConstantValues) error {
+func (vm *validatorMgrV106) setupValidatorNodes(ctx cosmos.Context, height int64, constAccessor constants.ConstantValues) error {
 	if height != genesisBlockHeight {
 		ctx.Logger().Info("only need to setup validator node when start up", "height", height)
 		return nil
@@ -987,7 +989,7 @@ func (vm *validatorMgrV102) setupValidatorNodes(ctx cosmos.Context, height int64
 	return nil
 }
 
-func (vm *validatorMgrV102) getLastChurnHeight(ctx cosmos.Context) int64 {
+func (vm *validatorMgrV106) getLastChurnHeight(ctx cosmos.Context) int64 {
 	vaults, err := vm.k.GetAsgardVaultsByStatus(ctx, ActiveVault)
 	if err != nil {
 		ctx.Logger().Error("Failed to get Asgard vaults", "error", err)
@@ -1003,7 +1005,7 @@ func (vm *validatorMgrV102) getLastChurnHeight(ctx cosmos.Context) int64 {
 	return lastChurnHeight
 }
 
-func (vm *validatorMgrV102) getScore(ctx cosmos.Context, slashPts, lastChurnHeight int64) cosmos.Uint {
+func (vm *validatorMgrV106) getScore(ctx cosmos.Context, slashPts, lastChurnHeight int64) cosmos.Uint {
 	// get to the 8th decimal point, but keep numbers integers for safer math
 	score := cosmos.NewUint(uint64((ctx.BlockHeight() - lastChurnHeight) * common.One))
 	if slashPts == 0 {
@@ -1013,7 +1015,7 @@ func (vm *validatorMgrV102) getScore(ctx cosmos.Context, slashPts, lastChurnHeig
 }
 
 // Iterate over active node accounts, finding bad actors with high slash points
-func (vm *validatorMgrV102) findBadActors(ctx cosmos.Context, minSlashPointsForBadValidator, badValidatorRedline int64) (NodeAccounts, error) {
+func (vm *validatorMgrV106) findBadActors(ctx cosmos.Context, minSlashPointsForBadValidator, badValidatorRedline int64) (NodeAccounts, error) {
 	badActors := make(NodeAccounts, 0)
 	nas, err := vm.k.ListActiveValidators(ctx)
 	if err != nil {
@@ -1111,7 +1113,7 @@ func (vm *validatorMgrV102) findBadActors(ctx cosmos.Context, minSlashPointsForB
 }
 
 // Iterate over active node accounts, finding the one that has been active longest
-func (vm *validatorMgrV102) findOldActor(ctx cosmos.Context) (NodeAccount, error) {
+func (vm *validatorMgrV106) findOldActor(ctx cosmos.Context) (NodeAccount, error) {
 	na := NodeAccount{}
 	nas, err := vm.k.ListActiveValidators(ctx)
 	if err != nil {
@@ -1146,7 +1148,7 @@ func (vm *validatorMgrV102) findOldActor(ctx cosmos.Context) (NodeAccount, error
 }
 
 // Iterate over active node accounts, finding the one that has the lowest bond
-func (vm *validatorMgrV102) findLowBondActor(ctx cosmos.Context) (NodeAccount, error) {
+func (vm *validatorMgrV106) findLowBondActor(ctx cosmos.Context) (NodeAccount, error) {
 	na := NodeAccount{}
 	nas, err := vm.k.ListActiveValidators(ctx)
 	if err != nil {
@@ -1193,7 +1195,7 @@ func (vm *validatorMgrV102) findLowBondActor(ctx cosmos.Context) (NodeAccount, e
 }
 
 // Mark an old to be churned out
-func (vm *validatorMgrV102) markActor(ctx cosmos.Context, na NodeAccount, reason string) error {
+func (vm *validatorMgrV106) markActor(ctx cosmos.Context, na NodeAccount, reason string) error {
 	if !na.IsEmpty() && na.LeaveScore == 0 {
 		ctx.Logger().Info("marked Validator to be churned out", "node address", na.NodeAddress, "reason", reason)
 		slashPts, err := vm.k.GetNodeAccountSlashPoints(ctx, na.NodeAddress)
@@ -1207,7 +1209,7 @@ func (vm *validatorMgrV102) markActor(ctx cosmos.Context, na NodeAccount, reason
 }
 
 // Mark an old actor to be churned out
-func (vm *validatorMgrV102) markOldActor(ctx cosmos.Context) error {
+func (vm *validatorMgrV106) markOldActor(ctx cosmos.Context) error {
 	na, err := vm.findOldActor(ctx)
 	if err != nil {
 		return err
@@ -1219,7 +1221,7 @@ func (vm *validatorMgrV102) markOldActor(ctx cosmos.Context) error {
 }
 
 // Mark an low bond actor to be churned out
-func (vm *validatorMgrV102) markLowBondActor(ctx cosmos.Context) error {
+func (vm *validatorMgrV106) markLowBondActor(ctx cosmos.Context) error {
 	na, err := vm.findLowBondActor(ctx)
 	if err != nil {
 		return err
@@ -1231,7 +1233,7 @@ func (vm *validatorMgrV102) markLowBondActor(ctx cosmos.Context) error {
 }
 
 // Mark a bad actor to be churned out
-func (vm *validatorMgrV102) markBadActor(ctx cosmos.Context, minSlashPointsForBadValidator, redline int64) error {
+func (vm *validatorMgrV106) markBadActor(ctx cosmos.Context, minSlashPointsForBadValidator, redline int64) error {
 	nas, err := vm.findBadActors(ctx, minSlashPointsForBadValidator, redline)
 	if err != nil {
 		return err
@@ -1247,7 +1249,7 @@ func (vm *validatorMgrV102) markBadActor(ctx cosmos.Context, minSlashPointsForBa
 // Mark up to `MaxNodeToChurnOutForLowVersion` nodes as low version
 // This will slate them to churn out. `MaxNodeToChurnOutForLowVersion`
 // is a Mimir setting that defaults in constants to 1
-func (vm *validatorMgrV102) markLowVersionValidators(ctx cosmos.Context, constAccessor constants.ConstantValues) error {
+func (vm *validatorMgrV106) markLowVersionValidators(ctx cosmos.Context, constAccessor constants.ConstantValues) error {
 	// Get max number of nodes to mark as low version
 	maxNodes, err := vm.k.GetMimir(ctx, constants.MaxNodeToChurnOutForLowVersion.String())
 	if maxNodes < 0 || err != nil {
@@ -1269,7 +1271,7 @@ func (vm *validatorMgrV102) markLowVersionValidators(ctx cosmos.Context, constAc
 }
 
 // Finds up to `maxNodesToFind` active validators with version lower than the most "popular" version
-func (vm *validatorMgrV102) findLowVersionValidators(ctx cosmos.Context, maxNodesToFind int64) (NodeAccounts, error) {
+func (vm *validatorMgrV106) findLowVersionValidators(ctx cosmos.Context, maxNodesToFind int64) (NodeAccounts, error) {
 	minimumVersion := vm.k.GetMinJoinVersion(ctx)
 	activeNodes, err := vm.k.ListValidatorsByStatus(ctx, NodeActive)
 	if err != nil {
@@ -1307,7 +1309,7 @@ func (vm *validatorMgrV102) findLowVersionValidators(ctx cosmos.Context, maxNode
 }
 
 // find any actor that are ready to become "ready" status
-func (vm *validatorMgrV102) markReadyActors(ctx cosmos.Context, constAccessor constants.ConstantValues) error {
+func (vm *validatorMgrV106) markReadyActors(ctx cosmos.Context, constAccessor constants.ConstantValues) error {
 	standby, err := vm.k.ListValidatorsByStatus(ctx, NodeStandby)
 	if err != nil {
 		return err
@@ -1331,7 +1333,7 @@ func (vm *validatorMgrV102) markReadyActors(ctx cosmos.Context, constAccessor co
 }
 
 // NodeAccountPreflightCheck preflight check to find out what the node account's next status will be
-func (vm *validatorMgrV102) NodeAccountPreflightCheck(ctx cosmos.Context, na NodeAccount, constAccessor constants.ConstantValues) (NodeStatus, error) {
+func (vm *validatorMgrV106) NodeAccountPreflightCheck(ctx cosmos.Context, na NodeAccount, constAccessor constants.ConstantValues) (NodeStatus, error) {
 	// ensure banned nodes can't get churned in again
 	if na.ForcedToLeave {
 		return NodeDisabled, fmt.Errorf("node account has been banned")
@@ -1407,7 +1409,7 @@ func (vm *validatorMgrV102) NodeAccountPreflightCheck(ctx cosmos.Context, na Nod
 }
 
 // Returns a list of nodes to include in the next pool
-func (vm *validatorMgrV102) nextVaultNodeAccounts(ctx cosmos.Context, targetCount int, constAccessor constants.ConstantValues) (NodeAccounts, bool, error) {
+func (vm *validatorMgrV106) nextVaultNodeAccounts(ctx cosmos.Context, targetCount int, constAccessor constants.ConstantValues) (NodeAccounts, bool, error) {
 	rotation := false // track if are making any changes to the current active node accounts
 
 	// update list of ready actors
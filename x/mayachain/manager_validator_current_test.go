@@ -8,19 +8,19 @@ import (
 	"gitlab.com/mayachain/mayanode/constants"
 )
 
-type ValidatorMgrV102TestSuite struct{}
+type ValidatorMgrV106TestSuite struct{}
 
-var _ = Suite(&ValidatorMgrV102TestSuite{})
+var _ = Suite(&ValidatorMgrV106TestSuite{})
 
-func (vts *ValidatorMgrV102TestSuite) SetUpSuite(_ *C) {
+func (vts *ValidatorMgrV106TestSuite) SetUpSuite(_ *C) {
 	SetupConfigForTest()
 }
 
-func (vts *ValidatorMgrV102TestSuite) TestSetupValidatorNodes(c *C) {
+func (vts *ValidatorMgrV106TestSuite) TestSetupValidatorNodes(c *C) {
 	ctx, k := setupKeeperForTest(c)
 	ctx = ctx.WithBlockHeight(1)
 	mgr := NewDummyMgr()
-	networkMgr := newValidatorMgrV102(k, mgr.NetworkMgr(), mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newValidatorMgrV106(k, mgr.NetworkMgr(), mgr.TxOutStore(), mgr.EventMgr())
 	c.Assert(networkMgr, NotNil)
 	ver := GetCurrentVersion()
 	constAccessor := constants.GetConstantValues(ver)
@@ -42,7 +42,7 @@ func (vts *ValidatorMgrV102TestSuite) TestSetupValidatorNodes(c *C) {
 
 	// one active node and one ready node on start up
 	// it should take both of the node as active
-	networkMgr1 := newValidatorMgrV102(k, mgr.NetworkMgr(), mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr1 := newValidatorMgrV106(k, mgr.NetworkMgr(), mgr.TxOutStore(), mgr.EventMgr())
 
 	c.Assert(networkMgr1.BeginBlock(ctx, constAccessor, nil), IsNil)
 	activeNodes, err := k.ListActiveValidators(ctx)
@@ -55,7 +55,7 @@ func (vts *ValidatorMgrV102TestSuite) TestSetupValidatorNodes(c *C) {
 	c.Assert(k.SetNodeAccount(ctx, activeNode2), IsNil)
 
 	// three active nodes and 1 ready nodes, it should take them all
-	networkMgr2 := newValidatorMgrV102(k, mgr.NetworkMgr(), mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr2 := newValidatorMgrV106(k, mgr.NetworkMgr(), mgr.TxOutStore(), mgr.EventMgr())
 	c.Assert(networkMgr2.BeginBlock(ctx, constAccessor, nil), IsNil)
 
 	activeNodes1, err := k.ListActiveValidators(ctx)
@@ -63,9 +63,9 @@ func (vts *ValidatorMgrV102TestSuite) TestSetupValidatorNodes(c *C) {
 	c.Assert(len(activeNodes1) == 4, Equals, true)
 }
 
-func (vts *ValidatorMgrV102TestSuite) TestRagnarokForChaosnet(c *C) {
+func (vts *ValidatorMgrV106TestSuite) TestRagnarokForChaosnet(c *C) {
 	ctx, mgr := setupManagerForTest(c)
-	networkMgr := newValidatorMgrV102(mgr.Keeper(), mgr.NetworkMgr(), mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newValidatorMgrV106(mgr.Keeper(), mgr.NetworkMgr(), mgr.TxOutStore(), mgr.EventMgr())
 
 	mgr.constAccessor = constants.NewDummyConstants(map[constants.ConstantName]int64{
 		constants.DesiredValidatorSet:           12,
@@ -109,7 +109,7 @@ func (vts *ValidatorMgrV102TestSuite) TestRagnarokForChaosnet(c *C) {
 	c.Assert(ragnarokHeight == 1024, Equals, true, Commentf("%d == %d", ragnarokHeight, 1024))
 }
 
-func (vts *ValidatorMgrV102TestSuite) TestLowerVersion(c *C) {
+func (vts *ValidatorMgrV106TestSuite) TestLowerVersion(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 	ctx = ctx.WithBlockHeight(1440)
 
@@ -125,7 +125,7 @@ func (vts *ValidatorMgrV102TestSuite) TestLowerVersion(c *C) {
 		constants.StrictBondLiquidityRatio: false,
 	}, map[constants.ConstantName]string{})
 
-	networkMgr := newValidatorMgrV102(mgr.Keeper(), mgr.NetworkMgr(), mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newValidatorMgrV106(mgr.Keeper(), mgr.NetworkMgr(), mgr.TxOutStore(), mgr.EventMgr())
 	c.Assert(networkMgr, NotNil)
 	c.Assert(networkMgr.markLowVersionValidators(ctx, constAccessor), IsNil)
 
@@ -181,11 +181,11 @@ func (vts *ValidatorMgrV102TestSuite) TestLowerVersion(c *C) {
 	c.Assert(markedCount, Equals, 3)
 }
 
-func (vts *ValidatorMgrV102TestSuite) TestBadActors(c *C) {
+func (vts *ValidatorMgrV106TestSuite) TestBadActors(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 	ctx = ctx.WithBlockHeight(1000)
 
-	networkMgr := newValidatorMgrV102(mgr.Keeper(), mgr.NetworkMgr(), mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newValidatorMgrV106(mgr.Keeper(), mgr.NetworkMgr(), mgr.TxOutStore(), mgr.EventMgr())
 	c.Assert(networkMgr, NotNil)
 
 	// no bad actors with active node accounts
@@ -236,11 +236,11 @@ func (vts *ValidatorMgrV102TestSuite) TestBadActors(c *C) {
 	c.Check(count, Equals, 2)
 }
 
-func (vts *ValidatorMgrV102TestSuite) TestFindBadActors(c *C) {
+func (vts *ValidatorMgrV106TestSuite) TestFindBadActors(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 	ctx = ctx.WithBlockHeight(1000)
 
-	networkMgr := newValidatorMgrV102(mgr.Keeper(), mgr.NetworkMgr(), mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newValidatorMgrV106(mgr.Keeper(), mgr.NetworkMgr(), mgr.TxOutStore(), mgr.EventMgr())
 	c.Assert(networkMgr, NotNil)
 
 	activeNode := GetRandomValidatorNode(NodeActive)
@@ -280,11 +280,11 @@ func (vts *ValidatorMgrV102TestSuite) TestFindBadActors(c *C) {
 	c.Assert(nodeAccounts.Contains(activeNode3), Equals, true)
 }
 
-func (vts *ValidatorMgrV102TestSuite) TestFindLowBondActor(c *C) {
+func (vts *ValidatorMgrV106TestSuite) TestFindLowBondActor(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 	ctx = ctx.WithBlockHeight(1000)
 
-	networkMgr := newValidatorMgrV102(mgr.Keeper(), mgr.NetworkMgr(), mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newValidatorMgrV106(mgr.Keeper(), mgr.NetworkMgr(), mgr.TxOutStore(), mgr.EventMgr())
 	c.Assert(networkMgr, NotNil)
 
 	na := GetRandomValidatorNode(NodeActive)
@@ -341,13 +341,13 @@ func (vts *ValidatorMgrV102TestSuite) TestFindLowBondActor(c *C) {
 	c.Assert(naBond.IsZero(), Equals, true)
 }
 
-func (vts *ValidatorMgrV102TestSuite) TestGetChangedNodes(c *C) {
+func (vts *ValidatorMgrV106TestSuite) TestGetChangedNodes(c *C) {
 	ctx, k := setupKeeperForTest(c)
 	ctx = ctx.WithBlockHeight(1)
 	ver := GetCurrentVersion()
 
 	mgr := NewDummyMgrWithKeeper(k)
-	networkMgr := newValidatorMgrV102(k, mgr.NetworkMgr(), mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newValidatorMgrV106(k, mgr.NetworkMgr(), mgr.TxOutStore(), mgr.EventMgr())
 	c.Assert(networkMgr, NotNil)
 
 	constAccessor := constants.GetConstantValues(ver)
@@ -380,10 +380,10 @@ func (vts *ValidatorMgrV102TestSuite) TestGetChangedNodes(c *C) {
 	c.Assert(removedNodes, HasLen, 1)
 }
 
-func (vts *ValidatorMgrV102TestSuite) TestSplitNext(c *C) {
+func (vts *ValidatorMgrV106TestSuite) TestSplitNext(c *C) {
 	ctx, k := setupKeeperForTest(c)
 	mgr := NewDummyMgr()
-	networkMgr := newValidatorMgrV102(k, mgr.NetworkMgr(), mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newValidatorMgrV106(k, mgr.NetworkMgr(), mgr.TxOutStore(), mgr.EventMgr())
 	c.Assert(networkMgr, NotNil)
 
 	nas := make(NodeAccounts, 0)
@@ -446,7 +446,7 @@ func (vts *ValidatorMgrV102TestSuite) TestSplitNext(c *C) {
 	c.Assert(sets[0], HasLen, 3)
 }
 
-func (vts *ValidatorMgrV102TestSuite) TestFindCounToRemove(c *C) {
+func (vts *ValidatorMgrV106TestSuite) TestFindCounToRemove(c *C) {
 	// remove one
 	c.Check(findCountToRemove(0, NodeAccounts{
 		NodeAccount{LeaveScore: 12},
@@ -497,7 +497,7 @@ func (vts *ValidatorMgrV102TestSuite) TestFindCounToRemove(c *C) {
 	}), Equals, 3)
 }
 
-func (vts *ValidatorMgrV102TestSuite) TestFindMaxAbleToLeave(c *C) {
+func (vts *ValidatorMgrV106TestSuite) TestFindMaxAbleToLeave(c *C) {
 	c.Check(findMaxAbleToLeave(-1), Equals, 0)
 	c.Check(findMaxAbleToLeave(0), Equals, 0)
 	c.Check(findMaxAbleToLeave(1), Equals, 0)
@@ -515,10 +515,10 @@ func (vts *ValidatorMgrV102TestSuite) TestFindMaxAbleToLeave(c *C) {
 	c.Check(findMaxAbleToLeave(12), Equals, 3)
 }
 
-func (vts *ValidatorMgrV102TestSuite) TestFindNextVaultNodeAccounts(c *C) {
+func (vts *ValidatorMgrV106TestSuite) TestFindNextVaultNodeAccounts(c *C) {
 	ctx, k := setupKeeperForTest(c)
 	mgr := NewDummyMgrWithKeeper(k)
-	networkMgr := newValidatorMgrV102(k, mgr.NetworkMgr(), mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newValidatorMgrV106(k, mgr.NetworkMgr(), mgr.TxOutStore(), mgr.EventMgr())
 	c.Assert(networkMgr, NotNil)
 	ver := GetCurrentVersion()
 	constAccessor := constants.GetConstantValues(ver)
@@ -542,10 +542,10 @@ func (vts *ValidatorMgrV102TestSuite) TestFindNextVaultNodeAccounts(c *C) {
 	c.Assert(nasAfter, HasLen, 10)
 }
 
-func (vts *ValidatorMgrV102TestSuite) TestFindNextVaultNodeAccountsMax(c *C) {
+func (vts *ValidatorMgrV106TestSuite) TestFindNextVaultNodeAccountsMax(c *C) {
 	// test that we don't exceed the targetCount
 	ctx, mgr := setupManagerForTest(c)
-	networkMgr := newValidatorMgrV102(mgr.Keeper(), mgr.NetworkMgr(), mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newValidatorMgrV106(mgr.Keeper(), mgr.NetworkMgr(), mgr.TxOutStore(), mgr.EventMgr())
 	c.Assert(networkMgr, NotNil)
 	// create active nodes
 	for i := 0; i < 12; i++ {
@@ -580,12 +580,12 @@ func (vts *ValidatorMgrV102TestSuite) TestFindNextVaultNodeAccountsMax(c *C) {
 	c.Assert(nasAfter, HasLen, 12, Commentf("%d", len(nasAfter)))
 }
 
-func (vts *ValidatorMgrV102TestSuite) TestWeightedBondReward(c *C) {
+func (vts *ValidatorMgrV106TestSuite) TestWeightedBondReward(c *C) {
 	ctx, k := setupKeeperForTest(c)
 	ctx = ctx.WithBlockHeight(20)
 
 	mgr := NewDummyMgrWithKeeper(k)
-	networkMgr := newValidatorMgrV102(k, mgr.NetworkMgr(), mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newValidatorMgrV106(k, mgr.NetworkMgr(), mgr.TxOutStore(), mgr.EventMgr())
 	c.Assert(networkMgr, NotNil)
 	mgr.Keeper().SetMimir(ctx, "MinimumBondInCacao", 100_000_00000000)
 
@@ -614,21 +614,25 @@ func (vts *ValidatorMgrV102TestSuite) TestWeightedBondReward(c *C) {
 	SetupLiquidityBondForTest(c, ctx, k, common.BTCAsset, na1.BondAddress, na1, cosmos.NewUint(3*common.One))
 	SetupLiquidityBondForTest(c, ctx, k, common.BTCAsset, na2.BondAddress, na2, cosmos.NewUint(2*common.One))
 	SetupLiquidityBondForTest(c, ctx, k, common.BTCAsset, na3.BondAddress, na3, cosmos.NewUint(1*common.One))
+	FundModule(c, ctx, k, BondName, 9)
 
 	// pay out bond rewards
 	c.Assert(networkMgr.ragnarokBondReward(ctx, mgr), IsNil)
 
-	na1, _ = mgr.Keeper().GetNodeAccount(ctx, na1.NodeAddress)
-	na2, _ = mgr.Keeper().GetNodeAccount(ctx, na2.NodeAddress)
-	na3, _ = mgr.Keeper().GetNodeAccount(ctx, na3.NodeAddress)
-
-	// There's no reward hard cap. na1, na2, na3 should have the same reward
-	c.Check(na1.Reward.Uint64(), Equals, uint64(3*common.One), Commentf("expected %d, got %d", 3*common.One, na1.Reward.Uint64()))
-	c.Check(na2.Reward.Uint64(), Equals, uint64(3*common.One), Commentf("expected %d, got %d", 3*common.One, na2.Reward.Uint64()))
-	c.Check(na3.Reward.Uint64(), Equals, uint64(3*common.One), Commentf("expected %d, got %d", 3*common.One, na3.Reward.Uint64()))
+	// bond rewards are paid straight to the bond providers, there's no reward
+	// hard cap, so na1, na2, na3 should have the same reward
+	for _, na := range []NodeAccount{na1, na2, na3} {
+		addr, err := na.BondAddress.AccAddress()
+		c.Assert(err, IsNil)
+		balance := k.GetBalance(ctx, addr).AmountOf(common.BaseNative.Native())
+		c.Check(balance.Uint64(), Equals, uint64(3*common.One), Commentf("expected %d, got %d", 3*common.One, balance.Uint64()))
+		na, err = k.GetNodeAccount(ctx, na.NodeAddress)
+		c.Assert(err, IsNil)
+		c.Check(na.Reward.IsZero(), Equals, true)
+	}
 }
 
-func (vts *ValidatorMgrV102TestSuite) TestActiveNodeRequestToLeaveShouldBeStandby(c *C) {
+func (vts *ValidatorMgrV106TestSuite) TestActiveNodeRequestToLeaveShouldBeStandby(c *C) {
 	var err error
 	ctx, mgr := setupManagerForTest(c)
 	ctx = ctx.WithBlockHeight(10)
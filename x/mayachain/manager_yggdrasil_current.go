@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/blang/semver"
+
 	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
 	"gitlab.com/mayachain/mayanode/constants"
@@ -11,7 +13,20 @@ import (
 	kvTypes "gitlab.com/mayachain/mayanode/x/mayachain/keeper/types"
 )
 
-var mimirStopFundYggdrasil = `StopFundYggdrasil`
+var (
+	mimirStopFundYggdrasil = `StopFundYggdrasil`
+	mimirAsgardOnly        = `AsgardOnly`
+)
+
+// isAsgardOnly returns true once the yggdrasil vaults are retired, their funds
+// recalled to asgard and outbounds sent from asgard only
+func isAsgardOnly(ctx cosmos.Context, k keeper.Keeper) bool {
+	if k.GetVersion().LT(semver.MustParse("1.106.0")) {
+		return false
+	}
+	asgardOnly, err := k.GetMimir(ctx, mimirAsgardOnly)
+	return err == nil && asgardOnly > 0 && asgardOnly <= ctx.BlockHeight()
+}
 
 // YggMgrV79 is an implementation of YggManager
 type YggMgrV79 struct {
@@ -35,6 +50,13 @@ func (ymgr YggMgrV79) Fund(ctx cosmos.Context, mgr Manager) error {
 	if ragnarokHeight > 0 {
 		return nil
 	}
+	if isAsgardOnly(ctx, ymgr.keeper) {
+		// check abandon yggdrasil
+		if err := ymgr.abandonYggdrasilVaults(ctx, mgr); err != nil {
+			ctx.Logger().Error("fail to check whether need to abandon yggdrasil vault", "error", err)
+		}
+		return ymgr.recallYggdrasilFunds(ctx, mgr)
+	}
 	stopFundYggdrasil, err := mgr.Keeper().GetMimir(ctx, mimirStopFundYggdrasil)
 	if err == nil && stopFundYggdrasil > 0 {
 		ctx.Logger().Info("mimir stop fund yggdrasil")
@@ -199,6 +221,51 @@ func (ymgr YggMgrV79) Fund(ctx cosmos.Context, mgr Manager) error {
 	return nil
 }
 
+// recallYggdrasilFunds requests the yggdrasil vaults that still have funds to
+// return them to asgard, and requests it again if they haven't after
+// YggFundRetry blocks
+func (ymgr YggMgrV79) recallYggdrasilFunds(ctx cosmos.Context, mgr Manager) error {
+	maxBlock, err := ymgr.keeper.GetMimir(ctx, constants.YggFundRetry.String())
+	if maxBlock < 0 || err != nil {
+		maxBlock = mgr.GetConstants().GetInt64Value(constants.YggFundRetry)
+	}
+	vaultIter := ymgr.keeper.GetVaultIterator(ctx)
+	defer vaultIter.Close()
+	var yggs Vaults
+	for ; vaultIter.Valid(); vaultIter.Next() {
+		var v Vault
+		if err := ymgr.keeper.Cdc().Unmarshal(vaultIter.Value(), &v); err != nil {
+			ctx.Logger().Error("fail to unmarshal vault", "error", err)
+			continue
+		}
+		if !v.IsYggdrasil() || !v.HasFunds() {
+			continue
+		}
+		if v.LenPendingTxBlockHeights(ctx.BlockHeight(), maxBlock) > 0 {
+			// a return has been requested recently
+			continue
+		}
+		yggs = append(yggs, v)
+	}
+
+	for _, ygg := range yggs {
+		na, err := ymgr.keeper.GetNodeAccountByPubKey(ctx, ygg.PubKey)
+		if err != nil {
+			ctx.Logger().Error("fail to get node account by pub key", "error", err, "pubkey", ygg.PubKey)
+			continue
+		}
+		if err := mgr.ValidatorMgr().RequestYggReturn(ctx, na, mgr); err != nil {
+			ctx.Logger().Error("fail to request yggdrasil return", "error", err, "pubkey", ygg.PubKey)
+			continue
+		}
+		ygg.AppendPendingTxBlockHeights(ctx.BlockHeight(), mgr.GetConstants())
+		if err := ymgr.keeper.SetVault(ctx, ygg); err != nil {
+			return fmt.Errorf("fail to save yggdrasil vault: %w", err)
+		}
+	}
+	return nil
+}
+
 // sendCoinsToYggdrasil - adds outbound txs to send the given coins to a
 // yggdrasil pool
 func (ymgr YggMgrV79) sendCoinsToYggdrasil(ctx cosmos.Context, coins common.Coins, ygg Vault, mgr Manager) (int, error) {
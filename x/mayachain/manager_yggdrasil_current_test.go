@@ -423,6 +423,43 @@ func (s YggdrasilManagerV79Suite) TestAbandonYggdrasil(c *C) {
 	c.Check(naDisabledBond.Equal(cosmos.NewUint(162500*common.One)), Equals, true, Commentf("expected %d, got %d", 162500*common.One, naDisabledBond.Uint64()))
 }
 
+func (s YggdrasilManagerV79Suite) TestFundAsgardOnly(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	vault := GetRandomVault()
+	vault.Coins = common.Coins{
+		common.NewCoin(common.BaseAsset(), cosmos.NewUint(10000*common.One)),
+		common.NewCoin(common.BNBAsset, cosmos.NewUint(10000*common.One)),
+	}
+	c.Assert(mgr.Keeper().SetVault(ctx, vault), IsNil)
+
+	na := GetRandomValidatorNode(NodeActive)
+	c.Assert(mgr.Keeper().SetNodeAccount(ctx, na), IsNil)
+	ygg := NewVault(ctx.BlockHeight(), ActiveVault, YggdrasilVault, na.PubKeySet.Secp256k1, common.Chains{common.BNBChain}.Strings(), []ChainContract{})
+	ygg.AddFunds(common.Coins{
+		common.NewCoin(common.BNBAsset, cosmos.NewUint(100*common.One)),
+	})
+	c.Assert(mgr.Keeper().SetVault(ctx, ygg), IsNil)
+	mgr.Keeper().SetMimir(ctx, "AsgardOnly", ctx.BlockHeight())
+
+	// the yggdrasil vault is requested to return its funds
+	ymgr := newYggMgrV79(mgr.Keeper())
+	c.Assert(ymgr.Fund(ctx, mgr), IsNil)
+	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].VaultPubKey.Equals(ygg.PubKey), Equals, true)
+	c.Check(items[0].Memo, Equals, NewYggdrasilReturn(ctx.BlockHeight()).String())
+	ygg, err = mgr.Keeper().GetVault(ctx, ygg.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(ygg.PendingTxBlockHeights, DeepEquals, []int64{ctx.BlockHeight()})
+
+	// the return isn't requested again while the previous one is pending
+	c.Assert(ymgr.Fund(ctx, mgr), IsNil)
+	items, err = mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+}
+
 type abandonYggdrasilTestHelper struct {
 	keeper.Keeper
 	failToGetAsgardVaultByStatus bool
@@ -349,6 +349,8 @@ func GetTxOutStore(version semver.Version, keeper keeper.Keeper, eventMgr EventM
 // GetNetworkManager  retrieve a NetworkManager that is compatible with the given version
 func GetNetworkManager(version semver.Version, keeper keeper.Keeper, txOutStore TxOutStore, eventMgr EventManager) (NetworkManager, error) {
 	switch {
+	case version.GTE(semver.MustParse("1.106.0")):
+		return newNetworkMgrV106(keeper, txOutStore, eventMgr), nil
 	case version.GTE(semver.MustParse("1.102.0")):
 		return newNetworkMgrV102(keeper, txOutStore, eventMgr), nil
 	case version.GTE(semver.MustParse("1.96.0")):
@@ -361,6 +363,8 @@ func GetNetworkManager(version semver.Version, keeper keeper.Keeper, txOutStore
 // GetValidatorManager create a new instance of Validator Manager
 func GetValidatorManager(version semver.Version, keeper keeper.Keeper, networkMgr NetworkManager, txOutStore TxOutStore, eventMgr EventManager) (ValidatorManager, error) {
 	switch {
+	case version.GTE(semver.MustParse("1.106.0")):
+		return newValidatorMgrV106(keeper, networkMgr, txOutStore, eventMgr), nil
 	case version.GTE(semver.MustParse("1.102.0")):
 		return newValidatorMgrV102(keeper, networkMgr, txOutStore, eventMgr), nil
 	case version.GTE(semver.MustParse("1.95.0")):
@@ -412,6 +416,8 @@ func GetOrderBook(version semver.Version, keeper keeper.Keeper) (OrderBook, erro
 // GetSlasher return an implementation of Slasher
 func GetSlasher(version semver.Version, keeper keeper.Keeper, eventMgr EventManager) (Slasher, error) {
 	switch {
+	case version.GTE(semver.MustParse("1.106.0")):
+		return newSlasherV106(keeper, eventMgr), nil
 	case version.GTE(semver.MustParse("1.105.0")):
 		return newSlasherV105(keeper, eventMgr), nil
 	case version.GTE(semver.MustParse("1.104.0")):
@@ -243,9 +243,9 @@ func ParseMemo(version semver.Version, memo string) (mem Memo, err error) {
 	case TxSwap:
 		return ParseSwapMemo(cosmos.Context{}, nil, asset, parts)
 	case TxOutbound:
-		return ParseOutboundMemo(parts)
+		return ParseOutboundMemo(version, parts)
 	case TxRefund:
-		return ParseRefundMemo(parts)
+		return ParseRefundMemo(version, parts)
 	case TxBond:
 		return ParseBondMemo(version, parts)
 	case TxUnbond:
@@ -297,9 +297,9 @@ func ParseMemoWithMAYANames(ctx cosmos.Context, keeper keeper.Keeper, memo strin
 	case TxSwap:
 		return ParseSwapMemo(ctx, keeper, asset, parts)
 	case TxOutbound:
-		return ParseOutboundMemo(parts)
+		return ParseOutboundMemo(keeper.GetVersion(), parts)
 	case TxRefund:
-		return ParseRefundMemo(parts)
+		return ParseRefundMemo(keeper.GetVersion(), parts)
 	case TxBond:
 		return ParseBondMemo(keeper.GetVersion(), parts)
 	case TxUnbond:
@@ -329,7 +329,13 @@ func ParseMemoWithMAYANames(ctx cosmos.Context, keeper keeper.Keeper, memo strin
 
 func FetchAddress(ctx cosmos.Context, keeper keeper.Keeper, name string, chain common.Chain) (common.Address, error) {
 	// if name is an address, return as is
-	addr, err := common.NewAddress(name)
+	var addr common.Address
+	var err error
+	if keeper != nil && keeper.GetVersion().GTE(semver.MustParse("1.106.0")) {
+		addr, err = common.NewAddressV106(name)
+	} else {
+		addr, err = common.NewAddress(name)
+	}
 	if err == nil {
 		return addr, nil
 	}
@@ -3,6 +3,8 @@ package mayachain
 import (
 	"fmt"
 
+	"github.com/blang/semver"
+
 	"gitlab.com/mayachain/mayanode/common"
 )
 
@@ -23,10 +25,21 @@ func NewOutboundMemo(txID common.TxID) OutboundMemo {
 	}
 }
 
-func ParseOutboundMemo(parts []string) (OutboundMemo, error) {
+func ParseOutboundMemo(version semver.Version, parts []string) (OutboundMemo, error) {
+	switch {
+	case version.GTE(semver.MustParse("1.106.0")):
+		return ParseOutboundMemoV106(parts)
+	default:
+		return ParseOutboundMemoV1(parts)
+	}
+}
+
+// ParseOutboundMemoV106 accepts the tx id of any observation, including the sub
+// indexed ones of a tx carrying several inbounds
+func ParseOutboundMemoV106(parts []string) (OutboundMemo, error) {
 	if len(parts) < 2 {
 		return OutboundMemo{}, fmt.Errorf("not enough parameters")
 	}
-	txID, err := common.NewTxID(parts[1])
+	txID, err := common.NewTxIDV106(parts[1])
 	return NewOutboundMemo(txID), err
 }
@@ -0,0 +1,34 @@
+package mayachain
+
+import (
+	"fmt"
+	"strings"
+
+	"gitlab.com/mayachain/mayanode/common"
+)
+
+func ParseOutboundMemoV1(parts []string) (OutboundMemo, error) {
+	if len(parts) < 2 {
+		return OutboundMemo{}, fmt.Errorf("not enough parameters")
+	}
+	txID, err := parseTxIDV1(parts[1])
+	return NewOutboundMemo(txID), err
+}
+
+// parseTxIDV1 is the tx id parsing from before sub indexed tx ids
+func parseTxIDV1(hash string) (common.TxID, error) {
+	switch len(hash) {
+	case 64:
+		// do nothing
+	case 66: // ETH check
+		if !strings.HasPrefix(hash, "0x") {
+			err := fmt.Errorf("txid error: must be 66 characters (got %d)", len(hash))
+			return common.TxID(""), err
+		}
+	default:
+		err := fmt.Errorf("txid error: must be 64 characters (got %d)", len(hash))
+		return common.TxID(""), err
+	}
+
+	return common.TxID(strings.ToUpper(hash)), nil
+}
@@ -3,6 +3,8 @@ package mayachain
 import (
 	"fmt"
 
+	"github.com/blang/semver"
+
 	"gitlab.com/mayachain/mayanode/common"
 )
 
@@ -26,10 +28,21 @@ func NewRefundMemo(txID common.TxID) RefundMemo {
 	}
 }
 
-func ParseRefundMemo(parts []string) (RefundMemo, error) {
+func ParseRefundMemo(version semver.Version, parts []string) (RefundMemo, error) {
+	switch {
+	case version.GTE(semver.MustParse("1.106.0")):
+		return ParseRefundMemoV106(parts)
+	default:
+		return ParseRefundMemoV1(parts)
+	}
+}
+
+// ParseRefundMemoV106 accepts the tx id of any observation, including the sub
+// indexed ones of a tx carrying several inbounds
+func ParseRefundMemoV106(parts []string) (RefundMemo, error) {
 	if len(parts) < 2 {
 		return RefundMemo{}, fmt.Errorf("not enough parameters")
 	}
-	txID, err := common.NewTxID(parts[1])
+	txID, err := common.NewTxIDV106(parts[1])
 	return NewRefundMemo(txID), err
 }
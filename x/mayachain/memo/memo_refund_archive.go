@@ -0,0 +1,13 @@
+package mayachain
+
+import (
+	"fmt"
+)
+
+func ParseRefundMemoV1(parts []string) (RefundMemo, error) {
+	if len(parts) < 2 {
+		return RefundMemo{}, fmt.Errorf("not enough parameters")
+	}
+	txID, err := parseTxIDV1(parts[1])
+	return NewRefundMemo(txID), err
+}
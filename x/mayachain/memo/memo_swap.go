@@ -2,6 +2,7 @@ package mayachain
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -23,8 +24,12 @@ type SwapMemo struct {
 	DexTargetAddress     string
 	DexTargetLimit       *cosmos.Uint
 	OrderType            types.OrderType
+	IBCChannel           string
+	FeeAsset             common.Asset
 }
 
+var ibcChannelRegex = regexp.MustCompile(`^channel-[0-9]+$`)
+
 func (m SwapMemo) GetDestination() common.Address       { return m.Destination }
 func (m SwapMemo) GetSlipLimit() cosmos.Uint            { return m.SlipLimit }
 func (m SwapMemo) GetAffiliateAddress() common.Address  { return m.AffiliateAddress }
@@ -33,6 +38,8 @@ func (m SwapMemo) GetDexAggregator() string             { return m.DexAggregator
 func (m SwapMemo) GetDexTargetAddress() string          { return m.DexTargetAddress }
 func (m SwapMemo) GetDexTargetLimit() *cosmos.Uint      { return m.DexTargetLimit }
 func (m SwapMemo) GetOrderType() types.OrderType        { return m.OrderType }
+func (m SwapMemo) GetIBCChannel() string                { return m.IBCChannel }
+func (m SwapMemo) GetFeeAsset() common.Asset            { return m.FeeAsset }
 
 func (m SwapMemo) String() string {
 	slipLimit := m.SlipLimit.String()
@@ -70,10 +77,24 @@ func (m SwapMemo) String() string {
 		last = 8
 	}
 
+	dexTargetLimit := ""
 	if m.DexTargetLimit != nil && !m.DexTargetLimit.IsZero() {
-		args = append(args, m.DexTargetLimit.String())
+		dexTargetLimit = m.DexTargetLimit.String()
 		last = 9
 	}
+	feeAsset := ""
+	if !m.FeeAsset.IsEmpty() {
+		feeAsset = m.FeeAsset.String()
+	}
+	args = append(args, dexTargetLimit, m.IBCChannel, feeAsset)
+
+	if m.IBCChannel != "" {
+		last = 10
+	}
+
+	if feeAsset != "" {
+		last = 11
+	}
 
 	return strings.Join(args[:last], ":")
 }
@@ -100,6 +121,8 @@ func ParseSwapMemo(ctx cosmos.Context, keeper keeper.Keeper, asset common.Asset,
 		return ParseSwapMemoV1(ctx, keeper, asset, parts)
 	}
 	switch {
+	case keeper.GetVersion().GTE(semver.MustParse("1.106.0")):
+		return ParseSwapMemoV106(ctx, keeper, asset, parts)
 	case keeper.GetVersion().GTE(semver.MustParse("1.92.0")):
 		return ParseSwapMemoV92(ctx, keeper, asset, parts)
 	default:
@@ -107,7 +130,7 @@ func ParseSwapMemo(ctx cosmos.Context, keeper keeper.Keeper, asset common.Asset,
 	}
 }
 
-func ParseSwapMemoV92(ctx cosmos.Context, keeper keeper.Keeper, asset common.Asset, parts []string) (SwapMemo, error) {
+func ParseSwapMemoV106(ctx cosmos.Context, keeper keeper.Keeper, asset common.Asset, parts []string) (SwapMemo, error) {
 	var err error
 	var order types.OrderType
 	dexAgg := ""
@@ -174,5 +197,28 @@ func ParseSwapMemoV92(ctx cosmos.Context, keeper keeper.Keeper, asset common.Ass
 		}
 	}
 
-	return NewSwapMemo(asset, destination, slip, affAddr, affPts, dexAgg, dexTargetAddress, dexTargetLimit, order), nil
+	// IBC channel can be empty, when it is set, the outbound will be sent to
+	// the destination over IBC instead of the MAYAChain bank
+	ibcChannel := ""
+	if len(parts) > 9 && len(parts[9]) > 0 {
+		if !ibcChannelRegex.MatchString(parts[9]) {
+			return SwapMemo{}, fmt.Errorf("ibc channel:%s is invalid", parts[9])
+		}
+		ibcChannel = parts[9]
+	}
+
+	// fee asset can be empty, when it is set, the outbound fee is paid in it
+	// rather than deducted from the outbound
+	feeAsset := common.EmptyAsset
+	if len(parts) > 10 && len(parts[10]) > 0 {
+		feeAsset, err = common.NewAsset(parts[10])
+		if err != nil {
+			return SwapMemo{}, fmt.Errorf("fee asset:%s is invalid: %w", parts[10], err)
+		}
+	}
+
+	swapMemo := NewSwapMemo(asset, destination, slip, affAddr, affPts, dexAgg, dexTargetAddress, dexTargetLimit, order)
+	swapMemo.IBCChannel = ibcChannel
+	swapMemo.FeeAsset = feeAsset
+	return swapMemo, nil
 }
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/blang/semver"
 	. "gopkg.in/check.v1"
 
 	"gitlab.com/mayachain/mayanode/common"
@@ -474,3 +475,106 @@ func (s *MemoSuite) TestParseAddLiquidityMemoTier(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(addMemo.Tier, Equals, int64(3))
 }
+
+func (s *MemoSuite) TestParseSwapMemoIBCChannel(c *C) {
+	ctx := cosmos.Context{}
+	k := kv1.KVStore{}
+	k.SetVersion(types.GetCurrentVersion())
+
+	parts := strings.Split("=:MAYA.CACAO:cosmos1xv9tklw7d82sezh9haa573wufgy59vmwe6xxe5:::::::channel-3", ":")
+	swapMemo, err := ParseSwapMemo(ctx, k, common.BaseAsset(), parts)
+	c.Assert(err, IsNil)
+	c.Check(swapMemo.GetIBCChannel(), Equals, "channel-3")
+	c.Check(swapMemo.GetDestination().String(), Equals, "cosmos1xv9tklw7d82sezh9haa573wufgy59vmwe6xxe5")
+	c.Check(swapMemo.String(), Equals, "=:MAYA.CACAO:cosmos1xv9tklw7d82sezh9haa573wufgy59vmwe6xxe5:::0::::channel-3")
+
+	parts = strings.Split("=:MAYA.CACAO:cosmos1xv9tklw7d82sezh9haa573wufgy59vmwe6xxe5:::::::transfer", ":")
+	_, err = ParseSwapMemo(ctx, k, common.BaseAsset(), parts)
+	c.Assert(err, NotNil)
+
+	// ibc channel is ignored before 1.106.0
+	k.SetVersion(semver.MustParse("1.105.0"))
+	parts = strings.Split("=:MAYA.CACAO:cosmos1xv9tklw7d82sezh9haa573wufgy59vmwe6xxe5:::::::channel-3", ":")
+	swapMemo, err = ParseSwapMemo(ctx, k, common.BaseAsset(), parts)
+	c.Assert(err, IsNil)
+	c.Check(swapMemo.GetIBCChannel(), Equals, "")
+}
+
+func (s *MemoSuite) TestParseSwapMemoFeeAsset(c *C) {
+	ctx := cosmos.Context{}
+	k := kv1.KVStore{}
+	k.SetVersion(types.GetCurrentVersion())
+
+	parts := strings.Split("=:MAYA.CACAO:cosmos1xv9tklw7d82sezh9haa573wufgy59vmwe6xxe5::::::::MAYA.CACAO", ":")
+	swapMemo, err := ParseSwapMemo(ctx, k, common.BaseAsset(), parts)
+	c.Assert(err, IsNil)
+	c.Check(swapMemo.GetFeeAsset().Equals(common.BaseAsset()), Equals, true)
+	c.Check(swapMemo.GetIBCChannel(), Equals, "")
+	c.Check(swapMemo.String(), Equals, "=:MAYA.CACAO:cosmos1xv9tklw7d82sezh9haa573wufgy59vmwe6xxe5:::0:::::MAYA.CACAO")
+
+	parts = strings.Split("=:MAYA.CACAO:cosmos1xv9tklw7d82sezh9haa573wufgy59vmwe6xxe5:::::::channel-3:BTC.BTC", ":")
+	swapMemo, err = ParseSwapMemo(ctx, k, common.BaseAsset(), parts)
+	c.Assert(err, IsNil)
+	c.Check(swapMemo.GetFeeAsset().Equals(common.BTCAsset), Equals, true)
+	c.Check(swapMemo.GetIBCChannel(), Equals, "channel-3")
+
+	parts = strings.Split("=:MAYA.CACAO:cosmos1xv9tklw7d82sezh9haa573wufgy59vmwe6xxe5::::::::TOOLONGCHAIN.BTC", ":")
+	_, err = ParseSwapMemo(ctx, k, common.BaseAsset(), parts)
+	c.Assert(err, NotNil)
+
+	// fee asset is ignored before 1.106.0
+	k.SetVersion(semver.MustParse("1.105.0"))
+	parts = strings.Split("=:MAYA.CACAO:cosmos1xv9tklw7d82sezh9haa573wufgy59vmwe6xxe5::::::::MAYA.CACAO", ":")
+	swapMemo, err = ParseSwapMemo(ctx, k, common.BaseAsset(), parts)
+	c.Assert(err, IsNil)
+	c.Check(swapMemo.GetFeeAsset().IsEmpty(), Equals, true)
+}
+
+func (s *MemoSuite) TestParseSubIndexedTxIDMemo(c *C) {
+	ctx := cosmos.Context{}
+	k := kv1.KVStore{}
+	k.SetVersion(types.GetCurrentVersion())
+
+	txID, err := common.NewSubTxID(types.GetRandomTxHash().String(), 2)
+	c.Assert(err, IsNil)
+	memo, err := ParseMemoWithMAYANames(ctx, k, "OUT:"+txID.String())
+	c.Assert(err, IsNil)
+	c.Check(memo.GetTxID(), Equals, txID)
+	c.Check(memo.String(), Equals, "OUT:"+txID.String())
+	memo, err = ParseMemoWithMAYANames(ctx, k, "REFUND:"+txID.String())
+	c.Assert(err, IsNil)
+	c.Check(memo.GetTxID(), Equals, txID)
+
+	_, err = ParseMemoWithMAYANames(ctx, k, "OUT:"+txID.BaseID().String()+"-0")
+	c.Check(err, NotNil)
+
+	// sub indexed tx ids are rejected before 1.106.0
+	k.SetVersion(semver.MustParse("1.105.0"))
+	_, err = ParseMemoWithMAYANames(ctx, k, "OUT:"+txID.String())
+	c.Check(err, NotNil)
+	_, err = ParseMemoWithMAYANames(ctx, k, "REFUND:"+txID.String())
+	c.Check(err, NotNil)
+	memo, err = ParseMemoWithMAYANames(ctx, k, "OUT:"+txID.BaseID().String())
+	c.Assert(err, IsNil)
+	c.Check(memo.GetTxID(), Equals, txID.BaseID())
+}
+
+func (s *MemoSuite) TestParseSwapMemoTaprootDestination(c *C) {
+	ctx := cosmos.Context{}
+	k := &kv1.KVStore{}
+	k.SetVersion(types.GetCurrentVersion())
+
+	memo := "=:BTC.BTC:bc1ppgj0l0jng3s2t54h5tckjxjdv4zkzpcwfdd4u7vhp63dgml96hds9eyvn6"
+	mem, err := ParseMemoWithMAYANames(ctx, k, memo)
+	c.Assert(err, IsNil)
+	c.Check(mem.GetDestination().String(), Equals, "bc1ppgj0l0jng3s2t54h5tckjxjdv4zkzpcwfdd4u7vhp63dgml96hds9eyvn6")
+
+	// taproot addresses of another network are rejected
+	_, err = ParseMemoWithMAYANames(ctx, k, "=:BTC.BTC:tb1pqqqqp399et2xygdj5xreqhjjvcmzhxw4aywxecjdzew6hylgvsesf3hn0c")
+	c.Check(err, NotNil)
+
+	// taproot addresses are not parsed before 1.106.0
+	k.SetVersion(semver.MustParse("1.105.0"))
+	_, err = ParseMemoWithMAYANames(ctx, k, memo)
+	c.Check(err, NotNil)
+}
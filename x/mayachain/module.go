@@ -203,6 +203,11 @@ func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 
 		am.mgr.Keeper().ClearObservingAddresses(ctx)
 	}
+	if am.mgr.GetVersion().GTE(semver.MustParse("1.106.0")) {
+		if err := NewMimirHandler(am.mgr).ApplyPendingMimirs(ctx); err != nil {
+			ctx.Logger().Error("fail to apply pending mimirs", "error", err)
+		}
+	}
 	am.mgr.GasMgr().BeginBlock(am.mgr)
 	am.mgr.Slasher().BeginBlock(ctx, req, am.mgr.GetConstants())
 	if err := am.mgr.ValidatorMgr().BeginBlock(ctx, am.mgr.GetConstants(), existingValidators); err != nil {
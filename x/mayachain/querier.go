@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
+	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -38,14 +41,24 @@ func NewQuerier(mgr *Mgrs, kbs cosmos.KeybaseStore) cosmos.Querier {
 			return queryLiquidityProviders(ctx, path[1:], req, mgr)
 		case q.QueryBucketLiquidityProvider.Key:
 			return queryLiquidityProvider(ctx, path[1:], req, mgr)
+		case q.QueryBucketLiquidityProviderEstimate.Key:
+			return queryLiquidityProviderEstimate(ctx, path[1:], req, mgr)
 		case q.QueryLiquidityProviders.Key:
 			return queryLiquidityProviders(ctx, path[1:], req, mgr)
 		case q.QueryLiquidityProvider.Key:
 			return queryLiquidityProvider(ctx, path[1:], req, mgr)
+		case q.QueryPoolSnapshots.Key:
+			return queryPoolSnapshots(ctx, path[1:], req, mgr)
+		case q.QueryPoolYield.Key:
+			return queryPoolYield(ctx, path[1:], req, mgr)
+		case q.QueryLiquidityProviderEstimate.Key:
+			return queryLiquidityProviderEstimate(ctx, path[1:], req, mgr)
 		case q.QueryTxVoter.Key:
 			return queryTxVoters(ctx, path[1:], req, mgr)
 		case q.QueryTx.Key:
 			return queryTx(ctx, path[1:], req, mgr)
+		case q.QueryTxStatus.Key:
+			return queryTxStatus(ctx, path[1:], req, mgr)
 		case q.QueryKeysignArray.Key:
 			return queryKeysign(ctx, kbs, path[1:], req, mgr)
 		case q.QueryKeysignArrayPubkey.Key:
@@ -62,18 +75,36 @@ func NewQuerier(mgr *Mgrs, kbs cosmos.KeybaseStore) cosmos.Querier {
 			return queryNode(ctx, path[1:], req, mgr)
 		case q.QueryNodes.Key:
 			return queryNodes(ctx, path[1:], req, mgr)
+		case q.QueryNodeBonds.Key:
+			return queryNodeBonds(ctx, path[1:], req, mgr)
+		case q.QueryNodeBondProviders.Key:
+			return queryNodeBondProviders(ctx, path[1:], req, mgr)
+		case q.QueryBondProviderBonds.Key:
+			return queryBondProviderBonds(ctx, path[1:], req, mgr)
 		case q.QueryInboundAddresses.Key:
 			return queryInboundAddresses(ctx, path[1:], req, mgr)
+		case q.QueryEVMTokens.Key:
+			return queryEVMTokens(ctx, path[1:], mgr)
 		case q.QueryNetwork.Key:
 			return queryNetwork(ctx, mgr)
+		case q.QueryOutboundFees.Key:
+			return queryOutboundFees(ctx, mgr)
+		case q.QueryOutboundFee.Key:
+			return queryOutboundFee(ctx, path[1:], mgr)
 		case q.QueryPOL.Key:
 			return queryPOL(ctx, mgr)
+		case q.QueryPOLPools.Key:
+			return queryPOLPools(ctx, mgr)
+		case q.QueryPOLSimulate.Key:
+			return queryPOLSimulate(ctx, mgr)
 		case q.QueryBalanceModule.Key:
 			return queryBalanceModule(ctx, path[1:], mgr)
 		case q.QueryVaultsAsgard.Key:
-			return queryAsgardVaults(ctx, mgr)
+			return queryAsgardVaults(ctx, req, mgr)
 		case q.QueryVaultsYggdrasil.Key:
 			return queryYggdrasilVaults(ctx, mgr)
+		case q.QueryYggdrasilStatus.Key:
+			return queryYggdrasilStatus(ctx, mgr)
 		case q.QueryVault.Key:
 			return queryVault(ctx, path[1:], mgr)
 		case q.QueryVaultPubkeys.Key:
@@ -94,6 +125,10 @@ func NewQuerier(mgr *Mgrs, kbs cosmos.KeybaseStore) cosmos.Querier {
 			return queryMimirNodesValues(ctx, path[1:], req, mgr)
 		case q.QueryMimirNodeValues.Key:
 			return queryMimirNodeValues(ctx, path[1:], req, mgr)
+		case q.QueryMimirRegistry.Key:
+			return queryMimirRegistry(ctx, path[1:], req, mgr)
+		case q.QueryMimirPending.Key:
+			return queryMimirPending(ctx, path[1:], req, mgr)
 		case q.QueryBan.Key:
 			return queryBan(ctx, path[1:], req, mgr)
 		case q.QueryRagnarok.Key:
@@ -102,14 +137,24 @@ func NewQuerier(mgr *Mgrs, kbs cosmos.KeybaseStore) cosmos.Querier {
 			return queryPendingOutbound(ctx, mgr)
 		case q.QueryScheduledOutbound.Key:
 			return queryScheduledOutbound(ctx, mgr)
+		case q.QueryScheduledOutboundSimulate.Key:
+			return queryScheduledOutboundSimulate(ctx, req, mgr)
+		case q.QueryScheduledOutboundVolume.Key:
+			return queryScheduledOutboundVolume(ctx, mgr)
 		case q.QueryTssKeygenMetrics.Key:
 			return queryTssKeygenMetric(ctx, path[1:], req, mgr)
 		case q.QueryTssMetrics.Key:
 			return queryTssMetric(ctx, path[1:], req, mgr)
+		case q.QueryBlame.Key:
+			return queryBlame(ctx, path[1:], req, mgr)
+		case q.QueryNodeBlame.Key:
+			return queryNodeBlame(ctx, path[1:], req, mgr)
 		case q.QueryMAYAName.Key:
 			return queryMAYAName(ctx, path[1:], req, mgr)
 		case q.QueryLiquidityAuctionTier.Key:
 			return queryLiquidityAuctionTier(ctx, path[1:], req, mgr)
+		case q.QueryLiquidityAuctionTiers.Key:
+			return queryLiquidityAuctionTiers(ctx, path[1:], req, mgr)
 		case q.QueryQuoteSwap.Key:
 			return queryQuoteSwap(ctx, path[1:], req, mgr)
 		case q.QueryQuoteSaverDeposit.Key:
@@ -210,21 +255,42 @@ func queryVault(ctx cosmos.Context, path []string, mgr *Mgrs) ([]byte, error) {
 	return res, nil
 }
 
-func queryAsgardVaults(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
+// queryAsgardVaults
+// /mayachain/vaults/asgard?limit={limit}&next_key={key}
+func queryAsgardVaults(ctx cosmos.Context, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	page, err := parsePageRequest(queryParams(req))
+	if err != nil {
+		return nil, err
+	}
+
+	// the asgard vaults are few and indexed, they are paged in memory rather
+	// than through the vault iterator, which holds every yggdrasil vault too
 	vaults, err := mgr.Keeper().GetAsgardVaults(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("fail to get asgard vaults: %w", err)
 	}
+	keys := make([]string, len(vaults))
+	for i, vault := range vaults {
+		keys[i] = vault.PubKey.String()
+	}
+	iterator, err := newListIterator(keys, page)
+	if err != nil {
+		return nil, err
+	}
 
 	var vaultsWithFunds []types.QueryVaultResp
-	for _, vault := range vaults {
+	pagination, err := paginate(ctx, iterator, page, func(_ []byte, take bool) (bool, error) {
+		vault := vaults[iterator.Index()]
 		if vault.Status == InactiveVault {
-			continue
+			return false, nil
 		}
 		if !vault.IsAsgard() {
-			continue
+			return false, nil
+		}
+		if !vault.HasFunds() && vault.Status != ActiveVault {
+			return false, nil
 		}
-		if vault.HasFunds() || vault.Status == ActiveVault {
+		if take {
 			vaultsWithFunds = append(vaultsWithFunds, types.QueryVaultResp{
 				BlockHeight:           vault.BlockHeight,
 				PubKey:                vault.PubKey,
@@ -242,9 +308,13 @@ func queryAsgardVaults(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
 				Addresses:             getVaultChainAddress(ctx, vault),
 			})
 		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	res, err := json.MarshalIndent(vaultsWithFunds, "", "	")
+	res, err := marshalPage(page, "vaults", vaultsWithFunds, pagination)
 	if err != nil {
 		ctx.Logger().Error("fail to marshal vaults response to json", "error", err)
 		return nil, fmt.Errorf("fail to marshal response to json: %w", err)
@@ -345,6 +415,77 @@ func queryYggdrasilVaults(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
 	return res, nil
 }
 
+// queryYggdrasilStatus
+// /mayachain/vaults/yggdrasil/status
+func queryYggdrasilStatus(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
+	asgardOnlyHeight, err := mgr.Keeper().GetMimir(ctx, mimirAsgardOnly)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get mimir: %w", err)
+	}
+
+	result := openapi.YggdrasilStatusResponse{
+		AsgardOnly: isAsgardOnly(ctx, mgr.Keeper()),
+		Vaults:     make([]openapi.YggdrasilStatus, 0),
+	}
+	if asgardOnlyHeight > 0 {
+		result.AsgardOnlyHeight = wrapInt64(asgardOnlyHeight)
+	}
+
+	totalValue := cosmos.ZeroUint()
+	iter := mgr.Keeper().GetVaultIterator(ctx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var vault Vault
+		if err := mgr.Keeper().Cdc().Unmarshal(iter.Value(), &vault); err != nil {
+			ctx.Logger().Error("fail to unmarshal yggdrasil", "error", err)
+			return nil, fmt.Errorf("fail to unmarshal yggdrasil: %w", err)
+		}
+		if !vault.IsYggdrasil() || !vault.HasFunds() {
+			continue
+		}
+
+		value := cosmos.ZeroUint()
+		for _, coin := range vault.Coins {
+			if coin.Asset.IsBase() {
+				value = value.Add(coin.Amount)
+				continue
+			}
+			pool, err := mgr.Keeper().GetPool(ctx, coin.Asset)
+			if err != nil {
+				ctx.Logger().Error("fail to get pool", "error", err)
+				continue
+			}
+			value = value.Add(pool.AssetValueInRune(coin.Amount))
+		}
+		totalValue = totalValue.Add(value)
+
+		status := openapi.YggdrasilStatus{
+			PubKey: vault.PubKey.String(),
+			Coins:  castCoins(vault.Coins...),
+			Value:  value.String(),
+		}
+		na, err := mgr.Keeper().GetNodeAccountByPubKey(ctx, vault.PubKey)
+		if err != nil {
+			ctx.Logger().Error("fail to get node account by pubkey", "error", err)
+		} else {
+			status.NodeAddress = wrapString(na.NodeAddress.String())
+			status.NodeStatus = wrapString(na.Status.String())
+		}
+		if len(vault.PendingTxBlockHeights) > 0 {
+			status.ReturnRequestedHeight = wrapInt64(vault.PendingTxBlockHeights[len(vault.PendingTxBlockHeights)-1])
+		}
+		result.Vaults = append(result.Vaults, status)
+	}
+	result.VaultsRemaining = int64(len(result.Vaults))
+	result.TotalValue = totalValue.String()
+
+	res, err := json.MarshalIndent(result, "", "	")
+	if err != nil {
+		return nil, fmt.Errorf("fail to marshal yggdrasil status to json: %w", err)
+	}
+	return res, nil
+}
+
 func queryVaultsPubkeys(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
 	var resp QueryVaultsPubKeys
 	resp.Asgard = make([]QueryVaultPubKeyContract, 0)
@@ -392,6 +533,91 @@ func queryVaultsPubkeys(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
 	return res, nil
 }
 
+// castOutboundFee returns the outbound fees withheld and the gas spent on the
+// chain, along with the fee multiplier they result in
+func castOutboundFee(ctx cosmos.Context, mgr *Mgrs, chain common.Chain) (openapi.OutboundFee, error) {
+	withheld, err := mgr.Keeper().GetOutboundFeeWithheldCacao(ctx, chain)
+	if err != nil {
+		return openapi.OutboundFee{}, fmt.Errorf("fail to get outbound fee withheld: %w", err)
+	}
+	spent, err := mgr.Keeper().GetOutboundFeeSpentCacao(ctx, chain)
+	if err != nil {
+		return openapi.OutboundFee{}, fmt.Errorf("fail to get outbound fee spent: %w", err)
+	}
+	multiplier := calcOutboundFeeMultiplier(
+		fetchConfigInt64(ctx, mgr, constants.MinOutboundFeeMultiplier),
+		fetchConfigInt64(ctx, mgr, constants.MaxOutboundFeeMultiplier),
+		fetchConfigInt64(ctx, mgr, constants.TargetOutboundFeeSurplusCacao),
+		withheld, spent)
+	record := NewOutboundFeeRecord(chain, ctx.BlockHeight(), withheld, spent)
+	return openapi.OutboundFee{
+		Chain:            chain.String(),
+		FeeWithheldCacao: withheld.String(),
+		FeeSpentCacao:    spent.String(),
+		SurplusCacao:     record.GetSurplus().String(),
+		FeeMultiplierBps: int64(multiplier.Uint64()),
+	}, nil
+}
+
+// queryOutboundFees
+// /mayachain/outbound_fees
+func queryOutboundFees(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
+	result := make([]openapi.OutboundFee, 0)
+	iterator := mgr.Keeper().GetNetworkFeeIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var networkFee NetworkFee
+		if err := mgr.Keeper().Cdc().Unmarshal(iterator.Value(), &networkFee); err != nil {
+			ctx.Logger().Error("fail to unmarshal network fee", "error", err)
+			continue
+		}
+		fee, err := castOutboundFee(ctx, mgr, networkFee.Chain)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, fee)
+	}
+	res, err := json.MarshalIndent(result, "", "	")
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal outbound fees to json: %w", err)
+	}
+	return res, nil
+}
+
+// queryOutboundFee
+// /mayachain/outbound_fee/{chain}
+func queryOutboundFee(ctx cosmos.Context, path []string, mgr *Mgrs) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, errors.New("chain not provided")
+	}
+	chain, err := common.NewChain(path[0])
+	if err != nil {
+		return nil, fmt.Errorf("fail to parse chain: %w", err)
+	}
+	result, err := castOutboundFee(ctx, mgr, chain)
+	if err != nil {
+		return nil, err
+	}
+	records, err := mgr.Keeper().GetOutboundFeeRecords(ctx, chain)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get outbound fee records: %w", err)
+	}
+	result.History = make([]openapi.OutboundFeeRecord, 0, len(records))
+	for _, record := range records {
+		result.History = append(result.History, openapi.OutboundFeeRecord{
+			Height:           record.Height,
+			FeeWithheldCacao: record.FeeWithheldCacao.String(),
+			FeeSpentCacao:    record.FeeSpentCacao.String(),
+			SurplusCacao:     record.GetSurplus().String(),
+		})
+	}
+	res, err := json.MarshalIndent(result, "", "	")
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal outbound fee to json: %w", err)
+	}
+	return res, nil
+}
+
 func queryNetwork(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
 	data, err := mgr.Keeper().GetNetwork(ctx)
 	if err != nil {
@@ -441,6 +667,102 @@ func queryPOL(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
 	return res, nil
 }
 
+func queryPOLPools(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
+	polAddress, err := mgr.Keeper().GetModuleAddress(ReserveName)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get POL address: %w", err)
+	}
+	pools, err := mgr.Keeper().GetPools(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get pools: %w", err)
+	}
+
+	result := make([]openapi.POLPool, 0)
+	for _, pool := range pools {
+		if pool.Asset.IsNative() || pool.Asset.IsSyntheticAsset() {
+			continue
+		}
+		lp, err := mgr.Keeper().GetLiquidityProvider(ctx, pool.Asset, polAddress)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get POL liquidity provider of pool(%s): %w", pool.Asset, err)
+		}
+		polPool, err := mgr.Keeper().GetPOLPool(ctx, pool.Asset)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get POL of pool(%s): %w", pool.Asset, err)
+		}
+		enabled, err := mgr.Keeper().GetMimir(ctx, "POL-"+pool.Asset.MimirString())
+		if err != nil {
+			return nil, fmt.Errorf("fail to get POL mimir of pool(%s): %w", pool.Asset, err)
+		}
+		maxDeposit, err := mgr.Keeper().GetMimir(ctx, "POLMaxPoolDeposit-"+pool.Asset.MimirString())
+		if err != nil {
+			return nil, fmt.Errorf("fail to get POL max deposit of pool(%s): %w", pool.Asset, err)
+		}
+		if lp.Units.IsZero() && polPool.CacaoDeposited.IsZero() && enabled <= 0 {
+			continue
+		}
+		if maxDeposit < 0 {
+			maxDeposit = 0
+		}
+
+		synthSupply := mgr.Keeper().GetTotalSupply(ctx, pool.Asset.GetSyntheticAsset())
+		pool.CalcUnits(mgr.GetVersion(), synthSupply)
+		value := common.GetSafeShare(lp.Units, pool.GetPoolUnits(), pool.BalanceCacao).MulUint64(2)
+		result = append(result, openapi.POLPool{
+			Asset:          pool.Asset.String(),
+			Enabled:        enabled == 1,
+			ForceWithdraw:  enabled == 2,
+			MaxDeposit:     strconv.FormatInt(maxDeposit, 10),
+			Units:          lp.Units.String(),
+			Value:          value.String(),
+			CacaoDeposited: polPool.CacaoDeposited.String(),
+			CacaoWithdrawn: polPool.CacaoWithdrawn.String(),
+			CurrentDeposit: polPool.CurrentDeposit().String(),
+			Pnl:            polPool.PnL(value).String(),
+		})
+	}
+
+	res, err := json.MarshalIndent(result, "", "	")
+	if err != nil {
+		ctx.Logger().Error("fail to marshal POL pools to json", "error", err)
+		return nil, fmt.Errorf("fail to marshal response to json: %w", err)
+	}
+	return res, nil
+}
+
+func queryPOLSimulate(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
+	// the POL cycle runs in the next block, and picks its pool by that height
+	simCtx := ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	action, err := getPOLCycleAction(simCtx, mgr)
+	if err != nil {
+		ctx.Logger().Error("fail to simulate POL cycle", "error", err)
+		return nil, fmt.Errorf("fail to simulate POL cycle: %w", err)
+	}
+
+	result := openapi.POLSimulateResponse{
+		Height:                 simCtx.BlockHeight(),
+		Action:                 "none",
+		CacaoAmount:            action.CacaoAmount.String(),
+		BasisPoints:            action.BasisPoints.String(),
+		SynthUtilization:       action.SynthUtilization.String(),
+		TargetSynthUtilization: action.TargetUtilization.String(),
+		Reason:                 wrapString(action.Reason),
+	}
+	if action.Action != "" {
+		result.Action = action.Action
+	}
+	if !action.Pool.IsEmpty() {
+		result.Asset = wrapString(action.Pool.Asset.String())
+	}
+
+	res, err := json.MarshalIndent(result, "", "	")
+	if err != nil {
+		ctx.Logger().Error("fail to marshal POL simulation to json", "error", err)
+		return nil, fmt.Errorf("fail to marshal response to json: %w", err)
+	}
+	return res, nil
+}
+
 func queryInboundAddresses(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
 	active, err := mgr.Keeper().GetAsgardVaultsByStatus(ctx, ActiveVault)
 	if err != nil {
@@ -502,6 +824,36 @@ func queryInboundAddresses(ctx cosmos.Context, path []string, req abci.RequestQu
 	return res, nil
 }
 
+// queryEVMTokens returns the tokens whitelisted on the given EVM chain
+func queryEVMTokens(ctx cosmos.Context, path []string, mgr *Mgrs) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, errors.New("chain not provided")
+	}
+	chain, err := common.NewChain(path[0])
+	if err != nil {
+		return nil, fmt.Errorf("fail to parse chain: %w", err)
+	}
+	if !chain.IsEVM() {
+		return nil, fmt.Errorf("%s is not an EVM chain", chain)
+	}
+
+	result := make(openapi.EVMTokensResponse, 0)
+	for _, token := range getEVMTokenWhitelist(ctx, mgr.Keeper(), chain) {
+		result = append(result, openapi.EVMToken{
+			Chain:    token.Chain.String(),
+			Address:  token.Address.String(),
+			Symbol:   token.Symbol,
+			Decimals: token.Decimals,
+		})
+	}
+
+	res, err := json.MarshalIndent(result, "", "	")
+	if err != nil {
+		return nil, fmt.Errorf("fail to marshal evm tokens to json: %w", err)
+	}
+	return res, nil
+}
+
 // queryNode return the Node information related to the request node address
 // /thorchain/node/{nodeaddress}
 func queryNode(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
@@ -684,9 +1036,51 @@ func getTotalEffectiveBond(ctx cosmos.Context, mgr *Mgrs, bondHardCap cosmos.Uin
 }
 
 // queryNodes return all the nodes that has bond
-// /thorchain/nodes
+// /thorchain/nodes?status={status}&version={version}&limit={limit}&next_key={key}
 func queryNodes(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
-	nodeAccounts, err := mgr.Keeper().ListValidatorsWithBond(ctx)
+	params := queryParams(req)
+	page, err := parsePageRequest(params)
+	if err != nil {
+		return nil, err
+	}
+	var status *NodeStatus
+	if len(params.Get("status")) > 0 {
+		// analyze-ignore(map-iteration)
+		for value, name := range types.NodeStatus_name {
+			if strings.EqualFold(name, params.Get("status")) {
+				s := NodeStatus(value)
+				status = &s
+			}
+		}
+		if status == nil {
+			return nil, fmt.Errorf("invalid node status: %s", params.Get("status"))
+		}
+	}
+	version := params.Get("version")
+
+	nodeAccounts := make(NodeAccounts, 0)
+	iterator := mgr.Keeper().GetNodeAccountIteratorFrom(ctx, page.key)
+	defer iterator.Close()
+	pagination, err := paginate(ctx, iterator, page, func(value []byte, take bool) (bool, error) {
+		var na NodeAccount
+		if err := mgr.Keeper().Cdc().Unmarshal(value, &na); err != nil {
+			return false, fmt.Errorf("fail to unmarshal node account: %w", err)
+		}
+		// the bond is only calculated for the nodes of the page, below
+		if na.Type != NodeTypeValidator {
+			return false, nil
+		}
+		if status != nil && na.Status != *status {
+			return false, nil
+		}
+		if len(version) > 0 && na.Version != version {
+			return false, nil
+		}
+		if take {
+			nodeAccounts = append(nodeAccounts, na)
+		}
+		return true, nil
+	})
 	if err != nil {
 		return nil, fmt.Errorf("fail to get node accounts: %w", err)
 	}
@@ -716,14 +1110,17 @@ func queryNodes(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *M
 	}
 
 	lastChurnHeight := vaults[0].BlockHeight
-	result := make([]QueryNodeAccount, len(nodeAccounts))
-	for i, na := range nodeAccounts {
-
+	// nodes without bond are dropped from the page, a page can hold fewer
+	// nodes than the limit
+	result := make([]QueryNodeAccount, 0, len(nodeAccounts))
+	for _, na := range nodeAccounts {
 		naBond, err := mgr.Keeper().CalcNodeLiquidityBond(ctx, na)
 		if err != nil {
 			return nil, fmt.Errorf("fail to calculate node liquidity bond: %w", err)
 		}
-
+		if naBond.IsZero() {
+			continue
+		}
 		if na.RequestedToLeave && naBond.LTE(cosmos.NewUint(common.One)) {
 			// ignore the node , it left and also has very little bond
 			continue
@@ -733,7 +1130,8 @@ func queryNodes(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *M
 			return nil, fmt.Errorf("fail to get node slash points: %w", err)
 		}
 
-		result[i] = NewQueryNodeAccount(na, naBond)
+		result = append(result, NewQueryNodeAccount(na, naBond))
+		i := len(result) - 1
 		result[i].SlashPoints = slashPts
 		if na.Status == NodeActive {
 			reward, err := getNodeCurrentRewards(ctx, mgr, na, lastChurnHeight, network.BondRewardRune, totalEffectiveBond, bondHardCap)
@@ -774,7 +1172,7 @@ func queryNodes(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *M
 		}
 	}
 
-	res, err := json.MarshalIndent(result, "", "	")
+	res, err := marshalPage(page, "nodes", result, pagination)
 	if err != nil {
 		ctx.Logger().Error("fail to marshal observers to json", "error", err)
 		return nil, fmt.Errorf("fail to marshal observers to json: %w", err)
@@ -783,155 +1181,539 @@ func queryNodes(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *M
 	return res, nil
 }
 
-// queryLiquidityProviders
-func queryLiquidityProviders(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+// queryNodeBonds return the liquidity bonded to a node per bond provider and pool
+// /mayachain/node/{nodeaddress}/bonds
+func queryNodeBonds(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
 	if len(path) == 0 {
-		return nil, errors.New("asset not provided")
-	}
-	path[0] = strings.Replace(path[0], "_", "/", 1)
-	asset, err := common.NewAsset(path[0])
-	if err != nil {
-		ctx.Logger().Error("fail to get parse asset", "error", err)
-		return nil, fmt.Errorf("fail to parse asset: %w", err)
-	}
-	var lps LiquidityProviders
-	iterator := mgr.Keeper().GetLiquidityProviderIterator(ctx, asset)
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var lp LiquidityProvider
-		mgr.Keeper().Cdc().MustUnmarshal(iterator.Value(), &lp)
-		lps = append(lps, lp)
+		return nil, errors.New("node address not provided")
 	}
-	res, err := json.MarshalIndent(lps, "", "	")
+	addr, err := cosmos.AccAddressFromBech32(path[0])
 	if err != nil {
-		ctx.Logger().Error("fail to marshal liquidity providers to json", "error", err)
-		return nil, fmt.Errorf("fail to marshal liquidity providers to json: %w", err)
-	}
-	return res, nil
-}
-
-// queryLiquidityProvider
-func queryLiquidityProvider(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
-	if len(path) < 2 {
-		return nil, errors.New("asset/lp not provided")
+		return nil, cosmos.ErrUnknownRequest("invalid account address")
 	}
-	path[0] = strings.Replace(path[0], "_", "/", 1)
-	asset, err := common.NewAsset(path[0])
+	nodeAcc, err := mgr.Keeper().GetNodeAccount(ctx, addr)
 	if err != nil {
-		ctx.Logger().Error("fail to get parse asset", "error", err)
-		return nil, fmt.Errorf("fail to parse asset: %w", err)
+		return nil, fmt.Errorf("fail to get node account: %w", err)
 	}
-	addr, err := common.NewAddress(path[1])
+	nodeBond, err := mgr.Keeper().CalcNodeLiquidityBond(ctx, nodeAcc)
 	if err != nil {
-		ctx.Logger().Error("fail to get parse address", "error", err)
-		return nil, fmt.Errorf("fail to parse address: %w", err)
+		return nil, fmt.Errorf("fail to get node bond: %w", err)
 	}
-	lp, err := mgr.Keeper().GetLiquidityProvider(ctx, asset, addr)
+	slashPts, err := mgr.Keeper().GetNodeAccountSlashPoints(ctx, addr)
 	if err != nil {
-		ctx.Logger().Error("fail to get liquidity provider", "error", err)
-		return nil, fmt.Errorf("fail to liquidity provider: %w", err)
+		return nil, fmt.Errorf("fail to get node slash points: %w", err)
 	}
-	res, err := json.MarshalIndent(lp, "", "	")
+	bp, err := mgr.Keeper().GetBondProviders(ctx, nodeAcc.NodeAddress)
 	if err != nil {
-		ctx.Logger().Error("fail to marshal liquidity provider to json", "error", err)
-		return nil, fmt.Errorf("fail to marshal liquidity provider to json: %w", err)
+		return nil, fmt.Errorf("fail to get bond providers: %w", err)
 	}
-	return res, nil
-}
-
-func queryBuckets(ctx cosmos.Context, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
-	buckets := make([]openapi.Bucket, 0)
-	iterator := mgr.Keeper().GetPoolIterator(ctx)
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var bucket Pool
-		if err := mgr.Keeper().Cdc().Unmarshal(iterator.Value(), &bucket); err != nil {
-			return nil, fmt.Errorf("fail to unmarshal bucket: %w", err)
-		}
-		// ignore bucket if no liquidity provider units
-		if bucket.LPUnits.IsZero() {
-			continue
-		}
 
-		if !bucket.Asset.IsVaultAsset() {
-			continue
+	result := openapi.NodeBondsResponse{
+		NodeAddress: nodeAcc.NodeAddress.String(),
+		TotalBond:   nodeBond.String(),
+		SlashPoints: slashPts,
+		Bonds:       make([]openapi.LPBond, 0),
+	}
+	liquidityPools := GetLiquidityPools(mgr.GetVersion())
+	for _, p := range bp.Providers {
+		lps, err := mgr.Keeper().GetLiquidityProviderByAssets(ctx, liquidityPools, common.Address(p.BondAddress.String()))
+		if err != nil {
+			return nil, fmt.Errorf("fail to get liquidity providers of bond provider: %w", err)
 		}
-
-		p := openapi.Bucket{
-			BalanceAsset: bucket.BalanceAsset.String(),
-			Asset:        bucket.Asset.String(),
-			LPUnits:      bucket.LPUnits.String(),
-			Status:       bucket.Status.String(),
+		for _, lp := range lps {
+			units := lp.GetUnitsBondedToNode(nodeAcc.NodeAddress)
+			if units.IsZero() {
+				continue
+			}
+			bond, err := getLPBond(ctx, mgr, lp, nodeAcc.NodeAddress, units, nodeBond)
+			if err != nil {
+				return nil, err
+			}
+			result.Bonds = append(result.Bonds, bond)
 		}
-		buckets = append(buckets, p)
 	}
-	res, err := json.MarshalIndent(buckets, "", "	")
+
+	res, err := json.MarshalIndent(result, "", "	")
 	if err != nil {
-		return nil, fmt.Errorf("could not marshal buckets result to json: %w", err)
+		return nil, fmt.Errorf("fail to marshal node bonds to json: %w", err)
 	}
 	return res, nil
 }
 
-// nolint: unparam
-func queryBucket(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+// queryNodeBondProviders return the bond providers of a node and their share of the current node reward
+// /mayachain/node/{nodeaddress}/bond_providers
+func queryNodeBondProviders(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
 	if len(path) == 0 {
-		return nil, errors.New("asset not provided")
+		return nil, errors.New("node address not provided")
 	}
-	path[0] = strings.Replace(path[0], "_", "/", 1)
-	asset, err := common.NewAsset(path[0])
+	addr, err := cosmos.AccAddressFromBech32(path[0])
 	if err != nil {
-		ctx.Logger().Error("fail to parse asset", "error", err)
-		return nil, fmt.Errorf("could not parse asset: %w", err)
-	}
-
-	if !asset.IsVaultAsset() {
-		return nil, fmt.Errorf("given asset is a pool asset: %s", asset)
+		return nil, cosmos.ErrUnknownRequest("invalid account address")
 	}
-
-	bucket, err := mgr.Keeper().GetPool(ctx, asset)
+	nodeAcc, err := mgr.Keeper().GetNodeAccount(ctx, addr)
 	if err != nil {
-		ctx.Logger().Error("fail to get bucket", "error", err)
-		return nil, fmt.Errorf("could not get bucket: %w", err)
+		return nil, fmt.Errorf("fail to get node account: %w", err)
 	}
-	if bucket.IsEmpty() {
-		return nil, fmt.Errorf("bucket: %s doesn't exist", path[0])
+	if nodeAcc.BondAddress.IsEmpty() {
+		return nil, fmt.Errorf("node account(%s) has no bond address", addr)
 	}
-
-	b := openapi.Bucket{
-		BalanceAsset: bucket.BalanceAsset.String(),
-		Asset:        bucket.Asset.String(),
-		LPUnits:      bucket.LPUnits.String(),
-		Status:       bucket.Status.String(),
+	nodeBond, err := mgr.Keeper().CalcNodeLiquidityBond(ctx, nodeAcc)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get node bond: %w", err)
+	}
+	bp, err := mgr.Keeper().GetBondProviders(ctx, nodeAcc.NodeAddress)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get bond providers: %w", err)
 	}
 
-	res, err := json.MarshalIndent(b, "", "	")
+	reward := cosmos.ZeroUint()
+	if nodeAcc.Status == NodeActive && !nodeBond.IsZero() {
+		vaults, err := mgr.Keeper().GetAsgardVaultsByStatus(ctx, ActiveVault)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get active vaults: %w", err)
+		}
+		if len(vaults) == 0 {
+			return nil, fmt.Errorf("no active vaults")
+		}
+		active, err := mgr.Keeper().ListActiveValidators(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get all active node account: %w", err)
+		}
+		network, err := mgr.Keeper().GetNetwork(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get network: %w", err)
+		}
+		bondHardCap := getHardBondCap(ctx, mgr, active)
+		totalEffectiveBond, err := getTotalEffectiveBond(ctx, mgr, bondHardCap)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get total effective bond: %w", err)
+		}
+		reward, err = getNodeCurrentRewards(ctx, mgr, nodeAcc, vaults[0].BlockHeight, network.BondRewardRune, totalEffectiveBond, bondHardCap)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get current node rewards: %w", err)
+		}
+	}
+
+	shares, err := getBondProviderShares(ctx, mgr.Keeper(), nodeAcc, reward)
 	if err != nil {
-		return nil, fmt.Errorf("could not marshal result to JSON: %w", err)
+		return nil, fmt.Errorf("fail to get bond provider shares: %w", err)
+	}
+	result := openapi.NodeBondProvidersResponse{
+		NodeAddress:     nodeAcc.NodeAddress.String(),
+		NodeOperatorFee: bp.NodeOperatorFee.String(),
+		TotalBond:       nodeBond.String(),
+		Reward:          reward.String(),
+		Providers:       make([]openapi.BondProviderShare, 0, len(bp.Providers)),
+	}
+	for _, p := range bp.Providers {
+		share := openapi.BondProviderShare{
+			BondAddress: p.BondAddress.String(),
+			Bonded:      p.HasBonded(),
+			Bond:        "0",
+			Reward:      "0",
+			OperatorFee: "0",
+		}
+		for _, s := range shares {
+			if s.BondAddress.Equals(p.BondAddress) {
+				share.Bond = s.Bond.String()
+				share.Reward = s.Reward.String()
+				share.OperatorFee = s.OperatorFee.String()
+				break
+			}
+		}
+		result.Providers = append(result.Providers, share)
+	}
+
+	res, err := json.MarshalIndent(result, "", "	")
+	if err != nil {
+		return nil, fmt.Errorf("fail to marshal node bond providers to json: %w", err)
+	}
+	return res, nil
+}
+
+// queryBondProviderBonds return the liquidity an address bonded to nodes per node and pool
+// /mayachain/bonds/{address}
+func queryBondProviderBonds(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, errors.New("bond address not provided")
+	}
+	addr, err := common.NewAddress(path[0])
+	if err != nil {
+		return nil, fmt.Errorf("fail to parse address: %w", err)
+	}
+	lps, err := mgr.Keeper().GetLiquidityProviderByAssets(ctx, GetLiquidityPools(mgr.GetVersion()), addr)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get liquidity providers: %w", err)
+	}
+
+	result := openapi.BondProviderBondsResponse{
+		BondAddress: addr.String(),
+		Bonds:       make([]openapi.LPBond, 0),
+	}
+	totalBond := cosmos.ZeroUint()
+	nodeBonds := make(map[string]cosmos.Uint)
+	for _, lp := range lps {
+		nodes := make([]cosmos.AccAddress, 0, len(lp.BondedNodes)+1)
+		if !lp.NodeBondAddress.Empty() {
+			nodes = append(nodes, lp.NodeBondAddress)
+		}
+		for _, bn := range lp.BondedNodes {
+			nodes = append(nodes, bn.NodeAddress)
+		}
+		for _, nodeAddr := range nodes {
+			units := lp.GetUnitsBondedToNode(nodeAddr)
+			if units.IsZero() {
+				continue
+			}
+			nodeBond, ok := nodeBonds[nodeAddr.String()]
+			if !ok {
+				nodeAcc, err := mgr.Keeper().GetNodeAccount(ctx, nodeAddr)
+				if err != nil {
+					return nil, fmt.Errorf("fail to get node account: %w", err)
+				}
+				nodeBond, err = mgr.Keeper().CalcNodeLiquidityBond(ctx, nodeAcc)
+				if err != nil {
+					return nil, fmt.Errorf("fail to get node bond: %w", err)
+				}
+				nodeBonds[nodeAddr.String()] = nodeBond
+			}
+			bond, err := getLPBond(ctx, mgr, lp, nodeAddr, units, nodeBond)
+			if err != nil {
+				return nil, err
+			}
+			totalBond = totalBond.Add(cosmos.NewUintFromString(bond.CacaoValue))
+			result.Bonds = append(result.Bonds, bond)
+		}
+	}
+	result.TotalBond = totalBond.String()
+
+	res, err := json.MarshalIndent(result, "", "	")
+	if err != nil {
+		return nil, fmt.Errorf("fail to marshal bond provider bonds to json: %w", err)
+	}
+	return res, nil
+}
+
+// getLPBond value the given liquidity units bonded to a node the same way
+// CalcNodeLiquidityBond does, and work out the share of a node slash
+// SlashNodeAccountLP would take from them
+func getLPBond(ctx cosmos.Context, mgr *Mgrs, lp LiquidityProvider, nodeAddr cosmos.AccAddress, units, nodeBond cosmos.Uint) (openapi.LPBond, error) {
+	pool, err := mgr.Keeper().GetPool(ctx, lp.Asset)
+	if err != nil {
+		return openapi.LPBond{}, fmt.Errorf("fail to get pool(%s): %w", lp.Asset, err)
+	}
+	value := common.GetSafeShare(units, pool.LPUnits, pool.BalanceCacao)
+	value = value.Add(pool.AssetValueInRune(common.GetSafeShare(units, pool.LPUnits, pool.BalanceAsset)))
+	exposure := common.GetSafeShare(value, nodeBond, cosmos.NewUint(10_000))
+	return openapi.LPBond{
+		NodeAddress:      nodeAddr.String(),
+		BondAddress:      lp.CacaoAddress.String(),
+		Asset:            lp.Asset.String(),
+		Units:            units.String(),
+		CacaoValue:       value.String(),
+		SlashExposureBps: int64(exposure.Uint64()),
+		Slashable:        pool.IsAvailable(),
+	}, nil
+}
+
+// queryLiquidityProviders
+// /mayachain/pool/{asset}/liquidity_providers?address={prefix}&min_units={units}&limit={limit}&next_key={key}
+func queryLiquidityProviders(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, errors.New("asset not provided")
+	}
+	path[0] = strings.Replace(path[0], "_", "/", 1)
+	asset, err := common.NewAsset(path[0])
+	if err != nil {
+		ctx.Logger().Error("fail to get parse asset", "error", err)
+		return nil, fmt.Errorf("fail to parse asset: %w", err)
+	}
+	params := queryParams(req)
+	page, err := parsePageRequest(params)
+	if err != nil {
+		return nil, err
+	}
+	minUnits := cosmos.ZeroUint()
+	if len(params.Get("min_units")) > 0 {
+		minUnits, err = cosmos.ParseUint(params.Get("min_units"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid min_units: %s", params.Get("min_units"))
+		}
+	}
+
+	var lps LiquidityProviders
+	// the address prefix narrows the iterator, no filtering needed
+	iterator := mgr.Keeper().GetLiquidityProviderIteratorFrom(ctx, asset, params.Get("address"), page.key)
+	defer iterator.Close()
+	pagination, err := paginate(ctx, iterator, page, func(value []byte, take bool) (bool, error) {
+		var lp LiquidityProvider
+		if err := mgr.Keeper().Cdc().Unmarshal(value, &lp); err != nil {
+			return false, fmt.Errorf("fail to unmarshal liquidity provider: %w", err)
+		}
+		if lp.Units.LT(minUnits) {
+			return false, nil
+		}
+		if take {
+			lps = append(lps, lp)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	res, err := marshalPage(page, "liquidity_providers", lps, pagination)
+	if err != nil {
+		ctx.Logger().Error("fail to marshal liquidity providers to json", "error", err)
+		return nil, fmt.Errorf("fail to marshal liquidity providers to json: %w", err)
+	}
+	return res, nil
+}
+
+// getLiquidityProviderFromPath parses the asset and address of the path and
+// returns the matching liquidity provider
+func getLiquidityProviderFromPath(ctx cosmos.Context, path []string, mgr *Mgrs) (LiquidityProvider, error) {
+	if len(path) < 2 {
+		return LiquidityProvider{}, errors.New("asset/lp not provided")
+	}
+	path[0] = strings.Replace(path[0], "_", "/", 1)
+	asset, err := common.NewAsset(path[0])
+	if err != nil {
+		ctx.Logger().Error("fail to get parse asset", "error", err)
+		return LiquidityProvider{}, fmt.Errorf("fail to parse asset: %w", err)
+	}
+	addr, err := common.NewAddress(path[1])
+	if err != nil {
+		ctx.Logger().Error("fail to get parse address", "error", err)
+		return LiquidityProvider{}, fmt.Errorf("fail to parse address: %w", err)
+	}
+	lp, err := mgr.Keeper().GetLiquidityProvider(ctx, asset, addr)
+	if err != nil {
+		ctx.Logger().Error("fail to get liquidity provider", "error", err)
+		return LiquidityProvider{}, fmt.Errorf("fail to liquidity provider: %w", err)
+	}
+	return lp, nil
+}
+
+// estimateLiquidityProvider returns what the liquidity provider would receive
+// withdrawing their whole position at the current height
+func estimateLiquidityProvider(ctx cosmos.Context, mgr *Mgrs, lp LiquidityProvider) (withdrawEstimate, error) {
+	pool, err := mgr.Keeper().GetPool(ctx, lp.Asset)
+	if err != nil {
+		return withdrawEstimate{}, fmt.Errorf("fail to get pool: %w", err)
+	}
+	if pool.IsEmpty() {
+		return withdrawEstimate{}, fmt.Errorf("pool %s doesn't exist", lp.Asset)
+	}
+	return estimateWithdrawV105(ctx, mgr, pool, lp)
+}
+
+// queryLiquidityProvider
+func queryLiquidityProvider(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	lp, err := getLiquidityProviderFromPath(ctx, path, mgr)
+	if err != nil {
+		return nil, err
+	}
+	result := openapi.LiquidityProvider{
+		Asset:                     lp.Asset.String(),
+		CacaoAddress:              wrapString(lp.CacaoAddress.String()),
+		AssetAddress:              wrapString(lp.AssetAddress.String()),
+		LastAddHeight:             wrapInt64(lp.LastAddHeight),
+		LastWithdrawHeight:        wrapInt64(lp.LastWithdrawHeight),
+		Units:                     lp.Units.String(),
+		PendingCacao:              lp.PendingCacao.String(),
+		PendingAsset:              lp.PendingAsset.String(),
+		PendingTxId:               wrapString(lp.PendingTxID.String()),
+		CacaoDepositValue:         lp.CacaoDepositValue.String(),
+		AssetDepositValue:         lp.AssetDepositValue.String(),
+		WithdrawCounter:           lp.WithdrawCounter.String(),
+		LastWithdrawCounterHeight: wrapInt64(lp.LastWithdrawCounterHeight),
+		BondedNodes:               make([]openapi.LPBondedNode, 0, len(lp.BondedNodes)),
+	}
+	if !lp.NodeBondAddress.Empty() {
+		result.NodeBondAddress = wrapString(lp.NodeBondAddress.String())
+	}
+	for _, node := range lp.BondedNodes {
+		result.BondedNodes = append(result.BondedNodes, openapi.LPBondedNode{
+			NodeAddress: node.NodeAddress.String(),
+			Units:       node.Units.String(),
+		})
+	}
+
+	// the estimate is informational, the position is returned regardless
+	est, err := estimateLiquidityProvider(ctx, mgr, lp)
+	if err != nil {
+		ctx.Logger().Error("fail to estimate liquidity provider withdraw", "error", err)
+	} else {
+		result.CacaoRedeemValue = wrapString(est.CacaoAmount.String())
+		result.AssetRedeemValue = wrapString(est.AssetAmount.String())
+		result.ImpermanentLossProtection = wrapString(est.ProtectionCacaoAmount.String())
+		result.GrowthBps = &est.GrowthBasisPoints
+		result.LockupBlocksRemaining = &est.LockUpBlocksRemaining
+	}
+
+	res, err := json.MarshalIndent(result, "", "	")
+	if err != nil {
+		ctx.Logger().Error("fail to marshal liquidity provider to json", "error", err)
+		return nil, fmt.Errorf("fail to marshal liquidity provider to json: %w", err)
+	}
+	return res, nil
+}
+
+func queryLiquidityProviderEstimate(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	lp, err := getLiquidityProviderFromPath(ctx, path, mgr)
+	if err != nil {
+		return nil, err
+	}
+	est, err := estimateLiquidityProvider(ctx, mgr, lp)
+	if err != nil {
+		ctx.Logger().Error("fail to estimate liquidity provider withdraw", "error", err)
+		return nil, fmt.Errorf("fail to estimate liquidity provider withdraw: %w", err)
+	}
+	result := openapi.LiquidityProviderEstimateResponse{
+		Asset:                     lp.Asset.String(),
+		CacaoAddress:              wrapString(lp.CacaoAddress.String()),
+		AssetAddress:              wrapString(lp.AssetAddress.String()),
+		Height:                    ctx.BlockHeight(),
+		Units:                     lp.Units.String(),
+		CacaoDepositValue:         lp.CacaoDepositValue.String(),
+		AssetDepositValue:         lp.AssetDepositValue.String(),
+		CacaoRedeemValue:          est.CacaoAmount.String(),
+		AssetRedeemValue:          est.AssetAmount.String(),
+		ImpermanentLossProtection: est.ProtectionCacaoAmount.String(),
+		DepositValue:              est.DepositValue.String(),
+		RedeemValue:               est.RedeemValue.String(),
+		GrowthBps:                 est.GrowthBasisPoints,
+		LockupBlocksRemaining:     est.LockUpBlocksRemaining,
+	}
+	res, err := json.MarshalIndent(result, "", "	")
+	if err != nil {
+		ctx.Logger().Error("fail to marshal liquidity provider estimate to json", "error", err)
+		return nil, fmt.Errorf("fail to marshal liquidity provider estimate to json: %w", err)
+	}
+	return res, nil
+}
+
+func queryBuckets(ctx cosmos.Context, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	buckets := make([]openapi.Bucket, 0)
+	iterator := mgr.Keeper().GetPoolIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var bucket Pool
+		if err := mgr.Keeper().Cdc().Unmarshal(iterator.Value(), &bucket); err != nil {
+			return nil, fmt.Errorf("fail to unmarshal bucket: %w", err)
+		}
+		// ignore bucket if no liquidity provider units
+		if bucket.LPUnits.IsZero() {
+			continue
+		}
+
+		if !bucket.Asset.IsVaultAsset() {
+			continue
+		}
+
+		p, err := castBucket(ctx, mgr, bucket)
+		if err != nil {
+			return nil, err
+		}
+		buckets = append(buckets, p)
+	}
+	res, err := json.MarshalIndent(buckets, "", "	")
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal buckets result to json: %w", err)
 	}
 	return res, nil
 }
 
 // nolint: unparam
-func queryPool(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+func queryBucket(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
 	if len(path) == 0 {
 		return nil, errors.New("asset not provided")
 	}
+	path[0] = strings.Replace(path[0], "_", "/", 1)
 	asset, err := common.NewAsset(path[0])
 	if err != nil {
 		ctx.Logger().Error("fail to parse asset", "error", err)
 		return nil, fmt.Errorf("could not parse asset: %w", err)
 	}
 
-	pool, err := mgr.Keeper().GetPool(ctx, asset)
+	if !asset.IsVaultAsset() {
+		return nil, fmt.Errorf("given asset is a pool asset: %s", asset)
+	}
+
+	bucket, err := mgr.Keeper().GetPool(ctx, asset)
 	if err != nil {
-		ctx.Logger().Error("fail to get pool", "error", err)
-		return nil, fmt.Errorf("could not get pool: %w", err)
+		ctx.Logger().Error("fail to get bucket", "error", err)
+		return nil, fmt.Errorf("could not get bucket: %w", err)
 	}
-	if pool.IsEmpty() {
-		return nil, fmt.Errorf("pool: %s doesn't exist", path[0])
+	if bucket.IsEmpty() {
+		return nil, fmt.Errorf("bucket: %s doesn't exist", path[0])
+	}
+
+	b, err := castBucket(ctx, mgr, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := json.MarshalIndent(b, "", "	")
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal result to JSON: %w", err)
+	}
+	return res, nil
+}
+
+// castBucket returns the bucket along with its savers, the yield paid to them,
+// and how much of the synth cap of the layer1 pool is filled
+func castBucket(ctx cosmos.Context, mgr *Mgrs, bucket Pool) (openapi.Bucket, error) {
+	b := openapi.Bucket{
+		BalanceAsset: bucket.BalanceAsset.String(),
+		Asset:        bucket.Asset.String(),
+		LPUnits:      bucket.LPUnits.String(),
+		Status:       bucket.Status.String(),
+	}
+
+	savers, err := mgr.Keeper().GetSaversCount(ctx, bucket.Asset)
+	if err != nil {
+		return openapi.Bucket{}, fmt.Errorf("fail to get savers count: %w", err)
+	}
+	b.SaversCount = &savers
+
+	totalYield, err := mgr.Keeper().GetSaversYield(ctx, bucket.Asset)
+	if err != nil {
+		return openapi.Bucket{}, fmt.Errorf("fail to get savers yield: %w", err)
+	}
+	b.TotalYield = wrapString(totalYield.String())
+	if yield, ok := getPoolYield(ctx, mgr, bucket, 0); ok {
+		b.AprBps = &yield.APRBasisPoints
+		b.ApyBps = &yield.APYBasisPoints
+	}
+
+	pool, err := mgr.Keeper().GetPool(ctx, bucket.Asset.GetLayer1Asset())
+	if err != nil {
+		return openapi.Bucket{}, fmt.Errorf("fail to get pool: %w", err)
+	}
+	synthSupply := mgr.Keeper().GetTotalSupply(ctx, bucket.Asset.GetSyntheticAsset())
+	maxSynths := fetchConfigInt64(ctx, mgr, constants.MaxSynthPerAssetDepth)
+	b.SynthSupply = wrapString(synthSupply.String())
+	b.MaxSynthCoverageBps = &maxSynths
+	if !pool.BalanceAsset.IsZero() {
+		// same measure the swap handler caps the synth supply with
+		coverage := int64(synthSupply.MulUint64(MaxWithdrawBasisPoints).Quo(pool.BalanceAsset).Uint64())
+		b.SynthCoverageBps = &coverage
+		if maxSynths > 0 {
+			fill := coverage * MaxWithdrawBasisPoints / maxSynths
+			b.SynthFillBps = &fill
+		}
+	}
+	return b, nil
+}
+
+// nolint: unparam
+func queryPool(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	pool, err := getPoolFromPath(ctx, path, mgr)
+	if err != nil {
+		return nil, err
 	}
 	synthSupply := mgr.Keeper().GetTotalSupply(ctx, pool.Asset.GetSyntheticAsset())
-	pool.CalcUnits(mgr.GetVersion(), synthSupply)
 
 	p := &openapi.Pool{
 		BalanceCacao:        pool.BalanceCacao.String(),
@@ -946,6 +1728,10 @@ func queryPool(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mg
 		PendingInboundCacao: pool.PendingInboundCacao.String(),
 		PendingInboundAsset: pool.PendingInboundAsset.String(),
 	}
+	if yield, ok := getPoolYield(ctx, mgr, pool, 0); ok {
+		p.AprBps = &yield.APRBasisPoints
+		p.ApyBps = &yield.APYBasisPoints
+	}
 
 	res, err := json.MarshalIndent(p, "", "	")
 	if err != nil {
@@ -954,21 +1740,207 @@ func queryPool(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mg
 	return res, nil
 }
 
+// poolYield is the yield of a pool between a snapshot and the current height
+type poolYield struct {
+	StartHeight    int64
+	PeriodBlocks   int64
+	APRBasisPoints int64
+	APYBasisPoints int64
+	LiquidityFees  cosmos.Uint
+	SwapVolume     cosmos.Uint
+}
+
+// getPoolYield returns the yield of the pool since the oldest snapshot within
+// the given period, or since the oldest snapshot kept when the period is zero.
+// The pool is expected to have its units calculated. The yield comes from the
+// growth of the value of a liquidity unit, driven by the liquidity fees for a
+// pool and by the synth yield paid to the savers for a bucket.
+func getPoolYield(ctx cosmos.Context, mgr *Mgrs, pool Pool, period int64) (poolYield, bool) {
+	snapshots, err := mgr.Keeper().GetPoolSnapshots(ctx, pool.Asset)
+	if err != nil {
+		ctx.Logger().Error("fail to get pool snapshots", "pool", pool.Asset, "error", err)
+		return poolYield{}, false
+	}
+	yield := poolYield{
+		LiquidityFees: cosmos.ZeroUint(),
+		SwapVolume:    cosmos.ZeroUint(),
+	}
+	var start *PoolSnapshot
+	for i := range snapshots {
+		snapshot := snapshots[i]
+		if snapshot.Height >= ctx.BlockHeight() || (period > 0 && snapshot.Height < ctx.BlockHeight()-period) {
+			continue
+		}
+		if start == nil {
+			start = &snapshot
+			continue
+		}
+		// the fees and volume of a snapshot are the ones since the previous snapshot
+		yield.LiquidityFees = yield.LiquidityFees.Add(snapshot.LiquidityFees)
+		yield.SwapVolume = yield.SwapVolume.Add(snapshot.SwapVolume)
+	}
+	if start == nil || start.GetUnitValue().IsZero() {
+		return poolYield{}, false
+	}
+	fees, volume, err := mgr.Keeper().GetPoolSnapshotSwaps(ctx, pool.Asset)
+	if err != nil {
+		ctx.Logger().Error("fail to get pool swaps since last snapshot", "pool", pool.Asset, "error", err)
+		return poolYield{}, false
+	}
+	yield.LiquidityFees = yield.LiquidityFees.Add(fees)
+	yield.SwapVolume = yield.SwapVolume.Add(volume)
+	yield.StartHeight = start.Height
+	yield.PeriodBlocks = ctx.BlockHeight() - start.Height
+
+	startValue := cosmos.NewDecFromBigInt(start.GetUnitValue().BigInt())
+	growth := cosmos.NewDecFromBigInt(pool.GetUnitValue().BigInt()).Sub(startValue).Quo(startValue)
+	blocksPerYear := fetchConfigInt64(ctx, mgr, constants.BlocksPerYear)
+	yield.APRBasisPoints = growth.MulInt64(MaxWithdrawBasisPoints).MulInt64(blocksPerYear).QuoInt64(yield.PeriodBlocks).TruncateInt64()
+	// compound the growth of the period over a year
+	periodsPerYear := float64(blocksPerYear) / float64(yield.PeriodBlocks)
+	apy := (math.Pow(1+growth.MustFloat64(), periodsPerYear) - 1) * MaxWithdrawBasisPoints
+	switch {
+	case apy >= math.MaxInt64 || math.IsNaN(apy):
+		yield.APYBasisPoints = math.MaxInt64
+	default:
+		yield.APYBasisPoints = int64(apy)
+	}
+	return yield, true
+}
+
+func getPoolFromPath(ctx cosmos.Context, path []string, mgr *Mgrs) (Pool, error) {
+	if len(path) == 0 {
+		return Pool{}, errors.New("asset not provided")
+	}
+	asset, err := common.NewAsset(path[0])
+	if err != nil {
+		ctx.Logger().Error("fail to parse asset", "error", err)
+		return Pool{}, fmt.Errorf("could not parse asset: %w", err)
+	}
+	pool, err := mgr.Keeper().GetPool(ctx, asset)
+	if err != nil {
+		ctx.Logger().Error("fail to get pool", "error", err)
+		return Pool{}, fmt.Errorf("could not get pool: %w", err)
+	}
+	if pool.IsEmpty() {
+		return Pool{}, fmt.Errorf("pool: %s doesn't exist", path[0])
+	}
+	synthSupply := mgr.Keeper().GetTotalSupply(ctx, pool.Asset.GetSyntheticAsset())
+	pool.CalcUnits(mgr.GetVersion(), synthSupply)
+	return pool, nil
+}
+
+// queryPoolSnapshots
+// /mayachain/pool/{asset}/snapshots
+func queryPoolSnapshots(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	pool, err := getPoolFromPath(ctx, path, mgr)
+	if err != nil {
+		return nil, err
+	}
+	snapshots, err := mgr.Keeper().GetPoolSnapshots(ctx, pool.Asset)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get pool snapshots: %w", err)
+	}
+	result := make([]openapi.PoolSnapshot, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		result = append(result, openapi.PoolSnapshot{
+			Height:        snapshot.Height,
+			BalanceCacao:  snapshot.BalanceCacao.String(),
+			BalanceAsset:  snapshot.BalanceAsset.String(),
+			LPUnits:       snapshot.LPUnits.String(),
+			SynthUnits:    snapshot.SynthUnits.String(),
+			PoolUnits:     snapshot.GetPoolUnits().String(),
+			Luvi:          snapshot.GetLUVI().String(),
+			LiquidityFees: snapshot.LiquidityFees.String(),
+			SwapVolume:    snapshot.SwapVolume.String(),
+		})
+	}
+	res, err := json.MarshalIndent(result, "", "	")
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal pool snapshots to json: %w", err)
+	}
+	return res, nil
+}
+
+// queryPoolYield
+// /mayachain/pool/{asset}/yield?period={blocks}
+func queryPoolYield(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	pool, err := getPoolFromPath(ctx, path, mgr)
+	if err != nil {
+		return nil, err
+	}
+	var period int64
+	if value := queryParams(req).Get("period"); value != "" {
+		period, err = strconv.ParseInt(value, 10, 64)
+		if err != nil || period <= 0 {
+			return nil, fmt.Errorf("invalid period: %s", value)
+		}
+	}
+	yield, ok := getPoolYield(ctx, mgr, pool, period)
+	if !ok {
+		return nil, fmt.Errorf("no snapshot of pool %s within the period", pool.Asset)
+	}
+	result := openapi.PoolYieldResponse{
+		Asset:         pool.Asset.String(),
+		StartHeight:   yield.StartHeight,
+		EndHeight:     ctx.BlockHeight(),
+		PeriodBlocks:  yield.PeriodBlocks,
+		AprBps:        yield.APRBasisPoints,
+		ApyBps:        yield.APYBasisPoints,
+		LiquidityFees: yield.LiquidityFees.String(),
+		SwapVolume:    yield.SwapVolume.String(),
+	}
+	res, err := json.MarshalIndent(result, "", "	")
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal pool yield to json: %w", err)
+	}
+	return res, nil
+}
+
+// queryPools
+// /mayachain/pools?status={status}&limit={limit}&next_key={key}
 func queryPools(ctx cosmos.Context, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	params := queryParams(req)
+	page, err := parsePageRequest(params)
+	if err != nil {
+		return nil, err
+	}
+	var status *PoolStatus
+	if len(params.Get("status")) > 0 {
+		// analyze-ignore(map-iteration)
+		for value, name := range types.PoolStatus_name {
+			if strings.EqualFold(name, params.Get("status")) {
+				s := PoolStatus(value)
+				status = &s
+			}
+		}
+		if status == nil {
+			return nil, fmt.Errorf("invalid pool status: %s", params.Get("status"))
+		}
+	}
+
 	pools := make([]openapi.Pool, 0)
-	iterator := mgr.Keeper().GetPoolIterator(ctx)
-	for ; iterator.Valid(); iterator.Next() {
+	iterator := mgr.Keeper().GetPoolIteratorFrom(ctx, page.key)
+	defer iterator.Close()
+	pagination, err := paginate(ctx, iterator, page, func(value []byte, take bool) (bool, error) {
 		var pool Pool
-		if err := mgr.Keeper().Cdc().Unmarshal(iterator.Value(), &pool); err != nil {
-			return nil, fmt.Errorf("fail to unmarshal pool: %w", err)
+		if err := mgr.Keeper().Cdc().Unmarshal(value, &pool); err != nil {
+			return false, fmt.Errorf("fail to unmarshal pool: %w", err)
 		}
 		// ignore pool if no liquidity provider units
 		if pool.LPUnits.IsZero() {
-			continue
+			return false, nil
 		}
 
 		if pool.Asset.IsVaultAsset() {
-			continue
+			return false, nil
+		}
+
+		if status != nil && pool.Status != *status {
+			return false, nil
+		}
+		if !take {
+			return true, nil
 		}
 
 		synthSupply := mgr.Keeper().GetTotalSupply(ctx, pool.Asset.GetSyntheticAsset())
@@ -987,9 +1959,17 @@ func queryPools(ctx cosmos.Context, req abci.RequestQuery, mgr *Mgrs) ([]byte, e
 			PendingInboundCacao: pool.PendingInboundCacao.String(),
 			PendingInboundAsset: pool.PendingInboundAsset.String(),
 		}
+		if yield, ok := getPoolYield(ctx, mgr, pool, 0); ok {
+			p.AprBps = &yield.APRBasisPoints
+			p.ApyBps = &yield.APYBasisPoints
+		}
 		pools = append(pools, p)
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	res, err := json.MarshalIndent(pools, "", "	")
+	res, err := marshalPage(page, "pools", pools, pagination)
 	if err != nil {
 		return nil, fmt.Errorf("could not marshal pools result to json: %w", err)
 	}
@@ -1000,7 +1980,7 @@ func queryTxVoters(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr
 	if len(path) == 0 {
 		return nil, errors.New("tx id not provided")
 	}
-	hash, err := common.NewTxID(path[0])
+	hash, err := common.NewTxIDV106(path[0])
 	if err != nil {
 		ctx.Logger().Error("fail to parse tx id", "error", err)
 		return nil, fmt.Errorf("fail to parse tx id: %w", err)
@@ -1033,7 +2013,7 @@ func queryTx(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs
 	if len(path) == 0 {
 		return nil, errors.New("tx id not provided")
 	}
-	hash, err := common.NewTxID(path[0])
+	hash, err := common.NewTxIDV106(path[0])
 	if err != nil {
 		ctx.Logger().Error("fail to parse tx id", "error", err)
 		return nil, fmt.Errorf("fail to parse tx id: %w", err)
@@ -1067,11 +2047,302 @@ func queryTx(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs
 	}{
 		KeysignMetrics: *keysignMetric,
 	}
-	result.ObservedTx = voter.GetTx(nodeAccounts)
+	result.ObservedTx = voter.GetTx(nodeAccounts)
+	res, err := json.MarshalIndent(result, "", "	")
+	if err != nil {
+		ctx.Logger().Error("fail to marshal tx hash to json", "error", err)
+		return nil, fmt.Errorf("fail to marshal tx hash to json: %w", err)
+	}
+	return res, nil
+}
+
+// castTx converts a tx to its openapi representation
+func castTx(tx common.Tx) openapi.Tx {
+	return openapi.Tx{
+		Id:          wrapString(tx.ID.String()),
+		Chain:       wrapString(tx.Chain.String()),
+		FromAddress: wrapString(tx.FromAddress.String()),
+		ToAddress:   wrapString(tx.ToAddress.String()),
+		Coins:       castCoins(tx.Coins...),
+		Gas:         castCoins(tx.Gas...),
+		Memo:        wrapString(tx.Memo),
+	}
+}
+
+// castCoins converts coins to their openapi representation
+func castCoins(coins ...common.Coin) []openapi.Coin {
+	result := make([]openapi.Coin, 0, len(coins))
+	for _, coin := range coins {
+		result = append(result, openapi.Coin{
+			Asset:    coin.Asset.String(),
+			Amount:   coin.Amount.String(),
+			Decimals: wrapInt64(coin.Decimals),
+		})
+	}
+	return result
+}
+
+// castTxOutItem converts a tx out item scheduled at the given height to its
+// openapi representation
+func castTxOutItem(toi TxOutItem, height int64) openapi.TxOutItem {
+	return openapi.TxOutItem{
+		Chain:       toi.Chain.String(),
+		ToAddress:   toi.ToAddress.String(),
+		VaultPubKey: wrapString(toi.VaultPubKey.String()),
+		Coin:        castCoins(toi.Coin)[0],
+		Memo:        wrapString(toi.Memo),
+		MaxGas:      castCoins(toi.MaxGas...),
+		GasRate:     wrapInt64(toi.GasRate),
+		InHash:      wrapString(toi.InHash.String()),
+		OutHash:     wrapString(toi.OutHash.String()),
+		Height:      height,
+	}
+}
+
+// queryTxStatus walks the lifecycle of an inbound, from its observation to
+// the observation of its outbounds, with the heights of each stage
+// /mayachain/tx/status/{hash}
+func queryTxStatus(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, errors.New("tx id not provided")
+	}
+	hash, err := common.NewTxIDV106(path[0])
+	if err != nil {
+		ctx.Logger().Error("fail to parse tx id", "error", err)
+		return nil, fmt.Errorf("fail to parse tx id: %w", err)
+	}
+	voter, err := mgr.Keeper().GetObservedTxInVoter(ctx, hash)
+	if err != nil {
+		ctx.Logger().Error("fail to get observed tx voter", "error", err)
+		return nil, fmt.Errorf("fail to get observed tx voter: %w", err)
+	}
+	if len(voter.Txs) == 0 {
+		return nil, fmt.Errorf("tx: %s doesn't exist", hash)
+	}
+	nodeAccounts, err := mgr.Keeper().ListActiveValidators(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get node accounts: %w", err)
+	}
+
+	result := openapi.TxStatusResponse{TxId: hash.String()}
+	stage := "done"
+	setStage := func(s string) {
+		// the first stage that isn't completed is the current one
+		if stage == "done" {
+			stage = s
+		}
+	}
+
+	// inbound observation, the nodes are counted once per observation state
+	preConfirmation := make(map[string]bool)
+	final := make(map[string]bool)
+	for _, tx := range voter.Txs {
+		for _, signer := range tx.GetSigners() {
+			if tx.IsFinal() {
+				final[signer.String()] = true
+			} else {
+				preConfirmation[signer.String()] = true
+			}
+		}
+	}
+	observedHeight := voter.Height
+	if observedHeight == 0 {
+		observedHeight = voter.FinalisedHeight
+	}
+	result.Stages.InboundObserved = openapi.InboundObservedStage{
+		Started:              true,
+		PreConfirmationCount: wrapInt64(int64(len(preConfirmation))),
+		FinalCount:           wrapInt64(int64(len(final))),
+		Height:               wrapInt64(observedHeight),
+		Completed:            observedHeight > 0,
+	}
+	if observedHeight == 0 {
+		setStage("inbound_observation")
+	}
+
+	tx := voter.GetTx(nodeAccounts)
+	if tx.IsEmpty() {
+		// no consensus yet, report the first observation
+		tx = voter.Txs[0]
+	}
+	apiTx := castTx(tx.Tx)
+	result.Tx = &apiTx
+
+	// confirmation counting, only for the chains whose inbounds wait for
+	// confirmations before they are finalised
+	if tx.FinaliseHeight > tx.BlockHeight {
+		stage := openapi.InboundConfirmationCountedStage{
+			Chain:                           wrapString(tx.Tx.Chain.String()),
+			ExternalObservedHeight:          wrapInt64(tx.BlockHeight),
+			ExternalConfirmationDelayHeight: wrapInt64(tx.FinaliseHeight),
+			Completed:                       voter.FinalisedHeight > 0,
+		}
+		if !stage.Completed {
+			chainHeight, err := mgr.Keeper().GetLastChainHeight(ctx, tx.Tx.Chain)
+			if err != nil {
+				ctx.Logger().Error("fail to get last chain height", "chain", tx.Tx.Chain, "error", err)
+			}
+			remaining := tx.FinaliseHeight - chainHeight
+			if remaining < 0 {
+				remaining = 0
+			}
+			stage.RemainingConfirmationBlocks = wrapInt64(remaining)
+			chainMs := tx.Tx.Chain.ApproximateBlockMilliseconds()
+			baseMs := common.BASEChain.ApproximateBlockMilliseconds()
+			if chainMs > 0 && baseMs > 0 {
+				// round up, the inbound is finalised in the block after the confirmation
+				blocks := (remaining*chainMs + baseMs - 1) / baseMs
+				stage.ExpectedHeight = wrapInt64(ctx.BlockHeight() + blocks + 1)
+			}
+			setStage("inbound_confirmation")
+		}
+		result.Stages.InboundConfirmationCounted = &stage
+	}
+
+	result.Stages.InboundFinalised = &openapi.InboundFinalisedStage{
+		Height:    wrapInt64(voter.FinalisedHeight),
+		Completed: voter.FinalisedHeight > 0,
+	}
+	if voter.FinalisedHeight == 0 {
+		setStage("inbound_confirmation")
+	}
+
+	// swaps wait in the swap queue, limit orders rest in the order book
+	memo, _ := ParseMemoWithMAYANames(ctx, mgr.Keeper(), tx.Tx.Memo)
+	var queueLen int64
+	var queued *MsgSwap
+	iterator := mgr.Keeper().GetSwapQueueIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var msg MsgSwap
+		if err := mgr.Keeper().Cdc().Unmarshal(iterator.Value(), &msg); err != nil {
+			continue
+		}
+		queueLen++
+		if queued == nil && msg.Tx.ID.Equals(hash) {
+			queued = &msg
+		}
+	}
+	switch {
+	case queued != nil:
+		minSwapsPerBlock := fetchConfigInt64(ctx, mgr, constants.MinSwapsPerBlock)
+		maxSwapsPerBlock := fetchConfigInt64(ctx, mgr, constants.MaxSwapsPerBlock)
+		// the swap is processed by the time the queue, as it stands, is drained
+		swapQ := newSwapQv95(mgr.Keeper())
+		blocks := int64(0)
+		for remaining := queueLen; remaining > 0; blocks++ {
+			todo := swapQ.getTodoNum(remaining, minSwapsPerBlock, maxSwapsPerBlock)
+			if todo <= 0 {
+				break
+			}
+			remaining -= todo
+		}
+		result.Stages.SwapStatus = &openapi.SwapStatus{
+			Pending:        true,
+			Queue:          wrapString("swap_queue"),
+			OrderType:      wrapString(queued.OrderType.String()),
+			QueueLength:    wrapInt64(queueLen),
+			ExpectedHeight: wrapInt64(ctx.BlockHeight() + blocks),
+		}
+		setStage("swap_queue")
+	case mgr.Keeper().HasOrderBookItem(ctx, hash):
+		msg, err := mgr.Keeper().GetOrderBookItem(ctx, hash)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get order book item: %w", err)
+		}
+		result.Stages.SwapStatus = &openapi.SwapStatus{
+			Pending:   true,
+			Queue:     wrapString("order_book"),
+			OrderType: wrapString(msg.OrderType.String()),
+		}
+		setStage("order_book")
+	case memo != nil && memo.IsType(TxSwap):
+		result.Stages.SwapStatus = &openapi.SwapStatus{Pending: false}
+	}
+
+	// outbounds are scheduled on a single height, and signed by their vault
+	// within the signing period
+	scheduledHeight := voter.OutboundHeight
+	if scheduledHeight == 0 {
+		scheduledHeight = voter.FinalisedHeight
+	}
+	if len(voter.Actions) > 0 {
+		remaining := scheduledHeight - ctx.BlockHeight()
+		if remaining < 0 {
+			remaining = 0
+		}
+		result.Stages.OutboundDelay = &openapi.OutboundDelayStage{
+			ScheduledHeight:      wrapInt64(scheduledHeight),
+			RemainingDelayBlocks: wrapInt64(remaining),
+			Completed:            remaining == 0,
+		}
+		if remaining > 0 {
+			setStage("outbound_delay")
+		}
+
+		var pending int64
+		txOut, err := mgr.Keeper().GetTxOut(ctx, scheduledHeight)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get tx out array from key value store: %w", err)
+		}
+		for _, toi := range txOut.TxArray {
+			if !toi.InHash.Equals(hash) {
+				continue
+			}
+			result.PlannedOutTxs = append(result.PlannedOutTxs, castTxOutItem(toi, scheduledHeight))
+			if toi.OutHash.IsEmpty() {
+				pending++
+			}
+		}
+		if len(result.PlannedOutTxs) == 0 {
+			// outbounds of the native chain are not signed, they are sent right away
+			for _, toi := range voter.Actions {
+				result.PlannedOutTxs = append(result.PlannedOutTxs, castTxOutItem(toi, scheduledHeight))
+			}
+		}
+		signed := openapi.OutboundSignedStage{
+			ScheduledHeight: wrapInt64(scheduledHeight),
+			Pending:         wrapInt64(pending),
+			Completed:       remaining == 0 && pending == 0,
+		}
+		if remaining == 0 {
+			signingPeriod := mgr.GetConstants().GetInt64Value(constants.SigningTransactionPeriod)
+			signed.BlocksSinceScheduled = wrapInt64(ctx.BlockHeight() - scheduledHeight)
+			signed.ExpectedHeight = wrapInt64(scheduledHeight + signingPeriod)
+		}
+		result.Stages.OutboundSigned = &signed
+		if !signed.Completed {
+			setStage("outbound_keysign")
+		}
+
+		observed := openapi.OutboundObservedStage{
+			Started:   len(voter.OutTxs) > 0,
+			Completed: voter.IsDone(),
+		}
+		var outHeight int64
+		for _, outTx := range voter.OutTxs {
+			result.OutTxs = append(result.OutTxs, castTx(outTx))
+			outVoter, err := mgr.Keeper().GetObservedTxOutVoter(ctx, outTx.ID)
+			if err != nil {
+				ctx.Logger().Error("fail to get observed tx out voter", "error", err)
+				continue
+			}
+			if outVoter.Height > outHeight {
+				outHeight = outVoter.Height
+			}
+		}
+		observed.Height = wrapInt64(outHeight)
+		result.Stages.OutboundObserved = &observed
+		if !observed.Completed {
+			setStage("outbound_observation")
+		}
+	}
+	result.Stage = wrapString(stage)
+
 	res, err := json.MarshalIndent(result, "", "	")
 	if err != nil {
-		ctx.Logger().Error("fail to marshal tx hash to json", "error", err)
-		return nil, fmt.Errorf("fail to marshal tx hash to json: %w", err)
+		ctx.Logger().Error("fail to marshal tx status to json", "error", err)
+		return nil, fmt.Errorf("fail to marshal tx status to json: %w", err)
 	}
 	return res, nil
 }
@@ -1367,7 +2638,13 @@ func queryMimirWithKey(ctx cosmos.Context, path []string, req abci.RequestQuery,
 	return res, nil
 }
 
+// queryMimirValues
+// /mayachain/mimir?limit={limit}&next_key={key}
 func queryMimirValues(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	page, err := parsePageRequest(queryParams(req))
+	if err != nil {
+		return nil, err
+	}
 	values := make(map[string]int64)
 
 	// collect keys
@@ -1384,23 +2661,40 @@ func queryMimirValues(ctx cosmos.Context, path []string, req abci.RequestQuery,
 		values[k] = 0
 	}
 
+	// the keys of both prefixes are merged, they are paged in key order
+	keys := make([]string, 0, len(values))
 	// analyze-ignore(map-iteration)
 	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	iterator, err := newListIterator(keys, page)
+	if err != nil {
+		return nil, err
+	}
+	values = make(map[string]int64)
+	pagination, err := paginate(ctx, iterator, page, func(_ []byte, take bool) (bool, error) {
+		k := keys[iterator.Index()]
 		v, err := mgr.Keeper().GetMimir(ctx, k)
 		if err != nil {
-			return nil, fmt.Errorf("fail to get mimir, err: %w", err)
+			return false, fmt.Errorf("fail to get mimir, err: %w", err)
 		}
 		// v from GetMimir is of type int64.
 		if v == -1 {
 			// This key has node votes but no node consensus or Admin-set value,
 			// so do not display its unset status in the Mimir endpoint.
-			delete(values, k)
-			continue
+			return false, nil
+		}
+		if take {
+			values[k] = v
 		}
-		values[k] = v
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	res, err := json.MarshalIndent(values, "", "	")
+	res, err := marshalPage(page, "mimir", values, pagination)
 	if err != nil {
 		ctx.Logger().Error("fail to marshal mimir values to json", "error", err)
 		return nil, fmt.Errorf("fail to marshal mimir values to json: %w", err)
@@ -1408,20 +2702,33 @@ func queryMimirValues(ctx cosmos.Context, path []string, req abci.RequestQuery,
 	return res, nil
 }
 
+// queryMimirAdminValues
+// /mayachain/mimir/admin?limit={limit}&next_key={key}
 func queryMimirAdminValues(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	page, err := parsePageRequest(queryParams(req))
+	if err != nil {
+		return nil, err
+	}
 	values := make(map[string]int64)
-	iter := mgr.Keeper().GetMimirIterator(ctx)
+	iter := mgr.Keeper().GetMimirIteratorFrom(ctx, page.key)
 	defer iter.Close()
-	for ; iter.Valid(); iter.Next() {
-		value := types.ProtoInt64{}
-		if err := mgr.Keeper().Cdc().Unmarshal(iter.Value(), &value); err != nil {
+	pagination, err := paginate(ctx, iter, page, func(value []byte, take bool) (bool, error) {
+		if !take {
+			return true, nil
+		}
+		v := types.ProtoInt64{}
+		if err := mgr.Keeper().Cdc().Unmarshal(value, &v); err != nil {
 			ctx.Logger().Error("fail to unmarshal mimir value", "error", err)
-			return nil, fmt.Errorf("fail to unmarshal mimir value: %w", err)
+			return false, fmt.Errorf("fail to unmarshal mimir value: %w", err)
 		}
 		k := strings.TrimPrefix(string(iter.Key()), "mimir//")
-		values[k] = value.GetValue()
+		values[k] = v.GetValue()
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	res, err := json.MarshalIndent(values, "", "	")
+	res, err := marshalPage(page, "mimir", values, pagination)
 	if err != nil {
 		ctx.Logger().Error("fail to marshal mimir values to json", "error", err)
 		return nil, fmt.Errorf("fail to marshal mimir values to json: %w", err)
@@ -1429,20 +2736,39 @@ func queryMimirAdminValues(ctx cosmos.Context, path []string, req abci.RequestQu
 	return res, nil
 }
 
+// queryMimirNodesAllValues
+// /mayachain/mimir/nodes_all?limit={limit}&next_key={key}
 func queryMimirNodesAllValues(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	page, err := parsePageRequest(queryParams(req))
+	if err != nil {
+		return nil, err
+	}
 	mimirs := NodeMimirs{}
-	iter := mgr.Keeper().GetNodeMimirIterator(ctx)
+	iter := mgr.Keeper().GetNodeMimirIteratorFrom(ctx, page.key)
 	defer iter.Close()
-	for ; iter.Valid(); iter.Next() {
+	// the node mimirs are paged per key, a page holds all the votes of its keys
+	pagination, err := paginate(ctx, iter, page, func(value []byte, take bool) (bool, error) {
+		if !take {
+			return true, nil
+		}
 		m := NodeMimirs{}
-		if err := mgr.Keeper().Cdc().Unmarshal(iter.Value(), &m); err != nil {
+		if err := mgr.Keeper().Cdc().Unmarshal(value, &m); err != nil {
 			ctx.Logger().Error("fail to unmarshal node mimir value", "error", err)
-			return nil, fmt.Errorf("fail to unmarshal node mimir value: %w", err)
+			return false, fmt.Errorf("fail to unmarshal node mimir value: %w", err)
 		}
 		mimirs.Mimirs = append(mimirs.Mimirs, m.Mimirs...)
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	res, err := json.MarshalIndent(mimirs, "", "	")
+	var res []byte
+	if page.paged {
+		res, err = marshalPage(page, "mimirs", mimirs.Mimirs, pagination)
+	} else {
+		res, err = json.MarshalIndent(mimirs, "", "	")
+	}
 	if err != nil {
 		ctx.Logger().Error("fail to marshal mimir values to json", "error", err)
 		return nil, fmt.Errorf("fail to marshal mimir values to json: %w", err)
@@ -1512,6 +2838,62 @@ func queryMimirNodeValues(ctx cosmos.Context, path []string, req abci.RequestQue
 	return res, nil
 }
 
+// queryMimirRegistry returns the known mimir keys, with their types and bounds
+func queryMimirRegistry(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	result := make([]openapi.MimirRegistryKey, 0)
+	for _, m := range constants.GetMimirRegistry() {
+		result = append(result, openapi.MimirRegistryKey{
+			Key:         m.Key,
+			Description: m.Description,
+			Type:        string(m.Type),
+			Min:         m.Min,
+			Max:         m.Max,
+			Setter:      string(m.Setter),
+		})
+	}
+	res, err := json.MarshalIndent(result, "", "	")
+	if err != nil {
+		ctx.Logger().Error("fail to marshal mimir registry to json", "error", err)
+		return nil, fmt.Errorf("fail to marshal mimir registry to json: %w", err)
+	}
+	return res, nil
+}
+
+// queryMimirPending returns the scheduled mimir changes, by activation height
+func queryMimirPending(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	result := make([]openapi.PendingMimir, 0)
+	iter := mgr.Keeper().GetPendingMimirIterator(ctx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var pending PendingMimirs
+		if err := mgr.Keeper().Cdc().Unmarshal(iter.Value(), &pending); err != nil {
+			ctx.Logger().Error("fail to unmarshal pending mimirs", "error", err)
+			return nil, fmt.Errorf("fail to unmarshal pending mimirs: %w", err)
+		}
+		for _, m := range pending.Mimirs {
+			result = append(result, openapi.PendingMimir{
+				Key:              m.Key,
+				Value:            m.Value,
+				Signer:           m.Signer.String(),
+				ActivationHeight: m.ActivationHeight,
+			})
+		}
+	}
+	// the heights are stored as strings, they don't iterate in numerical order
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].ActivationHeight != result[j].ActivationHeight {
+			return result[i].ActivationHeight < result[j].ActivationHeight
+		}
+		return result[i].Key < result[j].Key
+	})
+	res, err := json.MarshalIndent(result, "", "	")
+	if err != nil {
+		ctx.Logger().Error("fail to marshal pending mimirs to json", "error", err)
+		return nil, fmt.Errorf("fail to marshal pending mimirs to json: %w", err)
+	}
+	return res, nil
+}
+
 func queryBan(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
 	if len(path) == 0 {
 		return nil, errors.New("node address not available")
@@ -1567,6 +2949,109 @@ func queryScheduledOutbound(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
 	return res, nil
 }
 
+// queryScheduledOutboundSimulate
+// /mayachain/queue/scheduled/simulate?asset={asset}&amount={amount}
+func queryScheduledOutboundSimulate(ctx cosmos.Context, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	params := queryParams(req)
+	asset, err := common.NewAsset(params.Get("asset"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid asset: %w", err)
+	}
+	amount, err := cosmos.ParseUint(params.Get("amount"))
+	if err != nil || amount.IsZero() {
+		return nil, fmt.Errorf("invalid amount: %s", params.Get("amount"))
+	}
+	if !asset.IsBase() {
+		pool, err := mgr.Keeper().GetPool(ctx, asset.GetLayer1Asset())
+		if err != nil {
+			return nil, fmt.Errorf("fail to get pool: %w", err)
+		}
+		if pool.IsEmpty() {
+			return nil, fmt.Errorf("pool: %s doesn't exist", asset.GetLayer1Asset())
+		}
+	}
+
+	schedule, err := calcTxOutSchedule(ctx, mgr.Keeper(), mgr.GetConstants(), common.NewCoin(asset, amount))
+	if err != nil {
+		return nil, fmt.Errorf("fail to simulate outbound scheduling: %w", err)
+	}
+
+	// the outbound is appended after the ones already scheduled up to its height
+	position := int64(1)
+	for height := ctx.BlockHeight() + 1; height <= schedule.Height; height++ {
+		txOut, err := mgr.Keeper().GetTxOut(ctx, height)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get tx out array from key value store: %w", err)
+		}
+		position += int64(len(txOut.TxArray))
+	}
+	maxBlockValue, err := mgr.Keeper().GetMimir(ctx, constants.MinTxOutVolumeThreshold.String())
+	if maxBlockValue <= 0 || err != nil {
+		maxBlockValue = mgr.GetConstants().GetInt64Value(constants.MinTxOutVolumeThreshold)
+	}
+
+	result := openapi.ScheduledSimulateResponse{
+		OutboundHeight:      schedule.Height,
+		OutboundDelayBlocks: schedule.Height - ctx.BlockHeight(),
+		QueuePosition:       position,
+		Value:               schedule.Value.String(),
+		ScheduledValue:      schedule.ScheduledValue.String(),
+		DelayRate:           cosmos.NewUint(uint64(schedule.DelayRate)).String(),
+		BlockValue:          schedule.BlockValue.Add(schedule.Value).String(),
+		MaxBlockValue:       cosmos.NewUint(uint64(maxBlockValue)).String(),
+	}
+	res, err := json.MarshalIndent(result, "", "	")
+	if err != nil {
+		return nil, fmt.Errorf("fail to marshal outbound simulation to json: %w", err)
+	}
+	return res, nil
+}
+
+// queryScheduledOutboundVolume
+// /mayachain/queue/scheduled/volume
+func queryScheduledOutboundVolume(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
+	maxTxOutOffset, err := mgr.Keeper().GetMimir(ctx, constants.MaxTxOutOffset.String())
+	if maxTxOutOffset <= 0 || err != nil {
+		maxTxOutOffset = mgr.GetConstants().GetInt64Value(constants.MaxTxOutOffset)
+	}
+	txOutDelayMax, err := mgr.Keeper().GetMimir(ctx, constants.TxOutDelayMax.String())
+	if txOutDelayMax <= 0 || err != nil {
+		txOutDelayMax = mgr.GetConstants().GetInt64Value(constants.TxOutDelayMax)
+	}
+
+	result := make([]openapi.ScheduledVolume, 0)
+	for height := ctx.BlockHeight() + 1; height <= ctx.BlockHeight()+txOutDelayMax; height++ {
+		txOut, err := mgr.Keeper().GetTxOut(ctx, height)
+		if err != nil {
+			ctx.Logger().Error("fail to get tx out array from key value store", "error", err)
+			continue
+		}
+		if len(txOut.TxArray) == 0 {
+			if height > ctx.BlockHeight()+maxTxOutOffset {
+				// we've hit our max offset, and an empty block, we can assume the
+				// rest will be empty as well
+				break
+			}
+			continue
+		}
+		value, err := mgr.Keeper().GetTxOutValue(ctx, height)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get tx out value: %w", err)
+		}
+		result = append(result, openapi.ScheduledVolume{
+			Height: height,
+			Count:  int64(len(txOut.TxArray)),
+			Value:  value.String(),
+		})
+	}
+
+	res, err := json.MarshalIndent(result, "", "	")
+	if err != nil {
+		return nil, fmt.Errorf("fail to marshal scheduled outbound volume to json: %w", err)
+	}
+	return res, nil
+}
+
 func queryPendingOutbound(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
 	constAccessor := mgr.GetConstants()
 	signingTransactionPeriod := constAccessor.GetInt64Value(constants.SigningTransactionPeriod)
@@ -1652,6 +3137,162 @@ func queryTssMetric(ctx cosmos.Context, path []string, req abci.RequestQuery, mg
 	return json.MarshalIndent(m, "", "	")
 }
 
+// blameWindowStart returns the first height of the window requested through
+// the optional window query parameter, the window defaults to and is capped by
+// the blame history kept in the key value store
+func blameWindowStart(ctx cosmos.Context, req abci.RequestQuery, mgr *Mgrs) (int64, url.Values, error) {
+	window := fetchConfigInt64(ctx, mgr, constants.BlameHistoryBlocks)
+	params := url.Values{}
+	if u, err := url.ParseRequestURI(string(req.Data)); err == nil {
+		params = u.Query()
+	}
+	if len(params.Get("window")) > 0 {
+		w, err := strconv.ParseInt(params.Get("window"), 10, 64)
+		if err != nil || w <= 0 {
+			return 0, nil, fmt.Errorf("invalid window: %s", params.Get("window"))
+		}
+		if w < window {
+			window = w
+		}
+	}
+	from := ctx.BlockHeight() - window + 1
+	if from < 1 {
+		from = 1
+	}
+	return from, params, nil
+}
+
+// getBlameRecords returns the blame records from the given height on, oldest first
+func getBlameRecords(ctx cosmos.Context, mgr *Mgrs, from int64) ([]BlameRecord, error) {
+	var records []BlameRecord
+	iter := mgr.Keeper().GetBlameRecordIterator(ctx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record BlameRecord
+		if err := mgr.Keeper().Cdc().Unmarshal(iter.Value(), &record); err != nil {
+			return nil, fmt.Errorf("fail to unmarshal blame record: %w", err)
+		}
+		if record.Height < from {
+			continue
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// queryBlame ranks the nodes by how often they were blamed for failed keysigns
+// and keygens over the window
+// /mayachain/blame?window={blocks}&type={keysign|keygen}
+func queryBlame(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	from, params, err := blameWindowStart(ctx, req, mgr)
+	if err != nil {
+		return nil, err
+	}
+	blameType := params.Get("type")
+	if len(blameType) > 0 && blameType != BlameTypeKeysign && blameType != BlameTypeKeygen {
+		return nil, fmt.Errorf("invalid blame type: %s", blameType)
+	}
+	records, err := getBlameRecords(ctx, mgr, from)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make(map[string]*openapi.NodeBlame)
+	for _, record := range records {
+		if len(blameType) > 0 && record.Type != blameType {
+			continue
+		}
+		node, ok := nodes[record.NodeAddress.String()]
+		if !ok {
+			node = &openapi.NodeBlame{
+				NodeAddress: record.NodeAddress.String(),
+				NodePubKey:  wrapString(record.NodePubKey.String()),
+			}
+			nodes[record.NodeAddress.String()] = node
+		}
+		node.Total++
+		switch record.Type {
+		case BlameTypeKeysign:
+			node.Keysign++
+		case BlameTypeKeygen:
+			node.Keygen++
+		}
+		// records are iterated oldest first
+		node.LastHeight = record.Height
+		node.LastRound = wrapString(record.Round)
+	}
+
+	result := openapi.BlameResponse{
+		FromHeight: from,
+		Nodes:      make([]openapi.NodeBlame, 0, len(nodes)),
+	}
+	for _, node := range nodes {
+		result.Nodes = append(result.Nodes, *node)
+	}
+	sort.SliceStable(result.Nodes, func(i, j int) bool {
+		if result.Nodes[i].Total != result.Nodes[j].Total {
+			return result.Nodes[i].Total > result.Nodes[j].Total
+		}
+		if result.Nodes[i].LastHeight != result.Nodes[j].LastHeight {
+			return result.Nodes[i].LastHeight > result.Nodes[j].LastHeight
+		}
+		return result.Nodes[i].NodeAddress < result.Nodes[j].NodeAddress
+	})
+
+	res, err := json.MarshalIndent(result, "", "	")
+	if err != nil {
+		return nil, fmt.Errorf("fail to marshal blame to json: %w", err)
+	}
+	return res, nil
+}
+
+// queryNodeBlame returns the blame history of a node over the window, newest first
+// /mayachain/blame/{nodeaddress}?window={blocks}
+func queryNodeBlame(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, errors.New("node address not provided")
+	}
+	addr, err := cosmos.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, cosmos.ErrUnknownRequest("invalid account address")
+	}
+	from, _, err := blameWindowStart(ctx, req, mgr)
+	if err != nil {
+		return nil, err
+	}
+	records, err := getBlameRecords(ctx, mgr, from)
+	if err != nil {
+		return nil, err
+	}
+
+	result := openapi.NodeBlameResponse{
+		NodeAddress: addr.String(),
+		FromHeight:  from,
+		Records:     make([]openapi.BlameRecord, 0),
+	}
+	for i := len(records) - 1; i >= 0; i-- {
+		record := records[i]
+		if !record.NodeAddress.Equals(addr) {
+			continue
+		}
+		result.Records = append(result.Records, openapi.BlameRecord{
+			Height:      record.Height,
+			Type:        record.Type,
+			Id:          record.ID,
+			VaultPubKey: wrapString(record.VaultPubKey.String()),
+			Chain:       wrapString(record.Chain.String()),
+			Round:       wrapString(record.Round),
+			FailReason:  wrapString(record.FailReason),
+		})
+	}
+
+	res, err := json.MarshalIndent(result, "", "	")
+	if err != nil {
+		return nil, fmt.Errorf("fail to marshal node blame to json: %w", err)
+	}
+	return res, nil
+}
+
 // queryLiquidityAuctionTier
 func queryLiquidityAuctionTier(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
 	if len(path) < 2 {
@@ -1723,6 +3364,106 @@ func queryLiquidityAuctionTier(ctx cosmos.Context, path []string, req abci.Reque
 	return res, nil
 }
 
+// queryLiquidityAuctionTiers list the liquidity auction tier of every liquidity
+// provider in the given pool, along with the aggregate liquidity per tier
+func queryLiquidityAuctionTiers(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, errors.New("asset not provided")
+	}
+	path[0] = strings.Replace(path[0], "_", "/", 1)
+	asset, err := common.NewAsset(path[0])
+	if err != nil {
+		ctx.Logger().Error("fail to get parse asset", "error", err)
+		return nil, fmt.Errorf("fail to parse asset: %w", err)
+	}
+
+	pool, err := mgr.Keeper().GetPool(ctx, asset)
+	if err != nil {
+		ctx.Logger().Error("fail to get pool", "error", err)
+		return nil, fmt.Errorf("fail to get pool: %w", err)
+	}
+	poolUnits := pool.GetPoolUnits()
+
+	type tierProvider struct {
+		CacaoAddress common.Address `json:"cacao_address"`
+		AssetAddress common.Address `json:"asset_address"`
+		Tier         int64          `json:"tier"`
+		Units        cosmos.Uint    `json:"units"`
+	}
+	type tierLiquidity struct {
+		Tier       int64       `json:"tier"`
+		Providers  int64       `json:"providers"`
+		Units      cosmos.Uint `json:"units"`
+		CacaoDepth cosmos.Uint `json:"cacao_depth"`
+		AssetDepth cosmos.Uint `json:"asset_depth"`
+	}
+
+	providers := make([]tierProvider, 0)
+	aggregates := make(map[int64]*tierLiquidity)
+	iterator := mgr.Keeper().GetLiquidityProviderIterator(ctx, asset)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var lp LiquidityProvider
+		mgr.Keeper().Cdc().MustUnmarshal(iterator.Value(), &lp)
+		if lp.Units.IsZero() {
+			continue
+		}
+
+		tier := int64(0)
+		if !lp.CacaoAddress.IsEmpty() {
+			tier, err = mgr.Keeper().GetLiquidityAuctionTier(ctx, lp.CacaoAddress)
+			if err != nil {
+				ctx.Logger().Error("fail to get tier", "error", err)
+				return nil, fmt.Errorf("fail to get tier: %w", err)
+			}
+		}
+		providers = append(providers, tierProvider{
+			CacaoAddress: lp.CacaoAddress,
+			AssetAddress: lp.AssetAddress,
+			Tier:         tier,
+			Units:        lp.Units,
+		})
+
+		agg, ok := aggregates[tier]
+		if !ok {
+			agg = &tierLiquidity{
+				Tier:  tier,
+				Units: cosmos.ZeroUint(),
+			}
+			aggregates[tier] = agg
+		}
+		agg.Providers++
+		agg.Units = agg.Units.Add(lp.Units)
+	}
+
+	tiers := make([]tierLiquidity, 0, len(aggregates))
+	for _, agg := range aggregates {
+		agg.CacaoDepth = common.GetSafeShare(agg.Units, poolUnits, pool.BalanceCacao)
+		agg.AssetDepth = common.GetSafeShare(agg.Units, poolUnits, pool.BalanceAsset)
+		tiers = append(tiers, *agg)
+	}
+	sort.SliceStable(tiers, func(i, j int) bool {
+		return tiers[i].Tier < tiers[j].Tier
+	})
+
+	m := struct {
+		Asset     common.Asset    `json:"asset"`
+		Providers []tierProvider  `json:"liquidity_providers"`
+		Tiers     []tierLiquidity `json:"tiers"`
+	}{
+		Asset:     asset,
+		Providers: providers,
+		Tiers:     tiers,
+	}
+
+	res, err := json.MarshalIndent(m, "", "	")
+	if err != nil {
+		ctx.Logger().Error("fail to marshal tiers to json", "error", err)
+		return nil, fmt.Errorf("fail to marshal tiers to json: %w", err)
+	}
+	return res, nil
+}
+
 // -------------------------------------------------------------------------------------
 // Generic Helpers
 // -------------------------------------------------------------------------------------
@@ -1741,6 +3482,142 @@ func wrapInt64(d int64) *int64 {
 	return &d
 }
 
+// queryParams returns the query parameters of the request
+func queryParams(req abci.RequestQuery) url.Values {
+	if u, err := url.ParseRequestURI(string(req.Data)); err == nil {
+		return u.Query()
+	}
+	return url.Values{}
+}
+
+const (
+	defaultPageLimit = 100
+	maxPageLimit     = 1000
+)
+
+// pageRequest is the page of a list query requested through the limit and
+// next_key query parameters, list queries are only paged when either is set.
+// The total is only counted on request, as it walks every entry of the query.
+type pageRequest struct {
+	paged      bool
+	limit      int64
+	key        []byte
+	countTotal bool
+}
+
+func parsePageRequest(params url.Values) (pageRequest, error) {
+	page := pageRequest{limit: defaultPageLimit}
+	if len(params.Get("limit")) > 0 {
+		limit, err := strconv.ParseInt(params.Get("limit"), 10, 64)
+		if err != nil || limit <= 0 || limit > maxPageLimit {
+			return page, fmt.Errorf("invalid limit: %s", params.Get("limit"))
+		}
+		page.paged = true
+		page.limit = limit
+	}
+	if len(params.Get("next_key")) > 0 {
+		key, err := base64.URLEncoding.DecodeString(params.Get("next_key"))
+		if err != nil {
+			return page, fmt.Errorf("invalid next_key: %s", params.Get("next_key"))
+		}
+		page.paged = true
+		page.key = key
+	}
+	if len(params.Get("count_total")) > 0 {
+		countTotal, err := strconv.ParseBool(params.Get("count_total"))
+		if err != nil {
+			return page, fmt.Errorf("invalid count_total: %s", params.Get("count_total"))
+		}
+		if countTotal && len(page.key) > 0 {
+			return page, errors.New("count_total is only supported on the first page")
+		}
+		page.countTotal = countTotal
+	}
+	return page, nil
+}
+
+// pageIterator is the part of an iterator paginate walks
+type pageIterator interface {
+	Valid() bool
+	Next()
+	Key() []byte
+	Value() []byte
+}
+
+// listIterator walks a list of keys held in memory, it starts at the key of
+// the page. It holds no values, the index of the current key is used instead.
+type listIterator struct {
+	keys []string
+	i    int
+}
+
+func newListIterator(keys []string, page pageRequest) (*listIterator, error) {
+	iter := &listIterator{keys: keys}
+	if len(page.key) == 0 {
+		return iter, nil
+	}
+	for iter.i < len(keys) && keys[iter.i] != string(page.key) {
+		iter.i++
+	}
+	if iter.i == len(keys) {
+		return nil, fmt.Errorf("next_key not found: %s", page.key)
+	}
+	return iter, nil
+}
+
+func (l *listIterator) Valid() bool   { return l.i < len(l.keys) }
+func (l *listIterator) Next()         { l.i++ }
+func (l *listIterator) Key() []byte   { return []byte(l.keys[l.i]) }
+func (l *listIterator) Value() []byte { return nil }
+func (l *listIterator) Index() int    { return l.i }
+
+// paginate walks the iterator, which starts at the key of the page. visit
+// reports whether an entry passes the filters of the query, and takes it into
+// the page when take is set. The walk stops once the next key is found, unless
+// the total was requested, then the remaining entries are only counted.
+func paginate(ctx cosmos.Context, iter pageIterator, page pageRequest, visit func(value []byte, take bool) (bool, error)) (openapi.Pagination, error) {
+	pagination := openapi.Pagination{Height: ctx.BlockHeight()}
+	countTotal := page.countTotal
+	var count, total int64
+	for ; iter.Valid(); iter.Next() {
+		full := page.paged && count == page.limit
+		ok, err := visit(iter.Value(), !full)
+		if err != nil {
+			return pagination, err
+		}
+		if !ok {
+			continue
+		}
+		total++
+		if !full {
+			count++
+			continue
+		}
+		if pagination.NextKey == nil {
+			pagination.NextKey = wrapString(base64.URLEncoding.EncodeToString(iter.Key()))
+		}
+		if !countTotal {
+			break
+		}
+	}
+	if countTotal {
+		pagination.Total = &total
+	}
+	return pagination, nil
+}
+
+// marshalPage marshals the items of a list query, paged queries wrap the
+// items together with the pagination under the given field
+func marshalPage(page pageRequest, field string, items interface{}, pagination openapi.Pagination) ([]byte, error) {
+	if !page.paged {
+		return json.MarshalIndent(items, "", "	")
+	}
+	return json.MarshalIndent(map[string]interface{}{
+		field:        items,
+		"pagination": pagination,
+	}, "", "	")
+}
+
 func simulateInternal(ctx cosmos.Context, mgr *Mgrs, msg sdk.Msg) (sdk.Events, error) {
 	// validate
 	err := msg.ValidateBasic()
@@ -1,6 +1,7 @@
 package mayachain
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"strconv"
 
@@ -17,6 +18,7 @@ import (
 	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
 	"gitlab.com/mayachain/mayanode/constants"
+	openapi "gitlab.com/mayachain/mayanode/openapi/gen"
 	"gitlab.com/mayachain/mayanode/x/mayachain/keeper"
 	"gitlab.com/mayachain/mayanode/x/mayachain/query"
 	"gitlab.com/mayachain/mayanode/x/mayachain/types"
@@ -335,6 +337,477 @@ func (s *QuerierSuite) TestQueryLiquidityProviders(c *C) {
 	c.Assert(lps, HasLen, 1)
 }
 
+func (s *QuerierSuite) TestQueryOutboundFees(c *C) {
+	ctx := s.ctx.WithBlockHeight(100)
+	s.k.SetMimir(ctx, constants.TargetOutboundFeeSurplusCacao.String(), 100*common.One)
+	c.Assert(s.k.SaveNetworkFee(ctx, common.BTCChain, NewNetworkFee(common.BTCChain, 250, 10)), IsNil)
+	c.Assert(s.k.AddToOutboundFeeWithheldCacao(ctx, common.BTCChain, cosmos.NewUint(150*common.One)), IsNil)
+	c.Assert(s.k.AddToOutboundFeeSpentCacao(ctx, common.BTCChain, cosmos.NewUint(100*common.One)), IsNil)
+	s.k.SetOutboundFeeRecord(ctx, NewOutboundFeeRecord(common.BTCChain, 50, cosmos.NewUint(10*common.One), cosmos.NewUint(20*common.One)))
+
+	result, err := s.querier(ctx, []string{query.QueryOutboundFees.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var fees openapi.OutboundFeesResponse
+	c.Assert(json.Unmarshal(result, &fees), IsNil)
+	c.Assert(fees, HasLen, 2)
+	// nothing withheld on BNB yet, the maximum multiplier applies
+	c.Check(fees[0].Chain, Equals, "BNB")
+	c.Check(fees[0].SurplusCacao, Equals, "0")
+	c.Check(fees[0].FeeMultiplierBps, Equals, int64(30_000))
+	c.Check(fees[1].Chain, Equals, "BTC")
+	c.Check(fees[1].FeeWithheldCacao, Equals, "15000000000")
+	c.Check(fees[1].FeeSpentCacao, Equals, "10000000000")
+	c.Check(fees[1].SurplusCacao, Equals, "5000000000")
+	c.Check(fees[1].FeeMultiplierBps, Equals, int64(22_500))
+	c.Check(fees[1].History, HasLen, 0)
+
+	result, err = s.querier(ctx, []string{query.QueryOutboundFee.Key, "BTC"}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var fee openapi.OutboundFee
+	c.Assert(json.Unmarshal(result, &fee), IsNil)
+	c.Check(fee.SurplusCacao, Equals, "5000000000")
+	c.Assert(fee.History, HasLen, 1)
+	c.Check(fee.History[0].Height, Equals, int64(50))
+	c.Check(fee.History[0].SurplusCacao, Equals, "-1000000000")
+
+	_, err = s.querier(ctx, []string{query.QueryOutboundFee.Key, ""}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+}
+
+func (s *QuerierSuite) TestQueryScheduledOutboundSimulate(c *C) {
+	ctx := s.ctx.WithBlockHeight(100)
+	s.k.SetMimir(ctx, constants.MinTxOutVolumeThreshold.String(), 100*common.One)
+	s.k.SetMimir(ctx, constants.TxOutDelayRate.String(), 10*common.One)
+	outbound := func(amount uint64) TxOutItem {
+		return TxOutItem{
+			Chain:     common.BASEChain,
+			ToAddress: GetRandomBaseAddress(),
+			InHash:    GetRandomTxHash(),
+			Coin:      common.NewCoin(common.BaseAsset(), cosmos.NewUint(amount)),
+		}
+	}
+	c.Assert(s.k.AppendTxOut(ctx, 102, outbound(95*common.One)), IsNil)
+	c.Assert(s.k.AppendTxOut(ctx, 105, outbound(10*common.One)), IsNil)
+	c.Assert(s.k.AppendTxOut(ctx, 105, outbound(10*common.One)), IsNil)
+
+	// the outbound is delayed 2 blocks, but height 102 has no space left for it
+	result, err := s.querier(ctx, []string{query.QueryScheduledOutboundSimulate.Key}, abci.RequestQuery{Data: []byte("/mayachain/queue/scheduled/simulate?asset=MAYA.CACAO&amount=2000000000")})
+	c.Assert(err, IsNil)
+	var simulation openapi.ScheduledSimulateResponse
+	c.Assert(json.Unmarshal(result, &simulation), IsNil)
+	c.Check(simulation.OutboundHeight, Equals, int64(103))
+	c.Check(simulation.OutboundDelayBlocks, Equals, int64(3))
+	c.Check(simulation.QueuePosition, Equals, int64(2))
+	c.Check(simulation.Value, Equals, "2000000000")
+	c.Check(simulation.ScheduledValue, Equals, "13500000000")
+	c.Check(simulation.DelayRate, Equals, "999999999")
+	c.Check(simulation.BlockValue, Equals, "2000000000")
+	c.Check(simulation.MaxBlockValue, Equals, "10000000000")
+
+	// the simulation matches the height the txout manager schedules the outbound at
+	height, err := s.mgr.TxOutStore().CalcTxOutHeight(ctx, s.mgr.GetVersion(), TxOutItem{
+		Memo: "OUT:-",
+		Coin: common.NewCoin(common.BaseAsset(), cosmos.NewUint(20*common.One)),
+	})
+	c.Assert(err, IsNil)
+	c.Check(height, Equals, simulation.OutboundHeight)
+
+	_, err = s.querier(ctx, []string{query.QueryScheduledOutboundSimulate.Key}, abci.RequestQuery{Data: []byte("/mayachain/queue/scheduled/simulate?asset=MAYA.CACAO&amount=0")})
+	c.Assert(err, NotNil)
+	_, err = s.querier(ctx, []string{query.QueryScheduledOutboundSimulate.Key}, abci.RequestQuery{Data: []byte("/mayachain/queue/scheduled/simulate?asset=BTC.BTC&amount=100")})
+	c.Assert(err, NotNil)
+
+	result, err = s.querier(ctx, []string{query.QueryScheduledOutboundVolume.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var volume openapi.ScheduledVolumeResponse
+	c.Assert(json.Unmarshal(result, &volume), IsNil)
+	c.Assert(volume, HasLen, 2)
+	c.Check(volume[0].Height, Equals, int64(102))
+	c.Check(volume[0].Count, Equals, int64(1))
+	c.Check(volume[0].Value, Equals, "9500000000")
+	c.Check(volume[1].Height, Equals, int64(105))
+	c.Check(volume[1].Count, Equals, int64(2))
+	c.Check(volume[1].Value, Equals, "2000000000")
+}
+
+func (s *QuerierSuite) TestQueryPoolYield(c *C) {
+	ctx := s.ctx.WithBlockHeight(600_000)
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.BalanceCacao = cosmos.NewUint(100 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	pool.LPUnits = cosmos.NewUint(100 * common.One)
+	pool.Status = PoolAvailable
+	// a tenth of a year ago
+	s.k.SetPoolSnapshot(ctx, NewPoolSnapshot(74_400, pool, cosmos.NewUint(100), cosmos.NewUint(1000)))
+	pool.BalanceCacao = cosmos.NewUint(105 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(105 * common.One)
+	s.k.SetPoolSnapshot(ctx, NewPoolSnapshot(337_800, pool, cosmos.NewUint(5), cosmos.NewUint(50)))
+	c.Assert(s.k.AddToPoolSnapshotSwaps(ctx, common.BTCAsset, cosmos.NewUint(1), cosmos.NewUint(10)), IsNil)
+	// the pool grew 10% since the first snapshot
+	pool.BalanceCacao = cosmos.NewUint(110 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(110 * common.One)
+	c.Assert(s.k.SetPool(ctx, pool), IsNil)
+
+	result, err := s.querier(ctx, []string{query.QueryPoolYield.Key, "BTC.BTC"}, abci.RequestQuery{Data: []byte("/mayachain/pool/BTC.BTC/yield")})
+	c.Assert(err, IsNil)
+	var yield openapi.PoolYieldResponse
+	c.Assert(json.Unmarshal(result, &yield), IsNil)
+	c.Check(yield.StartHeight, Equals, int64(74_400))
+	c.Check(yield.EndHeight, Equals, int64(600_000))
+	c.Check(yield.PeriodBlocks, Equals, int64(525_600))
+	c.Check(yield.AprBps, Equals, int64(10_000))
+	c.Check(yield.ApyBps, Equals, int64(15_937))
+	c.Check(yield.LiquidityFees, Equals, "6")
+	c.Check(yield.SwapVolume, Equals, "60")
+
+	result, err = s.querier(ctx, []string{query.QueryPoolYield.Key, "BTC.BTC"}, abci.RequestQuery{Data: []byte("/mayachain/pool/BTC.BTC/yield?period=300000")})
+	c.Assert(err, IsNil)
+	c.Assert(json.Unmarshal(result, &yield), IsNil)
+	c.Check(yield.StartHeight, Equals, int64(337_800))
+	c.Check(yield.LiquidityFees, Equals, "1")
+	c.Check(yield.SwapVolume, Equals, "10")
+
+	_, err = s.querier(ctx, []string{query.QueryPoolYield.Key, "BTC.BTC"}, abci.RequestQuery{Data: []byte("/mayachain/pool/BTC.BTC/yield?period=-1")})
+	c.Assert(err, NotNil)
+	_, err = s.querier(ctx, []string{query.QueryPoolYield.Key, "BTC.BTC"}, abci.RequestQuery{Data: []byte("/mayachain/pool/BTC.BTC/yield?period=100")})
+	c.Assert(err, NotNil)
+
+	// the pool query carries the yield since the oldest snapshot
+	result, err = s.querier(ctx, []string{query.QueryPool.Key, "BTC.BTC"}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var p openapi.Pool
+	c.Assert(json.Unmarshal(result, &p), IsNil)
+	c.Assert(p.AprBps, NotNil)
+	c.Check(*p.AprBps, Equals, int64(10_000))
+	c.Assert(p.ApyBps, NotNil)
+	c.Check(*p.ApyBps, Equals, int64(15_937))
+
+	result, err = s.querier(ctx, []string{query.QueryPoolSnapshots.Key, "BTC.BTC"}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var snapshots []openapi.PoolSnapshot
+	c.Assert(json.Unmarshal(result, &snapshots), IsNil)
+	c.Assert(snapshots, HasLen, 2)
+	c.Check(snapshots[0].Height, Equals, int64(74_400))
+	c.Check(snapshots[0].Luvi, Equals, "1000000000000")
+	c.Check(snapshots[1].LiquidityFees, Equals, "5")
+}
+
+func (s *QuerierSuite) TestQueryLiquidityProviderEstimate(c *C) {
+	ctx := s.ctx.WithBlockHeight(100)
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.BalanceCacao = cosmos.NewUint(100 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	pool.LPUnits = cosmos.NewUint(100 * common.One)
+	pool.Status = PoolAvailable
+	c.Assert(s.k.SetPool(ctx, pool), IsNil)
+	lp := LiquidityProvider{
+		Asset:             common.BTCAsset,
+		CacaoAddress:      GetRandomBaseAddress(),
+		AssetAddress:      GetRandomBTCAddress(),
+		LastAddHeight:     90,
+		Units:             cosmos.NewUint(10 * common.One),
+		CacaoDepositValue: cosmos.NewUint(5 * common.One),
+		AssetDepositValue: cosmos.NewUint(5 * common.One),
+	}
+	s.k.SetLiquidityProvider(ctx, lp)
+
+	result, err := s.querier(ctx, []string{query.QueryLiquidityProviderEstimate.Key, "BTC.BTC", lp.CacaoAddress.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var est openapi.LiquidityProviderEstimateResponse
+	c.Assert(json.Unmarshal(result, &est), IsNil)
+	c.Check(est.Height, Equals, int64(100))
+	c.Check(est.CacaoRedeemValue, Equals, "1000000000")
+	c.Check(est.AssetRedeemValue, Equals, "1000000000")
+	c.Check(est.ImpermanentLossProtection, Equals, "0")
+	c.Check(est.DepositValue, Equals, "1000000000")
+	c.Check(est.RedeemValue, Equals, "2000000000")
+	c.Check(est.GrowthBps, Equals, int64(10000))
+
+	// the liquidity provider query carries the estimate
+	result, err = s.querier(ctx, []string{query.QueryLiquidityProvider.Key, "BTC.BTC", lp.CacaoAddress.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var res openapi.LiquidityProvider
+	c.Assert(json.Unmarshal(result, &res), IsNil)
+	c.Check(res.Units, Equals, lp.Units.String())
+	c.Check(*res.CacaoAddress, Equals, lp.CacaoAddress.String())
+	c.Assert(res.CacaoRedeemValue, NotNil)
+	c.Check(*res.CacaoRedeemValue, Equals, "1000000000")
+	c.Assert(res.GrowthBps, NotNil)
+	c.Check(*res.GrowthBps, Equals, int64(10000))
+
+	_, err = s.querier(ctx, []string{query.QueryLiquidityProviderEstimate.Key, "ETH.ETH", lp.CacaoAddress.String()}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+}
+
+func (s *QuerierSuite) TestQueryBucketSavers(c *C) {
+	ctx := s.ctx.WithBlockHeight(600_000)
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.BalanceCacao = cosmos.NewUint(100 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	pool.LPUnits = cosmos.NewUint(100 * common.One)
+	pool.Status = PoolAvailable
+	c.Assert(s.k.SetPool(ctx, pool), IsNil)
+	coin := common.NewCoin(common.BTCAsset.GetSyntheticAsset(), cosmos.NewUint(15*common.One))
+	c.Assert(s.k.MintToModule(ctx, ModuleName, coin), IsNil)
+	c.Assert(s.k.SendFromModuleToModule(ctx, ModuleName, AsgardName, common.NewCoins(coin)), IsNil)
+	s.k.SetMimir(ctx, constants.MaxSynthPerAssetDepth.String(), 3000)
+
+	bucket := NewPool()
+	bucket.Asset = common.BTCAsset.GetSyntheticAsset()
+	bucket.BalanceAsset = cosmos.NewUint(10 * common.One)
+	bucket.LPUnits = cosmos.NewUint(10 * common.One)
+	bucket.Status = PoolAvailable
+	// a tenth of a year ago, the savers earned 10% since
+	s.k.SetPoolSnapshot(ctx, NewPoolSnapshot(74_400, bucket, cosmos.ZeroUint(), cosmos.ZeroUint()))
+	bucket.BalanceAsset = cosmos.NewUint(11 * common.One)
+	c.Assert(s.k.SetPool(ctx, bucket), IsNil)
+	c.Assert(s.k.AddSaversYield(ctx, bucket.Asset, cosmos.NewUint(common.One)), IsNil)
+
+	saver := LiquidityProvider{
+		Asset:             bucket.Asset,
+		AssetAddress:      GetRandomBTCAddress(),
+		LastAddHeight:     74_400,
+		Units:             cosmos.NewUint(5 * common.One),
+		AssetDepositValue: cosmos.NewUint(5 * common.One),
+	}
+	s.k.SetLiquidityProvider(ctx, saver)
+	s.k.SetLiquidityProvider(ctx, LiquidityProvider{
+		Asset:             bucket.Asset,
+		AssetAddress:      GetRandomBTCAddress(),
+		Units:             cosmos.NewUint(5 * common.One),
+		AssetDepositValue: cosmos.NewUint(5 * common.One),
+	})
+	s.k.SetLiquidityProvider(ctx, LiquidityProvider{
+		Asset:        bucket.Asset,
+		AssetAddress: GetRandomBTCAddress(),
+		Units:        cosmos.ZeroUint(),
+	})
+	c.Assert(s.k.AddSaversCount(ctx, bucket.Asset, 2), IsNil)
+
+	result, err := s.querier(ctx, []string{query.QueryBucket.Key, "BTC/BTC"}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var b openapi.Bucket
+	c.Assert(json.Unmarshal(result, &b), IsNil)
+	c.Assert(b.SaversCount, NotNil)
+	c.Check(*b.SaversCount, Equals, int64(2))
+	c.Assert(b.TotalYield, NotNil)
+	c.Check(*b.TotalYield, Equals, "100000000")
+	c.Assert(b.AprBps, NotNil)
+	c.Check(*b.AprBps, Equals, int64(10_000))
+	c.Assert(b.SynthSupply, NotNil)
+	c.Check(*b.SynthSupply, Equals, "1500000000")
+	c.Assert(b.SynthCoverageBps, NotNil)
+	c.Check(*b.SynthCoverageBps, Equals, int64(1500))
+	c.Assert(b.MaxSynthCoverageBps, NotNil)
+	c.Check(*b.MaxSynthCoverageBps, Equals, int64(3000))
+	c.Assert(b.SynthFillBps, NotNil)
+	c.Check(*b.SynthFillBps, Equals, int64(5000))
+
+	result, err = s.querier(ctx, []string{query.QueryBuckets.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var buckets []openapi.Bucket
+	c.Assert(json.Unmarshal(result, &buckets), IsNil)
+	c.Assert(buckets, HasLen, 1)
+	c.Check(*buckets[0].SynthFillBps, Equals, int64(5000))
+
+	// the saver values and growth are in the asset
+	result, err = s.querier(ctx, []string{query.QueryBucketLiquidityProviderEstimate.Key, "BTC/BTC", saver.AssetAddress.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var est openapi.LiquidityProviderEstimateResponse
+	c.Assert(json.Unmarshal(result, &est), IsNil)
+	c.Check(est.CacaoRedeemValue, Equals, "0")
+	c.Check(est.AssetRedeemValue, Equals, "550000000")
+	c.Check(est.DepositValue, Equals, "500000000")
+	c.Check(est.RedeemValue, Equals, "550000000")
+	c.Check(est.GrowthBps, Equals, int64(1000))
+
+	result, err = s.querier(ctx, []string{query.QueryBucketLiquidityProvider.Key, "BTC/BTC", saver.AssetAddress.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var res openapi.LiquidityProvider
+	c.Assert(json.Unmarshal(result, &res), IsNil)
+	c.Assert(res.AssetRedeemValue, NotNil)
+	c.Check(*res.AssetRedeemValue, Equals, "550000000")
+	c.Assert(res.GrowthBps, NotNil)
+	c.Check(*res.GrowthBps, Equals, int64(1000))
+}
+
+func (s *QuerierSuite) TestQueryLiquidityProvidersPaged(c *C) {
+	ctx := s.ctx
+	for i := 1; i <= 5; i++ {
+		s.k.SetLiquidityProvider(ctx, LiquidityProvider{
+			Asset:        common.BTCAsset,
+			CacaoAddress: GetRandomBaseAddress(),
+			AssetAddress: GetRandomBTCAddress(),
+			Units:        cosmos.NewUint(uint64(i * 10)),
+		})
+	}
+
+	type lpPage struct {
+		LiquidityProviders LiquidityProviders `json:"liquidity_providers"`
+		Pagination         openapi.Pagination `json:"pagination"`
+	}
+	get := func(params string) lpPage {
+		var page lpPage
+		result, err := s.querier(ctx, []string{query.QueryLiquidityProviders.Key, "BTC.BTC"}, abci.RequestQuery{
+			Data: []byte("/mayachain/pool/BTC.BTC/liquidity_providers?" + params),
+		})
+		c.Assert(err, IsNil)
+		c.Assert(json.Unmarshal(result, &page), IsNil)
+		return page
+	}
+
+	// the total is only counted on request
+	page := get("limit=2")
+	c.Assert(page.LiquidityProviders, HasLen, 2)
+	c.Check(page.Pagination.Total, IsNil)
+	c.Check(page.Pagination.NextKey, NotNil)
+
+	// walk all the pages
+	page = get("limit=2&count_total=true")
+	c.Assert(page.LiquidityProviders, HasLen, 2)
+	c.Check(*page.Pagination.Total, Equals, int64(5))
+	c.Check(page.Pagination.Height, Equals, ctx.BlockHeight())
+	seen := page.LiquidityProviders
+	for page.Pagination.NextKey != nil {
+		page = get("limit=2&next_key=" + *page.Pagination.NextKey)
+		c.Check(page.Pagination.Total, IsNil)
+		seen = append(seen, page.LiquidityProviders...)
+	}
+	c.Assert(seen, HasLen, 5)
+	for i := 1; i < len(seen); i++ {
+		c.Check(seen[i-1].Key() < seen[i].Key(), Equals, true)
+	}
+
+	// filter on units
+	page = get("limit=10&min_units=30&count_total=true")
+	c.Check(page.LiquidityProviders, HasLen, 3)
+	c.Check(*page.Pagination.Total, Equals, int64(3))
+	c.Check(page.Pagination.NextKey, IsNil)
+
+	// filter on address prefix
+	addr := seen[2].CacaoAddress.String()
+	page = get("limit=10&address=" + addr[:len(addr)-2])
+	c.Assert(page.LiquidityProviders, HasLen, 1)
+	c.Check(page.LiquidityProviders[0].CacaoAddress.Equals(seen[2].CacaoAddress), Equals, true)
+
+	// unpaged queries keep returning the list
+	result, err := s.querier(ctx, []string{query.QueryLiquidityProviders.Key, "BTC.BTC"}, abci.RequestQuery{
+		Data: []byte("/mayachain/pool/BTC.BTC/liquidity_providers?min_units=50"),
+	})
+	c.Assert(err, IsNil)
+	var lps LiquidityProviders
+	c.Assert(json.Unmarshal(result, &lps), IsNil)
+	c.Check(lps, HasLen, 1)
+
+	// bad requests
+	_, err = s.querier(ctx, []string{query.QueryLiquidityProviders.Key, "BTC.BTC"}, abci.RequestQuery{
+		Data: []byte("/mayachain/pool/BTC.BTC/liquidity_providers?limit=1001"),
+	})
+	c.Check(err, NotNil)
+	_, err = s.querier(ctx, []string{query.QueryLiquidityProviders.Key, "BTC.BTC"}, abci.RequestQuery{
+		Data: []byte("/mayachain/pool/BTC.BTC/liquidity_providers?next_key=!!"),
+	})
+	c.Check(err, NotNil)
+	_, err = s.querier(ctx, []string{query.QueryLiquidityProviders.Key, "BTC.BTC"}, abci.RequestQuery{
+		Data: []byte("/mayachain/pool/BTC.BTC/liquidity_providers?limit=1&count_total=maybe"),
+	})
+	c.Check(err, NotNil)
+	_, err = s.querier(ctx, []string{query.QueryLiquidityProviders.Key, "BTC.BTC"}, abci.RequestQuery{
+		Data: []byte("/mayachain/pool/BTC.BTC/liquidity_providers?count_total=true&next_key=" + *get("limit=1").Pagination.NextKey),
+	})
+	c.Check(err, NotNil)
+}
+
+func (s *QuerierSuite) TestQueryPoolsPaged(c *C) {
+	ctx := s.ctx
+	for _, asset := range []common.Asset{common.BNBAsset, common.BTCAsset, common.ETHAsset} {
+		pool := NewPool()
+		pool.Asset = asset
+		pool.LPUnits = cosmos.NewUint(100)
+		pool.Status = PoolAvailable
+		if asset.Equals(common.ETHAsset) {
+			pool.Status = PoolStaged
+		}
+		c.Assert(s.k.SetPool(ctx, pool), IsNil)
+	}
+
+	type poolPage struct {
+		Pools      []openapi.Pool     `json:"pools"`
+		Pagination openapi.Pagination `json:"pagination"`
+	}
+	get := func(params string) poolPage {
+		var page poolPage
+		result, err := s.querier(ctx, []string{query.QueryPools.Key}, abci.RequestQuery{
+			Data: []byte("/mayachain/pools?" + params),
+		})
+		c.Assert(err, IsNil)
+		c.Assert(json.Unmarshal(result, &page), IsNil)
+		return page
+	}
+
+	page := get("limit=1&status=available&count_total=true")
+	c.Assert(page.Pools, HasLen, 1)
+	c.Check(page.Pools[0].Asset, Equals, common.BNBAsset.String())
+	c.Check(*page.Pagination.Total, Equals, int64(2))
+	c.Assert(page.Pagination.NextKey, NotNil)
+
+	page = get("limit=1&status=available&next_key=" + *page.Pagination.NextKey)
+	c.Assert(page.Pools, HasLen, 1)
+	c.Check(page.Pools[0].Asset, Equals, common.BTCAsset.String())
+	c.Check(page.Pagination.NextKey, IsNil)
+
+	page = get("limit=5&status=staged")
+	c.Assert(page.Pools, HasLen, 1)
+	c.Check(page.Pools[0].Asset, Equals, common.ETHAsset.String())
+
+	_, err := s.querier(ctx, []string{query.QueryPools.Key}, abci.RequestQuery{
+		Data: []byte("/mayachain/pools?status=unknown"),
+	})
+	c.Check(err, NotNil)
+}
+
+func (s *QuerierSuite) TestQueryMimirAdminPaged(c *C) {
+	ctx := s.ctx
+	s.k.SetMimir(ctx, "AAA", 1)
+	s.k.SetMimir(ctx, "BBB", 2)
+	s.k.SetMimir(ctx, "CCC", 3)
+
+	type mimirPage struct {
+		Mimir      map[string]int64   `json:"mimir"`
+		Pagination openapi.Pagination `json:"pagination"`
+	}
+	var page mimirPage
+	result, err := s.querier(ctx, []string{query.QueryMimirAdminValues.Key}, abci.RequestQuery{
+		Data: []byte("/mayachain/mimir/admin?limit=2"),
+	})
+	c.Assert(err, IsNil)
+	c.Assert(json.Unmarshal(result, &page), IsNil)
+	c.Check(page.Mimir, DeepEquals, map[string]int64{"AAA": 1, "BBB": 2})
+	c.Assert(page.Pagination.NextKey, NotNil)
+
+	result, err = s.querier(ctx, []string{query.QueryMimirAdminValues.Key}, abci.RequestQuery{
+		Data: []byte("/mayachain/mimir/admin?limit=2&next_key=" + *page.Pagination.NextKey),
+	})
+	c.Assert(err, IsNil)
+	page = mimirPage{}
+	c.Assert(json.Unmarshal(result, &page), IsNil)
+	c.Check(page.Mimir, DeepEquals, map[string]int64{"CCC": 3})
+	c.Check(page.Pagination.NextKey, IsNil)
+
+	// the merged mimir keys page in key order
+	result, err = s.querier(ctx, []string{query.QueryMimirValues.Key}, abci.RequestQuery{
+		Data: []byte("/mayachain/mimir?limit=1&next_key=" + base64.URLEncoding.EncodeToString([]byte("BBB"))),
+	})
+	c.Assert(err, IsNil)
+	page = mimirPage{}
+	c.Assert(json.Unmarshal(result, &page), IsNil)
+	c.Check(page.Mimir, DeepEquals, map[string]int64{"BBB": 2})
+	c.Assert(page.Pagination.NextKey, NotNil)
+}
+
 func (s *QuerierSuite) TestQueryTxInVoter(c *C) {
 	req := abci.RequestQuery{
 		Data:   nil,
@@ -385,6 +858,85 @@ func (s *QuerierSuite) TestQueryTx(c *C) {
 	c.Assert(newTx.Valid(), IsNil)
 }
 
+func (s *QuerierSuite) TestQueryTxStatus(c *C) {
+	ctx := s.ctx.WithBlockHeight(100)
+	tx := GetRandomTx()
+	result, err := s.querier(ctx, []string{query.QueryTxStatus.Key, tx.ID.String()}, abci.RequestQuery{})
+	c.Assert(result, IsNil)
+	c.Assert(err, NotNil)
+
+	nodeAccount := GetRandomValidatorNode(NodeActive)
+	c.Assert(s.k.SetNodeAccount(ctx, nodeAccount), IsNil)
+	voter := NewObservedTxVoter(tx.ID, nil)
+	voter.Add(NewObservedTx(tx, 10, nodeAccount.PubKeySet.Secp256k1, 10), nodeAccount.NodeAddress)
+	voter.FinalisedHeight = 99
+	s.k.SetObservedTxInVoter(ctx, voter)
+
+	// waiting in the swap queue
+	c.Assert(s.k.SetSwapQueueItem(ctx, MsgSwap{Tx: tx}, 0), IsNil)
+	var resp openapi.TxStatusResponse
+	result, err = s.querier(ctx, []string{query.QueryTxStatus.Key, tx.ID.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	c.Assert(json.Unmarshal(result, &resp), IsNil)
+	c.Check(*resp.Stage, Equals, "swap_queue")
+	c.Check(resp.Stages.InboundObserved.Completed, Equals, true)
+	c.Check(*resp.Stages.InboundObserved.FinalCount, Equals, int64(1))
+	c.Check(resp.Stages.InboundFinalised.Completed, Equals, true)
+	c.Assert(resp.Stages.SwapStatus, NotNil)
+	c.Check(resp.Stages.SwapStatus.Pending, Equals, true)
+	c.Check(*resp.Stages.SwapStatus.QueueLength, Equals, int64(1))
+	c.Check(*resp.Stages.SwapStatus.ExpectedHeight, Equals, int64(101))
+	s.k.RemoveSwapQueueItem(ctx, tx.ID, 0)
+
+	// outbound scheduled in the future
+	toi := TxOutItem{
+		Chain:       common.BNBChain,
+		ToAddress:   GetRandomBNBAddress(),
+		VaultPubKey: GetRandomPubKey(),
+		Coin:        common.NewCoin(common.BNBAsset, cosmos.NewUint(common.One)),
+		Memo:        NewOutboundMemo(tx.ID).String(),
+		InHash:      tx.ID,
+	}
+	voter.Actions = append(voter.Actions, toi)
+	voter.OutboundHeight = 120
+	s.k.SetObservedTxInVoter(ctx, voter)
+	c.Assert(s.k.AppendTxOut(ctx, 120, toi), IsNil)
+	result, err = s.querier(ctx, []string{query.QueryTxStatus.Key, tx.ID.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	resp = openapi.TxStatusResponse{}
+	c.Assert(json.Unmarshal(result, &resp), IsNil)
+	c.Check(*resp.Stage, Equals, "outbound_delay")
+	c.Check(*resp.Stages.OutboundDelay.RemainingDelayBlocks, Equals, int64(20))
+	c.Assert(resp.PlannedOutTxs, HasLen, 1)
+	c.Check(resp.PlannedOutTxs[0].Height, Equals, int64(120))
+
+	// waiting to be signed
+	ctx = ctx.WithBlockHeight(125)
+	result, err = s.querier(ctx, []string{query.QueryTxStatus.Key, tx.ID.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	resp = openapi.TxStatusResponse{}
+	c.Assert(json.Unmarshal(result, &resp), IsNil)
+	c.Check(*resp.Stage, Equals, "outbound_keysign")
+	c.Check(*resp.Stages.OutboundSigned.Pending, Equals, int64(1))
+	c.Check(*resp.Stages.OutboundSigned.BlocksSinceScheduled, Equals, int64(5))
+
+	// outbound observed
+	outTx := GetRandomTx()
+	txOut, err := s.k.GetTxOut(ctx, 120)
+	c.Assert(err, IsNil)
+	txOut.TxArray[0].OutHash = outTx.ID
+	c.Assert(s.k.SetTxOut(ctx, txOut), IsNil)
+	voter.OutTxs = append(voter.OutTxs, outTx)
+	s.k.SetObservedTxInVoter(ctx, voter)
+	result, err = s.querier(ctx, []string{query.QueryTxStatus.Key, tx.ID.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	resp = openapi.TxStatusResponse{}
+	c.Assert(json.Unmarshal(result, &resp), IsNil)
+	c.Check(*resp.Stage, Equals, "done")
+	c.Check(resp.Stages.OutboundObserved.Completed, Equals, true)
+	c.Assert(resp.OutTxs, HasLen, 1)
+}
+
 func (s *QuerierSuite) TestQueryKeyGen(c *C) {
 	req := abci.RequestQuery{
 		Data:   nil,
@@ -488,6 +1040,43 @@ func (s *QuerierSuite) TestQueryMimir(c *C) {
 	c.Assert(json.Unmarshal(result, &m), IsNil)
 }
 
+func (s *QuerierSuite) TestQueryMimirRegistry(c *C) {
+	result, err := s.querier(s.ctx, []string{query.QueryMimirRegistry.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var registry []openapi.MimirRegistryKey
+	c.Assert(json.Unmarshal(result, &registry), IsNil)
+	c.Assert(len(registry), Equals, len(constants.GetMimirRegistry()))
+	found := false
+	for _, m := range registry {
+		if m.Key == "PermittedSolvencyGap" {
+			found = true
+			c.Check(m.Type, Equals, "bps")
+			c.Check(m.Max, Equals, int64(10_000))
+			c.Check(m.Setter, Equals, "any")
+		}
+	}
+	c.Check(found, Equals, true)
+}
+
+func (s *QuerierSuite) TestQueryMimirPending(c *C) {
+	signer := GetRandomBech32Addr()
+	s.k.SetPendingMimirs(s.ctx, 200, PendingMimirs{Mimirs: []PendingMimir{
+		{Key: "HALTTRADING", Value: 0, Signer: signer, ActivationHeight: 200},
+	}})
+	s.k.SetPendingMimirs(s.ctx, 30, PendingMimirs{Mimirs: []PendingMimir{
+		{Key: "MAXSWAPSPERBLOCK", Value: 50, Signer: signer, ActivationHeight: 30},
+	}})
+	result, err := s.querier(s.ctx, []string{query.QueryMimirPending.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var pending []openapi.PendingMimir
+	c.Assert(json.Unmarshal(result, &pending), IsNil)
+	c.Assert(pending, HasLen, 2)
+	c.Check(pending[0].Key, Equals, "MAXSWAPSPERBLOCK")
+	c.Check(pending[0].ActivationHeight, Equals, int64(30))
+	c.Check(pending[1].Key, Equals, "HALTTRADING")
+	c.Check(pending[1].Signer, Equals, signer.String())
+}
+
 func (s *QuerierSuite) TestQueryBan(c *C) {
 	result, err := s.querier(s.ctx, []string{
 		query.QueryBan.Key,
@@ -593,6 +1182,276 @@ func (s *QuerierSuite) TestQueryNodeAccount(c *C) {
 	c.Assert(r3.Reward.Uint64(), Equals, cosmos.NewUint(common.One*750).Uint64(), Commentf("expected %s, got %s", cosmos.NewUint(750*common.One).String(), r3.Reward.String()))
 }
 
+func (s *QuerierSuite) TestQueryNodeBonds(c *C) {
+	result, err := s.querier(s.ctx, []string{
+		query.QueryNodeBonds.Key,
+		"Whatever",
+	}, abci.RequestQuery{})
+	c.Assert(result, IsNil)
+	c.Assert(err, NotNil)
+
+	na := GetRandomValidatorNode(NodeActive)
+	bp := NewBondProviders(na.NodeAddress)
+	acc, err := na.BondAddress.AccAddress()
+	c.Assert(err, IsNil)
+	bp.Providers = append(bp.Providers, NewBondProvider(acc))
+	bp.Providers[0].Bonded = true
+	SetupLiquidityBondForTest(c, s.ctx, s.k, common.BTCAsset, na.BondAddress, na, cosmos.NewUint(1000*common.One))
+	c.Assert(s.k.SetBondProviders(s.ctx, bp), IsNil)
+	c.Assert(s.k.SetNodeAccount(s.ctx, na), IsNil)
+
+	// second bond provider bonds half of its liquidity to the node
+	provider := GetRandomBaseAddress()
+	acc, err = provider.AccAddress()
+	c.Assert(err, IsNil)
+	bp.Providers = append(bp.Providers, NewBondProvider(acc))
+	bp.Providers[1].Bonded = true
+	c.Assert(s.k.SetBondProviders(s.ctx, bp), IsNil)
+	pool, err := s.k.GetPool(s.ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	pool.BalanceCacao = pool.BalanceCacao.Add(cosmos.NewUint(2000 * common.One))
+	pool.BalanceAsset = pool.BalanceAsset.Add(cosmos.NewUint(2000 * common.One))
+	pool.LPUnits = pool.LPUnits.Add(cosmos.NewUint(2000 * common.One))
+	c.Assert(s.k.SetPool(s.ctx, pool), IsNil)
+	lp := LiquidityProvider{
+		Asset:        common.BTCAsset,
+		CacaoAddress: provider,
+		Units:        cosmos.NewUint(2000 * common.One),
+	}
+	lp.Bond(na.NodeAddress, cosmos.NewUint(1000*common.One))
+	s.k.SetLiquidityProvider(s.ctx, lp)
+
+	result, err = s.querier(s.ctx, []string{
+		query.QueryNodeBonds.Key,
+		na.NodeAddress.String(),
+	}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var nodeBonds openapi.NodeBondsResponse
+	c.Assert(json.Unmarshal(result, &nodeBonds), IsNil)
+	c.Assert(nodeBonds.TotalBond, Equals, cosmos.NewUint(4000*common.One).String())
+	c.Assert(nodeBonds.Bonds, HasLen, 2)
+	for _, bond := range nodeBonds.Bonds {
+		c.Check(bond.Asset, Equals, common.BTCAsset.String())
+		c.Check(bond.Units, Equals, cosmos.NewUint(1000*common.One).String())
+		c.Check(bond.CacaoValue, Equals, cosmos.NewUint(2000*common.One).String())
+		c.Check(bond.SlashExposureBps, Equals, int64(5000))
+		c.Check(bond.Slashable, Equals, true)
+	}
+
+	result, err = s.querier(s.ctx, []string{
+		query.QueryBondProviderBonds.Key,
+		provider.String(),
+	}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var providerBonds openapi.BondProviderBondsResponse
+	c.Assert(json.Unmarshal(result, &providerBonds), IsNil)
+	c.Assert(providerBonds.BondAddress, Equals, provider.String())
+	c.Assert(providerBonds.TotalBond, Equals, cosmos.NewUint(2000*common.One).String())
+	c.Assert(providerBonds.Bonds, HasLen, 1)
+	c.Assert(providerBonds.Bonds[0].NodeAddress, Equals, na.NodeAddress.String())
+	c.Assert(providerBonds.Bonds[0].SlashExposureBps, Equals, int64(5000))
+}
+
+func (s *QuerierSuite) TestQueryNodeBondProviders(c *C) {
+	result, err := s.querier(s.ctx, []string{
+		query.QueryNodeBondProviders.Key,
+		"Whatever",
+	}, abci.RequestQuery{})
+	c.Assert(result, IsNil)
+	c.Assert(err, NotNil)
+
+	na, provider := setupBondProvidersForTest(c, s.ctx, s.k, 1000)
+	vault := GetRandomVault()
+	vault.Status = ActiveVault
+	vault.BlockHeight = 1
+	c.Assert(s.k.SetVault(s.ctx, vault), IsNil)
+	network, err := s.k.GetNetwork(s.ctx)
+	c.Assert(err, IsNil)
+	network.BondRewardRune = cosmos.NewUint(100 * common.One)
+	c.Assert(s.k.SetNetwork(s.ctx, network), IsNil)
+	ctx := s.ctx.WithBlockHeight(11)
+
+	result, err = s.querier(ctx, []string{
+		query.QueryNodeBondProviders.Key,
+		na.NodeAddress.String(),
+	}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var r openapi.NodeBondProvidersResponse
+	c.Assert(json.Unmarshal(result, &r), IsNil)
+	c.Assert(r.NodeOperatorFee, Equals, "1000")
+	c.Assert(r.TotalBond, Equals, cosmos.NewUint(4000*common.One).String())
+	c.Assert(r.Reward, Equals, cosmos.NewUint(100*common.One).String())
+	c.Assert(r.Providers, HasLen, 2)
+	c.Check(r.Providers[0].BondAddress, Equals, na.BondAddress.String())
+	c.Check(r.Providers[0].Bonded, Equals, true)
+	c.Check(r.Providers[0].Reward, Equals, cosmos.NewUint(45*common.One).String())
+	c.Check(r.Providers[0].OperatorFee, Equals, cosmos.NewUint(10*common.One).String())
+	c.Check(r.Providers[1].BondAddress, Equals, provider.String())
+	c.Check(r.Providers[1].Bond, Equals, cosmos.NewUint(2000*common.One).String())
+	c.Check(r.Providers[1].Reward, Equals, cosmos.NewUint(45*common.One).String())
+	c.Check(r.Providers[1].OperatorFee, Equals, "0")
+}
+
+func (s *QuerierSuite) TestQueryBlame(c *C) {
+	ctx := s.ctx.WithBlockHeight(1000)
+	flaky := GetRandomValidatorNode(NodeActive)
+	other := GetRandomValidatorNode(NodeActive)
+	vault := GetRandomPubKey()
+	blame := Blame{FailReason: "fail to keysign", Round: "SignRound4Message"}
+	for _, record := range []BlameRecord{
+		NewBlameRecord(100, BlameTypeKeysign, "old", flaky.NodeAddress, flaky.PubKeySet.Secp256k1, vault, common.BTCChain, blame),
+		NewBlameRecord(900, BlameTypeKeysign, "a", flaky.NodeAddress, flaky.PubKeySet.Secp256k1, vault, common.BTCChain, blame),
+		NewBlameRecord(950, BlameTypeKeygen, "b", flaky.NodeAddress, flaky.PubKeySet.Secp256k1, vault, common.EmptyChain, blame),
+		NewBlameRecord(990, BlameTypeKeysign, "c", other.NodeAddress, other.PubKeySet.Secp256k1, vault, common.ETHChain, blame),
+	} {
+		c.Assert(s.k.SetBlameRecord(ctx, record), IsNil)
+	}
+
+	result, err := s.querier(ctx, []string{query.QueryBlame.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var resp openapi.BlameResponse
+	c.Assert(json.Unmarshal(result, &resp), IsNil)
+	c.Assert(resp.Nodes, HasLen, 2)
+	c.Check(resp.Nodes[0].NodeAddress, Equals, flaky.NodeAddress.String())
+	c.Check(resp.Nodes[0].Total, Equals, int64(3))
+	c.Check(resp.Nodes[0].Keysign, Equals, int64(2))
+	c.Check(resp.Nodes[0].Keygen, Equals, int64(1))
+	c.Check(resp.Nodes[0].LastHeight, Equals, int64(950))
+	c.Check(resp.Nodes[1].NodeAddress, Equals, other.NodeAddress.String())
+	c.Check(resp.Nodes[1].Total, Equals, int64(1))
+
+	// only the last 100 blocks
+	result, err = s.querier(ctx, []string{query.QueryBlame.Key}, abci.RequestQuery{
+		Data: []byte("/mayachain/blame?window=100&type=keysign"),
+	})
+	c.Assert(err, IsNil)
+	c.Assert(json.Unmarshal(result, &resp), IsNil)
+	c.Check(resp.FromHeight, Equals, int64(901))
+	c.Assert(resp.Nodes, HasLen, 1)
+	c.Check(resp.Nodes[0].NodeAddress, Equals, other.NodeAddress.String())
+
+	_, err = s.querier(ctx, []string{query.QueryBlame.Key}, abci.RequestQuery{
+		Data: []byte("/mayachain/blame?window=-1"),
+	})
+	c.Assert(err, NotNil)
+	_, err = s.querier(ctx, []string{query.QueryBlame.Key}, abci.RequestQuery{
+		Data: []byte("/mayachain/blame?type=whatever"),
+	})
+	c.Assert(err, NotNil)
+
+	_, err = s.querier(ctx, []string{query.QueryNodeBlame.Key, "whatever"}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+	result, err = s.querier(ctx, []string{query.QueryNodeBlame.Key, flaky.NodeAddress.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var nodeResp openapi.NodeBlameResponse
+	c.Assert(json.Unmarshal(result, &nodeResp), IsNil)
+	c.Check(nodeResp.NodeAddress, Equals, flaky.NodeAddress.String())
+	c.Assert(nodeResp.Records, HasLen, 3)
+	// newest first
+	c.Check(nodeResp.Records[0].Id, Equals, "b")
+	c.Check(nodeResp.Records[0].Type, Equals, BlameTypeKeygen)
+	c.Check(nodeResp.Records[0].Chain, IsNil)
+	c.Check(*nodeResp.Records[1].Chain, Equals, "BTC")
+	c.Check(*nodeResp.Records[1].Round, Equals, "SignRound4Message")
+	c.Check(nodeResp.Records[2].Id, Equals, "old")
+}
+
+func (s *QuerierSuite) TestQueryPOLPools(c *C) {
+	pool := setupPOLPoolForTest(c, s.ctx, s.mgr)
+	s.k.SetMimir(s.ctx, "POL-BTC-BTC", 1)
+	s.k.SetMimir(s.ctx, "POLMaxPoolDeposit-BTC-BTC", 10*common.One)
+	polPool := NewPOLPool(pool.Asset)
+	polPool.CacaoDeposited = cosmos.NewUint(30 * common.One)
+	polPool.CacaoWithdrawn = cosmos.NewUint(10 * common.One)
+	c.Assert(s.k.SetPOLPool(s.ctx, polPool), IsNil)
+
+	polAddress, err := s.k.GetModuleAddress(ReserveName)
+	c.Assert(err, IsNil)
+	s.k.SetLiquidityProvider(s.ctx, LiquidityProvider{
+		Asset:             pool.Asset,
+		CacaoAddress:      polAddress,
+		Units:             cosmos.NewUint(10 * common.One),
+		PendingCacao:      cosmos.ZeroUint(),
+		PendingAsset:      cosmos.ZeroUint(),
+		AssetDepositValue: cosmos.ZeroUint(),
+		CacaoDepositValue: cosmos.ZeroUint(),
+	})
+
+	result, err := s.querier(s.ctx, []string{query.QueryPOLPools.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var r openapi.POLPoolsResponse
+	c.Assert(json.Unmarshal(result, &r), IsNil)
+	c.Assert(r, HasLen, 1)
+	c.Check(r[0].Asset, Equals, pool.Asset.String())
+	c.Check(r[0].Enabled, Equals, true)
+	c.Check(r[0].ForceWithdraw, Equals, false)
+	c.Check(r[0].MaxDeposit, Equals, cosmos.NewUint(10*common.One).String())
+	c.Check(r[0].Units, Equals, cosmos.NewUint(10*common.One).String())
+	// 10 of 133.33 pool units (synth units included) of 100 CACAO, doubled
+	c.Check(r[0].Value, Equals, "1500000000")
+	c.Check(r[0].CurrentDeposit, Equals, cosmos.NewUint(20*common.One).String())
+	c.Check(r[0].Pnl, Equals, "-500000000")
+}
+
+func (s *QuerierSuite) TestQueryPOLSimulate(c *C) {
+	pool := setupPOLPoolForTest(c, s.ctx, s.mgr)
+
+	// no pool has POL enabled
+	_, err := s.querier(s.ctx, []string{query.QueryPOLSimulate.Key}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+
+	s.k.SetMimir(s.ctx, "POL-BTC-BTC", 1)
+	result, err := s.querier(s.ctx, []string{query.QueryPOLSimulate.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var r openapi.POLSimulateResponse
+	c.Assert(json.Unmarshal(result, &r), IsNil)
+	c.Assert(r.Asset, NotNil)
+	c.Check(*r.Asset, Equals, pool.Asset.String())
+	c.Check(r.Action, Equals, POLActionDeposit)
+	c.Check(r.CacaoAmount, Equals, cosmos.NewUint(common.One).String())
+	c.Check(r.SynthUtilization, Equals, "2500")
+	c.Check(r.TargetSynthUtilization, Equals, "1000")
+	c.Check(r.Reason, IsNil)
+
+	// simulating doesn't move any liquidity
+	pol, err := s.k.GetPOL(s.ctx)
+	c.Assert(err, IsNil)
+	c.Check(pol.CacaoDeposited.IsZero(), Equals, true)
+
+	s.k.SetMimir(s.ctx, constants.POLSynthUtilization.String(), 2500)
+	result, err = s.querier(s.ctx, []string{query.QueryPOLSimulate.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	c.Assert(json.Unmarshal(result, &r), IsNil)
+	c.Check(r.Action, Equals, "none")
+	c.Assert(r.Reason, NotNil)
+	c.Check(*r.Reason, Equals, "synth utilization within target")
+}
+
+func (s *QuerierSuite) TestQueryPOLSimulateNextBlock(c *C) {
+	ctx := s.ctx.WithBlockHeight(100)
+	setupPOLPoolForTest(c, ctx, s.mgr)
+	pool := NewPool()
+	pool.Asset = common.ETHAsset
+	pool.Status = PoolAvailable
+	pool.BalanceCacao = cosmos.NewUint(100 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	pool.LPUnits = cosmos.NewUint(100 * common.One)
+	c.Assert(s.k.SetPool(ctx, pool), IsNil)
+	s.k.SetMimir(ctx, "POL-BTC-BTC", 1)
+	s.k.SetMimir(ctx, "POL-ETH-ETH", 1)
+
+	// the pool is picked by the height of the next block, as the POL cycle does
+	pools := getPOLPools(ctx, s.mgr)
+	c.Assert(pools, HasLen, 2)
+	result, err := s.querier(ctx, []string{query.QueryPOLSimulate.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var r openapi.POLSimulateResponse
+	c.Assert(json.Unmarshal(result, &r), IsNil)
+	c.Check(r.Height, Equals, int64(101))
+	c.Assert(r.Asset, NotNil)
+	c.Check(*r.Asset, Equals, pools[101%2].Asset.String())
+}
+
 func (s *QuerierSuite) TestQueryPoolAddresses(c *C) {
 	na := GetRandomValidatorNode(NodeActive)
 	c.Assert(s.k.SetNodeAccount(s.ctx, na), IsNil)
@@ -677,6 +1536,38 @@ func (s *QuerierSuite) TestQueryYggdrasilVault(c *C) {
 	c.Assert(json.Unmarshal(result, &r), IsNil)
 }
 
+func (s *QuerierSuite) TestQueryYggdrasilStatus(c *C) {
+	na := GetRandomValidatorNode(NodeActive)
+	c.Assert(s.k.SetNodeAccount(s.ctx, na), IsNil)
+	vault := NewVault(s.ctx.BlockHeight(), ActiveVault, YggdrasilVault, na.PubKeySet.Secp256k1, common.Chains{common.BNBChain}.Strings(), []ChainContract{})
+	vault.AddFunds(common.Coins{
+		common.NewCoin(common.BNBAsset, cosmos.NewUint(common.One*10)),
+	})
+	vault.PendingTxBlockHeights = []int64{s.ctx.BlockHeight()}
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceAsset = cosmos.NewUint(common.One * 100)
+	pool.BalanceCacao = cosmos.NewUint(common.One * 200)
+	pool.Status = PoolAvailable
+	c.Assert(s.k.SetPool(s.ctx, pool), IsNil)
+	c.Assert(s.k.SetVault(s.ctx, vault), IsNil)
+	s.k.SetMimir(s.ctx, "AsgardOnly", s.ctx.BlockHeight())
+
+	result, err := s.querier(s.ctx, []string{query.QueryYggdrasilStatus.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var r openapi.YggdrasilStatusResponse
+	c.Assert(json.Unmarshal(result, &r), IsNil)
+	c.Check(r.AsgardOnly, Equals, true)
+	c.Check(*r.AsgardOnlyHeight, Equals, s.ctx.BlockHeight())
+	c.Check(r.VaultsRemaining, Equals, int64(1))
+	c.Check(r.TotalValue, Equals, "2000000000")
+	c.Assert(r.Vaults, HasLen, 1)
+	c.Check(r.Vaults[0].PubKey, Equals, vault.PubKey.String())
+	c.Check(*r.Vaults[0].NodeAddress, Equals, na.NodeAddress.String())
+	c.Check(*r.Vaults[0].ReturnRequestedHeight, Equals, s.ctx.BlockHeight())
+	c.Check(r.Vaults[0].Coins, HasLen, 1)
+}
+
 func (s *QuerierSuite) TestQueryVaultPubKeys(c *C) {
 	node := GetRandomValidatorNode(NodeActive)
 	c.Assert(s.k.SetNodeAccount(s.ctx, node), IsNil)
@@ -850,3 +1741,96 @@ func (s *QuerierSuite) TestQueryLiquidityAuctionTier(c *C) {
 	c.Assert(lp.Units.Uint64(), Equals, returnLATier.LiquidityProvider.Units.Uint64())
 	c.Assert(returnLATier.WithdrawLimitStopBlock, Equals, int64(220))
 }
+
+func (s *QuerierSuite) TestQueryLiquidityAuctionTiers(c *C) {
+	// Not enough argument
+	result, err := s.querier(s.ctx, []string{
+		query.QueryLiquidityAuctionTiers.Key,
+	}, abci.RequestQuery{})
+	c.Assert(result, IsNil)
+	c.Assert(err, NotNil)
+
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.Status = PoolAvailable
+	pool.BalanceCacao = cosmos.NewUint(1000 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	pool.LPUnits = cosmos.NewUint(400)
+	c.Assert(s.k.SetPool(s.ctx, pool), IsNil)
+
+	tiers := []int64{0, 1, 1, 3}
+	for _, tier := range tiers {
+		address := GetRandomBaseAddress()
+		s.k.SetLiquidityProvider(s.ctx, types.LiquidityProvider{
+			Asset:        common.BTCAsset,
+			CacaoAddress: address,
+			AssetAddress: GetRandomBTCAddress(),
+			Units:        cosmos.NewUint(100),
+		})
+		if tier > 0 {
+			c.Assert(s.k.SetLiquidityAuctionTier(s.ctx, address, tier), IsNil)
+		}
+	}
+
+	result, err = s.querier(s.ctx, []string{
+		query.QueryLiquidityAuctionTiers.Key,
+		common.BTCAsset.String(),
+	}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var resp struct {
+		Asset     common.Asset `json:"asset"`
+		Providers []struct {
+			CacaoAddress common.Address `json:"cacao_address"`
+			Tier         int64          `json:"tier"`
+			Units        cosmos.Uint    `json:"units"`
+		} `json:"liquidity_providers"`
+		Tiers []struct {
+			Tier       int64       `json:"tier"`
+			Providers  int64       `json:"providers"`
+			Units      cosmos.Uint `json:"units"`
+			CacaoDepth cosmos.Uint `json:"cacao_depth"`
+			AssetDepth cosmos.Uint `json:"asset_depth"`
+		} `json:"tiers"`
+	}
+	c.Assert(json.Unmarshal(result, &resp), IsNil)
+	c.Assert(resp.Asset.Equals(common.BTCAsset), Equals, true)
+	c.Assert(resp.Providers, HasLen, 4)
+	c.Assert(resp.Tiers, HasLen, 3)
+
+	c.Check(resp.Tiers[0].Tier, Equals, int64(0))
+	c.Check(resp.Tiers[0].Providers, Equals, int64(1))
+	c.Check(resp.Tiers[1].Tier, Equals, int64(1))
+	c.Check(resp.Tiers[1].Providers, Equals, int64(2))
+	c.Check(resp.Tiers[1].Units.Uint64(), Equals, uint64(200))
+	c.Check(resp.Tiers[1].CacaoDepth.Uint64(), Equals, uint64(500*common.One))
+	c.Check(resp.Tiers[1].AssetDepth.Uint64(), Equals, uint64(50*common.One))
+	c.Check(resp.Tiers[2].Tier, Equals, int64(3))
+	c.Check(resp.Tiers[2].CacaoDepth.Uint64(), Equals, uint64(250*common.One))
+}
+
+func (s *QuerierSuite) TestQueryEVMTokens(c *C) {
+	compiled := getEVMTokenWhitelist(s.ctx, s.k, common.ETHChain)
+	listed := NewEVMToken(common.ETHChain, "0x1111111111111111111111111111111111111abc", "FOO", 18)
+	c.Assert(s.k.SetEVMToken(s.ctx, listed), IsNil)
+
+	result, err := s.querier(s.ctx, []string{query.QueryEVMTokens.Key, "ETH"}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var resp openapi.EVMTokensResponse
+	c.Assert(json.Unmarshal(result, &resp), IsNil)
+	c.Assert(resp, HasLen, len(compiled)+1)
+	found := false
+	for _, token := range resp {
+		c.Check(token.Chain, Equals, "ETH")
+		if token.Address == listed.Address.String() {
+			found = true
+			c.Check(token.Symbol, Equals, "FOO")
+			c.Check(token.Decimals, Equals, int64(18))
+		}
+	}
+	c.Check(found, Equals, true)
+
+	_, err = s.querier(s.ctx, []string{query.QueryEVMTokens.Key}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+	_, err = s.querier(s.ctx, []string{query.QueryEVMTokens.Key, "BTC"}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+}
@@ -33,65 +33,91 @@ func (q Query) Path(args ...string) string {
 
 // query endpoints supported by the thorchain Querier
 var (
-	QueryPool                     = Query{Key: "pool", EndpointTemplate: "/%s/pool/{%s}"}
-	QueryPools                    = Query{Key: "pools", EndpointTemplate: "/%s/pools"}
-	QueryBucket                   = Query{Key: "bucket", EndpointTemplate: "/%s/bucket/{%s}"}
-	QueryBuckets                  = Query{Key: "buckets", EndpointTemplate: "/%s/buckets"}
-	QueryLiquidityProviders       = Query{Key: "lps", EndpointTemplate: "/%s/pool/{%s}/liquidity_providers"}
-	QueryLiquidityProvider        = Query{Key: "lp", EndpointTemplate: "/%s/pool/{%s}/liquidity_provider/{%s}"}
-	QueryBucketLiquidityProviders = Query{Key: "lps", EndpointTemplate: "/%s/bucket/{%s}/liquidity_providers"}
-	QueryBucketLiquidityProvider  = Query{Key: "lp", EndpointTemplate: "/%s/bucket/{%s}/liquidity_provider/{%s}"}
-	QueryTx                       = Query{Key: "tx", EndpointTemplate: "/%s/tx/{%s}"}
-	QueryTxVoter                  = Query{Key: "txvoter", EndpointTemplate: "/%s/tx/{%s}/signers"}
-	QueryKeysignArray             = Query{Key: "keysign", EndpointTemplate: "/%s/keysign/{%s}"}
-	QueryKeysignArrayPubkey       = Query{Key: "keysignpubkey", EndpointTemplate: "/%s/keysign/{%s}/{%s}"}
-	QueryKeygensPubkey            = Query{Key: "keygenspubkey", EndpointTemplate: "/%s/keygen/{%s}/{%s}"}
-	QueryQueue                    = Query{Key: "outqueue", EndpointTemplate: "/%s/queue"}
-	QueryHeights                  = Query{Key: "heights", EndpointTemplate: "/%s/lastblock"}
-	QueryChainHeights             = Query{Key: "chainheights", EndpointTemplate: "/%s/lastblock/{%s}"}
-	QueryNodes                    = Query{Key: "nodes", EndpointTemplate: "/%s/nodes"}
-	QueryNode                     = Query{Key: "node", EndpointTemplate: "/%s/node/{%s}"}
-	QueryInboundAddresses         = Query{Key: "inboundaddresses", EndpointTemplate: "/%s/inbound_addresses"}
-	QueryNetwork                  = Query{Key: "network", EndpointTemplate: "/%s/network"}
-	QueryPOL                      = Query{Key: "pol", EndpointTemplate: "/%s/pol"}
-	QueryBalanceModule            = Query{Key: "balancemodule", EndpointTemplate: "/%s/balance/module/{%s}"}
-	QueryVaultsAsgard             = Query{Key: "vaultsasgard", EndpointTemplate: "/%s/vaults/asgard"}
-	QueryVaultsYggdrasil          = Query{Key: "vaultsyggdrasil", EndpointTemplate: "/%s/vaults/yggdrasil"}
-	QueryVault                    = Query{Key: "vault", EndpointTemplate: "/%s/vault/{%s}"}
-	QueryVaultPubkeys             = Query{Key: "vaultpubkeys", EndpointTemplate: "/%s/vaults/pubkeys"}
-	QueryConstantValues           = Query{Key: "constants", EndpointTemplate: "/%s/constants"}
-	QueryVersion                  = Query{Key: "version", EndpointTemplate: "/%s/version"}
-	QueryMimirValues              = Query{Key: "mimirs", EndpointTemplate: "/%s/mimir"}
-	QueryMimirWithKey             = Query{Key: "mimirwithkey", EndpointTemplate: "/%s/mimir/key/{%s}"}
-	QueryMimirAdminValues         = Query{Key: "adminmimirs", EndpointTemplate: "/%s/mimir/admin"}
-	QueryMimirNodesValues         = Query{Key: "nodesmimirs", EndpointTemplate: "/%s/mimir/nodes"}
-	QueryMimirNodesAllValues      = Query{Key: "nodesmimirsall", EndpointTemplate: "/%s/mimir/nodes_all"}
-	QueryMimirNodeValues          = Query{Key: "nodemimirs", EndpointTemplate: "/%s/mimir/node/{%s}"}
-	QueryBan                      = Query{Key: "ban", EndpointTemplate: "/%s/ban/{%s}"}
-	QueryRagnarok                 = Query{Key: "ragnarok", EndpointTemplate: "/%s/ragnarok"}
-	QueryPendingOutbound          = Query{Key: "pendingoutbound", EndpointTemplate: "/%s/queue/outbound"}
-	QueryScheduledOutbound        = Query{Key: "scheduledoutbound", EndpointTemplate: "/%s/queue/scheduled"}
-	QueryTssKeygenMetrics         = Query{Key: "tss_keygen_metric", EndpointTemplate: "/%s/metric/keygen/{%s}"}
-	QueryTssMetrics               = Query{Key: "tss_metric", EndpointTemplate: "/%s/metrics"}
-	QueryMAYAName                 = Query{Key: "mayaname", EndpointTemplate: "/%s/mayaname/{%s}"}
-	QueryLiquidityAuctionTier     = Query{Key: "la_tier", EndpointTemplate: "/%s/liquidity_auction_tier/{%s}/{%s}"}
-	QueryQuoteSwap                = Query{Key: "quoteswap", EndpointTemplate: "/%s/quote/swap"}
-	QueryQuoteSaverDeposit        = Query{Key: "quotesaverdeposit", EndpointTemplate: "/%s/quote/saver/deposit"}
-	QueryQuoteSaverWithdraw       = Query{Key: "quotesaverwithdraw", EndpointTemplate: "/%s/quote/saver/withdraw"}
+	QueryPool                            = Query{Key: "pool", EndpointTemplate: "/%s/pool/{%s}"}
+	QueryPools                           = Query{Key: "pools", EndpointTemplate: "/%s/pools"}
+	QueryPoolSnapshots                   = Query{Key: "poolsnapshots", EndpointTemplate: "/%s/pool/{%s}/snapshots"}
+	QueryPoolYield                       = Query{Key: "poolyield", EndpointTemplate: "/%s/pool/{%s}/yield"}
+	QueryBucket                          = Query{Key: "bucket", EndpointTemplate: "/%s/bucket/{%s}"}
+	QueryBuckets                         = Query{Key: "buckets", EndpointTemplate: "/%s/buckets"}
+	QueryLiquidityProviders              = Query{Key: "lps", EndpointTemplate: "/%s/pool/{%s}/liquidity_providers"}
+	QueryLiquidityProvider               = Query{Key: "lp", EndpointTemplate: "/%s/pool/{%s}/liquidity_provider/{%s}"}
+	QueryLiquidityProviderEstimate       = Query{Key: "lpestimate", EndpointTemplate: "/%s/pool/{%s}/liquidity_provider/{%s}/estimate"}
+	QueryBucketLiquidityProviders        = Query{Key: "lps", EndpointTemplate: "/%s/bucket/{%s}/liquidity_providers"}
+	QueryBucketLiquidityProvider         = Query{Key: "lp", EndpointTemplate: "/%s/bucket/{%s}/liquidity_provider/{%s}"}
+	QueryBucketLiquidityProviderEstimate = Query{Key: "lpestimate", EndpointTemplate: "/%s/bucket/{%s}/liquidity_provider/{%s}/estimate"}
+	QueryTx                              = Query{Key: "tx", EndpointTemplate: "/%s/tx/{%s}"}
+	QueryTxVoter                         = Query{Key: "txvoter", EndpointTemplate: "/%s/tx/{%s}/signers"}
+	QueryTxStatus                        = Query{Key: "txstatus", EndpointTemplate: "/%s/tx/status/{%s}"}
+	QueryKeysignArray                    = Query{Key: "keysign", EndpointTemplate: "/%s/keysign/{%s}"}
+	QueryKeysignArrayPubkey              = Query{Key: "keysignpubkey", EndpointTemplate: "/%s/keysign/{%s}/{%s}"}
+	QueryKeygensPubkey                   = Query{Key: "keygenspubkey", EndpointTemplate: "/%s/keygen/{%s}/{%s}"}
+	QueryQueue                           = Query{Key: "outqueue", EndpointTemplate: "/%s/queue"}
+	QueryHeights                         = Query{Key: "heights", EndpointTemplate: "/%s/lastblock"}
+	QueryChainHeights                    = Query{Key: "chainheights", EndpointTemplate: "/%s/lastblock/{%s}"}
+	QueryNodes                           = Query{Key: "nodes", EndpointTemplate: "/%s/nodes"}
+	QueryNode                            = Query{Key: "node", EndpointTemplate: "/%s/node/{%s}"}
+	QueryNodeBonds                       = Query{Key: "nodebonds", EndpointTemplate: "/%s/node/{%s}/bonds"}
+	QueryNodeBondProviders               = Query{Key: "nodebondproviders", EndpointTemplate: "/%s/node/{%s}/bond_providers"}
+	QueryBondProviderBonds               = Query{Key: "bondproviderbonds", EndpointTemplate: "/%s/bonds/{%s}"}
+	QueryInboundAddresses                = Query{Key: "inboundaddresses", EndpointTemplate: "/%s/inbound_addresses"}
+	QueryEVMTokens                       = Query{Key: "evmtokens", EndpointTemplate: "/%s/evm_tokens/{%s}"}
+	QueryNetwork                         = Query{Key: "network", EndpointTemplate: "/%s/network"}
+	QueryOutboundFees                    = Query{Key: "outboundfees", EndpointTemplate: "/%s/outbound_fees"}
+	QueryOutboundFee                     = Query{Key: "outboundfee", EndpointTemplate: "/%s/outbound_fee/{%s}"}
+	QueryPOL                             = Query{Key: "pol", EndpointTemplate: "/%s/pol"}
+	QueryPOLPools                        = Query{Key: "polpools", EndpointTemplate: "/%s/pol/pools"}
+	QueryPOLSimulate                     = Query{Key: "polsimulate", EndpointTemplate: "/%s/pol/simulate"}
+	QueryBalanceModule                   = Query{Key: "balancemodule", EndpointTemplate: "/%s/balance/module/{%s}"}
+	QueryVaultsAsgard                    = Query{Key: "vaultsasgard", EndpointTemplate: "/%s/vaults/asgard"}
+	QueryVaultsYggdrasil                 = Query{Key: "vaultsyggdrasil", EndpointTemplate: "/%s/vaults/yggdrasil"}
+	QueryYggdrasilStatus                 = Query{Key: "yggdrasilstatus", EndpointTemplate: "/%s/vaults/yggdrasil/status"}
+	QueryVault                           = Query{Key: "vault", EndpointTemplate: "/%s/vault/{%s}"}
+	QueryVaultPubkeys                    = Query{Key: "vaultpubkeys", EndpointTemplate: "/%s/vaults/pubkeys"}
+	QueryConstantValues                  = Query{Key: "constants", EndpointTemplate: "/%s/constants"}
+	QueryVersion                         = Query{Key: "version", EndpointTemplate: "/%s/version"}
+	QueryMimirValues                     = Query{Key: "mimirs", EndpointTemplate: "/%s/mimir"}
+	QueryMimirWithKey                    = Query{Key: "mimirwithkey", EndpointTemplate: "/%s/mimir/key/{%s}"}
+	QueryMimirAdminValues                = Query{Key: "adminmimirs", EndpointTemplate: "/%s/mimir/admin"}
+	QueryMimirNodesValues                = Query{Key: "nodesmimirs", EndpointTemplate: "/%s/mimir/nodes"}
+	QueryMimirNodesAllValues             = Query{Key: "nodesmimirsall", EndpointTemplate: "/%s/mimir/nodes_all"}
+	QueryMimirNodeValues                 = Query{Key: "nodemimirs", EndpointTemplate: "/%s/mimir/node/{%s}"}
+	QueryMimirRegistry                   = Query{Key: "mimirregistry", EndpointTemplate: "/%s/mimir/registry"}
+	QueryMimirPending                    = Query{Key: "mimirpending", EndpointTemplate: "/%s/mimir/pending"}
+	QueryBan                             = Query{Key: "ban", EndpointTemplate: "/%s/ban/{%s}"}
+	QueryRagnarok                        = Query{Key: "ragnarok", EndpointTemplate: "/%s/ragnarok"}
+	QueryPendingOutbound                 = Query{Key: "pendingoutbound", EndpointTemplate: "/%s/queue/outbound"}
+	QueryScheduledOutbound               = Query{Key: "scheduledoutbound", EndpointTemplate: "/%s/queue/scheduled"}
+	QueryScheduledOutboundSimulate       = Query{Key: "scheduledoutboundsimulate", EndpointTemplate: "/%s/queue/scheduled/simulate"}
+	QueryScheduledOutboundVolume         = Query{Key: "scheduledoutboundvolume", EndpointTemplate: "/%s/queue/scheduled/volume"}
+	QueryTssKeygenMetrics                = Query{Key: "tss_keygen_metric", EndpointTemplate: "/%s/metric/keygen/{%s}"}
+	QueryTssMetrics                      = Query{Key: "tss_metric", EndpointTemplate: "/%s/metrics"}
+	QueryBlame                           = Query{Key: "blame", EndpointTemplate: "/%s/blame"}
+	QueryNodeBlame                       = Query{Key: "nodeblame", EndpointTemplate: "/%s/blame/{%s}"}
+	QueryMAYAName                        = Query{Key: "mayaname", EndpointTemplate: "/%s/mayaname/{%s}"}
+	QueryLiquidityAuctionTier            = Query{Key: "la_tier", EndpointTemplate: "/%s/liquidity_auction_tier/{%s}/{%s}"}
+	QueryLiquidityAuctionTiers           = Query{Key: "la_tiers", EndpointTemplate: "/%s/liquidity_auction_tiers/{%s}"}
+	QueryQuoteSwap                       = Query{Key: "quoteswap", EndpointTemplate: "/%s/quote/swap"}
+	QueryQuoteSaverDeposit               = Query{Key: "quotesaverdeposit", EndpointTemplate: "/%s/quote/saver/deposit"}
+	QueryQuoteSaverWithdraw              = Query{Key: "quotesaverwithdraw", EndpointTemplate: "/%s/quote/saver/withdraw"}
 )
 
 // Queries all queries
 var Queries = []Query{
 	QueryPool,
 	QueryPools,
+	QueryPoolSnapshots,
+	QueryPoolYield,
 	QueryBucket,
 	QueryBuckets,
 	QueryLiquidityProviders,
 	QueryLiquidityProvider,
+	QueryLiquidityProviderEstimate,
 	QueryBucketLiquidityProviders,
 	QueryBucketLiquidityProvider,
+	QueryBucketLiquidityProviderEstimate,
 	QueryTxVoter,
 	QueryTx,
+	QueryTxStatus,
 	QueryKeysignArray,
 	QueryKeysignArrayPubkey,
 	QueryQueue,
@@ -99,12 +125,21 @@ var Queries = []Query{
 	QueryChainHeights,
 	QueryNode,
 	QueryNodes,
+	QueryNodeBonds,
+	QueryNodeBondProviders,
+	QueryBondProviderBonds,
 	QueryInboundAddresses,
+	QueryEVMTokens,
 	QueryNetwork,
+	QueryOutboundFees,
+	QueryOutboundFee,
 	QueryPOL,
+	QueryPOLPools,
+	QueryPOLSimulate,
 	QueryBalanceModule,
 	QueryVaultsAsgard,
 	QueryVaultsYggdrasil,
+	QueryYggdrasilStatus,
 	QueryVaultPubkeys,
 	QueryVault,
 	QueryKeygensPubkey,
@@ -116,14 +151,21 @@ var Queries = []Query{
 	QueryMimirNodesAllValues,
 	QueryMimirNodesValues,
 	QueryMimirNodeValues,
+	QueryMimirRegistry,
+	QueryMimirPending,
 	QueryBan,
 	QueryRagnarok,
 	QueryPendingOutbound,
 	QueryScheduledOutbound,
+	QueryScheduledOutboundSimulate,
+	QueryScheduledOutboundVolume,
 	QueryTssMetrics,
 	QueryTssKeygenMetrics,
+	QueryBlame,
+	QueryNodeBlame,
 	QueryMAYAName,
 	QueryLiquidityAuctionTier,
+	QueryLiquidityAuctionTiers,
 	QueryQuoteSwap,
 	QueryQuoteSaverDeposit,
 	QueryQuoteSaverWithdraw,
@@ -152,6 +152,11 @@ func (s *SwapperV95) Swap(ctx cosmos.Context,
 		if err := keeper.AddToLiquidityFees(ctx, evt.Pool, evt.LiquidityFeeInCacao); err != nil {
 			return assetAmount, swapEvents, fmt.Errorf("fail to add to liquidity fees: %w", err)
 		}
+		if mgr.GetVersion().GTE(semver.MustParse("1.106.0")) {
+			if err := keeper.AddToPoolSnapshotSwaps(ctx, evt.Pool, evt.LiquidityFeeInCacao, s.swapVolumeInCacao(ctx, keeper, evt)); err != nil {
+				ctx.Logger().Error("fail to add to pool snapshot swaps", "pool", evt.Pool, "error", err)
+			}
+		}
 		telemetry.IncrCounterWithLabels(
 			[]string{"mayanode", "swap", "count"},
 			float32(1),
@@ -196,6 +201,27 @@ func (s *SwapperV95) Swap(ctx cosmos.Context,
 	return assetAmount, swapEvents, nil
 }
 
+// swapVolumeInCacao returns the cacao side of a swap leg, the inbound is
+// valued through the pool of the leg when neither side is cacao
+func (s *SwapperV95) swapVolumeInCacao(ctx cosmos.Context, keeper keeper.Keeper, evt *EventSwap) cosmos.Uint {
+	if len(evt.InTx.Coins) == 0 {
+		return cosmos.ZeroUint()
+	}
+	in := evt.InTx.Coins[0]
+	if in.Asset.IsBase() {
+		return in.Amount
+	}
+	if evt.EmitAsset.Asset.IsBase() {
+		return evt.EmitAsset.Amount
+	}
+	pool, err := keeper.GetPool(ctx, evt.Pool.GetLayer1Asset())
+	if err != nil {
+		ctx.Logger().Error("fail to get pool", "pool", evt.Pool, "error", err)
+		return cosmos.ZeroUint()
+	}
+	return pool.AssetValueInRune(in.Amount)
+}
+
 func (s *SwapperV95) burnCoins(ctx cosmos.Context, keeper keeper.Keeper, coins common.Coins) error {
 	err := keeper.SendFromModuleToModule(ctx, AsgardName, ModuleName, coins)
 	if err != nil {
@@ -458,6 +458,12 @@ func (s *SwapV95Suite) TestSynthSwap_RuneSynthRune(c *C) {
 		c.Check(amount.Uint64(), Equals, swapResult.Uint64(),
 			Commentf("Actual: %d Exp: %d", amount.Uint64(), swapResult.Uint64()))
 
+		// the cacao side of the swap counts towards the volume of the pool
+		fees, volume, err := mgr.Keeper().GetPoolSnapshotSwaps(ctx, common.BNBAsset)
+		c.Assert(err, IsNil)
+		c.Check(volume.Uint64(), Equals, swapAmt.Uint64())
+		c.Check(fees.IsZero(), Equals, false)
+
 		pool, err = mgr.Keeper().GetPool(ctx, common.BNBAsset)
 		c.Assert(err, IsNil)
 
@@ -676,3 +682,29 @@ func (s *SwapV95Suite) TestSynthSwap_AssetSynth(c *C) {
 	// but we did check BalanceAsset, LPUnits, and totalSynthSupply, the
 	// three inputs to the calculation.
 }
+
+func (s *SwapV95Suite) TestSwapVolumeInCacao(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceCacao = cosmos.NewUint(1000 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	pool.LPUnits = pool.BalanceCacao
+	c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+	swapper := newSwapperV95()
+
+	newEvt := func(in, out common.Coin) *EventSwap {
+		tx := common.NewTx(GetRandomTxHash(), GetRandomBNBAddress(), GetRandomBNBAddress(), common.NewCoins(in), BNBGasFeeSingleton, "")
+		return NewEventSwap(common.BNBAsset, cosmos.ZeroUint(), cosmos.ZeroUint(), cosmos.ZeroUint(), cosmos.ZeroUint(), tx, out, cosmos.ZeroUint())
+	}
+
+	// the cacao side is the volume, whichever side of the leg it is on
+	evt := newEvt(common.NewCoin(common.BaseAsset(), cosmos.NewUint(50*common.One)), common.NewCoin(common.BNBAsset, cosmos.NewUint(4*common.One)))
+	c.Check(swapper.swapVolumeInCacao(ctx, mgr.Keeper(), evt).Uint64(), Equals, uint64(50*common.One))
+	evt = newEvt(common.NewCoin(common.BNBAsset, cosmos.NewUint(5*common.One)), common.NewCoin(common.BaseAsset(), cosmos.NewUint(45*common.One)))
+	c.Check(swapper.swapVolumeInCacao(ctx, mgr.Keeper(), evt).Uint64(), Equals, uint64(45*common.One))
+
+	// neither side is cacao, the inbound is valued through the pool
+	evt = newEvt(common.NewCoin(common.BNBAsset, cosmos.NewUint(5*common.One)), common.NewCoin(common.BNBAsset.GetSyntheticAsset(), cosmos.NewUint(4*common.One)))
+	c.Check(swapper.swapVolumeInCacao(ctx, mgr.Keeper(), evt).Uint64(), Equals, uint64(50*common.One))
+}
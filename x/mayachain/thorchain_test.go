@@ -494,6 +494,7 @@ func (s *ThorchainSuite) TestRagnarok(c *C) {
 		TotalBondUnits: cosmos.NewUint(3 * 1014), // block height * node count
 	}
 	FundModule(c, ctx, mgr.Keeper(), ReserveName, cosmos.NewUint(40_010_000_000*common.One).Uint64())
+	FundModule(c, ctx, mgr.Keeper(), BondName, 1000_000)
 	c.Assert(mgr.Keeper().SetNetwork(ctx, network), IsNil)
 	ctx = ctx.WithBlockHeight(1024)
 
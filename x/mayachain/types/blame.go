@@ -1,9 +1,13 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
 )
 
 func (m *Node) String() string {
@@ -23,3 +27,40 @@ func (m *Blame) String() string {
 	sb.WriteString(fmt.Sprintf("is unicast:%+v\n", m.IsUnicast))
 	return sb.String()
 }
+
+const (
+	BlameTypeKeysign = "keysign"
+	BlameTypeKeygen  = "keygen"
+)
+
+// NewBlameRecord create a new record of a node blamed for a failed keysign or keygen
+func NewBlameRecord(height int64, blameType, id string, nodeAddress cosmos.AccAddress, nodePubKey, vault common.PubKey, chain common.Chain, blame Blame) BlameRecord {
+	return BlameRecord{
+		Height:      height,
+		Type:        blameType,
+		ID:          id,
+		NodeAddress: nodeAddress,
+		NodePubKey:  nodePubKey,
+		VaultPubKey: vault,
+		Chain:       chain,
+		Round:       blame.Round,
+		FailReason:  blame.FailReason,
+	}
+}
+
+// Valid check whether the blame record has all the necessary values
+func (m *BlameRecord) Valid() error {
+	if m.Height <= 0 {
+		return errors.New("height must be greater than zero")
+	}
+	if m.Type != BlameTypeKeysign && m.Type != BlameTypeKeygen {
+		return fmt.Errorf("invalid blame type: %s", m.Type)
+	}
+	if m.NodeAddress.Empty() {
+		return errors.New("node address cannot be empty")
+	}
+	if m.NodePubKey.IsEmpty() {
+		return errors.New("node pubkey cannot be empty")
+	}
+	return nil
+}
@@ -49,6 +49,8 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgNodePauseChain{}, "mayachain/MsgNodePauseChain", nil)
 	cdc.RegisterConcrete(&MsgSolvency{}, "mayachain/MsgSolvency", nil)
 	cdc.RegisterConcrete(&MsgManageMAYAName{}, "mayachain/MsgManageMAYAName", nil)
+	cdc.RegisterConcrete(&MsgSetLiquidityAuctionTiers{}, "mayachain/MsgSetLiquidityAuctionTiers", nil)
+	cdc.RegisterConcrete(&MsgSetEVMTokens{}, "mayachain/MsgSetEVMTokens", nil)
 }
 
 // RegisterInterfaces register the types
@@ -84,4 +86,6 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*cosmos.Msg)(nil), &MsgNodePauseChain{})
 	registry.RegisterImplementations((*cosmos.Msg)(nil), &MsgManageMAYAName{})
 	registry.RegisterImplementations((*cosmos.Msg)(nil), &MsgSolvency{})
+	registry.RegisterImplementations((*cosmos.Msg)(nil), &MsgSetLiquidityAuctionTiers{})
+	registry.RegisterImplementations((*cosmos.Msg)(nil), &MsgSetEVMTokens{})
 }
@@ -13,6 +13,28 @@ func NewMsgMimir(key string, value int64, signer cosmos.AccAddress) *MsgMimir {
 	}
 }
 
+// NewMsgScheduleMimir is a constructor function for a MsgMimir which
+// activates at the given block height
+func NewMsgScheduleMimir(key string, value, activationHeight int64, signer cosmos.AccAddress) *MsgMimir {
+	return &MsgMimir{
+		Key:              key,
+		Value:            value,
+		Signer:           signer,
+		ActivationHeight: activationHeight,
+	}
+}
+
+// NewMsgCancelMimir is a constructor function for a MsgMimir which cancels the
+// pending mimir of the given key and activation height
+func NewMsgCancelMimir(key string, activationHeight int64, signer cosmos.AccAddress) *MsgMimir {
+	return &MsgMimir{
+		Key:              key,
+		Signer:           signer,
+		ActivationHeight: activationHeight,
+		Cancel:           true,
+	}
+}
+
 // Route should return the route key of the module
 func (m *MsgMimir) Route() string { return RouterKey }
 
@@ -27,6 +49,12 @@ func (m *MsgMimir) ValidateBasic() error {
 	if m.Signer.Empty() {
 		return cosmos.ErrInvalidAddress(m.Signer.String())
 	}
+	if m.ActivationHeight < 0 {
+		return cosmos.ErrUnknownRequest("activation height cannot be negative")
+	}
+	if m.Cancel && m.ActivationHeight == 0 {
+		return cosmos.ErrUnknownRequest("activation height of the pending mimir to cancel is required")
+	}
 	return nil
 }
 
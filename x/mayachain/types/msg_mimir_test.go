@@ -26,4 +26,9 @@ func (MsgMimirSuite) TestMsgMimir(c *C) {
 	err1 := msg1.ValidateBasic()
 	c.Assert(err1, NotNil)
 	c.Assert(errors.Is(err1, se.ErrInvalidAddress), Equals, true)
+
+	c.Check(NewMsgScheduleMimir("key", 1, 100, addr).ValidateBasic(), IsNil)
+	c.Check(NewMsgScheduleMimir("key", 1, -1, addr).ValidateBasic(), NotNil)
+	c.Check(NewMsgCancelMimir("key", 100, addr).ValidateBasic(), IsNil)
+	c.Check(NewMsgCancelMimir("key", 0, addr).ValidateBasic(), NotNil)
 }
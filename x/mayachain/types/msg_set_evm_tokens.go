@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+// NewMsgSetEVMTokens is a constructor function for MsgSetEVMTokens
+func NewMsgSetEVMTokens(tokens EVMTokens, signer cosmos.AccAddress) *MsgSetEVMTokens {
+	return &MsgSetEVMTokens{
+		Tokens: tokens,
+		Signer: signer,
+	}
+}
+
+// Route should return the router key of the module
+func (m *MsgSetEVMTokens) Route() string { return RouterKey }
+
+// Type should return the action
+func (m MsgSetEVMTokens) Type() string { return "set_evm_tokens" }
+
+// ValidateBasic runs stateless checks on the message
+func (m *MsgSetEVMTokens) ValidateBasic() error {
+	if m.Signer.Empty() {
+		return cosmos.ErrInvalidAddress(m.Signer.String())
+	}
+	if len(m.Tokens) == 0 {
+		return cosmos.ErrUnknownRequest("tokens cannot be empty")
+	}
+	seen := make(map[string]bool, len(m.Tokens))
+	for _, token := range m.Tokens {
+		if err := token.Valid(); err != nil {
+			return cosmos.ErrUnknownRequest(err.Error())
+		}
+		if seen[token.Key()] {
+			return cosmos.ErrUnknownRequest(fmt.Sprintf("duplicate token %s", token.Key()))
+		}
+		seen[token.Key()] = true
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgSetEVMTokens) GetSignBytes() []byte {
+	return cosmos.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgSetEVMTokens) GetSigners() []cosmos.AccAddress {
+	return []cosmos.AccAddress{m.Signer}
+}
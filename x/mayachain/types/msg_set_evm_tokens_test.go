@@ -0,0 +1,41 @@
+package types
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+type MsgSetEVMTokensSuite struct{}
+
+var _ = Suite(&MsgSetEVMTokensSuite{})
+
+func (MsgSetEVMTokensSuite) TestMsgSetEVMTokens(c *C) {
+	acc1 := GetRandomBech32Addr()
+	usdt := NewEVMToken(common.ETHChain, "0xdAC17F958D2ee523a2206206994597C13D831ec7", "USDT", 6)
+	usdc := NewEVMToken(common.AVAXChain, "0xB97EF9Ef8734C71904D8002F8b6Bc66Dd9c48a6E", "USDC", 6)
+	tokens := EVMTokens{usdt, usdc}
+	msg := NewMsgSetEVMTokens(tokens, acc1)
+	c.Assert(msg.Route(), Equals, RouterKey)
+	c.Assert(msg.Type(), Equals, "set_evm_tokens")
+	c.Assert(msg.ValidateBasic(), IsNil)
+	EnsureMsgBasicCorrect(msg, c)
+	c.Assert(msg.GetSigners()[0].String(), Equals, acc1.String())
+
+	c.Assert(NewMsgSetEVMTokens(tokens, cosmos.AccAddress{}).ValidateBasic(), NotNil)
+	c.Assert(NewMsgSetEVMTokens(nil, acc1).ValidateBasic(), NotNil)
+	// not an EVM chain
+	c.Assert(NewMsgSetEVMTokens(EVMTokens{NewEVMToken(common.BTCChain, usdt.Address, "USDT", 6)}, acc1).ValidateBasic(), NotNil)
+	// invalid address
+	c.Assert(NewMsgSetEVMTokens(EVMTokens{NewEVMToken(common.ETHChain, GetRandomBTCAddress(), "USDT", 6)}, acc1).ValidateBasic(), NotNil)
+	c.Assert(NewMsgSetEVMTokens(EVMTokens{NewEVMToken(common.ETHChain, usdt.Address, "", 6)}, acc1).ValidateBasic(), NotNil)
+	c.Assert(NewMsgSetEVMTokens(EVMTokens{NewEVMToken(common.ETHChain, usdt.Address, "USDT", -1)}, acc1).ValidateBasic(), NotNil)
+	// same token, different case
+	lower := NewEVMToken(common.ETHChain, "0xdac17f958d2ee523a2206206994597c13d831ec7", "USDT", 6)
+	c.Assert(NewMsgSetEVMTokens(EVMTokens{usdt, lower}, acc1).ValidateBasic(), NotNil)
+	// the same address on another chain is a different token
+	c.Assert(NewMsgSetEVMTokens(EVMTokens{usdt, NewEVMToken(common.AVAXChain, usdt.Address, "USDT", 6)}, acc1).ValidateBasic(), IsNil)
+	// delisting only needs the address
+	c.Assert(NewMsgSetEVMTokens(EVMTokens{{Chain: common.ETHChain, Address: usdt.Address, Delisted: true}}, acc1).ValidateBasic(), IsNil)
+}
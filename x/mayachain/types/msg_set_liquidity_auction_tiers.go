@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+// NewMsgSetLiquidityAuctionTiers is a constructor function for MsgSetLiquidityAuctionTiers
+func NewMsgSetLiquidityAuctionTiers(tiers LiquidityAuctionTiers, signer cosmos.AccAddress) *MsgSetLiquidityAuctionTiers {
+	return &MsgSetLiquidityAuctionTiers{
+		Tiers:  tiers,
+		Signer: signer,
+	}
+}
+
+// Route should return the router key of the module
+func (m *MsgSetLiquidityAuctionTiers) Route() string { return RouterKey }
+
+// Type should return the action
+func (m MsgSetLiquidityAuctionTiers) Type() string { return "set_liquidity_auction_tiers" }
+
+// ValidateBasic runs stateless checks on the message
+func (m *MsgSetLiquidityAuctionTiers) ValidateBasic() error {
+	if m.Signer.Empty() {
+		return cosmos.ErrInvalidAddress(m.Signer.String())
+	}
+	if len(m.Tiers) == 0 {
+		return cosmos.ErrUnknownRequest("tiers cannot be empty")
+	}
+	seen := make(map[string]bool, len(m.Tiers))
+	for _, tier := range m.Tiers {
+		if err := tier.Valid(); err != nil {
+			return cosmos.ErrUnknownRequest(err.Error())
+		}
+		// tiers are only looked up by the CACAO address of a liquidity provider
+		if !tier.Address.IsChain(common.BaseAsset().Chain) {
+			return cosmos.ErrInvalidAddress(tier.Address.String())
+		}
+		if tier.Tier < 0 {
+			return cosmos.ErrUnknownRequest(fmt.Sprintf("invalid tier(%d) for %s", tier.Tier, tier.Address))
+		}
+		if seen[tier.Key()] {
+			return cosmos.ErrUnknownRequest(fmt.Sprintf("duplicate address %s", tier.Address))
+		}
+		seen[tier.Key()] = true
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgSetLiquidityAuctionTiers) GetSignBytes() []byte {
+	return cosmos.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgSetLiquidityAuctionTiers) GetSigners() []cosmos.AccAddress {
+	return []cosmos.AccAddress{m.Signer}
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+type MsgSetLiquidityAuctionTiersSuite struct{}
+
+var _ = Suite(&MsgSetLiquidityAuctionTiersSuite{})
+
+func (MsgSetLiquidityAuctionTiersSuite) TestMsgSetLiquidityAuctionTiers(c *C) {
+	acc1 := GetRandomBech32Addr()
+	addr1 := GetRandomBaseAddress()
+	addr2 := GetRandomBaseAddress()
+	tiers := LiquidityAuctionTiers{
+		{Address: addr1, Tier: 1},
+		{Address: addr2, Tier: 0},
+	}
+	msg := NewMsgSetLiquidityAuctionTiers(tiers, acc1)
+	c.Assert(msg.Route(), Equals, RouterKey)
+	c.Assert(msg.Type(), Equals, "set_liquidity_auction_tiers")
+	c.Assert(msg.ValidateBasic(), IsNil)
+	EnsureMsgBasicCorrect(msg, c)
+	c.Assert(msg.GetSigners()[0].String(), Equals, acc1.String())
+
+	c.Assert(NewMsgSetLiquidityAuctionTiers(tiers, cosmos.AccAddress{}).ValidateBasic(), NotNil)
+	c.Assert(NewMsgSetLiquidityAuctionTiers(nil, acc1).ValidateBasic(), NotNil)
+	c.Assert(NewMsgSetLiquidityAuctionTiers(LiquidityAuctionTiers{{Address: "", Tier: 1}}, acc1).ValidateBasic(), NotNil)
+	c.Assert(NewMsgSetLiquidityAuctionTiers(LiquidityAuctionTiers{{Address: GetRandomBTCAddress(), Tier: 1}}, acc1).ValidateBasic(), NotNil)
+	c.Assert(NewMsgSetLiquidityAuctionTiers(LiquidityAuctionTiers{{Address: addr1, Tier: -1}}, acc1).ValidateBasic(), NotNil)
+	c.Assert(NewMsgSetLiquidityAuctionTiers(LiquidityAuctionTiers{{Address: addr1, Tier: 1}, {Address: addr1, Tier: 2}}, acc1).ValidateBasic(), NotNil)
+}
@@ -3,6 +3,8 @@ package types
 import (
 	"fmt"
 
+	"github.com/btcsuite/btcutil/bech32"
+
 	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
 )
@@ -124,6 +126,36 @@ func (m *MsgSwap) ValidateBasicV63() error {
 	return nil
 }
 
+// ValidateBasicV106 runs stateless checks on the message
+func (m *MsgSwap) ValidateBasicV106() error {
+	// the outbound fee can only be paid from the swapped coin, either in the
+	// coin itself or in its CACAO value
+	if !m.FeeAsset.IsEmpty() && len(m.Tx.Coins) > 0 &&
+		!m.FeeAsset.Equals(m.Tx.Coins[0].Asset) && !m.FeeAsset.IsNativeBase() {
+		return cosmos.ErrUnknownRequest("fee asset must be the swapped asset or CACAO")
+	}
+	if m.IBCChannel == "" {
+		return m.ValidateBasicV63()
+	}
+	if !m.TargetAsset.GetChain().IsBASEChain() {
+		return cosmos.ErrUnknownRequest("ibc transfer is only supported for native assets")
+	}
+	if !m.Tx.FromAddress.IsChain(common.BASEChain) {
+		return cosmos.ErrUnknownRequest("ibc transfer is only supported for swaps from a MAYA address")
+	}
+	if _, _, err := bech32.Decode(m.Destination.String()); err != nil {
+		return cosmos.ErrUnknownRequest("ibc transfer destination must be a bech32 address")
+	}
+	if len(m.Aggregator) != 0 {
+		return cosmos.ErrUnknownRequest("ibc transfer can't be used with an aggregator")
+	}
+	// validate the rest of the message as a swap back to the sender, since
+	// the destination is an address on the counterparty chain
+	msg := *m
+	msg.Destination = m.Tx.FromAddress
+	return msg.ValidateBasicV63()
+}
+
 // GetSignBytes encodes the message for signing
 func (m *MsgSwap) GetSignBytes() []byte {
 	return cosmos.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
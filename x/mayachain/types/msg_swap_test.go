@@ -159,3 +159,69 @@ func (MsgSwapSuite) TestMsgSwap(c *C) {
 	m = NewMsgSwap(tx, common.BNBAsset, GetRandomBNBAddress(), cosmos.ZeroUint(), GetRandomBaseAddress(), cosmos.NewUint(1024), "", "", nil, 0, addr)
 	c.Assert(m.ValidateBasicV63(), NotNil)
 }
+
+func (MsgSwapSuite) TestMsgSwapIBCChannel(c *C) {
+	addr := GetRandomBech32Addr()
+	tx := common.NewTx(
+		GetRandomTxHash(),
+		GetRandomBaseAddress(),
+		GetRandomBaseAddress(),
+		common.Coins{
+			common.NewCoin(common.BNBAsset.GetSyntheticAsset(), cosmos.NewUint(100000000)),
+		},
+		common.Gas{},
+		"",
+	)
+	receiver := common.Address("cosmos1xv9tklw7d82sezh9haa573wufgy59vmwe6xxe5")
+
+	m := NewMsgSwap(tx, common.BaseAsset(), receiver, cosmos.ZeroUint(), common.NoAddress, cosmos.ZeroUint(), "", "", nil, 0, addr)
+	c.Assert(m.ValidateBasicV106(), NotNil)
+	m.IBCChannel = "channel-0"
+	c.Assert(m.ValidateBasicV106(), IsNil)
+
+	// target asset must be a native asset
+	m = NewMsgSwap(tx, common.BNBAsset, receiver, cosmos.ZeroUint(), common.NoAddress, cosmos.ZeroUint(), "", "", nil, 0, addr)
+	m.IBCChannel = "channel-0"
+	c.Assert(m.ValidateBasicV106(), NotNil)
+
+	// destination must be a bech32 address
+	m = NewMsgSwap(tx, common.BaseAsset(), GetRandomBNBAddress(), cosmos.ZeroUint(), common.NoAddress, cosmos.ZeroUint(), "", "", nil, 0, addr)
+	m.IBCChannel = "channel-0"
+	c.Assert(m.ValidateBasicV106(), IsNil)
+	m.Destination = common.Address("0x90f2b1ae50e6018230e90a33f98c7844a0ab635a")
+	c.Assert(m.ValidateBasicV106(), NotNil)
+
+	// sender must be a MAYA address
+	tx.FromAddress = GetRandomBNBAddress()
+	m = NewMsgSwap(tx, common.BaseAsset(), receiver, cosmos.ZeroUint(), common.NoAddress, cosmos.ZeroUint(), "", "", nil, 0, addr)
+	m.IBCChannel = "channel-0"
+	c.Assert(m.ValidateBasicV106(), NotNil)
+}
+
+func (MsgSwapSuite) TestMsgSwapFeeAsset(c *C) {
+	addr := GetRandomBech32Addr()
+	tx := common.NewTx(
+		GetRandomTxHash(),
+		GetRandomBNBAddress(),
+		GetRandomBNBAddress(),
+		common.Coins{
+			common.NewCoin(common.BNBAsset, cosmos.NewUint(100000000)),
+		},
+		BNBGasFeeSingleton,
+		"",
+	)
+	m := NewMsgSwap(tx, common.BTCAsset, GetRandomBTCAddress(), cosmos.ZeroUint(), common.NoAddress, cosmos.ZeroUint(), "", "", nil, 0, addr)
+	c.Assert(m.ValidateBasicV106(), IsNil)
+
+	// the fee can be paid in the swapped asset or in CACAO
+	m.FeeAsset = common.BNBAsset
+	c.Assert(m.ValidateBasicV106(), IsNil)
+	m.FeeAsset = common.BaseAsset()
+	c.Assert(m.ValidateBasicV106(), IsNil)
+
+	// but not in any other asset
+	m.FeeAsset = common.ETHAsset
+	c.Assert(m.ValidateBasicV106(), NotNil)
+	m.FeeAsset = common.BNBAsset.GetSyntheticAsset()
+	c.Assert(m.ValidateBasicV106(), NotNil)
+}
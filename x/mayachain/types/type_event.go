@@ -11,32 +11,40 @@ import (
 
 // all event types support by BASEChain
 const (
-	AddLiquidityEventType      = "add_liquidity"
-	BondEventType              = "bond"
-	DonateEventType            = "donate"
-	ErrataEventType            = "errata"
-	FeeEventType               = "fee"
-	GasEventType               = "gas"
-	OutboundEventType          = "outbound"
-	PendingLiquidity           = "pending_liquidity"
-	PoolBalanceChangeEventType = "pool_balance_change"
-	PoolEventType              = "pool"
-	RefundEventType            = "refund"
-	ReserveEventType           = "reserve"
-	RewardEventType            = "rewards"
-	ScheduledOutboundEventType = "scheduled_outbound"
-	SecurityEventType          = "security"
-	SetMimirEventType          = "set_mimir"
-	SetNodeMimirEventType      = "set_node_mimir"
-	SlashEventType             = "slash"
-	SlashLiquidityEventType    = "slash_liquidity"
-	SlashPointEventType        = "slash_points"
-	SwapEventType              = "swap"
-	SwitchEventType            = "switch"
-	MAYANameEventType          = "mayaname"
-	TSSKeygenMetricEventType   = "tss_keygen"
-	TSSKeysignMetricEventType  = "tss_keysign"
-	WithdrawEventType          = "withdraw"
+	AddLiquidityEventType         = "add_liquidity"
+	BondEventType                 = "bond"
+	BondShareEventType            = "bond_share"
+	DonateEventType               = "donate"
+	ErrataEventType               = "errata"
+	EVMTokenEventType             = "evm_token"
+	FeeEventType                  = "fee"
+	GasEventType                  = "gas"
+	IBCTransferEventType          = "ibc_transfer"
+	KeygenEventType               = "keygen"
+	LiquidityAuctionTierEventType = "liquidity_auction_tier"
+	OutboundEventType             = "outbound"
+	PendingLiquidity              = "pending_liquidity"
+	POLEventType                  = "pol"
+	PoolBalanceChangeEventType    = "pool_balance_change"
+	PoolEventType                 = "pool"
+	RefundEventType               = "refund"
+	ReserveEventType              = "reserve"
+	RewardEventType               = "rewards"
+	SaversYieldEventType          = "savers_yield"
+	ScheduledOutboundEventType    = "scheduled_outbound"
+	SecurityEventType             = "security"
+	SetMimirEventType             = "set_mimir"
+	SetNodeMimirEventType         = "set_node_mimir"
+	PendingMimirEventType         = "pending_mimir"
+	SlashEventType                = "slash"
+	SlashLiquidityEventType       = "slash_liquidity"
+	SlashPointEventType           = "slash_points"
+	SwapEventType                 = "swap"
+	SwitchEventType               = "switch"
+	MAYANameEventType             = "mayaname"
+	TSSKeygenMetricEventType      = "tss_keygen"
+	TSSKeysignMetricEventType     = "tss_keysign"
+	WithdrawEventType             = "withdraw"
 )
 
 // PoolMods a list of pool modifications
@@ -192,6 +200,31 @@ func (m *EventDonate) Events() (cosmos.Events, error) {
 	return cosmos.Events{evt}, nil
 }
 
+// NewEventSaversYield create a new savers yield event
+func NewEventSaversYield(bucket Pool, amount cosmos.Uint) *EventSaversYield {
+	return &EventSaversYield{
+		Bucket:       bucket.Asset,
+		Amount:       amount,
+		BalanceAsset: bucket.BalanceAsset,
+		LPUnits:      bucket.LPUnits,
+	}
+}
+
+// Type return savers yield event type
+func (m *EventSaversYield) Type() string {
+	return SaversYieldEventType
+}
+
+// Events get all events
+func (m *EventSaversYield) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("bucket", m.Bucket.String()),
+		cosmos.NewAttribute("amount", m.Amount.String()),
+		cosmos.NewAttribute("balance_asset", m.BalanceAsset.String()),
+		cosmos.NewAttribute("lp_units", m.LPUnits.String()))
+	return cosmos.Events{evt}, nil
+}
+
 // NewEventPool create a new pool change event
 func NewEventPool(pool common.Asset, status PoolStatus) *EventPool {
 	return &EventPool{
@@ -404,6 +437,10 @@ func (m *EventScheduledOutbound) Events() (cosmos.Events, error) {
 		cosmos.NewAttribute("out_hash", m.OutTx.OutHash.String()),
 		cosmos.NewAttribute("module_name", m.OutTx.ModuleName),
 	}
+	// the height the outbound is signed at, it is only set from 1.106.0
+	if m.Height > 0 {
+		attrs = append(attrs, cosmos.NewAttribute("height", strconv.FormatInt(m.Height, 10)))
+	}
 
 	for i, gas := range m.OutTx.MaxGas {
 		attrs = append(attrs, cosmos.NewAttribute(fmt.Sprintf("max_gas_asset_%d", i), gas.Asset.String()))
@@ -483,6 +520,131 @@ func (m *EventSlashLiquidity) Events() (cosmos.Events, error) {
 	return cosmos.Events{evt}, nil
 }
 
+// share types reported by EventBondShare
+const (
+	BondShareReward      = "reward"
+	BondShareOperatorFee = "operator_fee"
+	BondShareSlash       = "slash"
+)
+
+// NewEventBondShare create a new instance of EventBondShare
+func NewEventBondShare(nodeAddr, bondAddr cosmos.AccAddress, shareType string, asset common.Asset, amount cosmos.Uint) *EventBondShare {
+	return &EventBondShare{
+		NodeAddress: nodeAddr,
+		BondAddress: bondAddr,
+		ShareType:   shareType,
+		Asset:       asset,
+		Amount:      amount,
+	}
+}
+
+// Type return bond share event type
+func (m *EventBondShare) Type() string {
+	return BondShareEventType
+}
+
+// Events return a standard cosmos events
+func (m *EventBondShare) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("node_address", m.NodeAddress.String()),
+		cosmos.NewAttribute("bond_address", m.BondAddress.String()),
+		cosmos.NewAttribute("share_type", m.ShareType),
+		cosmos.NewAttribute("asset", m.Asset.String()),
+		cosmos.NewAttribute("amount", m.Amount.String()),
+	)
+	return cosmos.Events{evt}, nil
+}
+
+// actions reported by EventPOL
+const (
+	POLActionDeposit  = "deposit"
+	POLActionWithdraw = "withdraw"
+)
+
+// NewEventPOL create a new instance of EventPOL
+func NewEventPOL(pool common.Asset, action string, cacaoAmt, basisPts, synthUtilization cosmos.Uint) *EventPOL {
+	return &EventPOL{
+		Pool:             pool,
+		Action:           action,
+		CacaoAmount:      cacaoAmt,
+		BasisPoints:      basisPts,
+		SynthUtilization: synthUtilization,
+	}
+}
+
+// Type return pol event type
+func (m *EventPOL) Type() string {
+	return POLEventType
+}
+
+// Events return a standard cosmos events
+func (m *EventPOL) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("pool", m.Pool.String()),
+		cosmos.NewAttribute("action", m.Action),
+		cosmos.NewAttribute("cacao_amount", m.CacaoAmount.String()),
+		cosmos.NewAttribute("basis_points", m.BasisPoints.String()),
+		cosmos.NewAttribute("synth_utilization", m.SynthUtilization.String()),
+	)
+	return cosmos.Events{evt}, nil
+}
+
+// NewEventLiquidityAuctionTier create a new instance of EventLiquidityAuctionTier
+func NewEventLiquidityAuctionTier(addr common.Address, tier, previousTier int64, signer cosmos.AccAddress) *EventLiquidityAuctionTier {
+	return &EventLiquidityAuctionTier{
+		Address:      addr,
+		Tier:         tier,
+		PreviousTier: previousTier,
+		Signer:       signer,
+	}
+}
+
+// Type return liquidity auction tier event type
+func (m *EventLiquidityAuctionTier) Type() string {
+	return LiquidityAuctionTierEventType
+}
+
+// Events return a standard cosmos events
+func (m *EventLiquidityAuctionTier) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("address", m.Address.String()),
+		cosmos.NewAttribute("tier", strconv.FormatInt(m.Tier, 10)),
+		cosmos.NewAttribute("previous_tier", strconv.FormatInt(m.PreviousTier, 10)),
+		cosmos.NewAttribute("signer", m.Signer.String()),
+	)
+	return cosmos.Events{evt}, nil
+}
+
+// NewEventEVMToken create a new instance of EventEVMToken
+func NewEventEVMToken(token EVMToken, signer cosmos.AccAddress) *EventEVMToken {
+	return &EventEVMToken{
+		Chain:    token.Chain,
+		Address:  token.Address,
+		Symbol:   token.Symbol,
+		Decimals: token.Decimals,
+		Delisted: token.Delisted,
+		Signer:   signer,
+	}
+}
+
+// Type return evm token event type
+func (m *EventEVMToken) Type() string {
+	return EVMTokenEventType
+}
+
+// Events return a standard cosmos events
+func (m *EventEVMToken) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("chain", m.Chain.String()),
+		cosmos.NewAttribute("address", m.Address.String()),
+		cosmos.NewAttribute("symbol", m.Symbol),
+		cosmos.NewAttribute("decimals", strconv.FormatInt(m.Decimals, 10)),
+		cosmos.NewAttribute("delisted", strconv.FormatBool(m.Delisted)),
+		cosmos.NewAttribute("signer", m.Signer.String()),
+	)
+	return cosmos.Events{evt}, nil
+}
+
 // NewEventErrata create a new errata event
 func NewEventErrata(txID common.TxID, pools PoolMods) *EventErrata {
 	return &EventErrata{
@@ -561,6 +723,29 @@ func (m *EventOutbound) Events() (cosmos.Events, error) {
 	return cosmos.Events{evt}, nil
 }
 
+// NewEventKeygen create a new keygen event
+func NewEventKeygen(height int64, keygen Keygen) *EventKeygen {
+	return &EventKeygen{
+		Height: height,
+		Keygen: keygen,
+	}
+}
+
+// Type return a string which represent the type of this event
+func (m *EventKeygen) Type() string {
+	return KeygenEventType
+}
+
+// Events return cosmos sdk events
+func (m *EventKeygen) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("height", strconv.FormatInt(m.Height, 10)),
+		cosmos.NewAttribute("id", m.Keygen.ID.String()),
+		cosmos.NewAttribute("type", m.Keygen.Type.String()),
+		cosmos.NewAttribute("members", strings.Join(m.Keygen.Members, ",")))
+	return cosmos.Events{evt}, nil
+}
+
 // NewEventTssKeygenMetric create a new EventTssMetric
 func NewEventTssKeygenMetric(pubkey common.PubKey, medianDurationMS int64) *EventTssKeygenMetric {
 	return &EventTssKeygenMetric{
@@ -828,3 +1013,76 @@ func (m *EventSetNodeMimir) Events() (cosmos.Events, error) {
 	)
 	return cosmos.Events{evt}, nil
 }
+
+// pending mimir actions reported by EventPendingMimir
+const (
+	PendingMimirActionSchedule = "schedule"
+	PendingMimirActionCancel   = "cancel"
+	PendingMimirActionApply    = "apply"
+)
+
+// NewEventPendingMimir create a new instance of EventPendingMimir
+func NewEventPendingMimir(action string, m PendingMimir) *EventPendingMimir {
+	return &EventPendingMimir{
+		Action:           action,
+		Key:              m.Key,
+		Value:            m.Value,
+		Signer:           m.Signer,
+		ActivationHeight: m.ActivationHeight,
+	}
+}
+
+// Type return a string which represent the type of this event
+func (m *EventPendingMimir) Type() string {
+	return PendingMimirEventType
+}
+
+// Events return cosmos sdk events
+func (m *EventPendingMimir) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("action", m.Action),
+		cosmos.NewAttribute("key", m.Key),
+		cosmos.NewAttribute("value", strconv.FormatInt(m.Value, 10)),
+		cosmos.NewAttribute("signer", m.Signer.String()),
+		cosmos.NewAttribute("activation_height", strconv.FormatInt(m.ActivationHeight, 10)),
+	)
+	return cosmos.Events{evt}, nil
+}
+
+// IBC transfer status reported by EventIBCTransfer
+const (
+	IBCTransferStatusSent     = "sent"
+	IBCTransferStatusAcked    = "acked"
+	IBCTransferStatusFailed   = "failed"
+	IBCTransferStatusTimedOut = "timed_out"
+)
+
+// NewEventIBCTransfer create a new instance of EventIBCTransfer
+func NewEventIBCTransfer(inTxID common.TxID, channel string, sequence uint64, receiver common.Address, coin common.Coin, status string) *EventIBCTransfer {
+	return &EventIBCTransfer{
+		InTxID:   inTxID,
+		Channel:  channel,
+		Sequence: sequence,
+		Receiver: receiver,
+		Coin:     coin,
+		Status:   status,
+	}
+}
+
+// Type return a string which represent the type of this event
+func (m *EventIBCTransfer) Type() string {
+	return IBCTransferEventType
+}
+
+// Events return cosmos sdk events
+func (m *EventIBCTransfer) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("in_tx_id", m.InTxID.String()),
+		cosmos.NewAttribute("channel", m.Channel),
+		cosmos.NewAttribute("sequence", strconv.FormatUint(m.Sequence, 10)),
+		cosmos.NewAttribute("receiver", m.Receiver.String()),
+		cosmos.NewAttribute("coin", m.Coin.String()),
+		cosmos.NewAttribute("status", m.Status),
+	)
+	return cosmos.Events{evt}, nil
+}
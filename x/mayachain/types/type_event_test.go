@@ -1,6 +1,8 @@
 package types
 
 import (
+	"strings"
+
 	. "gopkg.in/check.v1"
 
 	"gitlab.com/mayachain/mayanode/common"
@@ -100,6 +102,59 @@ func (s EventSuite) TestSlashLiquidity(c *C) {
 	c.Check(events, NotNil)
 }
 
+func (s EventSuite) TestBondShare(c *C) {
+	na := GetRandomValidatorNode(NodeStatus_Active)
+	bondAddr := GetRandomBech32Addr()
+	evt := NewEventBondShare(na.NodeAddress, bondAddr, BondShareOperatorFee, common.BaseNative, cosmos.NewUint(100))
+	c.Check(evt.Type(), Equals, "bond_share")
+	c.Check(evt.BondAddress.Equals(bondAddr), Equals, true)
+	c.Check(evt.ShareType, Equals, "operator_fee")
+	c.Check(evt.Amount.Uint64(), Equals, uint64(100))
+	events, err := evt.Events()
+	c.Check(err, IsNil)
+	c.Check(events, NotNil)
+}
+
+func (s EventSuite) TestPOL(c *C) {
+	evt := NewEventPOL(common.BTCAsset, POLActionDeposit, cosmos.NewUint(100), cosmos.NewUint(50), cosmos.NewUint(2500))
+	c.Check(evt.Type(), Equals, "pol")
+	c.Check(evt.Pool, Equals, common.BTCAsset)
+	c.Check(evt.Action, Equals, "deposit")
+	c.Check(evt.CacaoAmount.Uint64(), Equals, uint64(100))
+	c.Check(evt.BasisPoints.Uint64(), Equals, uint64(50))
+	c.Check(evt.SynthUtilization.Uint64(), Equals, uint64(2500))
+	events, err := evt.Events()
+	c.Check(err, IsNil)
+	c.Check(events, NotNil)
+}
+
+func (s EventSuite) TestLiquidityAuctionTier(c *C) {
+	addr := GetRandomBaseAddress()
+	signer := GetRandomBech32Addr()
+	evt := NewEventLiquidityAuctionTier(addr, 2, 3, signer)
+	c.Check(evt.Type(), Equals, "liquidity_auction_tier")
+	c.Check(evt.Address.Equals(addr), Equals, true)
+	c.Check(evt.Tier, Equals, int64(2))
+	c.Check(evt.PreviousTier, Equals, int64(3))
+	events, err := evt.Events()
+	c.Check(err, IsNil)
+	c.Check(events, NotNil)
+}
+
+func (s EventSuite) TestEVMToken(c *C) {
+	token := NewEVMToken(common.ETHChain, "0xdAC17F958D2ee523a2206206994597C13D831ec7", "USDT", 6)
+	signer := GetRandomBech32Addr()
+	evt := NewEventEVMToken(token, signer)
+	c.Check(evt.Type(), Equals, "evm_token")
+	c.Check(evt.Chain.Equals(common.ETHChain), Equals, true)
+	c.Check(evt.Symbol, Equals, "USDT")
+	c.Check(evt.Decimals, Equals, int64(6))
+	c.Check(evt.Delisted, Equals, false)
+	events, err := evt.Events()
+	c.Check(err, IsNil)
+	c.Check(events, NotNil)
+}
+
 func (s EventSuite) TestSlash(c *C) {
 	evt := NewEventSlash(common.BNBAsset, []PoolAmt{
 		{common.BNBAsset, -20},
@@ -197,6 +252,20 @@ func (s EventSuite) TestEventDonate(c *C) {
 	c.Check(events, NotNil)
 }
 
+func (s EventSuite) TestEventSaversYield(c *C) {
+	bucket := NewPool()
+	bucket.Asset = common.BTCAsset.GetSyntheticAsset()
+	bucket.BalanceAsset = cosmos.NewUint(1100)
+	bucket.LPUnits = cosmos.NewUint(1000)
+	e := NewEventSaversYield(bucket, cosmos.NewUint(100))
+	c.Check(e.Type(), Equals, "savers_yield")
+	c.Check(e.Bucket.Equals(bucket.Asset), Equals, true)
+	c.Check(e.Amount.Uint64(), Equals, uint64(100))
+	events, err := e.Events()
+	c.Check(err, IsNil)
+	c.Check(events, HasLen, 1)
+}
+
 func (EventSuite) TestEventRefund(c *C) {
 	e := NewEventRefund(1, "refund", GetRandomTx(), common.NewFee(common.Coins{
 		common.NewCoin(common.BNBAsset, cosmos.NewUint(100)),
@@ -267,3 +336,50 @@ func (EventSuite) TestEventPoolStageCost(c *C) {
 	c.Check(err, IsNil)
 	c.Check(events, NotNil)
 }
+
+func (s EventSuite) TestEventIBCTransfer(c *C) {
+	coin := common.NewCoin(common.BaseAsset(), cosmos.NewUint(100))
+	evt := NewEventIBCTransfer(GetRandomTxHash(), "channel-0", 1, GetRandomBaseAddress(), coin, IBCTransferStatusSent)
+	c.Check(evt.Type(), Equals, "ibc_transfer")
+	events, err := evt.Events()
+	c.Check(err, IsNil)
+	c.Check(events, NotNil)
+}
+
+func (EventSuite) TestEventKeygen(c *C) {
+	members := []string{GetRandomPubKey().String(), GetRandomPubKey().String()}
+	keygen, err := NewKeygen(10, members, KeygenType_AsgardKeygen)
+	c.Assert(err, IsNil)
+	e := NewEventKeygen(10, keygen)
+	c.Check(e.Type(), Equals, "keygen")
+	events, err := e.Events()
+	c.Check(err, IsNil)
+	c.Assert(events, HasLen, 1)
+	attrs := map[string]string{}
+	for _, attr := range events[0].Attributes {
+		attrs[string(attr.Key)] = string(attr.Value)
+	}
+	c.Check(attrs["height"], Equals, "10")
+	c.Check(attrs["members"], Equals, strings.Join(members, ","))
+}
+
+func (EventSuite) TestEventScheduledOutboundHeight(c *C) {
+	e := NewEventScheduledOutbound(TxOutItem{Chain: common.BTCChain, VaultPubKey: GetRandomPubKey()})
+	events, err := e.Events()
+	c.Check(err, IsNil)
+	for _, attr := range events[0].Attributes {
+		c.Check(string(attr.Key), Not(Equals), "height")
+	}
+
+	e.Height = 25
+	events, err = e.Events()
+	c.Check(err, IsNil)
+	found := false
+	for _, attr := range events[0].Attributes {
+		if string(attr.Key) == "height" {
+			found = true
+			c.Check(string(attr.Value), Equals, "25")
+		}
+	}
+	c.Check(found, Equals, true)
+}
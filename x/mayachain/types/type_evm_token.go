@@ -0,0 +1,53 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"gitlab.com/mayachain/mayanode/common"
+)
+
+var _ codec.ProtoMarshaler = &EVMToken{}
+
+// EVMTokens a list of EVM tokens
+type EVMTokens []EVMToken
+
+// NewEVMToken create a new instance of EVMToken
+func NewEVMToken(chain common.Chain, address common.Address, symbol string, decimals int64) EVMToken {
+	return EVMToken{
+		Chain:    chain,
+		Address:  address,
+		Symbol:   symbol,
+		Decimals: decimals,
+	}
+}
+
+// Valid check whether the token represent valid information
+func (m *EVMToken) Valid() error {
+	if !m.Chain.IsEVM() {
+		return fmt.Errorf("%s is not an EVM chain", m.Chain)
+	}
+	if m.Address.IsEmpty() || !m.Address.IsChain(m.Chain) {
+		return fmt.Errorf("invalid token address: %s", m.Address)
+	}
+	// a delisted token only needs to be identified
+	if m.Delisted {
+		return nil
+	}
+	if len(m.Symbol) == 0 {
+		return errors.New("symbol cannot be empty")
+	}
+	if m.Decimals < 0 || m.Decimals > 36 {
+		return fmt.Errorf("invalid decimals: %d", m.Decimals)
+	}
+	return nil
+}
+
+// Key return a string which can be used to identify the token, token
+// addresses are case insensitive
+func (m EVMToken) Key() string {
+	return fmt.Sprintf("%s/%s", m.Chain, strings.ToLower(m.Address.String()))
+}
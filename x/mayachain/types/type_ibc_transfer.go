@@ -0,0 +1,62 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"gitlab.com/mayachain/mayanode/common"
+)
+
+var _ codec.ProtoMarshaler = &IBCTransfer{}
+
+// NewIBCTransfer create a new instance of IBCTransfer
+func NewIBCTransfer(channel string, sequence uint64, inHash common.TxID, moduleName string, sender, receiver common.Address, coin common.Coin, height int64) IBCTransfer {
+	return IBCTransfer{
+		Channel:    channel,
+		Sequence:   sequence,
+		InHash:     inHash,
+		ModuleName: moduleName,
+		Sender:     sender,
+		Receiver:   receiver,
+		Coin:       coin,
+		Height:     height,
+	}
+}
+
+// Valid check whether IBCTransfer represent valid information
+func (m *IBCTransfer) Valid() error {
+	if m.Channel == "" {
+		return errors.New("channel cannot be empty")
+	}
+	if m.Sequence == 0 {
+		return errors.New("sequence cannot be zero")
+	}
+	if m.InHash.IsEmpty() {
+		return errors.New("in hash cannot be empty")
+	}
+	if m.ModuleName == "" {
+		return errors.New("module name cannot be empty")
+	}
+	if m.Sender.IsEmpty() {
+		return errors.New("sender cannot be empty")
+	}
+	if m.Receiver.IsEmpty() {
+		return errors.New("receiver cannot be empty")
+	}
+	if err := m.Coin.Valid(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Key return a string which can be used to identify the IBC transfer
+func (m IBCTransfer) Key() string {
+	return IBCTransferKey(m.Channel, m.Sequence)
+}
+
+// IBCTransferKey return the key of an IBC transfer sent over the given channel
+func IBCTransferKey(channel string, sequence uint64) string {
+	return fmt.Sprintf("%s/%d", channel, sequence)
+}
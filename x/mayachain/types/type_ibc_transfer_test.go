@@ -0,0 +1,43 @@
+package types
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
+)
+
+type IBCTransferSuite struct{}
+
+var _ = Suite(&IBCTransferSuite{})
+
+func (IBCTransferSuite) TestIBCTransfer(c *C) {
+	sender := GetRandomBaseAddress()
+	receiver := common.Address("cosmos1xv9tklw7d82sezh9haa573wufgy59vmwe6xxe5")
+	coin := common.NewCoin(common.BaseAsset(), cosmos.NewUint(100*common.One))
+	transfer := NewIBCTransfer("channel-0", 1, GetRandomTxHash(), AsgardName, sender, receiver, coin, 10)
+	c.Assert(transfer.Valid(), IsNil)
+	c.Check(transfer.Key(), Equals, "channel-0/1")
+
+	transfer1 := transfer
+	transfer1.Channel = ""
+	c.Check(transfer1.Valid(), NotNil)
+	transfer1 = transfer
+	transfer1.Sequence = 0
+	c.Check(transfer1.Valid(), NotNil)
+	transfer1 = transfer
+	transfer1.InHash = ""
+	c.Check(transfer1.Valid(), NotNil)
+	transfer1 = transfer
+	transfer1.ModuleName = ""
+	c.Check(transfer1.Valid(), NotNil)
+	transfer1 = transfer
+	transfer1.Sender = common.NoAddress
+	c.Check(transfer1.Valid(), NotNil)
+	transfer1 = transfer
+	transfer1.Receiver = common.NoAddress
+	c.Check(transfer1.Valid(), NotNil)
+	transfer1 = transfer
+	transfer1.Coin = common.NoCoin
+	c.Check(transfer1.Valid(), NotNil)
+}
@@ -98,3 +98,34 @@ func (m NodeMimirs) HasMinority(key string, nas []cosmos.AccAddress) (int64, boo
 	// Minotirty is a bit tricky, because a set can have multiple minorities, which can result in a potential consensus failure
 	return 0, false
 }
+
+// Get returns the pending mimir of the signer for the given key
+func (m PendingMimirs) Get(key string, acc cosmos.AccAddress) (PendingMimir, bool) {
+	for _, mim := range m.Mimirs {
+		if mim.Signer.Equals(acc) && strings.EqualFold(mim.Key, key) {
+			return mim, true
+		}
+	}
+	return PendingMimir{}, false
+}
+
+// Set schedules the mimir, replacing the one of the same signer and key
+func (m *PendingMimirs) Set(mimir PendingMimir) {
+	for i, mim := range m.Mimirs {
+		if mim.Signer.Equals(mimir.Signer) && strings.EqualFold(mim.Key, mimir.Key) {
+			m.Mimirs[i] = mimir
+			return
+		}
+	}
+	m.Mimirs = append(m.Mimirs, mimir)
+}
+
+// Delete removes the pending mimir of the signer for the given key
+func (m *PendingMimirs) Delete(key string, acc cosmos.AccAddress) {
+	for i, mim := range m.Mimirs {
+		if mim.Signer.Equals(acc) && strings.EqualFold(mim.Key, key) {
+			m.Mimirs = append(m.Mimirs[:i], m.Mimirs[i+1:]...)
+			return
+		}
+	}
+}
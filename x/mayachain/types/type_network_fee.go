@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
 )
 
 // NewNetworkFee create a new instance of network fee
@@ -29,3 +30,30 @@ func (m *NetworkFee) Valid() error {
 	}
 	return nil
 }
+
+// NewOutboundFeeRecord create a new instance of OutboundFeeRecord
+func NewOutboundFeeRecord(chain common.Chain, height int64, withheld, spent cosmos.Uint) OutboundFeeRecord {
+	return OutboundFeeRecord{
+		Chain:            chain,
+		Height:           height,
+		FeeWithheldCacao: withheld,
+		FeeSpentCacao:    spent,
+	}
+}
+
+// Valid - check whether OutboundFeeRecord struct represent valid information
+func (m *OutboundFeeRecord) Valid() error {
+	if m.Chain.IsEmpty() {
+		return errors.New("chain can't be empty")
+	}
+	if m.Height <= 0 {
+		return fmt.Errorf("height can't be zero or negative: %d", m.Height)
+	}
+	return nil
+}
+
+// GetSurplus returns the outbound fees withheld minus the gas spent, which is
+// negative when the chain runs a deficit
+func (m *OutboundFeeRecord) GetSurplus() cosmos.Int {
+	return cosmos.NewIntFromBigInt(m.FeeWithheldCacao.BigInt()).Sub(cosmos.NewIntFromBigInt(m.FeeSpentCacao.BigInt()))
+}
@@ -4,6 +4,7 @@ import (
 	. "gopkg.in/check.v1"
 
 	"gitlab.com/mayachain/mayanode/common"
+	"gitlab.com/mayachain/mayanode/common/cosmos"
 )
 
 type NetworkFeeSuite struct{}
@@ -21,3 +22,16 @@ func (NetworkFeeSuite) TestNetworkFee(c *C) {
 	n3 := NewNetworkFee(common.BNBChain, 1, 0)
 	c.Check(n3.Valid(), NotNil)
 }
+
+func (NetworkFeeSuite) TestOutboundFeeRecord(c *C) {
+	r := NewOutboundFeeRecord(common.BTCChain, 10, cosmos.NewUint(300), cosmos.NewUint(100))
+	c.Check(r.Valid(), IsNil)
+	c.Check(r.GetSurplus().Int64(), Equals, int64(200))
+	r.FeeSpentCacao = cosmos.NewUint(500)
+	c.Check(r.GetSurplus().Int64(), Equals, int64(-200))
+
+	r1 := NewOutboundFeeRecord(common.EmptyChain, 10, cosmos.ZeroUint(), cosmos.ZeroUint())
+	c.Check(r1.Valid(), NotNil)
+	r2 := NewOutboundFeeRecord(common.BTCChain, 0, cosmos.ZeroUint(), cosmos.ZeroUint())
+	c.Check(r2.Valid(), NotNil)
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,6 +11,13 @@ import (
 	"gitlab.com/mayachain/mayanode/common/cosmos"
 )
 
+// Inclusion proof types, one per family of source chain
+const (
+	InclusionProofUTXOMerkle = "utxo-merkle"
+	InclusionProofEVMReceipt = "evm-receipt"
+	InclusionProofCosmosTx   = "cosmos-tx"
+)
+
 // ObservedTxs a list of ObservedTx
 type ObservedTxs []ObservedTx
 
@@ -44,6 +52,36 @@ func (m *ObservedTx) Valid() error {
 	return nil
 }
 
+// Valid check the inclusion proof is well formed, it doesn't verify the proof
+func (m *InclusionProof) Valid() error {
+	switch m.Type {
+	case InclusionProofUTXOMerkle, InclusionProofCosmosTx:
+	case InclusionProofEVMReceipt:
+		if len(m.TxRoot) == 0 {
+			return errors.New("tx root can't be empty")
+		}
+	default:
+		return fmt.Errorf("unknown type %q", m.Type)
+	}
+	if len(m.Root) == 0 {
+		return errors.New("root can't be empty")
+	}
+	if m.Index < 0 || m.Index >= m.Total {
+		return fmt.Errorf("index %d out of range of %d", m.Index, m.Total)
+	}
+	return nil
+}
+
+// Hash returns the hash of the inclusion proof together with the block hash it
+// was attached with, this is what is kept of a proof once it's been verified
+func (m *InclusionProof) Hash(blockHash string) string {
+	buf, err := m.Marshal()
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%X", sha256.Sum256(append([]byte(blockHash+"/"), buf...)))
+}
+
 // IsEmpty check whether the Tx is empty
 func (m *ObservedTx) IsEmpty() bool {
 	return m.Tx.IsEmpty()
@@ -269,6 +307,10 @@ func (m *ObservedTxVoter) Add(observedTx ObservedTx, signer cosmos.AccAddress) b
 
 	}
 	if votedIdx != -1 {
+		// observations don't all carry a proof, the first verified one is kept
+		if len(m.Txs[votedIdx].ProofHash) == 0 {
+			m.Txs[votedIdx].ProofHash = observedTx.ProofHash
+		}
 		return m.Txs[votedIdx].Sign(signer)
 	}
 	observedTx.Signers = []string{signer.String()}
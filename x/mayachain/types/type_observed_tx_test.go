@@ -429,6 +429,65 @@ func (TypeObservedTxSuite) TestObservedTxEquals(c *C) {
 	targetLimit = cosmos.NewUint(100)
 	tx2.AggregatorTargetLimit = &targetLimit
 	c.Assert(tx1.Equals(tx2), Equals, true)
+
+	// observations agree whether they carry a proof or not
+	proof := &InclusionProof{Type: InclusionProofUTXOMerkle, Root: "root", Total: 1}
+	tx1.BlockHash = "blockhash"
+	tx1.Proof = proof
+	c.Assert(tx1.Equals(tx2), Equals, true)
+	tx2.ProofHash = proof.Hash("otherhash")
+	c.Assert(tx1.Equals(tx2), Equals, true)
+}
+
+func (TypeObservedTxSuite) TestObservedTxVoterProofHash(c *C) {
+	tx := GetRandomTx()
+	pk := GetRandomPubKey()
+	voter := NewObservedTxVoter(tx.ID, nil)
+	proof := &InclusionProof{Type: InclusionProofUTXOMerkle, Root: "root", Total: 1}
+
+	// a node that couldn't prove the tx votes along with the others
+	c.Check(voter.Add(NewObservedTx(tx, 1, pk, 1), GetRandomBech32Addr()), Equals, true)
+	withProof := NewObservedTx(tx, 1, pk, 1)
+	withProof.ProofHash = proof.Hash("blockhash")
+	c.Check(voter.Add(withProof, GetRandomBech32Addr()), Equals, true)
+	c.Assert(voter.Txs, HasLen, 1)
+	c.Check(voter.Txs[0].Signers, HasLen, 2)
+	c.Check(voter.Txs[0].ProofHash, Equals, proof.Hash("blockhash"))
+
+	// the first verified proof is kept
+	otherProof := NewObservedTx(tx, 1, pk, 1)
+	otherProof.ProofHash = proof.Hash("otherhash")
+	c.Check(voter.Add(otherProof, GetRandomBech32Addr()), Equals, true)
+	c.Assert(voter.Txs, HasLen, 1)
+	c.Check(voter.Txs[0].Signers, HasLen, 3)
+	c.Check(voter.Txs[0].ProofHash, Equals, proof.Hash("blockhash"))
+}
+
+func (TypeObservedTxSuite) TestInclusionProof(c *C) {
+	proof := &InclusionProof{
+		Type:  InclusionProofUTXOMerkle,
+		Root:  "root",
+		Index: 1,
+		Total: 2,
+	}
+	c.Assert(proof.Valid(), IsNil)
+	c.Check(proof.Hash("blockhash"), HasLen, 64)
+	c.Check(proof.Hash("blockhash"), Equals, proof.Hash("blockhash"))
+	c.Check(proof.Hash("blockhash"), Not(Equals), proof.Hash("otherhash"))
+
+	proof.Type = "bogus"
+	c.Check(proof.Valid(), NotNil)
+	proof.Type = InclusionProofEVMReceipt
+	c.Check(proof.Valid(), NotNil)
+	proof.TxRoot = "txroot"
+	c.Assert(proof.Valid(), IsNil)
+	proof.Root = ""
+	c.Check(proof.Valid(), NotNil)
+	proof.Root = "root"
+	proof.Index = 2
+	c.Check(proof.Valid(), NotNil)
+	proof.Index = -1
+	c.Check(proof.Valid(), NotNil)
 }
 
 func (TypeObservedTxSuite) TestObservedTxVote(c *C) {
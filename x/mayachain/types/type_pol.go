@@ -1,6 +1,9 @@
 package types
 
 import (
+	"errors"
+
+	"gitlab.com/mayachain/mayanode/common"
 	cosmos "gitlab.com/mayachain/mayanode/common/cosmos"
 )
 
@@ -25,3 +28,36 @@ func (pol ProtocolOwnedLiquidity) PnL(value cosmos.Uint) cosmos.Int {
 	v := cosmos.NewIntFromBigInt(value.BigInt())
 	return withdrawn.Sub(deposited).Add(v)
 }
+
+// NewPOLPool create a new instance of POLPool for the given pool
+func NewPOLPool(asset common.Asset) POLPool {
+	return POLPool{
+		Asset:          asset,
+		CacaoDeposited: cosmos.ZeroUint(),
+		CacaoWithdrawn: cosmos.ZeroUint(),
+	}
+}
+
+// Valid check whether POLPool has all the necessary fields
+func (pol POLPool) Valid() error {
+	if pol.Asset.IsEmpty() {
+		return errors.New("asset cannot be empty")
+	}
+	return nil
+}
+
+// CurrentDeposit - the entry basis of the POL in the pool, cacao deposited
+// minus cacao withdrawn
+func (pol POLPool) CurrentDeposit() cosmos.Int {
+	deposited := cosmos.NewIntFromBigInt(pol.CacaoDeposited.BigInt())
+	withdrawn := cosmos.NewIntFromBigInt(pol.CacaoWithdrawn.BigInt())
+	return deposited.Sub(withdrawn)
+}
+
+// PnL - Profit and Loss of the POL in the pool
+func (pol POLPool) PnL(value cosmos.Uint) cosmos.Int {
+	deposited := cosmos.NewIntFromBigInt(pol.CacaoDeposited.BigInt())
+	withdrawn := cosmos.NewIntFromBigInt(pol.CacaoWithdrawn.BigInt())
+	v := cosmos.NewIntFromBigInt(value.BigInt())
+	return withdrawn.Sub(deposited).Add(v)
+}
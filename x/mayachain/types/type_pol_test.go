@@ -3,6 +3,7 @@ package types
 import (
 	. "gopkg.in/check.v1"
 
+	"gitlab.com/mayachain/mayanode/common"
 	cosmos "gitlab.com/mayachain/mayanode/common/cosmos"
 )
 
@@ -39,3 +40,18 @@ func (s *ProtocolOwnedLiquiditySuite) PnL(c *C) {
 	pol.CacaoWithdrawn = cosmos.NewUint(10)
 	c.Check(pol.PnL(cosmos.NewUint(30)).Int64(), Equals, int64(15))
 }
+
+func (s *ProtocolOwnedLiquiditySuite) TestPOLPool(c *C) {
+	pol := NewPOLPool(common.BTCAsset)
+	c.Check(pol.Valid(), IsNil)
+	c.Check(pol.CacaoDeposited.Uint64(), Equals, uint64(0))
+	c.Check(pol.CacaoWithdrawn.Uint64(), Equals, uint64(0))
+
+	pol.CacaoDeposited = cosmos.NewUint(100)
+	pol.CacaoWithdrawn = cosmos.NewUint(25)
+	c.Check(pol.CurrentDeposit().Int64(), Equals, int64(75))
+	c.Check(pol.PnL(cosmos.NewUint(30)).Int64(), Equals, int64(-45))
+	c.Check(pol.PnL(cosmos.NewUint(100)).Int64(), Equals, int64(25))
+
+	c.Check(NewPOLPool(common.EmptyAsset).Valid(), NotNil)
+}
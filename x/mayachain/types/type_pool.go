@@ -232,3 +232,80 @@ func (m Pool) GetLUVI() cosmos.Uint {
 	result := bigInt.Quo(num, denom)
 	return cosmos.NewUintFromBigInt(result)
 }
+
+// GetUnitValue returns the value of a liquidity unit of the pool, which is the
+// LUVI of a layer1 pool, and the asset balance per unit of a savers bucket
+func (m Pool) GetUnitValue() cosmos.Uint {
+	if !m.Asset.IsVaultAsset() {
+		return m.GetLUVI()
+	}
+	if m.LPUnits.IsZero() {
+		return cosmos.ZeroUint()
+	}
+	return m.BalanceAsset.MulUint64(1e12).Quo(m.LPUnits)
+}
+
+// NewPoolSnapshot create a snapshot of the given pool at the given height, the
+// pool units are expected to be calculated already
+func NewPoolSnapshot(height int64, pool Pool, liquidityFees, swapVolume cosmos.Uint) PoolSnapshot {
+	return PoolSnapshot{
+		Asset:         pool.Asset,
+		Height:        height,
+		BalanceCacao:  pool.BalanceCacao,
+		BalanceAsset:  pool.BalanceAsset,
+		LPUnits:       pool.LPUnits,
+		SynthUnits:    pool.SynthUnits,
+		LiquidityFees: liquidityFees,
+		SwapVolume:    swapVolume,
+	}
+}
+
+// Valid check whether the pool snapshot is valid or not
+func (m PoolSnapshot) Valid() error {
+	if m.Asset.IsEmpty() {
+		return errors.New("pool snapshot asset cannot be empty")
+	}
+	if m.Height <= 0 {
+		return errors.New("pool snapshot height must be positive")
+	}
+	return nil
+}
+
+// GetPoolUnits return the sum of the LP and synth units of the snapshot
+func (m PoolSnapshot) GetPoolUnits() cosmos.Uint {
+	return m.LPUnits.Add(m.SynthUnits)
+}
+
+func (m PoolSnapshot) getPool() Pool {
+	return Pool{
+		Asset:        m.Asset,
+		BalanceCacao: m.BalanceCacao,
+		BalanceAsset: m.BalanceAsset,
+		LPUnits:      m.LPUnits,
+		SynthUnits:   m.SynthUnits,
+	}
+}
+
+// GetLUVI returns the liquidity unit value index of the pool at the snapshot
+func (m PoolSnapshot) GetLUVI() cosmos.Uint {
+	return m.getPool().GetLUVI()
+}
+
+// GetUnitValue returns the value of a liquidity unit of the pool at the snapshot
+func (m PoolSnapshot) GetUnitValue() cosmos.Uint {
+	return m.getPool().GetUnitValue()
+}
+
+// String implement fmt.Stringer
+func (m PoolSnapshot) String() string {
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintln("asset: " + m.Asset.String()))
+	sb.WriteString(fmt.Sprintln("height: " + strconv.FormatInt(m.Height, 10)))
+	sb.WriteString(fmt.Sprintln("cacao-balance: " + m.BalanceCacao.String()))
+	sb.WriteString(fmt.Sprintln("asset-balance: " + m.BalanceAsset.String()))
+	sb.WriteString(fmt.Sprintln("lp-units: " + m.LPUnits.String()))
+	sb.WriteString(fmt.Sprintln("synth-units: " + m.SynthUnits.String()))
+	sb.WriteString(fmt.Sprintln("liquidity-fees: " + m.LiquidityFees.String()))
+	sb.WriteString(fmt.Sprintln("swap-volume: " + m.SwapVolume.String()))
+	return sb.String()
+}
@@ -178,3 +178,24 @@ func (PoolTestSuite) TestLUVI(c *C) {
 	p.SynthUnits = cosmos.NewUint(12)
 	c.Check(p.GetLUVI().String(), Equals, "812766415156")
 }
+
+func (PoolTestSuite) TestUnitValue(c *C) {
+	p := NewPool()
+	p.Asset = common.BTCAsset
+	p.BalanceCacao = cosmos.NewUint(100)
+	p.BalanceAsset = cosmos.NewUint(50)
+	p.LPUnits = cosmos.NewUint(75)
+	p.SynthUnits = cosmos.NewUint(12)
+	c.Check(p.GetUnitValue().Equal(p.GetLUVI()), Equals, true)
+
+	// savers buckets have no cacao, a unit is worth its share of the asset
+	p.Asset = common.BTCAsset.GetSyntheticAsset()
+	p.BalanceCacao = cosmos.ZeroUint()
+	p.SynthUnits = cosmos.ZeroUint()
+	c.Check(p.GetUnitValue().String(), Equals, "666666666666")
+	snapshot := NewPoolSnapshot(10, p, cosmos.ZeroUint(), cosmos.ZeroUint())
+	c.Check(snapshot.GetUnitValue().String(), Equals, "666666666666")
+
+	p.LPUnits = cosmos.ZeroUint()
+	c.Check(p.GetUnitValue().IsZero(), Equals, true)
+}
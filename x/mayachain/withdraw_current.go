@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/blang/semver"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"gitlab.com/mayachain/mayanode/common"
 	"gitlab.com/mayachain/mayanode/common/cosmos"
@@ -83,37 +84,17 @@ func withdrawV105(ctx cosmos.Context, msg MsgWithdrawLiquidity, mgr Manager) (co
 	}
 
 	// calculate any impermanent loss protection or not
-	protectionCacaoAmount := cosmos.ZeroUint()
 	extraUnits := cosmos.ZeroUint()
-	fullProtectionLine, err := mgr.Keeper().GetMimir(ctx, constants.FullImpLossProtectionBlocks.String())
-	if fullProtectionLine < 0 || err != nil {
-		fullProtectionLine = cv.GetInt64Value(constants.FullImpLossProtectionBlocks)
-	}
-	ilpPoolMimirKey := fmt.Sprintf("ILP-DISABLED-%s", pool.Asset)
-	ilpDisabled, err := mgr.Keeper().GetMimir(ctx, ilpPoolMimirKey)
-	if err != nil {
-		ctx.Logger().Error("fail to get ILP-DISABLED mimir", "error", err, "key", ilpPoolMimirKey)
-		ilpDisabled = 0
-	}
-	// only when Pool is in Available status will apply impermanent loss protection
-	if fullProtectionLine > 0 && pool.Status == PoolAvailable && !(ilpDisabled > 0 && !pool.Asset.IsVaultAsset()) { // if protection line is zero, no imp loss protection is given
-		lastAddHeight := lp.LastAddHeight
-		if lastAddHeight < pool.StatusSince {
-			lastAddHeight = pool.StatusSince
-		}
-		implProtectionCacaoAmount, depositValue, redeemValue := calcImpLossV102(ctx, mgr, lastAddHeight, lp, msg.BasisPoints, fullProtectionLine, pool)
-		ctx.Logger().Info("imp loss calculation", "deposit value", depositValue, "redeem value", redeemValue, "protection", implProtectionCacaoAmount)
-		if !implProtectionCacaoAmount.IsZero() {
-			protectionCacaoAmount = implProtectionCacaoAmount
-			_, extraUnits, err = calculatePoolUnitsV1(pool.GetPoolUnits(), poolCacao, poolAsset, implProtectionCacaoAmount, cosmos.ZeroUint())
-			if err != nil {
-				return cosmos.ZeroUint(), cosmos.ZeroUint(), cosmos.ZeroUint(), cosmos.ZeroUint(), cosmos.ZeroUint(), err
-			}
-			ctx.Logger().Info("liquidity provider granted imp loss protection", "extra provider units", extraUnits, "extra rune", implProtectionCacaoAmount)
-			poolCacao = poolCacao.Add(implProtectionCacaoAmount)
-			fLiquidityProviderUnit = fLiquidityProviderUnit.Add(extraUnits)
-			pool.LPUnits = pool.LPUnits.Add(extraUnits)
+	protectionCacaoAmount := impLossProtectionV105(ctx, mgr, pool, lp, msg.BasisPoints)
+	if !protectionCacaoAmount.IsZero() {
+		_, extraUnits, err = calculatePoolUnitsV1(pool.GetPoolUnits(), poolCacao, poolAsset, protectionCacaoAmount, cosmos.ZeroUint())
+		if err != nil {
+			return cosmos.ZeroUint(), cosmos.ZeroUint(), cosmos.ZeroUint(), cosmos.ZeroUint(), cosmos.ZeroUint(), err
 		}
+		ctx.Logger().Info("liquidity provider granted imp loss protection", "extra provider units", extraUnits, "extra rune", protectionCacaoAmount)
+		poolCacao = poolCacao.Add(protectionCacaoAmount)
+		fLiquidityProviderUnit = fLiquidityProviderUnit.Add(extraUnits)
+		pool.LPUnits = pool.LPUnits.Add(extraUnits)
 	}
 
 	var withdrawCacao, withDrawAsset, unitAfter cosmos.Uint
@@ -206,6 +187,11 @@ func withdrawV105(ctx cosmos.Context, msg MsgWithdrawLiquidity, mgr Manager) (co
 			mgr.Keeper().RemoveLiquidityProvider(ctx, lp)
 		}
 	}
+	if pool.Asset.IsVaultAsset() && lp.Units.IsZero() && mgr.GetVersion().GTE(semver.MustParse("1.106.0")) {
+		if err := mgr.Keeper().AddSaversCount(ctx, pool.Asset, -1); err != nil {
+			ctx.Logger().Error("fail to remove saver from count", "bucket", pool.Asset, "error", err)
+		}
+	}
 	// add rune from the reserve to the asgard module, to cover imp loss protection
 	if !protectionCacaoAmount.IsZero() {
 		err := mgr.Keeper().SendFromModuleToModule(ctx, ReserveName, AsgardName, common.NewCoins(common.NewCoin(common.BaseAsset(), protectionCacaoAmount)))
@@ -216,6 +202,133 @@ func withdrawV105(ctx cosmos.Context, msg MsgWithdrawLiquidity, mgr Manager) (co
 	return withdrawCacao, withDrawAsset, protectionCacaoAmount, common.SafeSub(originalLiquidityProviderUnits, unitAfter), gasAsset, nil
 }
 
+// impLossProtectionV105 returns the impermanent loss protection, in cacao, the
+// liquidity provider is entitled to when withdrawing the given basis points
+func impLossProtectionV105(ctx cosmos.Context, mgr Manager, pool Pool, lp LiquidityProvider, withdrawBasisPoints cosmos.Uint) cosmos.Uint {
+	fullProtectionLine, err := mgr.Keeper().GetMimir(ctx, constants.FullImpLossProtectionBlocks.String())
+	if fullProtectionLine < 0 || err != nil {
+		fullProtectionLine = mgr.GetConstants().GetInt64Value(constants.FullImpLossProtectionBlocks)
+	}
+	ilpDisabled := isImpLossProtectionDisabled(ctx, mgr, pool)
+	// only when Pool is in Available status will apply impermanent loss protection
+	// if protection line is zero, no imp loss protection is given
+	if fullProtectionLine <= 0 || pool.Status != PoolAvailable || ilpDisabled {
+		return cosmos.ZeroUint()
+	}
+	lastAddHeight := lp.LastAddHeight
+	if lastAddHeight < pool.StatusSince {
+		lastAddHeight = pool.StatusSince
+	}
+	protection, depositValue, redeemValue := calcImpLossV102(ctx, mgr, lastAddHeight, lp, withdrawBasisPoints, fullProtectionLine, pool)
+	ctx.Logger().Info("imp loss calculation", "deposit value", depositValue, "redeem value", redeemValue, "protection", protection)
+	return protection
+}
+
+// isImpLossProtectionDisabled checks the ILP-DISABLED-<asset> mimir, vaults
+// are not affected by it
+func isImpLossProtectionDisabled(ctx cosmos.Context, mgr Manager, pool Pool) bool {
+	ilpPoolMimirKey := fmt.Sprintf("ILP-DISABLED-%s", pool.Asset)
+	ilpDisabled, err := mgr.Keeper().GetMimir(ctx, ilpPoolMimirKey)
+	if err != nil {
+		ctx.Logger().Error("fail to get ILP-DISABLED mimir", "error", err, "key", ilpPoolMimirKey)
+		return false
+	}
+	return ilpDisabled > 0 && !pool.Asset.IsVaultAsset()
+}
+
+// withdrawEstimate is what a liquidity provider would receive withdrawing
+// their whole position at the current height
+type withdrawEstimate struct {
+	CacaoAmount           cosmos.Uint
+	AssetAmount           cosmos.Uint
+	ProtectionCacaoAmount cosmos.Uint
+	// position value when deposited and now, both in cacao at the current pool
+	// price, or in the asset for savers as their yield is paid in the asset
+	DepositValue          cosmos.Uint
+	RedeemValue           cosmos.Uint
+	GrowthBasisPoints     int64
+	LockUpBlocksRemaining int64
+}
+
+// estimateWithdrawV105 runs the withdraw calculation of withdrawV105 for a full
+// symmetrical withdrawal without touching the store. Outbound fees and the gas
+// kept back when the last liquidity provider leaves a pool are not included.
+func estimateWithdrawV105(ctx cosmos.Context, mgr Manager, pool Pool, lp LiquidityProvider) (withdrawEstimate, error) {
+	est := withdrawEstimate{
+		CacaoAmount:           cosmos.ZeroUint(),
+		AssetAmount:           cosmos.ZeroUint(),
+		ProtectionCacaoAmount: cosmos.ZeroUint(),
+		DepositValue:          cosmos.ZeroUint(),
+		RedeemValue:           cosmos.ZeroUint(),
+	}
+	lockUpBlocks := mgr.GetConstants().GetInt64Value(constants.LiquidityLockUpBlocks)
+	if remaining := lp.LastAddHeight + lockUpBlocks - ctx.BlockHeight(); remaining > 0 {
+		est.LockUpBlocksRemaining = remaining
+	}
+
+	// liquidity which hasn't been paired yet is returned as is
+	if lp.Units.IsZero() {
+		est.CacaoAmount = lp.PendingCacao
+		est.AssetAmount = cosmos.RoundToDecimal(lp.PendingAsset, pool.Decimals)
+		est.DepositValue = estimateValue(pool, est.CacaoAmount, est.AssetAmount)
+		est.RedeemValue = est.DepositValue
+		return est, nil
+	}
+
+	synthSupply := mgr.Keeper().GetTotalSupply(ctx, pool.Asset.GetSyntheticAsset())
+	pool.CalcUnits(mgr.GetVersion(), synthSupply)
+	poolCacao := pool.BalanceCacao
+	poolAsset := pool.BalanceAsset
+	basisPoints := cosmos.NewUint(MaxWithdrawBasisPoints)
+
+	if pool.Status == PoolAvailable && lp.CacaoDepositValue.IsZero() && lp.AssetDepositValue.IsZero() {
+		lp.CacaoDepositValue = common.GetSafeShare(lp.Units, pool.GetPoolUnits(), pool.BalanceCacao)
+		lp.AssetDepositValue = common.GetSafeShare(lp.Units, pool.GetPoolUnits(), pool.BalanceAsset)
+	}
+	est.DepositValue = estimateValue(pool, lp.CacaoDepositValue, lp.AssetDepositValue)
+
+	extraUnits := cosmos.ZeroUint()
+	est.ProtectionCacaoAmount = impLossProtectionV105(ctx, mgr, pool, lp, basisPoints)
+	if !est.ProtectionCacaoAmount.IsZero() {
+		var err error
+		_, extraUnits, err = calculatePoolUnitsV1(pool.GetPoolUnits(), poolCacao, poolAsset, est.ProtectionCacaoAmount, cosmos.ZeroUint())
+		if err != nil {
+			return est, err
+		}
+		poolCacao = poolCacao.Add(est.ProtectionCacaoAmount)
+		pool.LPUnits = pool.LPUnits.Add(extraUnits)
+	}
+
+	pauseAsym, _ := mgr.Keeper().GetMimir(ctx, fmt.Sprintf("PauseAsymWithdrawal-%s", pool.Asset.GetChain()))
+	msg := MsgWithdrawLiquidity{Asset: pool.Asset, BasisPoints: basisPoints}
+	if pool.Asset.IsVaultAsset() {
+		est.CacaoAmount, est.AssetAmount, _ = calculateVaultWithdrawV1(pool.GetPoolUnits(), poolAsset, lp.Units, basisPoints)
+	} else {
+		var err error
+		est.CacaoAmount, est.AssetAmount, _, err = calculateWithdrawV102(pool.GetPoolUnits(), poolCacao, poolAsset, lp.Units, extraUnits, basisPoints, assetToWithdrawV89(msg, lp, pauseAsym))
+		if err != nil {
+			return est, err
+		}
+	}
+	est.AssetAmount = cosmos.RoundToDecimal(est.AssetAmount, pool.Decimals)
+
+	// value the redeemed amounts at the price before the withdrawal
+	est.RedeemValue = estimateValue(pool, est.CacaoAmount, est.AssetAmount)
+	if !est.DepositValue.IsZero() {
+		growth := cosmos.NewDecFromBigInt(est.RedeemValue.BigInt()).Sub(cosmos.NewDecFromBigInt(est.DepositValue.BigInt()))
+		est.GrowthBasisPoints = growth.MulInt64(MaxWithdrawBasisPoints).QuoInt(cosmos.NewIntFromBigInt(est.DepositValue.BigInt())).TruncateInt64()
+	}
+	return est, nil
+}
+
+// estimateValue values the given amounts the way withdrawEstimate reports them
+func estimateValue(pool Pool, cacaoAmt, assetAmt cosmos.Uint) cosmos.Uint {
+	if pool.Asset.IsVaultAsset() {
+		return assetAmt
+	}
+	return cacaoAmt.Add(pool.AssetValueInRune(assetAmt))
+}
+
 func validateWithdrawV105(ctx cosmos.Context, keeper keeper.Keeper, msg MsgWithdrawLiquidity) error {
 	if msg.WithdrawAddress.IsEmpty() {
 		return errors.New("empty withdraw address")
@@ -772,6 +772,80 @@ func (s *WithdrawSuiteV105) TestWithdrawWithImpermanentLossProtection(c *C) {
 	c.Assert(protectionCacaoAmt.Equal(cosmos.NewUint(113088)), Equals, true, Commentf("%d", protectionCacaoAmt.Uint64()))
 }
 
+func (s *WithdrawSuiteV105) TestEstimateWithdraw(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	pool := Pool{
+		BalanceCacao: cosmos.NewUint(100 * common.One),
+		BalanceAsset: cosmos.NewUint(100 * common.One),
+		Asset:        common.BTCAsset,
+		LPUnits:      cosmos.NewUint(200 * common.One),
+		Status:       PoolAvailable,
+	}
+	c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+	constantAccessor := constants.GetConstantValues(GetCurrentVersion())
+	addHandler := NewAddLiquidityHandler(mgr)
+	lpAddr := GetRandomBaseAddress()
+	c.Assert(addHandler.addLiquidity(ctx,
+		common.BTCAsset,
+		cosmos.NewUint(common.One),
+		cosmos.NewUint(common.One),
+		lpAddr,
+		GetRandomBTCAddress(),
+		GetRandomTx(),
+		false,
+		constantAccessor,
+		0), IsNil)
+
+	// move the price so the liquidity provider suffers some impermanent loss
+	p, err := mgr.Keeper().GetPool(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	p.BalanceCacao = p.BalanceCacao.Sub(cosmos.NewUint(5 * common.One))
+	p.BalanceAsset = p.BalanceAsset.Add(cosmos.NewUint(common.One))
+	c.Assert(mgr.Keeper().SetPool(ctx, p), IsNil)
+	lp, err := mgr.Keeper().GetLiquidityProvider(ctx, common.BTCAsset, lpAddr)
+	c.Assert(err, IsNil)
+
+	newctx := ctx.WithBlockHeight(ctx.BlockHeight() + 720_000*2)
+	est, err := estimateWithdrawV105(newctx, mgr, p, lp)
+	c.Assert(err, IsNil)
+	c.Check(est.LockUpBlocksRemaining, Equals, int64(0))
+	c.Check(est.ProtectionCacaoAmount.IsZero(), Equals, false)
+	c.Check(est.GrowthBasisPoints < 0, Equals, true, Commentf("%d", est.GrowthBasisPoints))
+	c.Check(est.DepositValue.GT(est.RedeemValue), Equals, true)
+
+	// the estimate matches the actual withdrawal
+	cacheCtx, _ := newctx.CacheContext()
+	msg := MsgWithdrawLiquidity{
+		WithdrawAddress: lpAddr,
+		BasisPoints:     cosmos.NewUint(MaxWithdrawBasisPoints),
+		Asset:           common.BTCAsset,
+		Tx:              common.Tx{ID: GetRandomTxHash()},
+		Signer:          GetRandomBech32Addr(),
+	}
+	cacaoAmt, assetAmt, protectionCacaoAmt, _, _, err := withdrawV105(cacheCtx, msg, mgr)
+	c.Assert(err, IsNil)
+	c.Check(est.CacaoAmount.Equal(cacaoAmt), Equals, true, Commentf("%s != %s", est.CacaoAmount, cacaoAmt))
+	c.Check(est.AssetAmount.Equal(assetAmt), Equals, true, Commentf("%s != %s", est.AssetAmount, assetAmt))
+	c.Check(est.ProtectionCacaoAmount.Equal(protectionCacaoAmt), Equals, true, Commentf("%s != %s", est.ProtectionCacaoAmount, protectionCacaoAmt))
+
+	// no protection once it is disabled for the pool
+	mgr.Keeper().SetMimir(ctx, fmt.Sprintf("ILP-DISABLED-%s", p.Asset), 1)
+	est, err = estimateWithdrawV105(newctx, mgr, p, lp)
+	c.Assert(err, IsNil)
+	c.Check(est.ProtectionCacaoAmount.IsZero(), Equals, true)
+	c.Check(est.CacaoAmount.LT(cacaoAmt), Equals, true)
+
+	// pending liquidity is returned as is
+	lp.Units = cosmos.ZeroUint()
+	lp.PendingCacao = cosmos.NewUint(common.One)
+	lp.PendingAsset = cosmos.ZeroUint()
+	est, err = estimateWithdrawV105(newctx, mgr, p, lp)
+	c.Assert(err, IsNil)
+	c.Check(est.CacaoAmount.Equal(cosmos.NewUint(common.One)), Equals, true)
+	c.Check(est.AssetAmount.IsZero(), Equals, true)
+	c.Check(est.GrowthBasisPoints, Equals, int64(0))
+}
+
 func (s *WithdrawSuiteV105) TestWithdrawPendingLiquidityShouldRoundToPoolDecimals(c *C) {
 	accountAddr := GetRandomValidatorNode(NodeActive).NodeAddress
 	ctx, mgr := setupManagerForTest(c)
@@ -1338,6 +1412,7 @@ func (WithdrawSuiteV105) TestWithdrawSynthSingleLP(c *C) {
 		PendingTxID:        GetRandomTxHash(),
 	}
 	mgr.Keeper().SetLiquidityProvider(ctx, lp)
+	c.Assert(mgr.Keeper().AddSaversCount(ctx, asset, 1), IsNil)
 	msg := MsgWithdrawLiquidity{
 		WithdrawAddress: lp.AssetAddress,
 		BasisPoints:     cosmos.NewUint(MaxWithdrawBasisPoints),
@@ -1353,6 +1428,11 @@ func (WithdrawSuiteV105) TestWithdrawSynthSingleLP(c *C) {
 	c.Check(unitsLeft.Uint64(), Equals, uint64(200*common.One), Commentf("%d", unitsLeft.Uint64()))
 	c.Check(gas.IsZero(), Equals, true)
 
+	// the saver left the bucket
+	savers, err := mgr.Keeper().GetSaversCount(ctx, asset)
+	c.Assert(err, IsNil)
+	c.Check(savers, Equals, int64(0))
+
 	pool, err = mgr.Keeper().GetPool(ctx, asset)
 	c.Check(err, IsNil)
 	c.Check(pool.BalanceCacao.Uint64(), Equals, uint64(0), Commentf("%d", pool.BalanceCacao.Uint64()))